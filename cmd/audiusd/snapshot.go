@@ -0,0 +1,47 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/AudiusProject/audiusd/pkg/core"
+	"github.com/AudiusProject/audiusd/pkg/core/config"
+	aLogger "github.com/AudiusProject/audiusd/pkg/logger"
+)
+
+const snapshotUsage = `usage: audiusd snapshot <export|import> <dir|bucket url>
+
+  export  write a snapshot of this node, which must be stopped
+  import  seed this node from a snapshot, its cometbft data must be empty
+
+the location is a local directory or a gocloud.dev/blob url such as s3://bucket?region=us-east-1
+`
+
+// runSnapshotCommand handles `audiusd snapshot ...` and returns the exit code
+func runSnapshotCommand(args []string) int {
+	if len(args) != 2 || (args[0] != "export" && args[0] != "import") {
+		fmt.Fprint(os.Stderr, snapshotUsage)
+		return 2
+	}
+
+	logger, err := aLogger.CreateLogger(config.GetRuntimeEnvironment(), config.GetLogLevel())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to create logger: %v\n", err)
+		return 1
+	}
+	defer logger.Sync()
+
+	ctx := context.Background()
+	switch args[0] {
+	case "export":
+		err = core.ExportSnapshot(ctx, logger, args[1])
+	case "import":
+		err = core.ImportSnapshot(ctx, logger, args[1])
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "snapshot %s failed: %v\n", args[0], err)
+		return 1
+	}
+	return 0
+}
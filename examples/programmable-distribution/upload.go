@@ -195,7 +195,10 @@ func uploadTrackExample(ctx context.Context, auds *sdk.AudiusdSDK, handler *Geol
 		},
 	}
 
-	transaction := &corev1beta1.Transaction{Envelope: envelope}
+	transaction, err := auds.SignEnvelope(envelope)
+	if err != nil {
+		return fmt.Errorf("failed to sign ERN envelope: %w", err)
+	}
 
 	submitRes, err := auds.Core.SendTransaction(ctx, connect.NewRequest(&corev1.SendTransactionRequest{
 		Transactionv2: transaction,
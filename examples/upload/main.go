@@ -187,8 +187,9 @@ func main() {
 		},
 	}
 
-	transaction := &corev1beta1.Transaction{
-		Envelope: envelope,
+	transaction, err := sdk.SignEnvelope(envelope)
+	if err != nil {
+		log.Fatalf("failed to sign envelope: %v", err)
 	}
 
 	submitRes, err := sdk.Core.SendTransaction(ctx, connect.NewRequest(&corev1.SendTransactionRequest{
@@ -25,7 +25,7 @@ var file_core_v1_service_proto_rawDesc = []byte{
 	0x0a, 0x15, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
 	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31,
 	0x1a, 0x13, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xae, 0x0e, 0x0a, 0x0b, 0x43, 0x6f, 0x72, 0x65, 0x53, 0x65,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xd7, 0x13, 0x0a, 0x0b, 0x43, 0x6f, 0x72, 0x65, 0x53, 0x65,
 	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x35, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x2e,
 	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69,
@@ -51,100 +51,142 @@ var file_core_v1_service_proto_rawDesc = []byte{
 	0x6f, 0x63, 0x6b, 0x73, 0x12, 0x19, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47,
 	0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
 	0x1a, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a,
-	0x0e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x1e, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1f, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x56, 0x0a, 0x0f, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x53, 0x65, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x12, 0x46, 0x6f,
-	0x72, 0x77, 0x61, 0x72, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x22, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x77, 0x61,
-	0x72, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46,
+	0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a,
+	0x0c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x1c, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x61,
+	0x0a, 0x12, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x22, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30,
+	0x01, 0x12, 0x53, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x0f, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5f,
+	0x0a, 0x12, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46,
 	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x77, 0x0a, 0x1a, 0x47,
-	0x65, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x74,
-	0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x77, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x63, 0x6f, 0x72, 0x65,
 	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
 	0x69, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41,
-	0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x7d, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x44, 0x65, 0x72, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x74,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7d, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x44,
+	0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x74,
+	0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x55, 0x6e,
+	0x6a, 0x61, 0x69, 0x6c, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x24, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x6a,
+	0x61, 0x69, 0x6c, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x55, 0x6e, 0x6a, 0x61, 0x69, 0x6c, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5f,
+	0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x73, 0x12, 0x22, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x62, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x41, 0x74, 0x74, 0x65, 0x73,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x41, 0x74,
 	0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x64,
-	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x12, 0x22, 0x2e, 0x63, 0x6f, 0x72, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x53, 0x6e, 0x61,
-	0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
-	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65,
-	0x64, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x62, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x6c, 0x61, 0x73, 0x68,
-	0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x2e, 0x63, 0x6f,
+	0x65, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x41,
+	0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x24, 0x2e, 0x63, 0x6f,
 	0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x41, 0x74,
-	0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x24, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6c,
-	0x61, 0x73, 0x68, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53,
+	0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x25, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53,
 	0x6c, 0x61, 0x73, 0x68, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x12, 0x24, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6c,
-	0x61, 0x73, 0x68, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x3b, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x45, 0x52, 0x4e, 0x12, 0x16, 0x2e, 0x63, 0x6f, 0x72, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x52, 0x4e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x17, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45,
-	0x52, 0x4e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x07,
-	0x47, 0x65, 0x74, 0x4d, 0x45, 0x41, 0x44, 0x12, 0x17, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x45, 0x41, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x18, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x45,
-	0x41, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x06,
-	0x47, 0x65, 0x74, 0x50, 0x49, 0x45, 0x12, 0x16, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x50, 0x49, 0x45, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17,
-	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x49, 0x45, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x09, 0x47, 0x65, 0x74,
-	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x19, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1a, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52,
-	0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x47, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x1a, 0x2e,
-	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72,
-	0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x6f, 0x72, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x52,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x06, 0x47, 0x65,
+	0x74, 0x45, 0x52, 0x4e, 0x12, 0x16, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x45, 0x52, 0x4e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x52, 0x4e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x45, 0x52,
+	0x4e, 0x58, 0x4d, 0x4c, 0x12, 0x19, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x45, 0x52, 0x4e, 0x58, 0x4d, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1a, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x52, 0x4e,
+	0x58, 0x4d, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a,
+	0x0d, 0x47, 0x65, 0x74, 0x45, 0x52, 0x4e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1d,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x52, 0x4e, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x52, 0x4e, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x4a, 0x0a, 0x0b, 0x50, 0x61, 0x72, 0x73, 0x65, 0x45, 0x52, 0x4e, 0x58, 0x4d, 0x4c, 0x12, 0x1b,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x45, 0x52,
+	0x4e, 0x58, 0x4d, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x45, 0x52, 0x4e, 0x58, 0x4d,
+	0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x07, 0x47,
+	0x65, 0x74, 0x4d, 0x45, 0x41, 0x44, 0x12, 0x17, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x4d, 0x45, 0x41, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x18, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x45, 0x41,
+	0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x06, 0x47,
+	0x65, 0x74, 0x50, 0x49, 0x45, 0x12, 0x16, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x50, 0x49, 0x45, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x49, 0x45, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x45,
+	0x6e, 0x72, 0x69, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x09, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x19, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x47, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x1a,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x14, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x24, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52,
 	0x65, 0x77, 0x61, 0x72, 0x64, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x24, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65,
-	0x77, 0x61, 0x72, 0x64, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x50, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x55, 0x52, 0x4c, 0x73,
-	0x12, 0x1d, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1e, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x53, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x79,
-	0x43, 0x49, 0x44, 0x12, 0x1e, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x79, 0x43, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x79, 0x43, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x32, 0x5a, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x41, 0x75, 0x64, 0x69, 0x75, 0x73, 0x50, 0x72, 0x6f, 0x6a, 0x65,
-	0x63, 0x74, 0x2f, 0x61, 0x75, 0x64, 0x69, 0x75, 0x73, 0x64, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x41, 0x74, 0x74, 0x65, 0x73,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x56, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x43, 0x6c, 0x61,
+	0x69, 0x6d, 0x73, 0x12, 0x1f, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x55, 0x52, 0x4c, 0x73, 0x12, 0x1d, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x55, 0x52, 0x4c,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x55, 0x52, 0x4c, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0e, 0x47, 0x65,
+	0x74, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x79, 0x43, 0x49, 0x44, 0x12, 0x1e, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x42, 0x79, 0x43, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x42, 0x79, 0x43, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42,
+	0x32, 0x5a, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x41, 0x75,
+	0x64, 0x69, 0x75, 0x73, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f, 0x61, 0x75, 0x64, 0x69,
+	0x75, 0x73, 0x64, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x72, 0x65,
+	0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var file_core_v1_service_proto_goTypes = []interface{}{
@@ -154,44 +196,60 @@ var file_core_v1_service_proto_goTypes = []interface{}{
 	(*GetNodeInfoRequest)(nil),                   // 3: core.v1.GetNodeInfoRequest
 	(*GetBlockRequest)(nil),                      // 4: core.v1.GetBlockRequest
 	(*GetBlocksRequest)(nil),                     // 5: core.v1.GetBlocksRequest
-	(*GetTransactionRequest)(nil),                // 6: core.v1.GetTransactionRequest
-	(*SendTransactionRequest)(nil),               // 7: core.v1.SendTransactionRequest
-	(*ForwardTransactionRequest)(nil),            // 8: core.v1.ForwardTransactionRequest
-	(*GetRegistrationAttestationRequest)(nil),    // 9: core.v1.GetRegistrationAttestationRequest
-	(*GetDeregistrationAttestationRequest)(nil),  // 10: core.v1.GetDeregistrationAttestationRequest
-	(*GetStoredSnapshotsRequest)(nil),            // 11: core.v1.GetStoredSnapshotsRequest
-	(*GetSlashAttestationRequest)(nil),           // 12: core.v1.GetSlashAttestationRequest
-	(*GetSlashAttestationsRequest)(nil),          // 13: core.v1.GetSlashAttestationsRequest
-	(*GetERNRequest)(nil),                        // 14: core.v1.GetERNRequest
-	(*GetMEADRequest)(nil),                       // 15: core.v1.GetMEADRequest
-	(*GetPIERequest)(nil),                        // 16: core.v1.GetPIERequest
-	(*GetRewardRequest)(nil),                     // 17: core.v1.GetRewardRequest
-	(*GetRewardsRequest)(nil),                    // 18: core.v1.GetRewardsRequest
-	(*GetRewardAttestationRequest)(nil),          // 19: core.v1.GetRewardAttestationRequest
-	(*GetStreamURLsRequest)(nil),                 // 20: core.v1.GetStreamURLsRequest
-	(*GetUploadByCIDRequest)(nil),                // 21: core.v1.GetUploadByCIDRequest
-	(*PingResponse)(nil),                         // 22: core.v1.PingResponse
-	(*GetHealthResponse)(nil),                    // 23: core.v1.GetHealthResponse
-	(*GetStatusResponse)(nil),                    // 24: core.v1.GetStatusResponse
-	(*GetNodeInfoResponse)(nil),                  // 25: core.v1.GetNodeInfoResponse
-	(*GetBlockResponse)(nil),                     // 26: core.v1.GetBlockResponse
-	(*GetBlocksResponse)(nil),                    // 27: core.v1.GetBlocksResponse
-	(*GetTransactionResponse)(nil),               // 28: core.v1.GetTransactionResponse
-	(*SendTransactionResponse)(nil),              // 29: core.v1.SendTransactionResponse
-	(*ForwardTransactionResponse)(nil),           // 30: core.v1.ForwardTransactionResponse
-	(*GetRegistrationAttestationResponse)(nil),   // 31: core.v1.GetRegistrationAttestationResponse
-	(*GetDeregistrationAttestationResponse)(nil), // 32: core.v1.GetDeregistrationAttestationResponse
-	(*GetStoredSnapshotsResponse)(nil),           // 33: core.v1.GetStoredSnapshotsResponse
-	(*GetSlashAttestationResponse)(nil),          // 34: core.v1.GetSlashAttestationResponse
-	(*GetSlashAttestationsResponse)(nil),         // 35: core.v1.GetSlashAttestationsResponse
-	(*GetERNResponse)(nil),                       // 36: core.v1.GetERNResponse
-	(*GetMEADResponse)(nil),                      // 37: core.v1.GetMEADResponse
-	(*GetPIEResponse)(nil),                       // 38: core.v1.GetPIEResponse
-	(*GetRewardResponse)(nil),                    // 39: core.v1.GetRewardResponse
-	(*GetRewardsResponse)(nil),                   // 40: core.v1.GetRewardsResponse
-	(*GetRewardAttestationResponse)(nil),         // 41: core.v1.GetRewardAttestationResponse
-	(*GetStreamURLsResponse)(nil),                // 42: core.v1.GetStreamURLsResponse
-	(*GetUploadByCIDResponse)(nil),               // 43: core.v1.GetUploadByCIDResponse
+	(*StreamBlocksRequest)(nil),                  // 6: core.v1.StreamBlocksRequest
+	(*StreamTransactionsRequest)(nil),            // 7: core.v1.StreamTransactionsRequest
+	(*GetTransactionRequest)(nil),                // 8: core.v1.GetTransactionRequest
+	(*SendTransactionRequest)(nil),               // 9: core.v1.SendTransactionRequest
+	(*ForwardTransactionRequest)(nil),            // 10: core.v1.ForwardTransactionRequest
+	(*GetRegistrationAttestationRequest)(nil),    // 11: core.v1.GetRegistrationAttestationRequest
+	(*GetDeregistrationAttestationRequest)(nil),  // 12: core.v1.GetDeregistrationAttestationRequest
+	(*GetUnjailAttestationRequest)(nil),          // 13: core.v1.GetUnjailAttestationRequest
+	(*GetStoredSnapshotsRequest)(nil),            // 14: core.v1.GetStoredSnapshotsRequest
+	(*GetSlashAttestationRequest)(nil),           // 15: core.v1.GetSlashAttestationRequest
+	(*GetSlashAttestationsRequest)(nil),          // 16: core.v1.GetSlashAttestationsRequest
+	(*GetERNRequest)(nil),                        // 17: core.v1.GetERNRequest
+	(*GetERNXMLRequest)(nil),                     // 18: core.v1.GetERNXMLRequest
+	(*GetERNHistoryRequest)(nil),                 // 19: core.v1.GetERNHistoryRequest
+	(*ParseERNXMLRequest)(nil),                   // 20: core.v1.ParseERNXMLRequest
+	(*GetMEADRequest)(nil),                       // 21: core.v1.GetMEADRequest
+	(*GetPIERequest)(nil),                        // 22: core.v1.GetPIERequest
+	(*GetEnrichmentRequest)(nil),                 // 23: core.v1.GetEnrichmentRequest
+	(*GetRewardRequest)(nil),                     // 24: core.v1.GetRewardRequest
+	(*GetRewardsRequest)(nil),                    // 25: core.v1.GetRewardsRequest
+	(*GetRewardAttestationRequest)(nil),          // 26: core.v1.GetRewardAttestationRequest
+	(*GetRewardClaimsRequest)(nil),               // 27: core.v1.GetRewardClaimsRequest
+	(*GetStreamURLsRequest)(nil),                 // 28: core.v1.GetStreamURLsRequest
+	(*GetUploadByCIDRequest)(nil),                // 29: core.v1.GetUploadByCIDRequest
+	(*PingResponse)(nil),                         // 30: core.v1.PingResponse
+	(*GetHealthResponse)(nil),                    // 31: core.v1.GetHealthResponse
+	(*GetStatusResponse)(nil),                    // 32: core.v1.GetStatusResponse
+	(*GetNodeInfoResponse)(nil),                  // 33: core.v1.GetNodeInfoResponse
+	(*GetBlockResponse)(nil),                     // 34: core.v1.GetBlockResponse
+	(*GetBlocksResponse)(nil),                    // 35: core.v1.GetBlocksResponse
+	(*StreamBlocksResponse)(nil),                 // 36: core.v1.StreamBlocksResponse
+	(*StreamTransactionsResponse)(nil),           // 37: core.v1.StreamTransactionsResponse
+	(*GetTransactionResponse)(nil),               // 38: core.v1.GetTransactionResponse
+	(*SendTransactionResponse)(nil),              // 39: core.v1.SendTransactionResponse
+	(*ForwardTransactionResponse)(nil),           // 40: core.v1.ForwardTransactionResponse
+	(*GetRegistrationAttestationResponse)(nil),   // 41: core.v1.GetRegistrationAttestationResponse
+	(*GetDeregistrationAttestationResponse)(nil), // 42: core.v1.GetDeregistrationAttestationResponse
+	(*GetUnjailAttestationResponse)(nil),         // 43: core.v1.GetUnjailAttestationResponse
+	(*GetStoredSnapshotsResponse)(nil),           // 44: core.v1.GetStoredSnapshotsResponse
+	(*GetSlashAttestationResponse)(nil),          // 45: core.v1.GetSlashAttestationResponse
+	(*GetSlashAttestationsResponse)(nil),         // 46: core.v1.GetSlashAttestationsResponse
+	(*GetERNResponse)(nil),                       // 47: core.v1.GetERNResponse
+	(*GetERNXMLResponse)(nil),                    // 48: core.v1.GetERNXMLResponse
+	(*GetERNHistoryResponse)(nil),                // 49: core.v1.GetERNHistoryResponse
+	(*ParseERNXMLResponse)(nil),                  // 50: core.v1.ParseERNXMLResponse
+	(*GetMEADResponse)(nil),                      // 51: core.v1.GetMEADResponse
+	(*GetPIEResponse)(nil),                       // 52: core.v1.GetPIEResponse
+	(*GetEnrichmentResponse)(nil),                // 53: core.v1.GetEnrichmentResponse
+	(*GetRewardResponse)(nil),                    // 54: core.v1.GetRewardResponse
+	(*GetRewardsResponse)(nil),                   // 55: core.v1.GetRewardsResponse
+	(*GetRewardAttestationResponse)(nil),         // 56: core.v1.GetRewardAttestationResponse
+	(*GetRewardClaimsResponse)(nil),              // 57: core.v1.GetRewardClaimsResponse
+	(*GetStreamURLsResponse)(nil),                // 58: core.v1.GetStreamURLsResponse
+	(*GetUploadByCIDResponse)(nil),               // 59: core.v1.GetUploadByCIDResponse
 }
 var file_core_v1_service_proto_depIdxs = []int32{
 	0,  // 0: core.v1.CoreService.Ping:input_type -> core.v1.PingRequest
@@ -200,46 +258,62 @@ var file_core_v1_service_proto_depIdxs = []int32{
 	3,  // 3: core.v1.CoreService.GetNodeInfo:input_type -> core.v1.GetNodeInfoRequest
 	4,  // 4: core.v1.CoreService.GetBlock:input_type -> core.v1.GetBlockRequest
 	5,  // 5: core.v1.CoreService.GetBlocks:input_type -> core.v1.GetBlocksRequest
-	6,  // 6: core.v1.CoreService.GetTransaction:input_type -> core.v1.GetTransactionRequest
-	7,  // 7: core.v1.CoreService.SendTransaction:input_type -> core.v1.SendTransactionRequest
-	8,  // 8: core.v1.CoreService.ForwardTransaction:input_type -> core.v1.ForwardTransactionRequest
-	9,  // 9: core.v1.CoreService.GetRegistrationAttestation:input_type -> core.v1.GetRegistrationAttestationRequest
-	10, // 10: core.v1.CoreService.GetDeregistrationAttestation:input_type -> core.v1.GetDeregistrationAttestationRequest
-	11, // 11: core.v1.CoreService.GetStoredSnapshots:input_type -> core.v1.GetStoredSnapshotsRequest
-	12, // 12: core.v1.CoreService.GetSlashAttestation:input_type -> core.v1.GetSlashAttestationRequest
-	13, // 13: core.v1.CoreService.GetSlashAttestations:input_type -> core.v1.GetSlashAttestationsRequest
-	14, // 14: core.v1.CoreService.GetERN:input_type -> core.v1.GetERNRequest
-	15, // 15: core.v1.CoreService.GetMEAD:input_type -> core.v1.GetMEADRequest
-	16, // 16: core.v1.CoreService.GetPIE:input_type -> core.v1.GetPIERequest
-	17, // 17: core.v1.CoreService.GetReward:input_type -> core.v1.GetRewardRequest
-	18, // 18: core.v1.CoreService.GetRewards:input_type -> core.v1.GetRewardsRequest
-	19, // 19: core.v1.CoreService.GetRewardAttestation:input_type -> core.v1.GetRewardAttestationRequest
-	20, // 20: core.v1.CoreService.GetStreamURLs:input_type -> core.v1.GetStreamURLsRequest
-	21, // 21: core.v1.CoreService.GetUploadByCID:input_type -> core.v1.GetUploadByCIDRequest
-	22, // 22: core.v1.CoreService.Ping:output_type -> core.v1.PingResponse
-	23, // 23: core.v1.CoreService.GetHealth:output_type -> core.v1.GetHealthResponse
-	24, // 24: core.v1.CoreService.GetStatus:output_type -> core.v1.GetStatusResponse
-	25, // 25: core.v1.CoreService.GetNodeInfo:output_type -> core.v1.GetNodeInfoResponse
-	26, // 26: core.v1.CoreService.GetBlock:output_type -> core.v1.GetBlockResponse
-	27, // 27: core.v1.CoreService.GetBlocks:output_type -> core.v1.GetBlocksResponse
-	28, // 28: core.v1.CoreService.GetTransaction:output_type -> core.v1.GetTransactionResponse
-	29, // 29: core.v1.CoreService.SendTransaction:output_type -> core.v1.SendTransactionResponse
-	30, // 30: core.v1.CoreService.ForwardTransaction:output_type -> core.v1.ForwardTransactionResponse
-	31, // 31: core.v1.CoreService.GetRegistrationAttestation:output_type -> core.v1.GetRegistrationAttestationResponse
-	32, // 32: core.v1.CoreService.GetDeregistrationAttestation:output_type -> core.v1.GetDeregistrationAttestationResponse
-	33, // 33: core.v1.CoreService.GetStoredSnapshots:output_type -> core.v1.GetStoredSnapshotsResponse
-	34, // 34: core.v1.CoreService.GetSlashAttestation:output_type -> core.v1.GetSlashAttestationResponse
-	35, // 35: core.v1.CoreService.GetSlashAttestations:output_type -> core.v1.GetSlashAttestationsResponse
-	36, // 36: core.v1.CoreService.GetERN:output_type -> core.v1.GetERNResponse
-	37, // 37: core.v1.CoreService.GetMEAD:output_type -> core.v1.GetMEADResponse
-	38, // 38: core.v1.CoreService.GetPIE:output_type -> core.v1.GetPIEResponse
-	39, // 39: core.v1.CoreService.GetReward:output_type -> core.v1.GetRewardResponse
-	40, // 40: core.v1.CoreService.GetRewards:output_type -> core.v1.GetRewardsResponse
-	41, // 41: core.v1.CoreService.GetRewardAttestation:output_type -> core.v1.GetRewardAttestationResponse
-	42, // 42: core.v1.CoreService.GetStreamURLs:output_type -> core.v1.GetStreamURLsResponse
-	43, // 43: core.v1.CoreService.GetUploadByCID:output_type -> core.v1.GetUploadByCIDResponse
-	22, // [22:44] is the sub-list for method output_type
-	0,  // [0:22] is the sub-list for method input_type
+	6,  // 6: core.v1.CoreService.StreamBlocks:input_type -> core.v1.StreamBlocksRequest
+	7,  // 7: core.v1.CoreService.StreamTransactions:input_type -> core.v1.StreamTransactionsRequest
+	8,  // 8: core.v1.CoreService.GetTransaction:input_type -> core.v1.GetTransactionRequest
+	9,  // 9: core.v1.CoreService.SendTransaction:input_type -> core.v1.SendTransactionRequest
+	10, // 10: core.v1.CoreService.ForwardTransaction:input_type -> core.v1.ForwardTransactionRequest
+	11, // 11: core.v1.CoreService.GetRegistrationAttestation:input_type -> core.v1.GetRegistrationAttestationRequest
+	12, // 12: core.v1.CoreService.GetDeregistrationAttestation:input_type -> core.v1.GetDeregistrationAttestationRequest
+	13, // 13: core.v1.CoreService.GetUnjailAttestation:input_type -> core.v1.GetUnjailAttestationRequest
+	14, // 14: core.v1.CoreService.GetStoredSnapshots:input_type -> core.v1.GetStoredSnapshotsRequest
+	15, // 15: core.v1.CoreService.GetSlashAttestation:input_type -> core.v1.GetSlashAttestationRequest
+	16, // 16: core.v1.CoreService.GetSlashAttestations:input_type -> core.v1.GetSlashAttestationsRequest
+	17, // 17: core.v1.CoreService.GetERN:input_type -> core.v1.GetERNRequest
+	18, // 18: core.v1.CoreService.GetERNXML:input_type -> core.v1.GetERNXMLRequest
+	19, // 19: core.v1.CoreService.GetERNHistory:input_type -> core.v1.GetERNHistoryRequest
+	20, // 20: core.v1.CoreService.ParseERNXML:input_type -> core.v1.ParseERNXMLRequest
+	21, // 21: core.v1.CoreService.GetMEAD:input_type -> core.v1.GetMEADRequest
+	22, // 22: core.v1.CoreService.GetPIE:input_type -> core.v1.GetPIERequest
+	23, // 23: core.v1.CoreService.GetEnrichment:input_type -> core.v1.GetEnrichmentRequest
+	24, // 24: core.v1.CoreService.GetReward:input_type -> core.v1.GetRewardRequest
+	25, // 25: core.v1.CoreService.GetRewards:input_type -> core.v1.GetRewardsRequest
+	26, // 26: core.v1.CoreService.GetRewardAttestation:input_type -> core.v1.GetRewardAttestationRequest
+	27, // 27: core.v1.CoreService.GetRewardClaims:input_type -> core.v1.GetRewardClaimsRequest
+	28, // 28: core.v1.CoreService.GetStreamURLs:input_type -> core.v1.GetStreamURLsRequest
+	29, // 29: core.v1.CoreService.GetUploadByCID:input_type -> core.v1.GetUploadByCIDRequest
+	30, // 30: core.v1.CoreService.Ping:output_type -> core.v1.PingResponse
+	31, // 31: core.v1.CoreService.GetHealth:output_type -> core.v1.GetHealthResponse
+	32, // 32: core.v1.CoreService.GetStatus:output_type -> core.v1.GetStatusResponse
+	33, // 33: core.v1.CoreService.GetNodeInfo:output_type -> core.v1.GetNodeInfoResponse
+	34, // 34: core.v1.CoreService.GetBlock:output_type -> core.v1.GetBlockResponse
+	35, // 35: core.v1.CoreService.GetBlocks:output_type -> core.v1.GetBlocksResponse
+	36, // 36: core.v1.CoreService.StreamBlocks:output_type -> core.v1.StreamBlocksResponse
+	37, // 37: core.v1.CoreService.StreamTransactions:output_type -> core.v1.StreamTransactionsResponse
+	38, // 38: core.v1.CoreService.GetTransaction:output_type -> core.v1.GetTransactionResponse
+	39, // 39: core.v1.CoreService.SendTransaction:output_type -> core.v1.SendTransactionResponse
+	40, // 40: core.v1.CoreService.ForwardTransaction:output_type -> core.v1.ForwardTransactionResponse
+	41, // 41: core.v1.CoreService.GetRegistrationAttestation:output_type -> core.v1.GetRegistrationAttestationResponse
+	42, // 42: core.v1.CoreService.GetDeregistrationAttestation:output_type -> core.v1.GetDeregistrationAttestationResponse
+	43, // 43: core.v1.CoreService.GetUnjailAttestation:output_type -> core.v1.GetUnjailAttestationResponse
+	44, // 44: core.v1.CoreService.GetStoredSnapshots:output_type -> core.v1.GetStoredSnapshotsResponse
+	45, // 45: core.v1.CoreService.GetSlashAttestation:output_type -> core.v1.GetSlashAttestationResponse
+	46, // 46: core.v1.CoreService.GetSlashAttestations:output_type -> core.v1.GetSlashAttestationsResponse
+	47, // 47: core.v1.CoreService.GetERN:output_type -> core.v1.GetERNResponse
+	48, // 48: core.v1.CoreService.GetERNXML:output_type -> core.v1.GetERNXMLResponse
+	49, // 49: core.v1.CoreService.GetERNHistory:output_type -> core.v1.GetERNHistoryResponse
+	50, // 50: core.v1.CoreService.ParseERNXML:output_type -> core.v1.ParseERNXMLResponse
+	51, // 51: core.v1.CoreService.GetMEAD:output_type -> core.v1.GetMEADResponse
+	52, // 52: core.v1.CoreService.GetPIE:output_type -> core.v1.GetPIEResponse
+	53, // 53: core.v1.CoreService.GetEnrichment:output_type -> core.v1.GetEnrichmentResponse
+	54, // 54: core.v1.CoreService.GetReward:output_type -> core.v1.GetRewardResponse
+	55, // 55: core.v1.CoreService.GetRewards:output_type -> core.v1.GetRewardsResponse
+	56, // 56: core.v1.CoreService.GetRewardAttestation:output_type -> core.v1.GetRewardAttestationResponse
+	57, // 57: core.v1.CoreService.GetRewardClaims:output_type -> core.v1.GetRewardClaimsResponse
+	58, // 58: core.v1.CoreService.GetStreamURLs:output_type -> core.v1.GetStreamURLsResponse
+	59, // 59: core.v1.CoreService.GetUploadByCID:output_type -> core.v1.GetUploadByCIDResponse
+	30, // [30:60] is the sub-list for method output_type
+	0,  // [0:30] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
@@ -142,6 +142,139 @@ func (GetStatusResponse_SyncInfo_StateSyncInfo_Phase) EnumDescriptor() ([]byte,
 	return file_core_v1_types_proto_rawDescGZIP(), []int{5, 3, 0, 0}
 }
 
+type GetStreamURLsRequest_Mode int32
+
+const (
+	// same as MODE_OWNER
+	GetStreamURLsRequest_MODE_UNSPECIFIED GetStreamURLsRequest_Mode = 0
+	// signer must be the ERN sender, deals are not evaluated
+	GetStreamURLsRequest_MODE_OWNER GetStreamURLsRequest_Mode = 1
+	// urls are only issued where a deal permits the requested use
+	// in the requester's territory at the current time
+	GetStreamURLsRequest_MODE_LISTENER GetStreamURLsRequest_Mode = 2
+)
+
+// Enum value maps for GetStreamURLsRequest_Mode.
+var (
+	GetStreamURLsRequest_Mode_name = map[int32]string{
+		0: "MODE_UNSPECIFIED",
+		1: "MODE_OWNER",
+		2: "MODE_LISTENER",
+	}
+	GetStreamURLsRequest_Mode_value = map[string]int32{
+		"MODE_UNSPECIFIED": 0,
+		"MODE_OWNER":       1,
+		"MODE_LISTENER":    2,
+	}
+)
+
+func (x GetStreamURLsRequest_Mode) Enum() *GetStreamURLsRequest_Mode {
+	p := new(GetStreamURLsRequest_Mode)
+	*p = x
+	return p
+}
+
+func (x GetStreamURLsRequest_Mode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetStreamURLsRequest_Mode) Descriptor() protoreflect.EnumDescriptor {
+	return file_core_v1_types_proto_enumTypes[2].Descriptor()
+}
+
+func (GetStreamURLsRequest_Mode) Type() protoreflect.EnumType {
+	return &file_core_v1_types_proto_enumTypes[2]
+}
+
+func (x GetStreamURLsRequest_Mode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetStreamURLsRequest_Mode.Descriptor instead.
+func (GetStreamURLsRequest_Mode) EnumDescriptor() ([]byte, []int) {
+	return file_core_v1_types_proto_rawDescGZIP(), []int{103, 0}
+}
+
+type GetStreamURLsResponse_StreamDenial_Reason int32
+
+const (
+	GetStreamURLsResponse_StreamDenial_REASON_UNSPECIFIED GetStreamURLsResponse_StreamDenial_Reason = 0
+	GetStreamURLsResponse_StreamDenial_REASON_NOT_FOUND   GetStreamURLsResponse_StreamDenial_Reason = 1
+	GetStreamURLsResponse_StreamDenial_REASON_TAKEN_DOWN  GetStreamURLsResponse_StreamDenial_Reason = 2
+	// no deal is bound to the release
+	GetStreamURLsResponse_StreamDenial_REASON_NO_DEAL   GetStreamURLsResponse_StreamDenial_Reason = 3
+	GetStreamURLsResponse_StreamDenial_REASON_TERRITORY GetStreamURLsResponse_StreamDenial_Reason = 4
+	// the requester's territory could not be determined
+	GetStreamURLsResponse_StreamDenial_REASON_LOCATION_UNKNOWN GetStreamURLsResponse_StreamDenial_Reason = 5
+	GetStreamURLsResponse_StreamDenial_REASON_NOT_YET_VALID    GetStreamURLsResponse_StreamDenial_Reason = 6
+	GetStreamURLsResponse_StreamDenial_REASON_EXPIRED          GetStreamURLsResponse_StreamDenial_Reason = 7
+	GetStreamURLsResponse_StreamDenial_REASON_COMMERCIAL_MODEL GetStreamURLsResponse_StreamDenial_Reason = 8
+	GetStreamURLsResponse_StreamDenial_REASON_USE_TYPE         GetStreamURLsResponse_StreamDenial_Reason = 9
+	// the ERN holding the address can't be decoded or doesn't describe it
+	GetStreamURLsResponse_StreamDenial_REASON_INVALID_RELEASE GetStreamURLsResponse_StreamDenial_Reason = 10
+	// the address is a party or deal, only releases and resources can be streamed
+	GetStreamURLsResponse_StreamDenial_REASON_NOT_STREAMABLE GetStreamURLsResponse_StreamDenial_Reason = 11
+)
+
+// Enum value maps for GetStreamURLsResponse_StreamDenial_Reason.
+var (
+	GetStreamURLsResponse_StreamDenial_Reason_name = map[int32]string{
+		0:  "REASON_UNSPECIFIED",
+		1:  "REASON_NOT_FOUND",
+		2:  "REASON_TAKEN_DOWN",
+		3:  "REASON_NO_DEAL",
+		4:  "REASON_TERRITORY",
+		5:  "REASON_LOCATION_UNKNOWN",
+		6:  "REASON_NOT_YET_VALID",
+		7:  "REASON_EXPIRED",
+		8:  "REASON_COMMERCIAL_MODEL",
+		9:  "REASON_USE_TYPE",
+		10: "REASON_INVALID_RELEASE",
+		11: "REASON_NOT_STREAMABLE",
+	}
+	GetStreamURLsResponse_StreamDenial_Reason_value = map[string]int32{
+		"REASON_UNSPECIFIED":      0,
+		"REASON_NOT_FOUND":        1,
+		"REASON_TAKEN_DOWN":       2,
+		"REASON_NO_DEAL":          3,
+		"REASON_TERRITORY":        4,
+		"REASON_LOCATION_UNKNOWN": 5,
+		"REASON_NOT_YET_VALID":    6,
+		"REASON_EXPIRED":          7,
+		"REASON_COMMERCIAL_MODEL": 8,
+		"REASON_USE_TYPE":         9,
+		"REASON_INVALID_RELEASE":  10,
+		"REASON_NOT_STREAMABLE":   11,
+	}
+)
+
+func (x GetStreamURLsResponse_StreamDenial_Reason) Enum() *GetStreamURLsResponse_StreamDenial_Reason {
+	p := new(GetStreamURLsResponse_StreamDenial_Reason)
+	*p = x
+	return p
+}
+
+func (x GetStreamURLsResponse_StreamDenial_Reason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetStreamURLsResponse_StreamDenial_Reason) Descriptor() protoreflect.EnumDescriptor {
+	return file_core_v1_types_proto_enumTypes[3].Descriptor()
+}
+
+func (GetStreamURLsResponse_StreamDenial_Reason) Type() protoreflect.EnumType {
+	return &file_core_v1_types_proto_enumTypes[3]
+}
+
+func (x GetStreamURLsResponse_StreamDenial_Reason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetStreamURLsResponse_StreamDenial_Reason.Descriptor instead.
+func (GetStreamURLsResponse_StreamDenial_Reason) EnumDescriptor() ([]byte, []int) {
+	return file_core_v1_types_proto_rawDescGZIP(), []int{104, 1, 0}
+}
+
 type PingRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -789,16 +922,20 @@ func (x *GetBlocksResponse) GetCurrentHeight() int64 {
 	return 0
 }
 
-type GetTransactionRequest struct {
+// filters apply to the transactions in a stream, an empty filter matches everything
+type StreamFilter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TxHash string `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// oneof field names of the transaction, e.g. plays, manage_entity, ern, mead, pie
+	TxTypes []string `protobuf:"bytes,1,rep,name=tx_types,json=txTypes,proto3" json:"tx_types,omitempty"`
+	// addresses a transaction signed, targets or created, matched case insensitively
+	Addresses []string `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
 }
 
-func (x *GetTransactionRequest) Reset() {
-	*x = GetTransactionRequest{}
+func (x *StreamFilter) Reset() {
+	*x = StreamFilter{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_core_v1_types_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -806,13 +943,13 @@ func (x *GetTransactionRequest) Reset() {
 	}
 }
 
-func (x *GetTransactionRequest) String() string {
+func (x *StreamFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTransactionRequest) ProtoMessage() {}
+func (*StreamFilter) ProtoMessage() {}
 
-func (x *GetTransactionRequest) ProtoReflect() protoreflect.Message {
+func (x *StreamFilter) ProtoReflect() protoreflect.Message {
 	mi := &file_core_v1_types_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -824,28 +961,37 @@ func (x *GetTransactionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTransactionRequest.ProtoReflect.Descriptor instead.
-func (*GetTransactionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamFilter.ProtoReflect.Descriptor instead.
+func (*StreamFilter) Descriptor() ([]byte, []int) {
 	return file_core_v1_types_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *GetTransactionRequest) GetTxHash() string {
+func (x *StreamFilter) GetTxTypes() []string {
 	if x != nil {
-		return x.TxHash
+		return x.TxTypes
 	}
-	return ""
+	return nil
 }
 
-type GetTransactionResponse struct {
+func (x *StreamFilter) GetAddresses() []string {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+type StreamBlocksRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Transaction *Transaction `protobuf:"bytes,2,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	// first height to send, zero follows from the next committed block
+	StartHeight int64         `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	Filter      *StreamFilter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
 }
 
-func (x *GetTransactionResponse) Reset() {
-	*x = GetTransactionResponse{}
+func (x *StreamBlocksRequest) Reset() {
+	*x = StreamBlocksRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_core_v1_types_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -853,13 +999,13 @@ func (x *GetTransactionResponse) Reset() {
 	}
 }
 
-func (x *GetTransactionResponse) String() string {
+func (x *StreamBlocksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTransactionResponse) ProtoMessage() {}
+func (*StreamBlocksRequest) ProtoMessage() {}
 
-func (x *GetTransactionResponse) ProtoReflect() protoreflect.Message {
+func (x *StreamBlocksRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_core_v1_types_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -871,29 +1017,37 @@ func (x *GetTransactionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTransactionResponse.ProtoReflect.Descriptor instead.
-func (*GetTransactionResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamBlocksRequest.ProtoReflect.Descriptor instead.
+func (*StreamBlocksRequest) Descriptor() ([]byte, []int) {
 	return file_core_v1_types_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *GetTransactionResponse) GetTransaction() *Transaction {
+func (x *StreamBlocksRequest) GetStartHeight() int64 {
 	if x != nil {
-		return x.Transaction
+		return x.StartHeight
+	}
+	return 0
+}
+
+func (x *StreamBlocksRequest) GetFilter() *StreamFilter {
+	if x != nil {
+		return x.Filter
 	}
 	return nil
 }
 
-type SendTransactionRequest struct {
+type StreamBlocksResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Transaction   *SignedTransaction   `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
-	Transactionv2 *v1beta1.Transaction `protobuf:"bytes,2,opt,name=transactionv2,proto3" json:"transactionv2,omitempty"`
+	// every block is sent in order, transactions that don't match the filter are left out
+	Block         *Block `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	CurrentHeight int64  `protobuf:"varint,2,opt,name=current_height,json=currentHeight,proto3" json:"current_height,omitempty"`
 }
 
-func (x *SendTransactionRequest) Reset() {
-	*x = SendTransactionRequest{}
+func (x *StreamBlocksResponse) Reset() {
+	*x = StreamBlocksResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_core_v1_types_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -901,13 +1055,13 @@ func (x *SendTransactionRequest) Reset() {
 	}
 }
 
-func (x *SendTransactionRequest) String() string {
+func (x *StreamBlocksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendTransactionRequest) ProtoMessage() {}
+func (*StreamBlocksResponse) ProtoMessage() {}
 
-func (x *SendTransactionRequest) ProtoReflect() protoreflect.Message {
+func (x *StreamBlocksResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_core_v1_types_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -919,36 +1073,37 @@ func (x *SendTransactionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendTransactionRequest.ProtoReflect.Descriptor instead.
-func (*SendTransactionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamBlocksResponse.ProtoReflect.Descriptor instead.
+func (*StreamBlocksResponse) Descriptor() ([]byte, []int) {
 	return file_core_v1_types_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *SendTransactionRequest) GetTransaction() *SignedTransaction {
+func (x *StreamBlocksResponse) GetBlock() *Block {
 	if x != nil {
-		return x.Transaction
+		return x.Block
 	}
 	return nil
 }
 
-func (x *SendTransactionRequest) GetTransactionv2() *v1beta1.Transaction {
+func (x *StreamBlocksResponse) GetCurrentHeight() int64 {
 	if x != nil {
-		return x.Transactionv2
+		return x.CurrentHeight
 	}
-	return nil
+	return 0
 }
 
-type SendTransactionResponse struct {
+type StreamTransactionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Transaction        *Transaction                `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
-	TransactionReceipt *v1beta1.TransactionReceipt `protobuf:"bytes,2,opt,name=transaction_receipt,json=transactionReceipt,proto3" json:"transaction_receipt,omitempty"`
+	// first height to send transactions from, zero follows from the next committed block
+	StartHeight int64         `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	Filter      *StreamFilter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
 }
 
-func (x *SendTransactionResponse) Reset() {
-	*x = SendTransactionResponse{}
+func (x *StreamTransactionsRequest) Reset() {
+	*x = StreamTransactionsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_core_v1_types_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -956,13 +1111,13 @@ func (x *SendTransactionResponse) Reset() {
 	}
 }
 
-func (x *SendTransactionResponse) String() string {
+func (x *StreamTransactionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendTransactionResponse) ProtoMessage() {}
+func (*StreamTransactionsRequest) ProtoMessage() {}
 
-func (x *SendTransactionResponse) ProtoReflect() protoreflect.Message {
+func (x *StreamTransactionsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_core_v1_types_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -974,36 +1129,36 @@ func (x *SendTransactionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendTransactionResponse.ProtoReflect.Descriptor instead.
-func (*SendTransactionResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*StreamTransactionsRequest) Descriptor() ([]byte, []int) {
 	return file_core_v1_types_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *SendTransactionResponse) GetTransaction() *Transaction {
+func (x *StreamTransactionsRequest) GetStartHeight() int64 {
 	if x != nil {
-		return x.Transaction
+		return x.StartHeight
 	}
-	return nil
+	return 0
 }
 
-func (x *SendTransactionResponse) GetTransactionReceipt() *v1beta1.TransactionReceipt {
+func (x *StreamTransactionsRequest) GetFilter() *StreamFilter {
 	if x != nil {
-		return x.TransactionReceipt
+		return x.Filter
 	}
 	return nil
 }
 
-type ForwardTransactionRequest struct {
+type StreamTransactionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Transaction   *SignedTransaction   `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
-	Transactionv2 *v1beta1.Transaction `protobuf:"bytes,2,opt,name=transactionv2,proto3" json:"transactionv2,omitempty"`
+	Transaction   *Transaction `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	CurrentHeight int64        `protobuf:"varint,2,opt,name=current_height,json=currentHeight,proto3" json:"current_height,omitempty"`
 }
 
-func (x *ForwardTransactionRequest) Reset() {
-	*x = ForwardTransactionRequest{}
+func (x *StreamTransactionsResponse) Reset() {
+	*x = StreamTransactionsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_core_v1_types_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1011,13 +1166,13 @@ func (x *ForwardTransactionRequest) Reset() {
 	}
 }
 
-func (x *ForwardTransactionRequest) String() string {
+func (x *StreamTransactionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ForwardTransactionRequest) ProtoMessage() {}
+func (*StreamTransactionsResponse) ProtoMessage() {}
 
-func (x *ForwardTransactionRequest) ProtoReflect() protoreflect.Message {
+func (x *StreamTransactionsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_core_v1_types_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1029,33 +1184,35 @@ func (x *ForwardTransactionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ForwardTransactionRequest.ProtoReflect.Descriptor instead.
-func (*ForwardTransactionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*StreamTransactionsResponse) Descriptor() ([]byte, []int) {
 	return file_core_v1_types_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *ForwardTransactionRequest) GetTransaction() *SignedTransaction {
+func (x *StreamTransactionsResponse) GetTransaction() *Transaction {
 	if x != nil {
 		return x.Transaction
 	}
 	return nil
 }
 
-func (x *ForwardTransactionRequest) GetTransactionv2() *v1beta1.Transaction {
+func (x *StreamTransactionsResponse) GetCurrentHeight() int64 {
 	if x != nil {
-		return x.Transactionv2
+		return x.CurrentHeight
 	}
-	return nil
+	return 0
 }
 
-type ForwardTransactionResponse struct {
+type GetTransactionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	TxHash string `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
 }
 
-func (x *ForwardTransactionResponse) Reset() {
-	*x = ForwardTransactionResponse{}
+func (x *GetTransactionRequest) Reset() {
+	*x = GetTransactionRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_core_v1_types_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1063,13 +1220,13 @@ func (x *ForwardTransactionResponse) Reset() {
 	}
 }
 
-func (x *ForwardTransactionResponse) String() string {
+func (x *GetTransactionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ForwardTransactionResponse) ProtoMessage() {}
+func (*GetTransactionRequest) ProtoMessage() {}
 
-func (x *ForwardTransactionResponse) ProtoReflect() protoreflect.Message {
+func (x *GetTransactionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_core_v1_types_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1081,21 +1238,28 @@ func (x *ForwardTransactionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ForwardTransactionResponse.ProtoReflect.Descriptor instead.
-func (*ForwardTransactionResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetTransactionRequest.ProtoReflect.Descriptor instead.
+func (*GetTransactionRequest) Descriptor() ([]byte, []int) {
 	return file_core_v1_types_proto_rawDescGZIP(), []int{17}
 }
 
-type GetRegistrationAttestationRequest struct {
+func (x *GetTransactionRequest) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+type GetTransactionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Registration *ValidatorRegistration `protobuf:"bytes,1,opt,name=registration,proto3" json:"registration,omitempty"`
+	Transaction *Transaction `protobuf:"bytes,2,opt,name=transaction,proto3" json:"transaction,omitempty"`
 }
 
-func (x *GetRegistrationAttestationRequest) Reset() {
-	*x = GetRegistrationAttestationRequest{}
+func (x *GetTransactionResponse) Reset() {
+	*x = GetTransactionResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_core_v1_types_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1103,13 +1267,13 @@ func (x *GetRegistrationAttestationRequest) Reset() {
 	}
 }
 
-func (x *GetRegistrationAttestationRequest) String() string {
+func (x *GetTransactionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRegistrationAttestationRequest) ProtoMessage() {}
+func (*GetTransactionResponse) ProtoMessage() {}
 
-func (x *GetRegistrationAttestationRequest) ProtoReflect() protoreflect.Message {
+func (x *GetTransactionResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_core_v1_types_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1121,29 +1285,29 @@ func (x *GetRegistrationAttestationRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRegistrationAttestationRequest.ProtoReflect.Descriptor instead.
-func (*GetRegistrationAttestationRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetTransactionResponse.ProtoReflect.Descriptor instead.
+func (*GetTransactionResponse) Descriptor() ([]byte, []int) {
 	return file_core_v1_types_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *GetRegistrationAttestationRequest) GetRegistration() *ValidatorRegistration {
+func (x *GetTransactionResponse) GetTransaction() *Transaction {
 	if x != nil {
-		return x.Registration
+		return x.Transaction
 	}
 	return nil
 }
 
-type GetRegistrationAttestationResponse struct {
+type SendTransactionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Signature    string                 `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
-	Registration *ValidatorRegistration `protobuf:"bytes,2,opt,name=registration,proto3" json:"registration,omitempty"`
+	Transaction   *SignedTransaction   `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	Transactionv2 *v1beta1.Transaction `protobuf:"bytes,2,opt,name=transactionv2,proto3" json:"transactionv2,omitempty"`
 }
 
-func (x *GetRegistrationAttestationResponse) Reset() {
-	*x = GetRegistrationAttestationResponse{}
+func (x *SendTransactionRequest) Reset() {
+	*x = SendTransactionRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_core_v1_types_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1151,13 +1315,13 @@ func (x *GetRegistrationAttestationResponse) Reset() {
 	}
 }
 
-func (x *GetRegistrationAttestationResponse) String() string {
+func (x *SendTransactionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRegistrationAttestationResponse) ProtoMessage() {}
+func (*SendTransactionRequest) ProtoMessage() {}
 
-func (x *GetRegistrationAttestationResponse) ProtoReflect() protoreflect.Message {
+func (x *SendTransactionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_core_v1_types_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1169,35 +1333,36 @@ func (x *GetRegistrationAttestationResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRegistrationAttestationResponse.ProtoReflect.Descriptor instead.
-func (*GetRegistrationAttestationResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SendTransactionRequest.ProtoReflect.Descriptor instead.
+func (*SendTransactionRequest) Descriptor() ([]byte, []int) {
 	return file_core_v1_types_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *GetRegistrationAttestationResponse) GetSignature() string {
+func (x *SendTransactionRequest) GetTransaction() *SignedTransaction {
 	if x != nil {
-		return x.Signature
+		return x.Transaction
 	}
-	return ""
+	return nil
 }
 
-func (x *GetRegistrationAttestationResponse) GetRegistration() *ValidatorRegistration {
+func (x *SendTransactionRequest) GetTransactionv2() *v1beta1.Transaction {
 	if x != nil {
-		return x.Registration
+		return x.Transactionv2
 	}
 	return nil
 }
 
-type GetDeregistrationAttestationRequest struct {
+type SendTransactionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Deregistration *ValidatorDeregistration `protobuf:"bytes,1,opt,name=deregistration,proto3" json:"deregistration,omitempty"`
+	Transaction        *Transaction                `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	TransactionReceipt *v1beta1.TransactionReceipt `protobuf:"bytes,2,opt,name=transaction_receipt,json=transactionReceipt,proto3" json:"transaction_receipt,omitempty"`
 }
 
-func (x *GetDeregistrationAttestationRequest) Reset() {
-	*x = GetDeregistrationAttestationRequest{}
+func (x *SendTransactionResponse) Reset() {
+	*x = SendTransactionResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_core_v1_types_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1205,13 +1370,13 @@ func (x *GetDeregistrationAttestationRequest) Reset() {
 	}
 }
 
-func (x *GetDeregistrationAttestationRequest) String() string {
+func (x *SendTransactionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDeregistrationAttestationRequest) ProtoMessage() {}
+func (*SendTransactionResponse) ProtoMessage() {}
 
-func (x *GetDeregistrationAttestationRequest) ProtoReflect() protoreflect.Message {
+func (x *SendTransactionResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_core_v1_types_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1223,29 +1388,36 @@ func (x *GetDeregistrationAttestationRequest) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDeregistrationAttestationRequest.ProtoReflect.Descriptor instead.
-func (*GetDeregistrationAttestationRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SendTransactionResponse.ProtoReflect.Descriptor instead.
+func (*SendTransactionResponse) Descriptor() ([]byte, []int) {
 	return file_core_v1_types_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *GetDeregistrationAttestationRequest) GetDeregistration() *ValidatorDeregistration {
+func (x *SendTransactionResponse) GetTransaction() *Transaction {
 	if x != nil {
-		return x.Deregistration
+		return x.Transaction
 	}
 	return nil
 }
 
-type GetDeregistrationAttestationResponse struct {
+func (x *SendTransactionResponse) GetTransactionReceipt() *v1beta1.TransactionReceipt {
+	if x != nil {
+		return x.TransactionReceipt
+	}
+	return nil
+}
+
+type ForwardTransactionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Signature      string                   `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
-	Deregistration *ValidatorDeregistration `protobuf:"bytes,2,opt,name=deregistration,proto3" json:"deregistration,omitempty"`
+	Transaction   *SignedTransaction   `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	Transactionv2 *v1beta1.Transaction `protobuf:"bytes,2,opt,name=transactionv2,proto3" json:"transactionv2,omitempty"`
 }
 
-func (x *GetDeregistrationAttestationResponse) Reset() {
-	*x = GetDeregistrationAttestationResponse{}
+func (x *ForwardTransactionRequest) Reset() {
+	*x = ForwardTransactionRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_core_v1_types_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1253,13 +1425,13 @@ func (x *GetDeregistrationAttestationResponse) Reset() {
 	}
 }
 
-func (x *GetDeregistrationAttestationResponse) String() string {
+func (x *ForwardTransactionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDeregistrationAttestationResponse) ProtoMessage() {}
+func (*ForwardTransactionRequest) ProtoMessage() {}
 
-func (x *GetDeregistrationAttestationResponse) ProtoReflect() protoreflect.Message {
+func (x *ForwardTransactionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_core_v1_types_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1271,40 +1443,33 @@ func (x *GetDeregistrationAttestationResponse) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDeregistrationAttestationResponse.ProtoReflect.Descriptor instead.
-func (*GetDeregistrationAttestationResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ForwardTransactionRequest.ProtoReflect.Descriptor instead.
+func (*ForwardTransactionRequest) Descriptor() ([]byte, []int) {
 	return file_core_v1_types_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *GetDeregistrationAttestationResponse) GetSignature() string {
+func (x *ForwardTransactionRequest) GetTransaction() *SignedTransaction {
 	if x != nil {
-		return x.Signature
+		return x.Transaction
 	}
-	return ""
+	return nil
 }
 
-func (x *GetDeregistrationAttestationResponse) GetDeregistration() *ValidatorDeregistration {
+func (x *ForwardTransactionRequest) GetTransactionv2() *v1beta1.Transaction {
 	if x != nil {
-		return x.Deregistration
+		return x.Transactionv2
 	}
 	return nil
 }
 
-type Block struct {
+type ForwardTransactionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Height       int64                  `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
-	Hash         string                 `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
-	ChainId      string                 `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	Proposer     string                 `protobuf:"bytes,4,opt,name=proposer,proto3" json:"proposer,omitempty"`
-	Timestamp    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Transactions []*Transaction         `protobuf:"bytes,6,rep,name=transactions,proto3" json:"transactions,omitempty"`
 }
 
-func (x *Block) Reset() {
-	*x = Block{}
+func (x *ForwardTransactionResponse) Reset() {
+	*x = ForwardTransactionResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_core_v1_types_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1312,13 +1477,13 @@ func (x *Block) Reset() {
 	}
 }
 
-func (x *Block) String() string {
+func (x *ForwardTransactionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Block) ProtoMessage() {}
+func (*ForwardTransactionResponse) ProtoMessage() {}
 
-func (x *Block) ProtoReflect() protoreflect.Message {
+func (x *ForwardTransactionResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_core_v1_types_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1330,84 +1495,84 @@ func (x *Block) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Block.ProtoReflect.Descriptor instead.
-func (*Block) Descriptor() ([]byte, []int) {
+// Deprecated: Use ForwardTransactionResponse.ProtoReflect.Descriptor instead.
+func (*ForwardTransactionResponse) Descriptor() ([]byte, []int) {
 	return file_core_v1_types_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *Block) GetHeight() int64 {
-	if x != nil {
-		return x.Height
-	}
-	return 0
+type GetRegistrationAttestationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Registration *ValidatorRegistration `protobuf:"bytes,1,opt,name=registration,proto3" json:"registration,omitempty"`
 }
 
-func (x *Block) GetHash() string {
-	if x != nil {
-		return x.Hash
+func (x *GetRegistrationAttestationRequest) Reset() {
+	*x = GetRegistrationAttestationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_v1_types_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Block) GetChainId() string {
-	if x != nil {
-		return x.ChainId
-	}
-	return ""
+func (x *GetRegistrationAttestationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Block) GetProposer() string {
-	if x != nil {
-		return x.Proposer
+func (*GetRegistrationAttestationRequest) ProtoMessage() {}
+
+func (x *GetRegistrationAttestationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_core_v1_types_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Block) GetTimestamp() *timestamppb.Timestamp {
-	if x != nil {
-		return x.Timestamp
-	}
-	return nil
+// Deprecated: Use GetRegistrationAttestationRequest.ProtoReflect.Descriptor instead.
+func (*GetRegistrationAttestationRequest) Descriptor() ([]byte, []int) {
+	return file_core_v1_types_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *Block) GetTransactions() []*Transaction {
+func (x *GetRegistrationAttestationRequest) GetRegistration() *ValidatorRegistration {
 	if x != nil {
-		return x.Transactions
+		return x.Registration
 	}
 	return nil
 }
 
-type Transaction struct {
+type GetRegistrationAttestationResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Hash          string                 `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
-	Transaction   *SignedTransaction     `protobuf:"bytes,2,opt,name=transaction,proto3" json:"transaction,omitempty"`
-	ChainId       string                 `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	Height        int64                  `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
-	BlockHash     string                 `protobuf:"bytes,5,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
-	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Transactionv2 *v1beta1.Transaction   `protobuf:"bytes,7,opt,name=transactionv2,proto3" json:"transactionv2,omitempty"`
+	Signature    string                 `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+	Registration *ValidatorRegistration `protobuf:"bytes,2,opt,name=registration,proto3" json:"registration,omitempty"`
 }
 
-func (x *Transaction) Reset() {
-	*x = Transaction{}
+func (x *GetRegistrationAttestationResponse) Reset() {
+	*x = GetRegistrationAttestationResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_core_v1_types_proto_msgTypes[23]
+		mi := &file_core_v1_types_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Transaction) String() string {
+func (x *GetRegistrationAttestationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Transaction) ProtoMessage() {}
+func (*GetRegistrationAttestationResponse) ProtoMessage() {}
 
-func (x *Transaction) ProtoReflect() protoreflect.Message {
-	mi := &file_core_v1_types_proto_msgTypes[23]
+func (x *GetRegistrationAttestationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_core_v1_types_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1418,100 +1583,98 @@ func (x *Transaction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Transaction.ProtoReflect.Descriptor instead.
-func (*Transaction) Descriptor() ([]byte, []int) {
-	return file_core_v1_types_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use GetRegistrationAttestationResponse.ProtoReflect.Descriptor instead.
+func (*GetRegistrationAttestationResponse) Descriptor() ([]byte, []int) {
+	return file_core_v1_types_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *Transaction) GetHash() string {
+func (x *GetRegistrationAttestationResponse) GetSignature() string {
 	if x != nil {
-		return x.Hash
+		return x.Signature
 	}
 	return ""
 }
 
-func (x *Transaction) GetTransaction() *SignedTransaction {
+func (x *GetRegistrationAttestationResponse) GetRegistration() *ValidatorRegistration {
 	if x != nil {
-		return x.Transaction
+		return x.Registration
 	}
 	return nil
 }
 
-func (x *Transaction) GetChainId() string {
-	if x != nil {
-		return x.ChainId
-	}
-	return ""
+type GetDeregistrationAttestationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Deregistration *ValidatorDeregistration `protobuf:"bytes,1,opt,name=deregistration,proto3" json:"deregistration,omitempty"`
 }
 
-func (x *Transaction) GetHeight() int64 {
-	if x != nil {
-		return x.Height
+func (x *GetDeregistrationAttestationRequest) Reset() {
+	*x = GetDeregistrationAttestationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_v1_types_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *Transaction) GetBlockHash() string {
-	if x != nil {
-		return x.BlockHash
-	}
-	return ""
+func (x *GetDeregistrationAttestationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Transaction) GetTimestamp() *timestamppb.Timestamp {
-	if x != nil {
-		return x.Timestamp
+func (*GetDeregistrationAttestationRequest) ProtoMessage() {}
+
+func (x *GetDeregistrationAttestationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_core_v1_types_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Transaction) GetTransactionv2() *v1beta1.Transaction {
+// Deprecated: Use GetDeregistrationAttestationRequest.ProtoReflect.Descriptor instead.
+func (*GetDeregistrationAttestationRequest) Descriptor() ([]byte, []int) {
+	return file_core_v1_types_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetDeregistrationAttestationRequest) GetDeregistration() *ValidatorDeregistration {
 	if x != nil {
-		return x.Transactionv2
+		return x.Deregistration
 	}
 	return nil
 }
 
-type SignedTransaction struct {
+type GetDeregistrationAttestationResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Signature string `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
-	RequestId string `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	// Types that are assignable to Transaction:
-	//
-	//	*SignedTransaction_Plays
-	//	*SignedTransaction_ValidatorRegistration
-	//	*SignedTransaction_SlaRollup
-	//	*SignedTransaction_ManageEntity
-	//	*SignedTransaction_ValidatorDeregistration
-	//	*SignedTransaction_StorageProof
-	//	*SignedTransaction_StorageProofVerification
-	//	*SignedTransaction_Attestation
-	//	*SignedTransaction_Release
-	//	*SignedTransaction_Reward
-	//	*SignedTransaction_FileUpload
-	Transaction isSignedTransaction_Transaction `protobuf_oneof:"transaction"`
+	Signature      string                   `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+	Deregistration *ValidatorDeregistration `protobuf:"bytes,2,opt,name=deregistration,proto3" json:"deregistration,omitempty"`
 }
 
-func (x *SignedTransaction) Reset() {
-	*x = SignedTransaction{}
+func (x *GetDeregistrationAttestationResponse) Reset() {
+	*x = GetDeregistrationAttestationResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_core_v1_types_proto_msgTypes[24]
+		mi := &file_core_v1_types_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SignedTransaction) String() string {
+func (x *GetDeregistrationAttestationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SignedTransaction) ProtoMessage() {}
+func (*GetDeregistrationAttestationResponse) ProtoMessage() {}
 
-func (x *SignedTransaction) ProtoReflect() protoreflect.Message {
-	mi := &file_core_v1_types_proto_msgTypes[24]
+func (x *GetDeregistrationAttestationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_core_v1_types_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1522,204 +1685,98 @@ func (x *SignedTransaction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SignedTransaction.ProtoReflect.Descriptor instead.
-func (*SignedTransaction) Descriptor() ([]byte, []int) {
-	return file_core_v1_types_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use GetDeregistrationAttestationResponse.ProtoReflect.Descriptor instead.
+func (*GetDeregistrationAttestationResponse) Descriptor() ([]byte, []int) {
+	return file_core_v1_types_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *SignedTransaction) GetSignature() string {
+func (x *GetDeregistrationAttestationResponse) GetSignature() string {
 	if x != nil {
 		return x.Signature
 	}
 	return ""
 }
 
-func (x *SignedTransaction) GetRequestId() string {
+func (x *GetDeregistrationAttestationResponse) GetDeregistration() *ValidatorDeregistration {
 	if x != nil {
-		return x.RequestId
-	}
-	return ""
-}
-
-func (m *SignedTransaction) GetTransaction() isSignedTransaction_Transaction {
-	if m != nil {
-		return m.Transaction
+		return x.Deregistration
 	}
 	return nil
 }
 
-func (x *SignedTransaction) GetPlays() *TrackPlays {
-	if x, ok := x.GetTransaction().(*SignedTransaction_Plays); ok {
-		return x.Plays
-	}
-	return nil
+type GetUnjailAttestationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Unjail *UnjailValidator `protobuf:"bytes,1,opt,name=unjail,proto3" json:"unjail,omitempty"`
 }
 
-func (x *SignedTransaction) GetValidatorRegistration() *ValidatorRegistrationLegacy {
-	if x, ok := x.GetTransaction().(*SignedTransaction_ValidatorRegistration); ok {
-		return x.ValidatorRegistration
+func (x *GetUnjailAttestationRequest) Reset() {
+	*x = GetUnjailAttestationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_v1_types_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *SignedTransaction) GetSlaRollup() *SlaRollup {
-	if x, ok := x.GetTransaction().(*SignedTransaction_SlaRollup); ok {
-		return x.SlaRollup
-	}
-	return nil
-}
-
-func (x *SignedTransaction) GetManageEntity() *ManageEntityLegacy {
-	if x, ok := x.GetTransaction().(*SignedTransaction_ManageEntity); ok {
-		return x.ManageEntity
-	}
-	return nil
-}
-
-func (x *SignedTransaction) GetValidatorDeregistration() *ValidatorMisbehaviorDeregistration {
-	if x, ok := x.GetTransaction().(*SignedTransaction_ValidatorDeregistration); ok {
-		return x.ValidatorDeregistration
-	}
-	return nil
-}
-
-func (x *SignedTransaction) GetStorageProof() *StorageProof {
-	if x, ok := x.GetTransaction().(*SignedTransaction_StorageProof); ok {
-		return x.StorageProof
-	}
-	return nil
-}
-
-func (x *SignedTransaction) GetStorageProofVerification() *StorageProofVerification {
-	if x, ok := x.GetTransaction().(*SignedTransaction_StorageProofVerification); ok {
-		return x.StorageProofVerification
-	}
-	return nil
+func (x *GetUnjailAttestationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *SignedTransaction) GetAttestation() *Attestation {
-	if x, ok := x.GetTransaction().(*SignedTransaction_Attestation); ok {
-		return x.Attestation
-	}
-	return nil
-}
+func (*GetUnjailAttestationRequest) ProtoMessage() {}
 
-func (x *SignedTransaction) GetRelease() *v1beta11.NewReleaseMessage {
-	if x, ok := x.GetTransaction().(*SignedTransaction_Release); ok {
-		return x.Release
+func (x *GetUnjailAttestationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_core_v1_types_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *SignedTransaction) GetReward() *RewardMessage {
-	if x, ok := x.GetTransaction().(*SignedTransaction_Reward); ok {
-		return x.Reward
-	}
-	return nil
+// Deprecated: Use GetUnjailAttestationRequest.ProtoReflect.Descriptor instead.
+func (*GetUnjailAttestationRequest) Descriptor() ([]byte, []int) {
+	return file_core_v1_types_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *SignedTransaction) GetFileUpload() *FileUpload {
-	if x, ok := x.GetTransaction().(*SignedTransaction_FileUpload); ok {
-		return x.FileUpload
+func (x *GetUnjailAttestationRequest) GetUnjail() *UnjailValidator {
+	if x != nil {
+		return x.Unjail
 	}
 	return nil
 }
 
-type isSignedTransaction_Transaction interface {
-	isSignedTransaction_Transaction()
-}
-
-type SignedTransaction_Plays struct {
-	Plays *TrackPlays `protobuf:"bytes,1000,opt,name=plays,proto3,oneof"`
-}
-
-type SignedTransaction_ValidatorRegistration struct {
-	ValidatorRegistration *ValidatorRegistrationLegacy `protobuf:"bytes,1001,opt,name=validator_registration,json=validatorRegistration,proto3,oneof"`
-}
-
-type SignedTransaction_SlaRollup struct {
-	SlaRollup *SlaRollup `protobuf:"bytes,1002,opt,name=sla_rollup,json=slaRollup,proto3,oneof"`
-}
-
-type SignedTransaction_ManageEntity struct {
-	ManageEntity *ManageEntityLegacy `protobuf:"bytes,1003,opt,name=manage_entity,json=manageEntity,proto3,oneof"`
-}
-
-type SignedTransaction_ValidatorDeregistration struct {
-	ValidatorDeregistration *ValidatorMisbehaviorDeregistration `protobuf:"bytes,1004,opt,name=validator_deregistration,json=validatorDeregistration,proto3,oneof"`
-}
-
-type SignedTransaction_StorageProof struct {
-	StorageProof *StorageProof `protobuf:"bytes,1005,opt,name=storage_proof,json=storageProof,proto3,oneof"`
-}
-
-type SignedTransaction_StorageProofVerification struct {
-	StorageProofVerification *StorageProofVerification `protobuf:"bytes,1006,opt,name=storage_proof_verification,json=storageProofVerification,proto3,oneof"`
-}
-
-type SignedTransaction_Attestation struct {
-	Attestation *Attestation `protobuf:"bytes,1007,opt,name=attestation,proto3,oneof"`
-}
-
-type SignedTransaction_Release struct {
-	Release *v1beta11.NewReleaseMessage `protobuf:"bytes,1008,opt,name=release,proto3,oneof"`
-}
-
-type SignedTransaction_Reward struct {
-	Reward *RewardMessage `protobuf:"bytes,1009,opt,name=reward,proto3,oneof"`
-}
-
-type SignedTransaction_FileUpload struct {
-	FileUpload *FileUpload `protobuf:"bytes,1010,opt,name=file_upload,json=fileUpload,proto3,oneof"`
-}
-
-func (*SignedTransaction_Plays) isSignedTransaction_Transaction() {}
-
-func (*SignedTransaction_ValidatorRegistration) isSignedTransaction_Transaction() {}
-
-func (*SignedTransaction_SlaRollup) isSignedTransaction_Transaction() {}
-
-func (*SignedTransaction_ManageEntity) isSignedTransaction_Transaction() {}
-
-func (*SignedTransaction_ValidatorDeregistration) isSignedTransaction_Transaction() {}
-
-func (*SignedTransaction_StorageProof) isSignedTransaction_Transaction() {}
-
-func (*SignedTransaction_StorageProofVerification) isSignedTransaction_Transaction() {}
-
-func (*SignedTransaction_Attestation) isSignedTransaction_Transaction() {}
-
-func (*SignedTransaction_Release) isSignedTransaction_Transaction() {}
-
-func (*SignedTransaction_Reward) isSignedTransaction_Transaction() {}
-
-func (*SignedTransaction_FileUpload) isSignedTransaction_Transaction() {}
-
-type TrackPlays struct {
+type GetUnjailAttestationResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Plays []*TrackPlay `protobuf:"bytes,1,rep,name=plays,proto3" json:"plays,omitempty"`
+	Signature string           `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+	Unjail    *UnjailValidator `protobuf:"bytes,2,opt,name=unjail,proto3" json:"unjail,omitempty"`
 }
 
-func (x *TrackPlays) Reset() {
-	*x = TrackPlays{}
+func (x *GetUnjailAttestationResponse) Reset() {
+	*x = GetUnjailAttestationResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_core_v1_types_proto_msgTypes[25]
+		mi := &file_core_v1_types_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TrackPlays) String() string {
+func (x *GetUnjailAttestationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TrackPlays) ProtoMessage() {}
+func (*GetUnjailAttestationResponse) ProtoMessage() {}
 
-func (x *TrackPlays) ProtoReflect() protoreflect.Message {
-	mi := &file_core_v1_types_proto_msgTypes[25]
+func (x *GetUnjailAttestationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_core_v1_types_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1730,49 +1787,55 @@ func (x *TrackPlays) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TrackPlays.ProtoReflect.Descriptor instead.
-func (*TrackPlays) Descriptor() ([]byte, []int) {
-	return file_core_v1_types_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use GetUnjailAttestationResponse.ProtoReflect.Descriptor instead.
+func (*GetUnjailAttestationResponse) Descriptor() ([]byte, []int) {
+	return file_core_v1_types_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *TrackPlays) GetPlays() []*TrackPlay {
+func (x *GetUnjailAttestationResponse) GetSignature() string {
 	if x != nil {
-		return x.Plays
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *GetUnjailAttestationResponse) GetUnjail() *UnjailValidator {
+	if x != nil {
+		return x.Unjail
 	}
 	return nil
 }
 
-type ValidatorRegistrationLegacy struct {
+type Block struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Endpoint     string `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
-	CometAddress string `protobuf:"bytes,2,opt,name=comet_address,json=cometAddress,proto3" json:"comet_address,omitempty"`
-	EthBlock     string `protobuf:"bytes,3,opt,name=eth_block,json=ethBlock,proto3" json:"eth_block,omitempty"`
-	NodeType     string `protobuf:"bytes,4,opt,name=node_type,json=nodeType,proto3" json:"node_type,omitempty"`
-	SpId         string `protobuf:"bytes,5,opt,name=sp_id,json=spId,proto3" json:"sp_id,omitempty"`
-	PubKey       []byte `protobuf:"bytes,6,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
-	Power        int64  `protobuf:"varint,7,opt,name=power,proto3" json:"power,omitempty"`
+	Height       int64                  `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Hash         string                 `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	ChainId      string                 `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Proposer     string                 `protobuf:"bytes,4,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	Timestamp    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Transactions []*Transaction         `protobuf:"bytes,6,rep,name=transactions,proto3" json:"transactions,omitempty"`
 }
 
-func (x *ValidatorRegistrationLegacy) Reset() {
-	*x = ValidatorRegistrationLegacy{}
+func (x *Block) Reset() {
+	*x = Block{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_core_v1_types_proto_msgTypes[26]
+		mi := &file_core_v1_types_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ValidatorRegistrationLegacy) String() string {
+func (x *Block) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidatorRegistrationLegacy) ProtoMessage() {}
+func (*Block) ProtoMessage() {}
 
-func (x *ValidatorRegistrationLegacy) ProtoReflect() protoreflect.Message {
-	mi := &file_core_v1_types_proto_msgTypes[26]
+func (x *Block) ProtoReflect() protoreflect.Message {
+	mi := &file_core_v1_types_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1783,91 +1846,89 @@ func (x *ValidatorRegistrationLegacy) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ValidatorRegistrationLegacy.ProtoReflect.Descriptor instead.
-func (*ValidatorRegistrationLegacy) Descriptor() ([]byte, []int) {
-	return file_core_v1_types_proto_rawDescGZIP(), []int{26}
-}
-
-func (x *ValidatorRegistrationLegacy) GetEndpoint() string {
-	if x != nil {
-		return x.Endpoint
-	}
-	return ""
+// Deprecated: Use Block.ProtoReflect.Descriptor instead.
+func (*Block) Descriptor() ([]byte, []int) {
+	return file_core_v1_types_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *ValidatorRegistrationLegacy) GetCometAddress() string {
+func (x *Block) GetHeight() int64 {
 	if x != nil {
-		return x.CometAddress
+		return x.Height
 	}
-	return ""
+	return 0
 }
 
-func (x *ValidatorRegistrationLegacy) GetEthBlock() string {
+func (x *Block) GetHash() string {
 	if x != nil {
-		return x.EthBlock
+		return x.Hash
 	}
 	return ""
 }
 
-func (x *ValidatorRegistrationLegacy) GetNodeType() string {
+func (x *Block) GetChainId() string {
 	if x != nil {
-		return x.NodeType
+		return x.ChainId
 	}
 	return ""
 }
 
-func (x *ValidatorRegistrationLegacy) GetSpId() string {
+func (x *Block) GetProposer() string {
 	if x != nil {
-		return x.SpId
+		return x.Proposer
 	}
 	return ""
 }
 
-func (x *ValidatorRegistrationLegacy) GetPubKey() []byte {
+func (x *Block) GetTimestamp() *timestamppb.Timestamp {
 	if x != nil {
-		return x.PubKey
+		return x.Timestamp
 	}
 	return nil
 }
 
-func (x *ValidatorRegistrationLegacy) GetPower() int64 {
+func (x *Block) GetTransactions() []*Transaction {
 	if x != nil {
-		return x.Power
+		return x.Transactions
 	}
-	return 0
+	return nil
 }
 
-type TrackPlay struct {
+type Transaction struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId    string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	TrackId   string                 `protobuf:"bytes,2,opt,name=track_id,json=trackId,proto3" json:"track_id,omitempty"`
-	Timestamp *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Signature string                 `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
-	City      string                 `protobuf:"bytes,5,opt,name=city,proto3" json:"city,omitempty"`
-	Region    string                 `protobuf:"bytes,6,opt,name=region,proto3" json:"region,omitempty"`
-	Country   string                 `protobuf:"bytes,7,opt,name=country,proto3" json:"country,omitempty"`
+	Hash          string                 `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Transaction   *SignedTransaction     `protobuf:"bytes,2,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	ChainId       string                 `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Height        int64                  `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	BlockHash     string                 `protobuf:"bytes,5,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Transactionv2 *v1beta1.Transaction   `protobuf:"bytes,7,opt,name=transactionv2,proto3" json:"transactionv2,omitempty"`
+	// abci result of finalizing the transaction, zero when it was applied
+	ResultCode uint32 `protobuf:"varint,8,opt,name=result_code,json=resultCode,proto3" json:"result_code,omitempty"`
+	ResultLog  string `protobuf:"bytes,9,opt,name=result_log,json=resultLog,proto3" json:"result_log,omitempty"`
+	// set for v1beta1 transactions, carries the addresses their messages created
+	TransactionReceipt *v1beta1.TransactionReceipt `protobuf:"bytes,10,opt,name=transaction_receipt,json=transactionReceipt,proto3" json:"transaction_receipt,omitempty"`
 }
 
-func (x *TrackPlay) Reset() {
-	*x = TrackPlay{}
+func (x *Transaction) Reset() {
+	*x = Transaction{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_core_v1_types_proto_msgTypes[27]
+		mi := &file_core_v1_types_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TrackPlay) String() string {
+func (x *Transaction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TrackPlay) ProtoMessage() {}
+func (*Transaction) ProtoMessage() {}
 
-func (x *TrackPlay) ProtoReflect() protoreflect.Message {
-	mi := &file_core_v1_types_proto_msgTypes[27]
+func (x *Transaction) ProtoReflect() protoreflect.Message {
+	mi := &file_core_v1_types_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1878,157 +1939,122 @@ func (x *TrackPlay) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TrackPlay.ProtoReflect.Descriptor instead.
-func (*TrackPlay) Descriptor() ([]byte, []int) {
-	return file_core_v1_types_proto_rawDescGZIP(), []int{27}
-}
-
-func (x *TrackPlay) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
+// Deprecated: Use Transaction.ProtoReflect.Descriptor instead.
+func (*Transaction) Descriptor() ([]byte, []int) {
+	return file_core_v1_types_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *TrackPlay) GetTrackId() string {
+func (x *Transaction) GetHash() string {
 	if x != nil {
-		return x.TrackId
+		return x.Hash
 	}
 	return ""
 }
 
-func (x *TrackPlay) GetTimestamp() *timestamppb.Timestamp {
+func (x *Transaction) GetTransaction() *SignedTransaction {
 	if x != nil {
-		return x.Timestamp
+		return x.Transaction
 	}
 	return nil
 }
 
-func (x *TrackPlay) GetSignature() string {
+func (x *Transaction) GetChainId() string {
 	if x != nil {
-		return x.Signature
+		return x.ChainId
 	}
 	return ""
 }
 
-func (x *TrackPlay) GetCity() string {
+func (x *Transaction) GetHeight() int64 {
 	if x != nil {
-		return x.City
+		return x.Height
 	}
-	return ""
+	return 0
 }
 
-func (x *TrackPlay) GetRegion() string {
+func (x *Transaction) GetBlockHash() string {
 	if x != nil {
-		return x.Region
+		return x.BlockHash
 	}
 	return ""
 }
 
-func (x *TrackPlay) GetCountry() string {
+func (x *Transaction) GetTimestamp() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Country
-	}
-	return ""
-}
-
-type SlaRollup struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Timestamp  *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	BlockStart int64                  `protobuf:"varint,2,opt,name=block_start,json=blockStart,proto3" json:"block_start,omitempty"`
-	BlockEnd   int64                  `protobuf:"varint,3,opt,name=block_end,json=blockEnd,proto3" json:"block_end,omitempty"`
-	Reports    []*SlaNodeReport       `protobuf:"bytes,4,rep,name=reports,proto3" json:"reports,omitempty"`
-}
-
-func (x *SlaRollup) Reset() {
-	*x = SlaRollup{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_core_v1_types_proto_msgTypes[28]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *SlaRollup) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*SlaRollup) ProtoMessage() {}
-
-func (x *SlaRollup) ProtoReflect() protoreflect.Message {
-	mi := &file_core_v1_types_proto_msgTypes[28]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Timestamp
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use SlaRollup.ProtoReflect.Descriptor instead.
-func (*SlaRollup) Descriptor() ([]byte, []int) {
-	return file_core_v1_types_proto_rawDescGZIP(), []int{28}
+	return nil
 }
 
-func (x *SlaRollup) GetTimestamp() *timestamppb.Timestamp {
+func (x *Transaction) GetTransactionv2() *v1beta1.Transaction {
 	if x != nil {
-		return x.Timestamp
+		return x.Transactionv2
 	}
 	return nil
 }
 
-func (x *SlaRollup) GetBlockStart() int64 {
+func (x *Transaction) GetResultCode() uint32 {
 	if x != nil {
-		return x.BlockStart
+		return x.ResultCode
 	}
 	return 0
 }
 
-func (x *SlaRollup) GetBlockEnd() int64 {
+func (x *Transaction) GetResultLog() string {
 	if x != nil {
-		return x.BlockEnd
+		return x.ResultLog
 	}
-	return 0
+	return ""
 }
 
-func (x *SlaRollup) GetReports() []*SlaNodeReport {
+func (x *Transaction) GetTransactionReceipt() *v1beta1.TransactionReceipt {
 	if x != nil {
-		return x.Reports
+		return x.TransactionReceipt
 	}
 	return nil
 }
 
-type SlaNodeReport struct {
+type SignedTransaction struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address           string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	NumBlocksProposed int32  `protobuf:"varint,2,opt,name=num_blocks_proposed,json=numBlocksProposed,proto3" json:"num_blocks_proposed,omitempty"`
+	Signature string `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+	RequestId string `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// Types that are assignable to Transaction:
+	//
+	//	*SignedTransaction_Plays
+	//	*SignedTransaction_ValidatorRegistration
+	//	*SignedTransaction_SlaRollup
+	//	*SignedTransaction_ManageEntity
+	//	*SignedTransaction_ValidatorDeregistration
+	//	*SignedTransaction_StorageProof
+	//	*SignedTransaction_StorageProofVerification
+	//	*SignedTransaction_Attestation
+	//	*SignedTransaction_Release
+	//	*SignedTransaction_Reward
+	//	*SignedTransaction_FileUpload
+	//	*SignedTransaction_VoteExtensions
+	Transaction isSignedTransaction_Transaction `protobuf_oneof:"transaction"`
 }
 
-func (x *SlaNodeReport) Reset() {
-	*x = SlaNodeReport{}
+func (x *SignedTransaction) Reset() {
+	*x = SignedTransaction{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_core_v1_types_proto_msgTypes[29]
+		mi := &file_core_v1_types_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SlaNodeReport) String() string {
+func (x *SignedTransaction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SlaNodeReport) ProtoMessage() {}
+func (*SignedTransaction) ProtoMessage() {}
 
-func (x *SlaNodeReport) ProtoReflect() protoreflect.Message {
-	mi := &file_core_v1_types_proto_msgTypes[29]
+func (x *SignedTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_core_v1_types_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2039,154 +2065,217 @@ func (x *SlaNodeReport) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SlaNodeReport.ProtoReflect.Descriptor instead.
-func (*SlaNodeReport) Descriptor() ([]byte, []int) {
-	return file_core_v1_types_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use SignedTransaction.ProtoReflect.Descriptor instead.
+func (*SignedTransaction) Descriptor() ([]byte, []int) {
+	return file_core_v1_types_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *SlaNodeReport) GetAddress() string {
+func (x *SignedTransaction) GetSignature() string {
 	if x != nil {
-		return x.Address
+		return x.Signature
 	}
 	return ""
 }
 
-func (x *SlaNodeReport) GetNumBlocksProposed() int32 {
+func (x *SignedTransaction) GetRequestId() string {
 	if x != nil {
-		return x.NumBlocksProposed
+		return x.RequestId
 	}
-	return 0
+	return ""
 }
 
-type ManageEntityLegacy struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	UserId     int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	EntityType string `protobuf:"bytes,2,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
-	EntityId   int64  `protobuf:"varint,3,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
-	Action     string `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
-	Metadata   string `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Signature  string `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`
-	Signer     string `protobuf:"bytes,7,opt,name=signer,proto3" json:"signer,omitempty"`
-	Nonce      string `protobuf:"bytes,8,opt,name=nonce,proto3" json:"nonce,omitempty"`
+func (m *SignedTransaction) GetTransaction() isSignedTransaction_Transaction {
+	if m != nil {
+		return m.Transaction
+	}
+	return nil
 }
 
-func (x *ManageEntityLegacy) Reset() {
-	*x = ManageEntityLegacy{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_core_v1_types_proto_msgTypes[30]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *SignedTransaction) GetPlays() *TrackPlays {
+	if x, ok := x.GetTransaction().(*SignedTransaction_Plays); ok {
+		return x.Plays
 	}
+	return nil
 }
 
-func (x *ManageEntityLegacy) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SignedTransaction) GetValidatorRegistration() *ValidatorRegistrationLegacy {
+	if x, ok := x.GetTransaction().(*SignedTransaction_ValidatorRegistration); ok {
+		return x.ValidatorRegistration
+	}
+	return nil
 }
 
-func (*ManageEntityLegacy) ProtoMessage() {}
-
-func (x *ManageEntityLegacy) ProtoReflect() protoreflect.Message {
-	mi := &file_core_v1_types_proto_msgTypes[30]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *SignedTransaction) GetSlaRollup() *SlaRollup {
+	if x, ok := x.GetTransaction().(*SignedTransaction_SlaRollup); ok {
+		return x.SlaRollup
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use ManageEntityLegacy.ProtoReflect.Descriptor instead.
-func (*ManageEntityLegacy) Descriptor() ([]byte, []int) {
-	return file_core_v1_types_proto_rawDescGZIP(), []int{30}
+func (x *SignedTransaction) GetManageEntity() *ManageEntityLegacy {
+	if x, ok := x.GetTransaction().(*SignedTransaction_ManageEntity); ok {
+		return x.ManageEntity
+	}
+	return nil
 }
 
-func (x *ManageEntityLegacy) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
+func (x *SignedTransaction) GetValidatorDeregistration() *ValidatorMisbehaviorDeregistration {
+	if x, ok := x.GetTransaction().(*SignedTransaction_ValidatorDeregistration); ok {
+		return x.ValidatorDeregistration
 	}
-	return 0
+	return nil
 }
 
-func (x *ManageEntityLegacy) GetEntityType() string {
-	if x != nil {
-		return x.EntityType
+func (x *SignedTransaction) GetStorageProof() *StorageProof {
+	if x, ok := x.GetTransaction().(*SignedTransaction_StorageProof); ok {
+		return x.StorageProof
 	}
-	return ""
+	return nil
 }
 
-func (x *ManageEntityLegacy) GetEntityId() int64 {
-	if x != nil {
-		return x.EntityId
+func (x *SignedTransaction) GetStorageProofVerification() *StorageProofVerification {
+	if x, ok := x.GetTransaction().(*SignedTransaction_StorageProofVerification); ok {
+		return x.StorageProofVerification
 	}
-	return 0
+	return nil
 }
 
-func (x *ManageEntityLegacy) GetAction() string {
-	if x != nil {
-		return x.Action
+func (x *SignedTransaction) GetAttestation() *Attestation {
+	if x, ok := x.GetTransaction().(*SignedTransaction_Attestation); ok {
+		return x.Attestation
 	}
-	return ""
+	return nil
 }
 
-func (x *ManageEntityLegacy) GetMetadata() string {
-	if x != nil {
-		return x.Metadata
+func (x *SignedTransaction) GetRelease() *v1beta11.NewReleaseMessage {
+	if x, ok := x.GetTransaction().(*SignedTransaction_Release); ok {
+		return x.Release
 	}
-	return ""
+	return nil
 }
 
-func (x *ManageEntityLegacy) GetSignature() string {
-	if x != nil {
-		return x.Signature
+func (x *SignedTransaction) GetReward() *RewardMessage {
+	if x, ok := x.GetTransaction().(*SignedTransaction_Reward); ok {
+		return x.Reward
 	}
-	return ""
+	return nil
 }
 
-func (x *ManageEntityLegacy) GetSigner() string {
-	if x != nil {
-		return x.Signer
-	}
-	return ""
+func (x *SignedTransaction) GetFileUpload() *FileUpload {
+	if x, ok := x.GetTransaction().(*SignedTransaction_FileUpload); ok {
+		return x.FileUpload
+	}
+	return nil
 }
 
-func (x *ManageEntityLegacy) GetNonce() string {
-	if x != nil {
-		return x.Nonce
+func (x *SignedTransaction) GetVoteExtensions() *VoteExtensions {
+	if x, ok := x.GetTransaction().(*SignedTransaction_VoteExtensions); ok {
+		return x.VoteExtensions
 	}
-	return ""
+	return nil
 }
 
-type ValidatorMisbehaviorDeregistration struct {
+type isSignedTransaction_Transaction interface {
+	isSignedTransaction_Transaction()
+}
+
+type SignedTransaction_Plays struct {
+	Plays *TrackPlays `protobuf:"bytes,1000,opt,name=plays,proto3,oneof"`
+}
+
+type SignedTransaction_ValidatorRegistration struct {
+	ValidatorRegistration *ValidatorRegistrationLegacy `protobuf:"bytes,1001,opt,name=validator_registration,json=validatorRegistration,proto3,oneof"`
+}
+
+type SignedTransaction_SlaRollup struct {
+	SlaRollup *SlaRollup `protobuf:"bytes,1002,opt,name=sla_rollup,json=slaRollup,proto3,oneof"`
+}
+
+type SignedTransaction_ManageEntity struct {
+	ManageEntity *ManageEntityLegacy `protobuf:"bytes,1003,opt,name=manage_entity,json=manageEntity,proto3,oneof"`
+}
+
+type SignedTransaction_ValidatorDeregistration struct {
+	ValidatorDeregistration *ValidatorMisbehaviorDeregistration `protobuf:"bytes,1004,opt,name=validator_deregistration,json=validatorDeregistration,proto3,oneof"`
+}
+
+type SignedTransaction_StorageProof struct {
+	StorageProof *StorageProof `protobuf:"bytes,1005,opt,name=storage_proof,json=storageProof,proto3,oneof"`
+}
+
+type SignedTransaction_StorageProofVerification struct {
+	StorageProofVerification *StorageProofVerification `protobuf:"bytes,1006,opt,name=storage_proof_verification,json=storageProofVerification,proto3,oneof"`
+}
+
+type SignedTransaction_Attestation struct {
+	Attestation *Attestation `protobuf:"bytes,1007,opt,name=attestation,proto3,oneof"`
+}
+
+type SignedTransaction_Release struct {
+	Release *v1beta11.NewReleaseMessage `protobuf:"bytes,1008,opt,name=release,proto3,oneof"`
+}
+
+type SignedTransaction_Reward struct {
+	Reward *RewardMessage `protobuf:"bytes,1009,opt,name=reward,proto3,oneof"`
+}
+
+type SignedTransaction_FileUpload struct {
+	FileUpload *FileUpload `protobuf:"bytes,1010,opt,name=file_upload,json=fileUpload,proto3,oneof"`
+}
+
+type SignedTransaction_VoteExtensions struct {
+	VoteExtensions *VoteExtensions `protobuf:"bytes,1011,opt,name=vote_extensions,json=voteExtensions,proto3,oneof"`
+}
+
+func (*SignedTransaction_Plays) isSignedTransaction_Transaction() {}
+
+func (*SignedTransaction_ValidatorRegistration) isSignedTransaction_Transaction() {}
+
+func (*SignedTransaction_SlaRollup) isSignedTransaction_Transaction() {}
+
+func (*SignedTransaction_ManageEntity) isSignedTransaction_Transaction() {}
+
+func (*SignedTransaction_ValidatorDeregistration) isSignedTransaction_Transaction() {}
+
+func (*SignedTransaction_StorageProof) isSignedTransaction_Transaction() {}
+
+func (*SignedTransaction_StorageProofVerification) isSignedTransaction_Transaction() {}
+
+func (*SignedTransaction_Attestation) isSignedTransaction_Transaction() {}
+
+func (*SignedTransaction_Release) isSignedTransaction_Transaction() {}
+
+func (*SignedTransaction_Reward) isSignedTransaction_Transaction() {}
+
+func (*SignedTransaction_FileUpload) isSignedTransaction_Transaction() {}
+
+func (*SignedTransaction_VoteExtensions) isSignedTransaction_Transaction() {}
+
+type TrackPlays struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CometAddress string `protobuf:"bytes,1,opt,name=comet_address,json=cometAddress,proto3" json:"comet_address,omitempty"`
-	PubKey       []byte `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	Plays []*TrackPlay `protobuf:"bytes,1,rep,name=plays,proto3" json:"plays,omitempty"`
 }
 
-func (x *ValidatorMisbehaviorDeregistration) Reset() {
-	*x = ValidatorMisbehaviorDeregistration{}
+func (x *TrackPlays) Reset() {
+	*x = TrackPlays{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_core_v1_types_proto_msgTypes[31]
+		mi := &file_core_v1_types_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ValidatorMisbehaviorDeregistration) String() string {
+func (x *TrackPlays) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidatorMisbehaviorDeregistration) ProtoMessage() {}
+func (*TrackPlays) ProtoMessage() {}
 
-func (x *ValidatorMisbehaviorDeregistration) ProtoReflect() protoreflect.Message {
-	mi := &file_core_v1_types_proto_msgTypes[31]
+func (x *TrackPlays) ProtoReflect() protoreflect.Message {
+	mi := &file_core_v1_types_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2197,54 +2286,49 @@ func (x *ValidatorMisbehaviorDeregistration) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ValidatorMisbehaviorDeregistration.ProtoReflect.Descriptor instead.
-func (*ValidatorMisbehaviorDeregistration) Descriptor() ([]byte, []int) {
-	return file_core_v1_types_proto_rawDescGZIP(), []int{31}
-}
-
-func (x *ValidatorMisbehaviorDeregistration) GetCometAddress() string {
-	if x != nil {
-		return x.CometAddress
-	}
-	return ""
+// Deprecated: Use TrackPlays.ProtoReflect.Descriptor instead.
+func (*TrackPlays) Descriptor() ([]byte, []int) {
+	return file_core_v1_types_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *ValidatorMisbehaviorDeregistration) GetPubKey() []byte {
+func (x *TrackPlays) GetPlays() []*TrackPlay {
 	if x != nil {
-		return x.PubKey
+		return x.Plays
 	}
 	return nil
 }
 
-type StorageProof struct {
+type ValidatorRegistrationLegacy struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Height          int64    `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
-	Address         string   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	ProverAddresses []string `protobuf:"bytes,3,rep,name=prover_addresses,json=proverAddresses,proto3" json:"prover_addresses,omitempty"`
-	Cid             string   `protobuf:"bytes,4,opt,name=cid,proto3" json:"cid,omitempty"`
-	ProofSignature  []byte   `protobuf:"bytes,5,opt,name=proof_signature,json=proofSignature,proto3" json:"proof_signature,omitempty"`
+	Endpoint     string `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	CometAddress string `protobuf:"bytes,2,opt,name=comet_address,json=cometAddress,proto3" json:"comet_address,omitempty"`
+	EthBlock     string `protobuf:"bytes,3,opt,name=eth_block,json=ethBlock,proto3" json:"eth_block,omitempty"`
+	NodeType     string `protobuf:"bytes,4,opt,name=node_type,json=nodeType,proto3" json:"node_type,omitempty"`
+	SpId         string `protobuf:"bytes,5,opt,name=sp_id,json=spId,proto3" json:"sp_id,omitempty"`
+	PubKey       []byte `protobuf:"bytes,6,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	Power        int64  `protobuf:"varint,7,opt,name=power,proto3" json:"power,omitempty"`
 }
 
-func (x *StorageProof) Reset() {
-	*x = StorageProof{}
+func (x *ValidatorRegistrationLegacy) Reset() {
+	*x = ValidatorRegistrationLegacy{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_core_v1_types_proto_msgTypes[32]
+		mi := &file_core_v1_types_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StorageProof) String() string {
+func (x *ValidatorRegistrationLegacy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StorageProof) ProtoMessage() {}
+func (*ValidatorRegistrationLegacy) ProtoMessage() {}
 
-func (x *StorageProof) ProtoReflect() protoreflect.Message {
-	mi := &file_core_v1_types_proto_msgTypes[32]
+func (x *ValidatorRegistrationLegacy) ProtoReflect() protoreflect.Message {
+	mi := &file_core_v1_types_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2255,72 +2339,91 @@ func (x *StorageProof) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StorageProof.ProtoReflect.Descriptor instead.
-func (*StorageProof) Descriptor() ([]byte, []int) {
-	return file_core_v1_types_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use ValidatorRegistrationLegacy.ProtoReflect.Descriptor instead.
+func (*ValidatorRegistrationLegacy) Descriptor() ([]byte, []int) {
+	return file_core_v1_types_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *StorageProof) GetHeight() int64 {
+func (x *ValidatorRegistrationLegacy) GetEndpoint() string {
 	if x != nil {
-		return x.Height
+		return x.Endpoint
 	}
-	return 0
+	return ""
 }
 
-func (x *StorageProof) GetAddress() string {
+func (x *ValidatorRegistrationLegacy) GetCometAddress() string {
 	if x != nil {
-		return x.Address
+		return x.CometAddress
 	}
 	return ""
 }
 
-func (x *StorageProof) GetProverAddresses() []string {
+func (x *ValidatorRegistrationLegacy) GetEthBlock() string {
 	if x != nil {
-		return x.ProverAddresses
+		return x.EthBlock
 	}
-	return nil
+	return ""
 }
 
-func (x *StorageProof) GetCid() string {
+func (x *ValidatorRegistrationLegacy) GetNodeType() string {
 	if x != nil {
-		return x.Cid
+		return x.NodeType
 	}
 	return ""
 }
 
-func (x *StorageProof) GetProofSignature() []byte {
+func (x *ValidatorRegistrationLegacy) GetSpId() string {
 	if x != nil {
-		return x.ProofSignature
+		return x.SpId
+	}
+	return ""
+}
+
+func (x *ValidatorRegistrationLegacy) GetPubKey() []byte {
+	if x != nil {
+		return x.PubKey
 	}
 	return nil
 }
 
-type StorageProofVerification struct {
+func (x *ValidatorRegistrationLegacy) GetPower() int64 {
+	if x != nil {
+		return x.Power
+	}
+	return 0
+}
+
+type TrackPlay struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Height int64  `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
-	Proof  []byte `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
+	UserId    string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TrackId   string                 `protobuf:"bytes,2,opt,name=track_id,json=trackId,proto3" json:"track_id,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Signature string                 `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	City      string                 `protobuf:"bytes,5,opt,name=city,proto3" json:"city,omitempty"`
+	Region    string                 `protobuf:"bytes,6,opt,name=region,proto3" json:"region,omitempty"`
+	Country   string                 `protobuf:"bytes,7,opt,name=country,proto3" json:"country,omitempty"`
 }
 
-func (x *StorageProofVerification) Reset() {
-	*x = StorageProofVerification{}
+func (x *TrackPlay) Reset() {
+	*x = TrackPlay{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_core_v1_types_proto_msgTypes[33]
+		mi := &file_core_v1_types_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StorageProofVerification) String() string {
+func (x *TrackPlay) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StorageProofVerification) ProtoMessage() {}
+func (*TrackPlay) ProtoMessage() {}
 
-func (x *StorageProofVerification) ProtoReflect() protoreflect.Message {
-	mi := &file_core_v1_types_proto_msgTypes[33]
+func (x *TrackPlay) ProtoReflect() protoreflect.Message {
+	mi := &file_core_v1_types_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2331,55 +2434,88 @@ func (x *StorageProofVerification) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StorageProofVerification.ProtoReflect.Descriptor instead.
-func (*StorageProofVerification) Descriptor() ([]byte, []int) {
-	return file_core_v1_types_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use TrackPlay.ProtoReflect.Descriptor instead.
+func (*TrackPlay) Descriptor() ([]byte, []int) {
+	return file_core_v1_types_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *StorageProofVerification) GetHeight() int64 {
+func (x *TrackPlay) GetUserId() string {
 	if x != nil {
-		return x.Height
+		return x.UserId
 	}
-	return 0
+	return ""
 }
 
-func (x *StorageProofVerification) GetProof() []byte {
+func (x *TrackPlay) GetTrackId() string {
 	if x != nil {
-		return x.Proof
+		return x.TrackId
 	}
-	return nil
+	return ""
 }
 
-type Attestation struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *TrackPlay) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
 
-	Signatures []string `protobuf:"bytes,1,rep,name=signatures,proto3" json:"signatures,omitempty"`
-	// Types that are assignable to Body:
-	//
-	//	*Attestation_ValidatorRegistration
-	//	*Attestation_ValidatorDeregistration
-	Body isAttestation_Body `protobuf_oneof:"body"`
+func (x *TrackPlay) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
 }
 
-func (x *Attestation) Reset() {
-	*x = Attestation{}
+func (x *TrackPlay) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *TrackPlay) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *TrackPlay) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+type SlaRollup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timestamp  *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	BlockStart int64                  `protobuf:"varint,2,opt,name=block_start,json=blockStart,proto3" json:"block_start,omitempty"`
+	BlockEnd   int64                  `protobuf:"varint,3,opt,name=block_end,json=blockEnd,proto3" json:"block_end,omitempty"`
+	Reports    []*SlaNodeReport       `protobuf:"bytes,4,rep,name=reports,proto3" json:"reports,omitempty"`
+}
+
+func (x *SlaRollup) Reset() {
+	*x = SlaRollup{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_core_v1_types_proto_msgTypes[34]
+		mi := &file_core_v1_types_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Attestation) String() string {
+func (x *SlaRollup) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Attestation) ProtoMessage() {}
+func (*SlaRollup) ProtoMessage() {}
 
-func (x *Attestation) ProtoReflect() protoreflect.Message {
-	mi := &file_core_v1_types_proto_msgTypes[34]
+func (x *SlaRollup) ProtoReflect() protoreflect.Message {
+	mi := &file_core_v1_types_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2390,88 +2526,71 @@ func (x *Attestation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Attestation.ProtoReflect.Descriptor instead.
-func (*Attestation) Descriptor() ([]byte, []int) {
-	return file_core_v1_types_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use SlaRollup.ProtoReflect.Descriptor instead.
+func (*SlaRollup) Descriptor() ([]byte, []int) {
+	return file_core_v1_types_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *Attestation) GetSignatures() []string {
+func (x *SlaRollup) GetTimestamp() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Signatures
+		return x.Timestamp
 	}
 	return nil
 }
 
-func (m *Attestation) GetBody() isAttestation_Body {
-	if m != nil {
-		return m.Body
+func (x *SlaRollup) GetBlockStart() int64 {
+	if x != nil {
+		return x.BlockStart
 	}
-	return nil
+	return 0
 }
 
-func (x *Attestation) GetValidatorRegistration() *ValidatorRegistration {
-	if x, ok := x.GetBody().(*Attestation_ValidatorRegistration); ok {
-		return x.ValidatorRegistration
+func (x *SlaRollup) GetBlockEnd() int64 {
+	if x != nil {
+		return x.BlockEnd
 	}
-	return nil
+	return 0
 }
 
-func (x *Attestation) GetValidatorDeregistration() *ValidatorDeregistration {
-	if x, ok := x.GetBody().(*Attestation_ValidatorDeregistration); ok {
-		return x.ValidatorDeregistration
+func (x *SlaRollup) GetReports() []*SlaNodeReport {
+	if x != nil {
+		return x.Reports
 	}
 	return nil
 }
 
-type isAttestation_Body interface {
-	isAttestation_Body()
-}
-
-type Attestation_ValidatorRegistration struct {
-	ValidatorRegistration *ValidatorRegistration `protobuf:"bytes,1000,opt,name=validator_registration,json=validatorRegistration,proto3,oneof"`
-}
-
-type Attestation_ValidatorDeregistration struct {
-	ValidatorDeregistration *ValidatorDeregistration `protobuf:"bytes,1001,opt,name=validator_deregistration,json=validatorDeregistration,proto3,oneof"`
-}
-
-func (*Attestation_ValidatorRegistration) isAttestation_Body() {}
-
-func (*Attestation_ValidatorDeregistration) isAttestation_Body() {}
-
-type ValidatorRegistration struct {
+type SlaNodeReport struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DelegateWallet string `protobuf:"bytes,1,opt,name=delegate_wallet,json=delegateWallet,proto3" json:"delegate_wallet,omitempty"`
-	Endpoint       string `protobuf:"bytes,2,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
-	NodeType       string `protobuf:"bytes,3,opt,name=node_type,json=nodeType,proto3" json:"node_type,omitempty"`
-	SpId           string `protobuf:"bytes,4,opt,name=sp_id,json=spId,proto3" json:"sp_id,omitempty"`
-	EthBlock       int64  `protobuf:"varint,5,opt,name=eth_block,json=ethBlock,proto3" json:"eth_block,omitempty"`
-	CometAddress   string `protobuf:"bytes,6,opt,name=comet_address,json=cometAddress,proto3" json:"comet_address,omitempty"`
-	PubKey         []byte `protobuf:"bytes,7,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
-	Power          int64  `protobuf:"varint,8,opt,name=power,proto3" json:"power,omitempty"`
-	Deadline       int64  `protobuf:"varint,9,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	Address           string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	NumBlocksProposed int32  `protobuf:"varint,2,opt,name=num_blocks_proposed,json=numBlocksProposed,proto3" json:"num_blocks_proposed,omitempty"`
+	// storage proof challenges the validator was picked for and how many it failed
+	ChallengesReceived int32 `protobuf:"varint,3,opt,name=challenges_received,json=challengesReceived,proto3" json:"challenges_received,omitempty"`
+	ChallengesFailed   int32 `protobuf:"varint,4,opt,name=challenges_failed,json=challengesFailed,proto3" json:"challenges_failed,omitempty"`
+	// peer observations from other validators that could and couldn't reach the validator
+	ReachableObservations   int32 `protobuf:"varint,5,opt,name=reachable_observations,json=reachableObservations,proto3" json:"reachable_observations,omitempty"`
+	UnreachableObservations int32 `protobuf:"varint,6,opt,name=unreachable_observations,json=unreachableObservations,proto3" json:"unreachable_observations,omitempty"`
 }
 
-func (x *ValidatorRegistration) Reset() {
-	*x = ValidatorRegistration{}
+func (x *SlaNodeReport) Reset() {
+	*x = SlaNodeReport{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_core_v1_types_proto_msgTypes[35]
+		mi := &file_core_v1_types_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ValidatorRegistration) String() string {
+func (x *SlaNodeReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidatorRegistration) ProtoMessage() {}
+func (*SlaNodeReport) ProtoMessage() {}
 
-func (x *ValidatorRegistration) ProtoReflect() protoreflect.Message {
-	mi := &file_core_v1_types_proto_msgTypes[35]
+func (x *SlaNodeReport) ProtoReflect() protoreflect.Message {
+	mi := &file_core_v1_types_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2482,101 +2601,85 @@ func (x *ValidatorRegistration) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ValidatorRegistration.ProtoReflect.Descriptor instead.
-func (*ValidatorRegistration) Descriptor() ([]byte, []int) {
-	return file_core_v1_types_proto_rawDescGZIP(), []int{35}
-}
-
-func (x *ValidatorRegistration) GetDelegateWallet() string {
-	if x != nil {
-		return x.DelegateWallet
-	}
-	return ""
-}
-
-func (x *ValidatorRegistration) GetEndpoint() string {
-	if x != nil {
-		return x.Endpoint
-	}
-	return ""
-}
-
-func (x *ValidatorRegistration) GetNodeType() string {
-	if x != nil {
-		return x.NodeType
-	}
-	return ""
+// Deprecated: Use SlaNodeReport.ProtoReflect.Descriptor instead.
+func (*SlaNodeReport) Descriptor() ([]byte, []int) {
+	return file_core_v1_types_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *ValidatorRegistration) GetSpId() string {
+func (x *SlaNodeReport) GetAddress() string {
 	if x != nil {
-		return x.SpId
+		return x.Address
 	}
 	return ""
 }
 
-func (x *ValidatorRegistration) GetEthBlock() int64 {
+func (x *SlaNodeReport) GetNumBlocksProposed() int32 {
 	if x != nil {
-		return x.EthBlock
+		return x.NumBlocksProposed
 	}
 	return 0
 }
 
-func (x *ValidatorRegistration) GetCometAddress() string {
+func (x *SlaNodeReport) GetChallengesReceived() int32 {
 	if x != nil {
-		return x.CometAddress
+		return x.ChallengesReceived
 	}
-	return ""
+	return 0
 }
 
-func (x *ValidatorRegistration) GetPubKey() []byte {
+func (x *SlaNodeReport) GetChallengesFailed() int32 {
 	if x != nil {
-		return x.PubKey
+		return x.ChallengesFailed
 	}
-	return nil
+	return 0
 }
 
-func (x *ValidatorRegistration) GetPower() int64 {
+func (x *SlaNodeReport) GetReachableObservations() int32 {
 	if x != nil {
-		return x.Power
+		return x.ReachableObservations
 	}
 	return 0
 }
 
-func (x *ValidatorRegistration) GetDeadline() int64 {
+func (x *SlaNodeReport) GetUnreachableObservations() int32 {
 	if x != nil {
-		return x.Deadline
+		return x.UnreachableObservations
 	}
 	return 0
 }
 
-type ValidatorDeregistration struct {
+type ManageEntityLegacy struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CometAddress string `protobuf:"bytes,1,opt,name=comet_address,json=cometAddress,proto3" json:"comet_address,omitempty"`
-	PubKey       []byte `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
-	Deadline     int64  `protobuf:"varint,3,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	UserId     int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	EntityType string `protobuf:"bytes,2,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
+	EntityId   int64  `protobuf:"varint,3,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	Action     string `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	Metadata   string `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Signature  string `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`
+	Signer     string `protobuf:"bytes,7,opt,name=signer,proto3" json:"signer,omitempty"`
+	Nonce      string `protobuf:"bytes,8,opt,name=nonce,proto3" json:"nonce,omitempty"`
 }
 
-func (x *ValidatorDeregistration) Reset() {
-	*x = ValidatorDeregistration{}
+func (x *ManageEntityLegacy) Reset() {
+	*x = ManageEntityLegacy{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_core_v1_types_proto_msgTypes[36]
+		mi := &file_core_v1_types_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ValidatorDeregistration) String() string {
+func (x *ManageEntityLegacy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidatorDeregistration) ProtoMessage() {}
+func (*ManageEntityLegacy) ProtoMessage() {}
 
-func (x *ValidatorDeregistration) ProtoReflect() protoreflect.Message {
-	mi := &file_core_v1_types_proto_msgTypes[36]
+func (x *ManageEntityLegacy) ProtoReflect() protoreflect.Message {
+	mi := &file_core_v1_types_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2587,80 +2690,78 @@ func (x *ValidatorDeregistration) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ValidatorDeregistration.ProtoReflect.Descriptor instead.
-func (*ValidatorDeregistration) Descriptor() ([]byte, []int) {
-	return file_core_v1_types_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use ManageEntityLegacy.ProtoReflect.Descriptor instead.
+func (*ManageEntityLegacy) Descriptor() ([]byte, []int) {
+	return file_core_v1_types_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *ValidatorDeregistration) GetCometAddress() string {
+func (x *ManageEntityLegacy) GetUserId() int64 {
 	if x != nil {
-		return x.CometAddress
+		return x.UserId
 	}
-	return ""
+	return 0
 }
 
-func (x *ValidatorDeregistration) GetPubKey() []byte {
+func (x *ManageEntityLegacy) GetEntityType() string {
 	if x != nil {
-		return x.PubKey
+		return x.EntityType
 	}
-	return nil
+	return ""
 }
 
-func (x *ValidatorDeregistration) GetDeadline() int64 {
+func (x *ManageEntityLegacy) GetEntityId() int64 {
 	if x != nil {
-		return x.Deadline
+		return x.EntityId
 	}
 	return 0
 }
 
-type GetStoredSnapshotsRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-}
-
-func (x *GetStoredSnapshotsRequest) Reset() {
-	*x = GetStoredSnapshotsRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_core_v1_types_proto_msgTypes[37]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ManageEntityLegacy) GetAction() string {
+	if x != nil {
+		return x.Action
 	}
+	return ""
 }
 
-func (x *GetStoredSnapshotsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ManageEntityLegacy) GetMetadata() string {
+	if x != nil {
+		return x.Metadata
+	}
+	return ""
 }
 
-func (*GetStoredSnapshotsRequest) ProtoMessage() {}
-
-func (x *GetStoredSnapshotsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_core_v1_types_proto_msgTypes[37]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ManageEntityLegacy) GetSignature() string {
+	if x != nil {
+		return x.Signature
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetStoredSnapshotsRequest.ProtoReflect.Descriptor instead.
-func (*GetStoredSnapshotsRequest) Descriptor() ([]byte, []int) {
-	return file_core_v1_types_proto_rawDescGZIP(), []int{37}
+func (x *ManageEntityLegacy) GetSigner() string {
+	if x != nil {
+		return x.Signer
+	}
+	return ""
 }
 
-type GetStoredSnapshotsResponse struct {
+func (x *ManageEntityLegacy) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
+type ValidatorMisbehaviorDeregistration struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Snapshots []*SnapshotMetadata `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+	CometAddress string `protobuf:"bytes,1,opt,name=comet_address,json=cometAddress,proto3" json:"comet_address,omitempty"`
+	PubKey       []byte `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
 }
 
-func (x *GetStoredSnapshotsResponse) Reset() {
-	*x = GetStoredSnapshotsResponse{}
+func (x *ValidatorMisbehaviorDeregistration) Reset() {
+	*x = ValidatorMisbehaviorDeregistration{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_core_v1_types_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2668,13 +2769,13 @@ func (x *GetStoredSnapshotsResponse) Reset() {
 	}
 }
 
-func (x *GetStoredSnapshotsResponse) String() string {
+func (x *ValidatorMisbehaviorDeregistration) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStoredSnapshotsResponse) ProtoMessage() {}
+func (*ValidatorMisbehaviorDeregistration) ProtoMessage() {}
 
-func (x *GetStoredSnapshotsResponse) ProtoReflect() protoreflect.Message {
+func (x *ValidatorMisbehaviorDeregistration) ProtoReflect() protoreflect.Message {
 	mi := &file_core_v1_types_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2686,31 +2787,39 @@ func (x *GetStoredSnapshotsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStoredSnapshotsResponse.ProtoReflect.Descriptor instead.
-func (*GetStoredSnapshotsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ValidatorMisbehaviorDeregistration.ProtoReflect.Descriptor instead.
+func (*ValidatorMisbehaviorDeregistration) Descriptor() ([]byte, []int) {
 	return file_core_v1_types_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *GetStoredSnapshotsResponse) GetSnapshots() []*SnapshotMetadata {
+func (x *ValidatorMisbehaviorDeregistration) GetCometAddress() string {
 	if x != nil {
-		return x.Snapshots
+		return x.CometAddress
+	}
+	return ""
+}
+
+func (x *ValidatorMisbehaviorDeregistration) GetPubKey() []byte {
+	if x != nil {
+		return x.PubKey
 	}
 	return nil
 }
 
-type SnapshotMetadata struct {
+type StorageProof struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Height     int64  `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
-	Hash       string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
-	ChunkCount int64  `protobuf:"varint,3,opt,name=chunk_count,json=chunkCount,proto3" json:"chunk_count,omitempty"`
-	ChainId    string `protobuf:"bytes,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Height          int64    `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Address         string   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	ProverAddresses []string `protobuf:"bytes,3,rep,name=prover_addresses,json=proverAddresses,proto3" json:"prover_addresses,omitempty"`
+	Cid             string   `protobuf:"bytes,4,opt,name=cid,proto3" json:"cid,omitempty"`
+	ProofSignature  []byte   `protobuf:"bytes,5,opt,name=proof_signature,json=proofSignature,proto3" json:"proof_signature,omitempty"`
 }
 
-func (x *SnapshotMetadata) Reset() {
-	*x = SnapshotMetadata{}
+func (x *StorageProof) Reset() {
+	*x = StorageProof{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_core_v1_types_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2718,13 +2827,13 @@ func (x *SnapshotMetadata) Reset() {
 	}
 }
 
-func (x *SnapshotMetadata) String() string {
+func (x *StorageProof) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SnapshotMetadata) ProtoMessage() {}
+func (*StorageProof) ProtoMessage() {}
 
-func (x *SnapshotMetadata) ProtoReflect() protoreflect.Message {
+func (x *StorageProof) ProtoReflect() protoreflect.Message {
 	mi := &file_core_v1_types_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2736,50 +2845,57 @@ func (x *SnapshotMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SnapshotMetadata.ProtoReflect.Descriptor instead.
-func (*SnapshotMetadata) Descriptor() ([]byte, []int) {
+// Deprecated: Use StorageProof.ProtoReflect.Descriptor instead.
+func (*StorageProof) Descriptor() ([]byte, []int) {
 	return file_core_v1_types_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *SnapshotMetadata) GetHeight() int64 {
+func (x *StorageProof) GetHeight() int64 {
 	if x != nil {
 		return x.Height
 	}
 	return 0
 }
 
-func (x *SnapshotMetadata) GetHash() string {
+func (x *StorageProof) GetAddress() string {
 	if x != nil {
-		return x.Hash
+		return x.Address
 	}
 	return ""
 }
 
-func (x *SnapshotMetadata) GetChunkCount() int64 {
+func (x *StorageProof) GetProverAddresses() []string {
 	if x != nil {
-		return x.ChunkCount
+		return x.ProverAddresses
 	}
-	return 0
+	return nil
 }
 
-func (x *SnapshotMetadata) GetChainId() string {
+func (x *StorageProof) GetCid() string {
 	if x != nil {
-		return x.ChainId
+		return x.Cid
 	}
 	return ""
 }
 
-type ClaimAuthority struct {
+func (x *StorageProof) GetProofSignature() []byte {
+	if x != nil {
+		return x.ProofSignature
+	}
+	return nil
+}
+
+type StorageProofVerification struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Name    string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Height int64  `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Proof  []byte `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
 }
 
-func (x *ClaimAuthority) Reset() {
-	*x = ClaimAuthority{}
+func (x *StorageProofVerification) Reset() {
+	*x = StorageProofVerification{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_core_v1_types_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2787,13 +2903,13 @@ func (x *ClaimAuthority) Reset() {
 	}
 }
 
-func (x *ClaimAuthority) String() string {
+func (x *StorageProofVerification) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClaimAuthority) ProtoMessage() {}
+func (*StorageProofVerification) ProtoMessage() {}
 
-func (x *ClaimAuthority) ProtoReflect() protoreflect.Message {
+func (x *StorageProofVerification) ProtoReflect() protoreflect.Message {
 	mi := &file_core_v1_types_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2805,38 +2921,42 @@ func (x *ClaimAuthority) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClaimAuthority.ProtoReflect.Descriptor instead.
-func (*ClaimAuthority) Descriptor() ([]byte, []int) {
+// Deprecated: Use StorageProofVerification.ProtoReflect.Descriptor instead.
+func (*StorageProofVerification) Descriptor() ([]byte, []int) {
 	return file_core_v1_types_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *ClaimAuthority) GetAddress() string {
+func (x *StorageProofVerification) GetHeight() int64 {
 	if x != nil {
-		return x.Address
+		return x.Height
 	}
-	return ""
+	return 0
 }
 
-func (x *ClaimAuthority) GetName() string {
+func (x *StorageProofVerification) GetProof() []byte {
 	if x != nil {
-		return x.Name
+		return x.Proof
 	}
-	return ""
+	return nil
 }
 
-type Reward struct {
+// what a validator attaches to its precommit
+type VoteExtension struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RewardId         string            `protobuf:"bytes,1,opt,name=reward_id,json=rewardId,proto3" json:"reward_id,omitempty"`
-	Amount           uint64            `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Name             string            `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	ClaimAuthorities []*ClaimAuthority `protobuf:"bytes,4,rep,name=claim_authorities,json=claimAuthorities,proto3" json:"claim_authorities,omitempty"`
+	// proofs for challenges the validator is a prover in, address is the validator's
+	StorageProofs []*StorageProof `protobuf:"bytes,1,rep,name=storage_proofs,json=storageProofs,proto3" json:"storage_proofs,omitempty"`
+	// plaintext proofs revealed once their challenge deadline has passed
+	StorageProofVerifications []*StorageProofVerification `protobuf:"bytes,2,rep,name=storage_proof_verifications,json=storageProofVerifications,proto3" json:"storage_proof_verifications,omitempty"`
+	// comet addresses of the peers the validator could and couldn't reach
+	ReachablePeers   []string `protobuf:"bytes,3,rep,name=reachable_peers,json=reachablePeers,proto3" json:"reachable_peers,omitempty"`
+	UnreachablePeers []string `protobuf:"bytes,4,rep,name=unreachable_peers,json=unreachablePeers,proto3" json:"unreachable_peers,omitempty"`
 }
 
-func (x *Reward) Reset() {
-	*x = Reward{}
+func (x *VoteExtension) Reset() {
+	*x = VoteExtension{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_core_v1_types_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2844,13 +2964,13 @@ func (x *Reward) Reset() {
 	}
 }
 
-func (x *Reward) String() string {
+func (x *VoteExtension) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Reward) ProtoMessage() {}
+func (*VoteExtension) ProtoMessage() {}
 
-func (x *Reward) ProtoReflect() protoreflect.Message {
+func (x *VoteExtension) ProtoReflect() protoreflect.Message {
 	mi := &file_core_v1_types_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2862,49 +2982,52 @@ func (x *Reward) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Reward.ProtoReflect.Descriptor instead.
-func (*Reward) Descriptor() ([]byte, []int) {
+// Deprecated: Use VoteExtension.ProtoReflect.Descriptor instead.
+func (*VoteExtension) Descriptor() ([]byte, []int) {
 	return file_core_v1_types_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *Reward) GetRewardId() string {
+func (x *VoteExtension) GetStorageProofs() []*StorageProof {
 	if x != nil {
-		return x.RewardId
+		return x.StorageProofs
 	}
-	return ""
+	return nil
 }
 
-func (x *Reward) GetAmount() uint64 {
+func (x *VoteExtension) GetStorageProofVerifications() []*StorageProofVerification {
 	if x != nil {
-		return x.Amount
+		return x.StorageProofVerifications
 	}
-	return 0
+	return nil
 }
 
-func (x *Reward) GetName() string {
+func (x *VoteExtension) GetReachablePeers() []string {
 	if x != nil {
-		return x.Name
+		return x.ReachablePeers
 	}
-	return ""
+	return nil
 }
 
-func (x *Reward) GetClaimAuthorities() []*ClaimAuthority {
+func (x *VoteExtension) GetUnreachablePeers() []string {
 	if x != nil {
-		return x.ClaimAuthorities
+		return x.UnreachablePeers
 	}
 	return nil
 }
 
-type GetRewardsRequest struct {
+type SignedVoteExtension struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ClaimAuthority string `protobuf:"bytes,1,opt,name=claim_authority,json=claimAuthority,proto3" json:"claim_authority,omitempty"`
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// marshalled VoteExtension, kept as signed by the validator
+	Extension []byte `protobuf:"bytes,2,opt,name=extension,proto3" json:"extension,omitempty"`
+	Signature []byte `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
-func (x *GetRewardsRequest) Reset() {
-	*x = GetRewardsRequest{}
+func (x *SignedVoteExtension) Reset() {
+	*x = SignedVoteExtension{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_core_v1_types_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2912,13 +3035,13 @@ func (x *GetRewardsRequest) Reset() {
 	}
 }
 
-func (x *GetRewardsRequest) String() string {
+func (x *SignedVoteExtension) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRewardsRequest) ProtoMessage() {}
+func (*SignedVoteExtension) ProtoMessage() {}
 
-func (x *GetRewardsRequest) ProtoReflect() protoreflect.Message {
+func (x *SignedVoteExtension) ProtoReflect() protoreflect.Message {
 	mi := &file_core_v1_types_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2930,28 +3053,45 @@ func (x *GetRewardsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRewardsRequest.ProtoReflect.Descriptor instead.
-func (*GetRewardsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SignedVoteExtension.ProtoReflect.Descriptor instead.
+func (*SignedVoteExtension) Descriptor() ([]byte, []int) {
 	return file_core_v1_types_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *GetRewardsRequest) GetClaimAuthority() string {
+func (x *SignedVoteExtension) GetValidatorAddress() string {
 	if x != nil {
-		return x.ClaimAuthority
+		return x.ValidatorAddress
 	}
 	return ""
 }
 
-type GetRewardsResponse struct {
+func (x *SignedVoteExtension) GetExtension() []byte {
+	if x != nil {
+		return x.Extension
+	}
+	return nil
+}
+
+func (x *SignedVoteExtension) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+// the vote extensions of a commit, aggregated into the next block by its proposer
+type VoteExtensions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Rewards []*GetRewardResponse `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	Height     int64                  `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Round      int32                  `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	Extensions []*SignedVoteExtension `protobuf:"bytes,3,rep,name=extensions,proto3" json:"extensions,omitempty"`
 }
 
-func (x *GetRewardsResponse) Reset() {
-	*x = GetRewardsResponse{}
+func (x *VoteExtensions) Reset() {
+	*x = VoteExtensions{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_core_v1_types_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2959,13 +3099,13 @@ func (x *GetRewardsResponse) Reset() {
 	}
 }
 
-func (x *GetRewardsResponse) String() string {
+func (x *VoteExtensions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRewardsResponse) ProtoMessage() {}
+func (*VoteExtensions) ProtoMessage() {}
 
-func (x *GetRewardsResponse) ProtoReflect() protoreflect.Message {
+func (x *VoteExtensions) ProtoReflect() protoreflect.Message {
 	mi := &file_core_v1_types_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2977,34 +3117,48 @@ func (x *GetRewardsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRewardsResponse.ProtoReflect.Descriptor instead.
-func (*GetRewardsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use VoteExtensions.ProtoReflect.Descriptor instead.
+func (*VoteExtensions) Descriptor() ([]byte, []int) {
 	return file_core_v1_types_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *GetRewardsResponse) GetRewards() []*GetRewardResponse {
+func (x *VoteExtensions) GetHeight() int64 {
 	if x != nil {
-		return x.Rewards
+		return x.Height
+	}
+	return 0
+}
+
+func (x *VoteExtensions) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *VoteExtensions) GetExtensions() []*SignedVoteExtension {
+	if x != nil {
+		return x.Extensions
 	}
 	return nil
 }
 
-type GetRewardAttestationRequest struct {
+type Attestation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	EthRecipientAddress string `protobuf:"bytes,1,opt,name=eth_recipient_address,json=ethRecipientAddress,proto3" json:"eth_recipient_address,omitempty"`
-	RewardAddress       string `protobuf:"bytes,2,opt,name=reward_address,json=rewardAddress,proto3" json:"reward_address,omitempty"` // The deployed reward address (new programmatic rewards)
-	Specifier           string `protobuf:"bytes,3,opt,name=specifier,proto3" json:"specifier,omitempty"`
-	ClaimAuthority      string `protobuf:"bytes,4,opt,name=claim_authority,json=claimAuthority,proto3" json:"claim_authority,omitempty"`
-	Signature           string `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
-	Amount              uint64 `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
-	RewardId            string `protobuf:"bytes,7,opt,name=reward_id,json=rewardId,proto3" json:"reward_id,omitempty"` // Legacy reward_id (for backwards compatibility)
+	Signatures []string `protobuf:"bytes,1,rep,name=signatures,proto3" json:"signatures,omitempty"`
+	// Types that are assignable to Body:
+	//
+	//	*Attestation_ValidatorRegistration
+	//	*Attestation_ValidatorDeregistration
+	//	*Attestation_UnjailValidator
+	Body isAttestation_Body `protobuf_oneof:"body"`
 }
 
-func (x *GetRewardAttestationRequest) Reset() {
-	*x = GetRewardAttestationRequest{}
+func (x *Attestation) Reset() {
+	*x = Attestation{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_core_v1_types_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3012,13 +3166,13 @@ func (x *GetRewardAttestationRequest) Reset() {
 	}
 }
 
-func (x *GetRewardAttestationRequest) String() string {
+func (x *Attestation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRewardAttestationRequest) ProtoMessage() {}
+func (*Attestation) ProtoMessage() {}
 
-func (x *GetRewardAttestationRequest) ProtoReflect() protoreflect.Message {
+func (x *Attestation) ProtoReflect() protoreflect.Message {
 	mi := &file_core_v1_types_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3030,71 +3184,86 @@ func (x *GetRewardAttestationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRewardAttestationRequest.ProtoReflect.Descriptor instead.
-func (*GetRewardAttestationRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Attestation.ProtoReflect.Descriptor instead.
+func (*Attestation) Descriptor() ([]byte, []int) {
 	return file_core_v1_types_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *GetRewardAttestationRequest) GetEthRecipientAddress() string {
+func (x *Attestation) GetSignatures() []string {
 	if x != nil {
-		return x.EthRecipientAddress
+		return x.Signatures
 	}
-	return ""
+	return nil
 }
 
-func (x *GetRewardAttestationRequest) GetRewardAddress() string {
-	if x != nil {
-		return x.RewardAddress
+func (m *Attestation) GetBody() isAttestation_Body {
+	if m != nil {
+		return m.Body
 	}
-	return ""
+	return nil
 }
 
-func (x *GetRewardAttestationRequest) GetSpecifier() string {
-	if x != nil {
-		return x.Specifier
+func (x *Attestation) GetValidatorRegistration() *ValidatorRegistration {
+	if x, ok := x.GetBody().(*Attestation_ValidatorRegistration); ok {
+		return x.ValidatorRegistration
 	}
-	return ""
+	return nil
 }
 
-func (x *GetRewardAttestationRequest) GetClaimAuthority() string {
-	if x != nil {
-		return x.ClaimAuthority
+func (x *Attestation) GetValidatorDeregistration() *ValidatorDeregistration {
+	if x, ok := x.GetBody().(*Attestation_ValidatorDeregistration); ok {
+		return x.ValidatorDeregistration
 	}
-	return ""
+	return nil
 }
 
-func (x *GetRewardAttestationRequest) GetSignature() string {
-	if x != nil {
-		return x.Signature
+func (x *Attestation) GetUnjailValidator() *UnjailValidator {
+	if x, ok := x.GetBody().(*Attestation_UnjailValidator); ok {
+		return x.UnjailValidator
 	}
-	return ""
+	return nil
 }
 
-func (x *GetRewardAttestationRequest) GetAmount() uint64 {
-	if x != nil {
-		return x.Amount
-	}
-	return 0
+type isAttestation_Body interface {
+	isAttestation_Body()
 }
 
-func (x *GetRewardAttestationRequest) GetRewardId() string {
-	if x != nil {
-		return x.RewardId
-	}
-	return ""
+type Attestation_ValidatorRegistration struct {
+	ValidatorRegistration *ValidatorRegistration `protobuf:"bytes,1000,opt,name=validator_registration,json=validatorRegistration,proto3,oneof"`
 }
 
-type GetRewardAttestationResponse struct {
+type Attestation_ValidatorDeregistration struct {
+	ValidatorDeregistration *ValidatorDeregistration `protobuf:"bytes,1001,opt,name=validator_deregistration,json=validatorDeregistration,proto3,oneof"`
+}
+
+type Attestation_UnjailValidator struct {
+	UnjailValidator *UnjailValidator `protobuf:"bytes,1002,opt,name=unjail_validator,json=unjailValidator,proto3,oneof"`
+}
+
+func (*Attestation_ValidatorRegistration) isAttestation_Body() {}
+
+func (*Attestation_ValidatorDeregistration) isAttestation_Body() {}
+
+func (*Attestation_UnjailValidator) isAttestation_Body() {}
+
+type ValidatorRegistration struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Owner       string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
-	Attestation string `protobuf:"bytes,2,opt,name=attestation,proto3" json:"attestation,omitempty"`
+	DelegateWallet string `protobuf:"bytes,1,opt,name=delegate_wallet,json=delegateWallet,proto3" json:"delegate_wallet,omitempty"`
+	Endpoint       string `protobuf:"bytes,2,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	NodeType       string `protobuf:"bytes,3,opt,name=node_type,json=nodeType,proto3" json:"node_type,omitempty"`
+	SpId           string `protobuf:"bytes,4,opt,name=sp_id,json=spId,proto3" json:"sp_id,omitempty"`
+	EthBlock       int64  `protobuf:"varint,5,opt,name=eth_block,json=ethBlock,proto3" json:"eth_block,omitempty"`
+	CometAddress   string `protobuf:"bytes,6,opt,name=comet_address,json=cometAddress,proto3" json:"comet_address,omitempty"`
+	PubKey         []byte `protobuf:"bytes,7,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	Power          int64  `protobuf:"varint,8,opt,name=power,proto3" json:"power,omitempty"`
+	Deadline       int64  `protobuf:"varint,9,opt,name=deadline,proto3" json:"deadline,omitempty"`
 }
 
-func (x *GetRewardAttestationResponse) Reset() {
-	*x = GetRewardAttestationResponse{}
+func (x *ValidatorRegistration) Reset() {
+	*x = ValidatorRegistration{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_core_v1_types_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3102,13 +3271,13 @@ func (x *GetRewardAttestationResponse) Reset() {
 	}
 }
 
-func (x *GetRewardAttestationResponse) String() string {
+func (x *ValidatorRegistration) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRewardAttestationResponse) ProtoMessage() {}
+func (*ValidatorRegistration) ProtoMessage() {}
 
-func (x *GetRewardAttestationResponse) ProtoReflect() protoreflect.Message {
+func (x *ValidatorRegistration) ProtoReflect() protoreflect.Message {
 	mi := &file_core_v1_types_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3120,39 +3289,86 @@ func (x *GetRewardAttestationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRewardAttestationResponse.ProtoReflect.Descriptor instead.
-func (*GetRewardAttestationResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ValidatorRegistration.ProtoReflect.Descriptor instead.
+func (*ValidatorRegistration) Descriptor() ([]byte, []int) {
 	return file_core_v1_types_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *GetRewardAttestationResponse) GetOwner() string {
+func (x *ValidatorRegistration) GetDelegateWallet() string {
 	if x != nil {
-		return x.Owner
+		return x.DelegateWallet
 	}
 	return ""
 }
 
-func (x *GetRewardAttestationResponse) GetAttestation() string {
+func (x *ValidatorRegistration) GetEndpoint() string {
 	if x != nil {
-		return x.Attestation
+		return x.Endpoint
 	}
 	return ""
 }
 
-type SlashRecommendation struct {
+func (x *ValidatorRegistration) GetNodeType() string {
+	if x != nil {
+		return x.NodeType
+	}
+	return ""
+}
+
+func (x *ValidatorRegistration) GetSpId() string {
+	if x != nil {
+		return x.SpId
+	}
+	return ""
+}
+
+func (x *ValidatorRegistration) GetEthBlock() int64 {
+	if x != nil {
+		return x.EthBlock
+	}
+	return 0
+}
+
+func (x *ValidatorRegistration) GetCometAddress() string {
+	if x != nil {
+		return x.CometAddress
+	}
+	return ""
+}
+
+func (x *ValidatorRegistration) GetPubKey() []byte {
+	if x != nil {
+		return x.PubKey
+	}
+	return nil
+}
+
+func (x *ValidatorRegistration) GetPower() int64 {
+	if x != nil {
+		return x.Power
+	}
+	return 0
+}
+
+func (x *ValidatorRegistration) GetDeadline() int64 {
+	if x != nil {
+		return x.Deadline
+	}
+	return 0
+}
+
+type ValidatorDeregistration struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address    string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Start      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
-	End        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
-	MissedSLAs int32                  `protobuf:"varint,4,opt,name=missedSLAs,proto3" json:"missedSLAs,omitempty"`
-	Amount     int64                  `protobuf:"varint,5,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	CometAddress string `protobuf:"bytes,1,opt,name=comet_address,json=cometAddress,proto3" json:"comet_address,omitempty"`
+	PubKey       []byte `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	Deadline     int64  `protobuf:"varint,3,opt,name=deadline,proto3" json:"deadline,omitempty"`
 }
 
-func (x *SlashRecommendation) Reset() {
-	*x = SlashRecommendation{}
+func (x *ValidatorDeregistration) Reset() {
+	*x = ValidatorDeregistration{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_core_v1_types_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3160,13 +3376,13 @@ func (x *SlashRecommendation) Reset() {
 	}
 }
 
-func (x *SlashRecommendation) String() string {
+func (x *ValidatorDeregistration) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SlashRecommendation) ProtoMessage() {}
+func (*ValidatorDeregistration) ProtoMessage() {}
 
-func (x *SlashRecommendation) ProtoReflect() protoreflect.Message {
+func (x *ValidatorDeregistration) ProtoReflect() protoreflect.Message {
 	mi := &file_core_v1_types_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3178,56 +3394,46 @@ func (x *SlashRecommendation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SlashRecommendation.ProtoReflect.Descriptor instead.
-func (*SlashRecommendation) Descriptor() ([]byte, []int) {
+// Deprecated: Use ValidatorDeregistration.ProtoReflect.Descriptor instead.
+func (*ValidatorDeregistration) Descriptor() ([]byte, []int) {
 	return file_core_v1_types_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *SlashRecommendation) GetAddress() string {
+func (x *ValidatorDeregistration) GetCometAddress() string {
 	if x != nil {
-		return x.Address
+		return x.CometAddress
 	}
 	return ""
 }
 
-func (x *SlashRecommendation) GetStart() *timestamppb.Timestamp {
-	if x != nil {
-		return x.Start
-	}
-	return nil
-}
-
-func (x *SlashRecommendation) GetEnd() *timestamppb.Timestamp {
+func (x *ValidatorDeregistration) GetPubKey() []byte {
 	if x != nil {
-		return x.End
+		return x.PubKey
 	}
 	return nil
 }
 
-func (x *SlashRecommendation) GetMissedSLAs() int32 {
-	if x != nil {
-		return x.MissedSLAs
-	}
-	return 0
-}
-
-func (x *SlashRecommendation) GetAmount() int64 {
+func (x *ValidatorDeregistration) GetDeadline() int64 {
 	if x != nil {
-		return x.Amount
+		return x.Deadline
 	}
 	return 0
 }
 
-type GetSlashAttestationRequest struct {
+// restores the voting power of a jailed validator once its cooldown has passed,
+// signed by the validator's delegate wallet
+type UnjailValidator struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Data *SlashRecommendation `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	CometAddress string `protobuf:"bytes,1,opt,name=comet_address,json=cometAddress,proto3" json:"comet_address,omitempty"`
+	PubKey       []byte `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	Deadline     int64  `protobuf:"varint,3,opt,name=deadline,proto3" json:"deadline,omitempty"`
 }
 
-func (x *GetSlashAttestationRequest) Reset() {
-	*x = GetSlashAttestationRequest{}
+func (x *UnjailValidator) Reset() {
+	*x = UnjailValidator{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_core_v1_types_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3235,13 +3441,13 @@ func (x *GetSlashAttestationRequest) Reset() {
 	}
 }
 
-func (x *GetSlashAttestationRequest) String() string {
+func (x *UnjailValidator) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSlashAttestationRequest) ProtoMessage() {}
+func (*UnjailValidator) ProtoMessage() {}
 
-func (x *GetSlashAttestationRequest) ProtoReflect() protoreflect.Message {
+func (x *UnjailValidator) ProtoReflect() protoreflect.Message {
 	mi := &file_core_v1_types_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3253,29 +3459,40 @@ func (x *GetSlashAttestationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSlashAttestationRequest.ProtoReflect.Descriptor instead.
-func (*GetSlashAttestationRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnjailValidator.ProtoReflect.Descriptor instead.
+func (*UnjailValidator) Descriptor() ([]byte, []int) {
 	return file_core_v1_types_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *GetSlashAttestationRequest) GetData() *SlashRecommendation {
+func (x *UnjailValidator) GetCometAddress() string {
 	if x != nil {
-		return x.Data
+		return x.CometAddress
+	}
+	return ""
+}
+
+func (x *UnjailValidator) GetPubKey() []byte {
+	if x != nil {
+		return x.PubKey
 	}
 	return nil
 }
 
-type GetSlashAttestationResponse struct {
+func (x *UnjailValidator) GetDeadline() int64 {
+	if x != nil {
+		return x.Deadline
+	}
+	return 0
+}
+
+type GetStoredSnapshotsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Signature string `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
-	Endpoint  string `protobuf:"bytes,2,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
 }
 
-func (x *GetSlashAttestationResponse) Reset() {
-	*x = GetSlashAttestationResponse{}
+func (x *GetStoredSnapshotsRequest) Reset() {
+	*x = GetStoredSnapshotsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_core_v1_types_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3283,13 +3500,13 @@ func (x *GetSlashAttestationResponse) Reset() {
 	}
 }
 
-func (x *GetSlashAttestationResponse) String() string {
+func (x *GetStoredSnapshotsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSlashAttestationResponse) ProtoMessage() {}
+func (*GetStoredSnapshotsRequest) ProtoMessage() {}
 
-func (x *GetSlashAttestationResponse) ProtoReflect() protoreflect.Message {
+func (x *GetStoredSnapshotsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_core_v1_types_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3301,35 +3518,21 @@ func (x *GetSlashAttestationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSlashAttestationResponse.ProtoReflect.Descriptor instead.
-func (*GetSlashAttestationResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetStoredSnapshotsRequest.ProtoReflect.Descriptor instead.
+func (*GetStoredSnapshotsRequest) Descriptor() ([]byte, []int) {
 	return file_core_v1_types_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *GetSlashAttestationResponse) GetSignature() string {
-	if x != nil {
-		return x.Signature
-	}
-	return ""
-}
-
-func (x *GetSlashAttestationResponse) GetEndpoint() string {
-	if x != nil {
-		return x.Endpoint
-	}
-	return ""
-}
-
-type GetSlashAttestationsRequest struct {
+type GetStoredSnapshotsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Request *GetSlashAttestationRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	Snapshots []*SnapshotMetadata `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
 }
 
-func (x *GetSlashAttestationsRequest) Reset() {
-	*x = GetSlashAttestationsRequest{}
+func (x *GetStoredSnapshotsResponse) Reset() {
+	*x = GetStoredSnapshotsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_core_v1_types_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3337,13 +3540,13 @@ func (x *GetSlashAttestationsRequest) Reset() {
 	}
 }
 
-func (x *GetSlashAttestationsRequest) String() string {
+func (x *GetStoredSnapshotsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSlashAttestationsRequest) ProtoMessage() {}
+func (*GetStoredSnapshotsResponse) ProtoMessage() {}
 
-func (x *GetSlashAttestationsRequest) ProtoReflect() protoreflect.Message {
+func (x *GetStoredSnapshotsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_core_v1_types_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3355,28 +3558,31 @@ func (x *GetSlashAttestationsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSlashAttestationsRequest.ProtoReflect.Descriptor instead.
-func (*GetSlashAttestationsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetStoredSnapshotsResponse.ProtoReflect.Descriptor instead.
+func (*GetStoredSnapshotsResponse) Descriptor() ([]byte, []int) {
 	return file_core_v1_types_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *GetSlashAttestationsRequest) GetRequest() *GetSlashAttestationRequest {
+func (x *GetStoredSnapshotsResponse) GetSnapshots() []*SnapshotMetadata {
 	if x != nil {
-		return x.Request
+		return x.Snapshots
 	}
 	return nil
 }
 
-type GetSlashAttestationsResponse struct {
+type SnapshotMetadata struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Attestations []*GetSlashAttestationResponse `protobuf:"bytes,1,rep,name=attestations,proto3" json:"attestations,omitempty"`
+	Height     int64  `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Hash       string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	ChunkCount int64  `protobuf:"varint,3,opt,name=chunk_count,json=chunkCount,proto3" json:"chunk_count,omitempty"`
+	ChainId    string `protobuf:"bytes,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (x *GetSlashAttestationsResponse) Reset() {
-	*x = GetSlashAttestationsResponse{}
+func (x *SnapshotMetadata) Reset() {
+	*x = SnapshotMetadata{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_core_v1_types_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3384,13 +3590,13 @@ func (x *GetSlashAttestationsResponse) Reset() {
 	}
 }
 
-func (x *GetSlashAttestationsResponse) String() string {
+func (x *SnapshotMetadata) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSlashAttestationsResponse) ProtoMessage() {}
+func (*SnapshotMetadata) ProtoMessage() {}
 
-func (x *GetSlashAttestationsResponse) ProtoReflect() protoreflect.Message {
+func (x *SnapshotMetadata) ProtoReflect() protoreflect.Message {
 	mi := &file_core_v1_types_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3402,28 +3608,50 @@ func (x *GetSlashAttestationsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSlashAttestationsResponse.ProtoReflect.Descriptor instead.
-func (*GetSlashAttestationsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SnapshotMetadata.ProtoReflect.Descriptor instead.
+func (*SnapshotMetadata) Descriptor() ([]byte, []int) {
 	return file_core_v1_types_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *GetSlashAttestationsResponse) GetAttestations() []*GetSlashAttestationResponse {
+func (x *SnapshotMetadata) GetHeight() int64 {
 	if x != nil {
-		return x.Attestations
+		return x.Height
 	}
-	return nil
+	return 0
 }
 
-type GetERNRequest struct {
+func (x *SnapshotMetadata) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *SnapshotMetadata) GetChunkCount() int64 {
+	if x != nil {
+		return x.ChunkCount
+	}
+	return 0
+}
+
+func (x *SnapshotMetadata) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+type ClaimAuthority struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Name    string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *GetERNRequest) Reset() {
-	*x = GetERNRequest{}
+func (x *ClaimAuthority) Reset() {
+	*x = ClaimAuthority{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_core_v1_types_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3431,13 +3659,13 @@ func (x *GetERNRequest) Reset() {
 	}
 }
 
-func (x *GetERNRequest) String() string {
+func (x *ClaimAuthority) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetERNRequest) ProtoMessage() {}
+func (*ClaimAuthority) ProtoMessage() {}
 
-func (x *GetERNRequest) ProtoReflect() protoreflect.Message {
+func (x *ClaimAuthority) ProtoReflect() protoreflect.Message {
 	mi := &file_core_v1_types_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3449,28 +3677,38 @@ func (x *GetERNRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetERNRequest.ProtoReflect.Descriptor instead.
-func (*GetERNRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ClaimAuthority.ProtoReflect.Descriptor instead.
+func (*ClaimAuthority) Descriptor() ([]byte, []int) {
 	return file_core_v1_types_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *GetERNRequest) GetAddress() string {
+func (x *ClaimAuthority) GetAddress() string {
 	if x != nil {
 		return x.Address
 	}
 	return ""
 }
 
-type GetERNResponse struct {
+func (x *ClaimAuthority) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type Reward struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Ern *v1beta11.NewReleaseMessage `protobuf:"bytes,1,opt,name=ern,proto3" json:"ern,omitempty"`
+	RewardId         string            `protobuf:"bytes,1,opt,name=reward_id,json=rewardId,proto3" json:"reward_id,omitempty"`
+	Amount           uint64            `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Name             string            `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	ClaimAuthorities []*ClaimAuthority `protobuf:"bytes,4,rep,name=claim_authorities,json=claimAuthorities,proto3" json:"claim_authorities,omitempty"`
 }
 
-func (x *GetERNResponse) Reset() {
-	*x = GetERNResponse{}
+func (x *Reward) Reset() {
+	*x = Reward{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_core_v1_types_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3478,13 +3716,13 @@ func (x *GetERNResponse) Reset() {
 	}
 }
 
-func (x *GetERNResponse) String() string {
+func (x *Reward) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetERNResponse) ProtoMessage() {}
+func (*Reward) ProtoMessage() {}
 
-func (x *GetERNResponse) ProtoReflect() protoreflect.Message {
+func (x *Reward) ProtoReflect() protoreflect.Message {
 	mi := &file_core_v1_types_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3496,28 +3734,49 @@ func (x *GetERNResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetERNResponse.ProtoReflect.Descriptor instead.
-func (*GetERNResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Reward.ProtoReflect.Descriptor instead.
+func (*Reward) Descriptor() ([]byte, []int) {
 	return file_core_v1_types_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *GetERNResponse) GetErn() *v1beta11.NewReleaseMessage {
+func (x *Reward) GetRewardId() string {
 	if x != nil {
-		return x.Ern
+		return x.RewardId
+	}
+	return ""
+}
+
+func (x *Reward) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Reward) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Reward) GetClaimAuthorities() []*ClaimAuthority {
+	if x != nil {
+		return x.ClaimAuthorities
 	}
 	return nil
 }
 
-type GetPartyRequest struct {
+type GetRewardsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	ClaimAuthority string `protobuf:"bytes,1,opt,name=claim_authority,json=claimAuthority,proto3" json:"claim_authority,omitempty"`
 }
 
-func (x *GetPartyRequest) Reset() {
-	*x = GetPartyRequest{}
+func (x *GetRewardsRequest) Reset() {
+	*x = GetRewardsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_core_v1_types_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3525,13 +3784,13 @@ func (x *GetPartyRequest) Reset() {
 	}
 }
 
-func (x *GetPartyRequest) String() string {
+func (x *GetRewardsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPartyRequest) ProtoMessage() {}
+func (*GetRewardsRequest) ProtoMessage() {}
 
-func (x *GetPartyRequest) ProtoReflect() protoreflect.Message {
+func (x *GetRewardsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_core_v1_types_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3543,28 +3802,28 @@ func (x *GetPartyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPartyRequest.ProtoReflect.Descriptor instead.
-func (*GetPartyRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetRewardsRequest.ProtoReflect.Descriptor instead.
+func (*GetRewardsRequest) Descriptor() ([]byte, []int) {
 	return file_core_v1_types_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *GetPartyRequest) GetAddress() string {
+func (x *GetRewardsRequest) GetClaimAuthority() string {
 	if x != nil {
-		return x.Address
+		return x.ClaimAuthority
 	}
 	return ""
 }
 
-type GetPartyResponse struct {
+type GetRewardsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Party *v1beta11.Party `protobuf:"bytes,1,opt,name=party,proto3" json:"party,omitempty"`
+	Rewards []*GetRewardResponse `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards,omitempty"`
 }
 
-func (x *GetPartyResponse) Reset() {
-	*x = GetPartyResponse{}
+func (x *GetRewardsResponse) Reset() {
+	*x = GetRewardsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_core_v1_types_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3572,13 +3831,13 @@ func (x *GetPartyResponse) Reset() {
 	}
 }
 
-func (x *GetPartyResponse) String() string {
+func (x *GetRewardsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPartyResponse) ProtoMessage() {}
+func (*GetRewardsResponse) ProtoMessage() {}
 
-func (x *GetPartyResponse) ProtoReflect() protoreflect.Message {
+func (x *GetRewardsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_core_v1_types_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3590,28 +3849,28 @@ func (x *GetPartyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPartyResponse.ProtoReflect.Descriptor instead.
-func (*GetPartyResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetRewardsResponse.ProtoReflect.Descriptor instead.
+func (*GetRewardsResponse) Descriptor() ([]byte, []int) {
 	return file_core_v1_types_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *GetPartyResponse) GetParty() *v1beta11.Party {
+func (x *GetRewardsResponse) GetRewards() []*GetRewardResponse {
 	if x != nil {
-		return x.Party
+		return x.Rewards
 	}
 	return nil
 }
 
-type GetResourceRequest struct {
+type GetRewardClaimsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	EthRecipientAddress string `protobuf:"bytes,1,opt,name=eth_recipient_address,json=ethRecipientAddress,proto3" json:"eth_recipient_address,omitempty"`
 }
 
-func (x *GetResourceRequest) Reset() {
-	*x = GetResourceRequest{}
+func (x *GetRewardClaimsRequest) Reset() {
+	*x = GetRewardClaimsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_core_v1_types_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3619,13 +3878,13 @@ func (x *GetResourceRequest) Reset() {
 	}
 }
 
-func (x *GetResourceRequest) String() string {
+func (x *GetRewardClaimsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetResourceRequest) ProtoMessage() {}
+func (*GetRewardClaimsRequest) ProtoMessage() {}
 
-func (x *GetResourceRequest) ProtoReflect() protoreflect.Message {
+func (x *GetRewardClaimsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_core_v1_types_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3637,28 +3896,28 @@ func (x *GetResourceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetResourceRequest.ProtoReflect.Descriptor instead.
-func (*GetResourceRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetRewardClaimsRequest.ProtoReflect.Descriptor instead.
+func (*GetRewardClaimsRequest) Descriptor() ([]byte, []int) {
 	return file_core_v1_types_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *GetResourceRequest) GetAddress() string {
+func (x *GetRewardClaimsRequest) GetEthRecipientAddress() string {
 	if x != nil {
-		return x.Address
+		return x.EthRecipientAddress
 	}
 	return ""
 }
 
-type GetResourceResponse struct {
+type GetRewardClaimsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Resource *v1beta11.Resource `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	Claims []*RewardClaim `protobuf:"bytes,1,rep,name=claims,proto3" json:"claims,omitempty"`
 }
 
-func (x *GetResourceResponse) Reset() {
-	*x = GetResourceResponse{}
+func (x *GetRewardClaimsResponse) Reset() {
+	*x = GetRewardClaimsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_core_v1_types_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3666,13 +3925,13 @@ func (x *GetResourceResponse) Reset() {
 	}
 }
 
-func (x *GetResourceResponse) String() string {
+func (x *GetRewardClaimsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetResourceResponse) ProtoMessage() {}
+func (*GetRewardClaimsResponse) ProtoMessage() {}
 
-func (x *GetResourceResponse) ProtoReflect() protoreflect.Message {
+func (x *GetRewardClaimsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_core_v1_types_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3684,28 +3943,36 @@ func (x *GetResourceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetResourceResponse.ProtoReflect.Descriptor instead.
-func (*GetResourceResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetRewardClaimsResponse.ProtoReflect.Descriptor instead.
+func (*GetRewardClaimsResponse) Descriptor() ([]byte, []int) {
 	return file_core_v1_types_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *GetResourceResponse) GetResource() *v1beta11.Resource {
+func (x *GetRewardClaimsResponse) GetClaims() []*RewardClaim {
 	if x != nil {
-		return x.Resource
+		return x.Claims
 	}
 	return nil
 }
 
-type GetReleaseRequest struct {
+type RewardClaim struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	RewardAddress       string `protobuf:"bytes,1,opt,name=reward_address,json=rewardAddress,proto3" json:"reward_address,omitempty"`
+	RewardId            string `protobuf:"bytes,2,opt,name=reward_id,json=rewardId,proto3" json:"reward_id,omitempty"`
+	Specifier           string `protobuf:"bytes,3,opt,name=specifier,proto3" json:"specifier,omitempty"`
+	EthRecipientAddress string `protobuf:"bytes,4,opt,name=eth_recipient_address,json=ethRecipientAddress,proto3" json:"eth_recipient_address,omitempty"`
+	Amount              uint64 `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	// validators that attested to the claim
+	Attesters []string `protobuf:"bytes,6,rep,name=attesters,proto3" json:"attesters,omitempty"`
+	// block the first attestation was recorded in
+	BlockHeight int64 `protobuf:"varint,7,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
 }
 
-func (x *GetReleaseRequest) Reset() {
-	*x = GetReleaseRequest{}
+func (x *RewardClaim) Reset() {
+	*x = RewardClaim{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_core_v1_types_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3713,13 +3980,13 @@ func (x *GetReleaseRequest) Reset() {
 	}
 }
 
-func (x *GetReleaseRequest) String() string {
+func (x *RewardClaim) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetReleaseRequest) ProtoMessage() {}
+func (*RewardClaim) ProtoMessage() {}
 
-func (x *GetReleaseRequest) ProtoReflect() protoreflect.Message {
+func (x *RewardClaim) ProtoReflect() protoreflect.Message {
 	mi := &file_core_v1_types_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3731,28 +3998,76 @@ func (x *GetReleaseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetReleaseRequest.ProtoReflect.Descriptor instead.
-func (*GetReleaseRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RewardClaim.ProtoReflect.Descriptor instead.
+func (*RewardClaim) Descriptor() ([]byte, []int) {
 	return file_core_v1_types_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *GetReleaseRequest) GetAddress() string {
+func (x *RewardClaim) GetRewardAddress() string {
 	if x != nil {
-		return x.Address
+		return x.RewardAddress
 	}
 	return ""
 }
 
-type GetReleaseResponse struct {
+func (x *RewardClaim) GetRewardId() string {
+	if x != nil {
+		return x.RewardId
+	}
+	return ""
+}
+
+func (x *RewardClaim) GetSpecifier() string {
+	if x != nil {
+		return x.Specifier
+	}
+	return ""
+}
+
+func (x *RewardClaim) GetEthRecipientAddress() string {
+	if x != nil {
+		return x.EthRecipientAddress
+	}
+	return ""
+}
+
+func (x *RewardClaim) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RewardClaim) GetAttesters() []string {
+	if x != nil {
+		return x.Attesters
+	}
+	return nil
+}
+
+func (x *RewardClaim) GetBlockHeight() int64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+type GetRewardAttestationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Release *v1beta11.Release `protobuf:"bytes,1,opt,name=release,proto3" json:"release,omitempty"`
+	EthRecipientAddress string `protobuf:"bytes,1,opt,name=eth_recipient_address,json=ethRecipientAddress,proto3" json:"eth_recipient_address,omitempty"`
+	RewardAddress       string `protobuf:"bytes,2,opt,name=reward_address,json=rewardAddress,proto3" json:"reward_address,omitempty"` // The deployed reward address (new programmatic rewards)
+	Specifier           string `protobuf:"bytes,3,opt,name=specifier,proto3" json:"specifier,omitempty"`
+	ClaimAuthority      string `protobuf:"bytes,4,opt,name=claim_authority,json=claimAuthority,proto3" json:"claim_authority,omitempty"`
+	Signature           string `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+	Amount              uint64 `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	RewardId            string `protobuf:"bytes,7,opt,name=reward_id,json=rewardId,proto3" json:"reward_id,omitempty"` // Legacy reward_id (for backwards compatibility)
 }
 
-func (x *GetReleaseResponse) Reset() {
-	*x = GetReleaseResponse{}
+func (x *GetRewardAttestationRequest) Reset() {
+	*x = GetRewardAttestationRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_core_v1_types_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3760,13 +4075,13 @@ func (x *GetReleaseResponse) Reset() {
 	}
 }
 
-func (x *GetReleaseResponse) String() string {
+func (x *GetRewardAttestationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetReleaseResponse) ProtoMessage() {}
+func (*GetRewardAttestationRequest) ProtoMessage() {}
 
-func (x *GetReleaseResponse) ProtoReflect() protoreflect.Message {
+func (x *GetRewardAttestationRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_core_v1_types_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3778,28 +4093,71 @@ func (x *GetReleaseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetReleaseResponse.ProtoReflect.Descriptor instead.
-func (*GetReleaseResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetRewardAttestationRequest.ProtoReflect.Descriptor instead.
+func (*GetRewardAttestationRequest) Descriptor() ([]byte, []int) {
 	return file_core_v1_types_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *GetReleaseResponse) GetRelease() *v1beta11.Release {
+func (x *GetRewardAttestationRequest) GetEthRecipientAddress() string {
 	if x != nil {
-		return x.Release
+		return x.EthRecipientAddress
 	}
-	return nil
+	return ""
 }
 
-type GetDealRequest struct {
-	state         protoimpl.MessageState
+func (x *GetRewardAttestationRequest) GetRewardAddress() string {
+	if x != nil {
+		return x.RewardAddress
+	}
+	return ""
+}
+
+func (x *GetRewardAttestationRequest) GetSpecifier() string {
+	if x != nil {
+		return x.Specifier
+	}
+	return ""
+}
+
+func (x *GetRewardAttestationRequest) GetClaimAuthority() string {
+	if x != nil {
+		return x.ClaimAuthority
+	}
+	return ""
+}
+
+func (x *GetRewardAttestationRequest) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *GetRewardAttestationRequest) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *GetRewardAttestationRequest) GetRewardId() string {
+	if x != nil {
+		return x.RewardId
+	}
+	return ""
+}
+
+type GetRewardAttestationResponse struct {
+	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Owner       string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Attestation string `protobuf:"bytes,2,opt,name=attestation,proto3" json:"attestation,omitempty"`
 }
 
-func (x *GetDealRequest) Reset() {
-	*x = GetDealRequest{}
+func (x *GetRewardAttestationResponse) Reset() {
+	*x = GetRewardAttestationResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_core_v1_types_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3807,13 +4165,13 @@ func (x *GetDealRequest) Reset() {
 	}
 }
 
-func (x *GetDealRequest) String() string {
+func (x *GetRewardAttestationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDealRequest) ProtoMessage() {}
+func (*GetRewardAttestationResponse) ProtoMessage() {}
 
-func (x *GetDealRequest) ProtoReflect() protoreflect.Message {
+func (x *GetRewardAttestationResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_core_v1_types_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3825,28 +4183,39 @@ func (x *GetDealRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDealRequest.ProtoReflect.Descriptor instead.
-func (*GetDealRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetRewardAttestationResponse.ProtoReflect.Descriptor instead.
+func (*GetRewardAttestationResponse) Descriptor() ([]byte, []int) {
 	return file_core_v1_types_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *GetDealRequest) GetAddress() string {
+func (x *GetRewardAttestationResponse) GetOwner() string {
 	if x != nil {
-		return x.Address
+		return x.Owner
 	}
 	return ""
 }
 
-type GetDealResponse struct {
+func (x *GetRewardAttestationResponse) GetAttestation() string {
+	if x != nil {
+		return x.Attestation
+	}
+	return ""
+}
+
+type SlashRecommendation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Deal *v1beta11.Deal `protobuf:"bytes,1,opt,name=deal,proto3" json:"deal,omitempty"`
+	Address    string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Start      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	End        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	MissedSLAs int32                  `protobuf:"varint,4,opt,name=missedSLAs,proto3" json:"missedSLAs,omitempty"`
+	Amount     int64                  `protobuf:"varint,5,opt,name=Amount,proto3" json:"Amount,omitempty"`
 }
 
-func (x *GetDealResponse) Reset() {
-	*x = GetDealResponse{}
+func (x *SlashRecommendation) Reset() {
+	*x = SlashRecommendation{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_core_v1_types_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3854,13 +4223,13 @@ func (x *GetDealResponse) Reset() {
 	}
 }
 
-func (x *GetDealResponse) String() string {
+func (x *SlashRecommendation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDealResponse) ProtoMessage() {}
+func (*SlashRecommendation) ProtoMessage() {}
 
-func (x *GetDealResponse) ProtoReflect() protoreflect.Message {
+func (x *SlashRecommendation) ProtoReflect() protoreflect.Message {
 	mi := &file_core_v1_types_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3872,28 +4241,56 @@ func (x *GetDealResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDealResponse.ProtoReflect.Descriptor instead.
-func (*GetDealResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SlashRecommendation.ProtoReflect.Descriptor instead.
+func (*SlashRecommendation) Descriptor() ([]byte, []int) {
 	return file_core_v1_types_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *GetDealResponse) GetDeal() *v1beta11.Deal {
+func (x *SlashRecommendation) GetAddress() string {
 	if x != nil {
-		return x.Deal
+		return x.Address
+	}
+	return ""
+}
+
+func (x *SlashRecommendation) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
 	}
 	return nil
 }
 
-type GetMEADRequest struct {
+func (x *SlashRecommendation) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *SlashRecommendation) GetMissedSLAs() int32 {
+	if x != nil {
+		return x.MissedSLAs
+	}
+	return 0
+}
+
+func (x *SlashRecommendation) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type GetSlashAttestationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Data *SlashRecommendation `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *GetMEADRequest) Reset() {
-	*x = GetMEADRequest{}
+func (x *GetSlashAttestationRequest) Reset() {
+	*x = GetSlashAttestationRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_core_v1_types_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3901,13 +4298,13 @@ func (x *GetMEADRequest) Reset() {
 	}
 }
 
-func (x *GetMEADRequest) String() string {
+func (x *GetSlashAttestationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMEADRequest) ProtoMessage() {}
+func (*GetSlashAttestationRequest) ProtoMessage() {}
 
-func (x *GetMEADRequest) ProtoReflect() protoreflect.Message {
+func (x *GetSlashAttestationRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_core_v1_types_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3919,28 +4316,29 @@ func (x *GetMEADRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMEADRequest.ProtoReflect.Descriptor instead.
-func (*GetMEADRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSlashAttestationRequest.ProtoReflect.Descriptor instead.
+func (*GetSlashAttestationRequest) Descriptor() ([]byte, []int) {
 	return file_core_v1_types_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *GetMEADRequest) GetAddress() string {
+func (x *GetSlashAttestationRequest) GetData() *SlashRecommendation {
 	if x != nil {
-		return x.Address
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-type GetMEADResponse struct {
+type GetSlashAttestationResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Mead *v1beta11.MeadMessage `protobuf:"bytes,1,opt,name=mead,proto3" json:"mead,omitempty"`
+	Signature string `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+	Endpoint  string `protobuf:"bytes,2,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
 }
 
-func (x *GetMEADResponse) Reset() {
-	*x = GetMEADResponse{}
+func (x *GetSlashAttestationResponse) Reset() {
+	*x = GetSlashAttestationResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_core_v1_types_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3948,13 +4346,13 @@ func (x *GetMEADResponse) Reset() {
 	}
 }
 
-func (x *GetMEADResponse) String() string {
+func (x *GetSlashAttestationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMEADResponse) ProtoMessage() {}
+func (*GetSlashAttestationResponse) ProtoMessage() {}
 
-func (x *GetMEADResponse) ProtoReflect() protoreflect.Message {
+func (x *GetSlashAttestationResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_core_v1_types_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3966,28 +4364,35 @@ func (x *GetMEADResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMEADResponse.ProtoReflect.Descriptor instead.
-func (*GetMEADResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSlashAttestationResponse.ProtoReflect.Descriptor instead.
+func (*GetSlashAttestationResponse) Descriptor() ([]byte, []int) {
 	return file_core_v1_types_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *GetMEADResponse) GetMead() *v1beta11.MeadMessage {
+func (x *GetSlashAttestationResponse) GetSignature() string {
 	if x != nil {
-		return x.Mead
+		return x.Signature
 	}
-	return nil
+	return ""
 }
 
-type GetPIERequest struct {
+func (x *GetSlashAttestationResponse) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+type GetSlashAttestationsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Request *GetSlashAttestationRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
 }
 
-func (x *GetPIERequest) Reset() {
-	*x = GetPIERequest{}
+func (x *GetSlashAttestationsRequest) Reset() {
+	*x = GetSlashAttestationsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_core_v1_types_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3995,13 +4400,13 @@ func (x *GetPIERequest) Reset() {
 	}
 }
 
-func (x *GetPIERequest) String() string {
+func (x *GetSlashAttestationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPIERequest) ProtoMessage() {}
+func (*GetSlashAttestationsRequest) ProtoMessage() {}
 
-func (x *GetPIERequest) ProtoReflect() protoreflect.Message {
+func (x *GetSlashAttestationsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_core_v1_types_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4013,28 +4418,28 @@ func (x *GetPIERequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPIERequest.ProtoReflect.Descriptor instead.
-func (*GetPIERequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSlashAttestationsRequest.ProtoReflect.Descriptor instead.
+func (*GetSlashAttestationsRequest) Descriptor() ([]byte, []int) {
 	return file_core_v1_types_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *GetPIERequest) GetAddress() string {
+func (x *GetSlashAttestationsRequest) GetRequest() *GetSlashAttestationRequest {
 	if x != nil {
-		return x.Address
+		return x.Request
 	}
-	return ""
+	return nil
 }
 
-type GetPIEResponse struct {
+type GetSlashAttestationsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pie *v1beta11.PieMessage `protobuf:"bytes,1,opt,name=pie,proto3" json:"pie,omitempty"`
+	Attestations []*GetSlashAttestationResponse `protobuf:"bytes,1,rep,name=attestations,proto3" json:"attestations,omitempty"`
 }
 
-func (x *GetPIEResponse) Reset() {
-	*x = GetPIEResponse{}
+func (x *GetSlashAttestationsResponse) Reset() {
+	*x = GetSlashAttestationsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_core_v1_types_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4042,13 +4447,13 @@ func (x *GetPIEResponse) Reset() {
 	}
 }
 
-func (x *GetPIEResponse) String() string {
+func (x *GetSlashAttestationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPIEResponse) ProtoMessage() {}
+func (*GetSlashAttestationsResponse) ProtoMessage() {}
 
-func (x *GetPIEResponse) ProtoReflect() protoreflect.Message {
+func (x *GetSlashAttestationsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_core_v1_types_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4060,32 +4465,28 @@ func (x *GetPIEResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPIEResponse.ProtoReflect.Descriptor instead.
-func (*GetPIEResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSlashAttestationsResponse.ProtoReflect.Descriptor instead.
+func (*GetSlashAttestationsResponse) Descriptor() ([]byte, []int) {
 	return file_core_v1_types_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *GetPIEResponse) GetPie() *v1beta11.PieMessage {
+func (x *GetSlashAttestationsResponse) GetAttestations() []*GetSlashAttestationResponse {
 	if x != nil {
-		return x.Pie
+		return x.Attestations
 	}
 	return nil
 }
 
-type RewardMessage struct {
+type GetERNRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Action:
-	//
-	//	*RewardMessage_Create
-	//	*RewardMessage_Delete
-	Action isRewardMessage_Action `protobuf_oneof:"action"`
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *RewardMessage) Reset() {
-	*x = RewardMessage{}
+func (x *GetERNRequest) Reset() {
+	*x = GetERNRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_core_v1_types_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4093,13 +4494,13 @@ func (x *RewardMessage) Reset() {
 	}
 }
 
-func (x *RewardMessage) String() string {
+func (x *GetERNRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RewardMessage) ProtoMessage() {}
+func (*GetERNRequest) ProtoMessage() {}
 
-func (x *RewardMessage) ProtoReflect() protoreflect.Message {
+func (x *GetERNRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_core_v1_types_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4111,63 +4512,28 @@ func (x *RewardMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RewardMessage.ProtoReflect.Descriptor instead.
-func (*RewardMessage) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetERNRequest.ProtoReflect.Descriptor instead.
+func (*GetERNRequest) Descriptor() ([]byte, []int) {
 	return file_core_v1_types_proto_rawDescGZIP(), []int{65}
 }
 
-func (m *RewardMessage) GetAction() isRewardMessage_Action {
-	if m != nil {
-		return m.Action
+func (x *GetERNRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
 	}
-	return nil
-}
-
-func (x *RewardMessage) GetCreate() *CreateReward {
-	if x, ok := x.GetAction().(*RewardMessage_Create); ok {
-		return x.Create
-	}
-	return nil
-}
-
-func (x *RewardMessage) GetDelete() *DeleteReward {
-	if x, ok := x.GetAction().(*RewardMessage_Delete); ok {
-		return x.Delete
-	}
-	return nil
-}
-
-type isRewardMessage_Action interface {
-	isRewardMessage_Action()
-}
-
-type RewardMessage_Create struct {
-	Create *CreateReward `protobuf:"bytes,1000,opt,name=create,proto3,oneof"`
-}
-
-type RewardMessage_Delete struct {
-	Delete *DeleteReward `protobuf:"bytes,1001,opt,name=delete,proto3,oneof"`
+	return ""
 }
 
-func (*RewardMessage_Create) isRewardMessage_Action() {}
-
-func (*RewardMessage_Delete) isRewardMessage_Action() {}
-
-type CreateReward struct {
+type GetERNResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RewardId            string            `protobuf:"bytes,1,opt,name=reward_id,json=rewardId,proto3" json:"reward_id,omitempty"`
-	Name                string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Amount              uint64            `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	ClaimAuthorities    []*ClaimAuthority `protobuf:"bytes,4,rep,name=claim_authorities,json=claimAuthorities,proto3" json:"claim_authorities,omitempty"`
-	DeadlineBlockHeight int64             `protobuf:"varint,5,opt,name=deadline_block_height,json=deadlineBlockHeight,proto3" json:"deadline_block_height,omitempty"`
-	Signature           string            `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"` // Signature over deterministic reward data
+	Ern *v1beta11.NewReleaseMessage `protobuf:"bytes,1,opt,name=ern,proto3" json:"ern,omitempty"`
 }
 
-func (x *CreateReward) Reset() {
-	*x = CreateReward{}
+func (x *GetERNResponse) Reset() {
+	*x = GetERNResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_core_v1_types_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4175,13 +4541,13 @@ func (x *CreateReward) Reset() {
 	}
 }
 
-func (x *CreateReward) String() string {
+func (x *GetERNResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateReward) ProtoMessage() {}
+func (*GetERNResponse) ProtoMessage() {}
 
-func (x *CreateReward) ProtoReflect() protoreflect.Message {
+func (x *GetERNResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_core_v1_types_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4193,65 +4559,31 @@ func (x *CreateReward) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateReward.ProtoReflect.Descriptor instead.
-func (*CreateReward) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetERNResponse.ProtoReflect.Descriptor instead.
+func (*GetERNResponse) Descriptor() ([]byte, []int) {
 	return file_core_v1_types_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *CreateReward) GetRewardId() string {
-	if x != nil {
-		return x.RewardId
-	}
-	return ""
-}
-
-func (x *CreateReward) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *CreateReward) GetAmount() uint64 {
-	if x != nil {
-		return x.Amount
-	}
-	return 0
-}
-
-func (x *CreateReward) GetClaimAuthorities() []*ClaimAuthority {
+func (x *GetERNResponse) GetErn() *v1beta11.NewReleaseMessage {
 	if x != nil {
-		return x.ClaimAuthorities
+		return x.Ern
 	}
 	return nil
 }
 
-func (x *CreateReward) GetDeadlineBlockHeight() int64 {
-	if x != nil {
-		return x.DeadlineBlockHeight
-	}
-	return 0
-}
-
-func (x *CreateReward) GetSignature() string {
-	if x != nil {
-		return x.Signature
-	}
-	return ""
-}
-
-type DeleteReward struct {
+type GetERNXMLRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address             string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"` // The deployed reward address
-	DeadlineBlockHeight int64  `protobuf:"varint,2,opt,name=deadline_block_height,json=deadlineBlockHeight,proto3" json:"deadline_block_height,omitempty"`
-	Signature           string `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"` // Signature over deterministic delete data
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// DPID of the party the XML is delivered to
+	RecipientPartyId string `protobuf:"bytes,2,opt,name=recipient_party_id,json=recipientPartyId,proto3" json:"recipient_party_id,omitempty"`
+	RecipientName    string `protobuf:"bytes,3,opt,name=recipient_name,json=recipientName,proto3" json:"recipient_name,omitempty"`
 }
 
-func (x *DeleteReward) Reset() {
-	*x = DeleteReward{}
+func (x *GetERNXMLRequest) Reset() {
+	*x = GetERNXMLRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_core_v1_types_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4259,13 +4591,13 @@ func (x *DeleteReward) Reset() {
 	}
 }
 
-func (x *DeleteReward) String() string {
+func (x *GetERNXMLRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteReward) ProtoMessage() {}
+func (*GetERNXMLRequest) ProtoMessage() {}
 
-func (x *DeleteReward) ProtoReflect() protoreflect.Message {
+func (x *GetERNXMLRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_core_v1_types_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4277,43 +4609,43 @@ func (x *DeleteReward) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteReward.ProtoReflect.Descriptor instead.
-func (*DeleteReward) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetERNXMLRequest.ProtoReflect.Descriptor instead.
+func (*GetERNXMLRequest) Descriptor() ([]byte, []int) {
 	return file_core_v1_types_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *DeleteReward) GetAddress() string {
+func (x *GetERNXMLRequest) GetAddress() string {
 	if x != nil {
 		return x.Address
 	}
 	return ""
 }
 
-func (x *DeleteReward) GetDeadlineBlockHeight() int64 {
+func (x *GetERNXMLRequest) GetRecipientPartyId() string {
 	if x != nil {
-		return x.DeadlineBlockHeight
+		return x.RecipientPartyId
 	}
-	return 0
+	return ""
 }
 
-func (x *DeleteReward) GetSignature() string {
+func (x *GetERNXMLRequest) GetRecipientName() string {
 	if x != nil {
-		return x.Signature
+		return x.RecipientName
 	}
 	return ""
 }
 
-type GetRewardRequest struct {
+type GetERNXMLResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"` // The deployed reward address
-	Txhash  string `protobuf:"bytes,2,opt,name=txhash,proto3" json:"txhash,omitempty"`
+	// the latest version of the ERN as ERN 4.3 XML
+	Xml []byte `protobuf:"bytes,1,opt,name=xml,proto3" json:"xml,omitempty"`
 }
 
-func (x *GetRewardRequest) Reset() {
-	*x = GetRewardRequest{}
+func (x *GetERNXMLResponse) Reset() {
+	*x = GetERNXMLResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_core_v1_types_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4321,13 +4653,13 @@ func (x *GetRewardRequest) Reset() {
 	}
 }
 
-func (x *GetRewardRequest) String() string {
+func (x *GetERNXMLResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRewardRequest) ProtoMessage() {}
+func (*GetERNXMLResponse) ProtoMessage() {}
 
-func (x *GetRewardRequest) ProtoReflect() protoreflect.Message {
+func (x *GetERNXMLResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_core_v1_types_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4339,41 +4671,31 @@ func (x *GetRewardRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRewardRequest.ProtoReflect.Descriptor instead.
-func (*GetRewardRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetERNXMLResponse.ProtoReflect.Descriptor instead.
+func (*GetERNXMLResponse) Descriptor() ([]byte, []int) {
 	return file_core_v1_types_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *GetRewardRequest) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
-}
-
-func (x *GetRewardRequest) GetTxhash() string {
+func (x *GetERNXMLResponse) GetXml() []byte {
 	if x != nil {
-		return x.Txhash
+		return x.Xml
 	}
-	return ""
+	return nil
 }
 
-type GetRewardResponse struct {
+type GetERNHistoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address          string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	RewardId         string   `protobuf:"bytes,2,opt,name=reward_id,json=rewardId,proto3" json:"reward_id,omitempty"`
-	Name             string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Amount           uint64   `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
-	ClaimAuthorities []string `protobuf:"bytes,5,rep,name=claim_authorities,json=claimAuthorities,proto3" json:"claim_authorities,omitempty"`
-	Sender           string   `protobuf:"bytes,6,opt,name=sender,proto3" json:"sender,omitempty"`
-	BlockHeight      int64    `protobuf:"varint,7,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// versions to compare, leave both unset to only list the history
+	DiffFromVersion uint32 `protobuf:"varint,2,opt,name=diff_from_version,json=diffFromVersion,proto3" json:"diff_from_version,omitempty"`
+	DiffToVersion   uint32 `protobuf:"varint,3,opt,name=diff_to_version,json=diffToVersion,proto3" json:"diff_to_version,omitempty"`
 }
 
-func (x *GetRewardResponse) Reset() {
-	*x = GetRewardResponse{}
+func (x *GetERNHistoryRequest) Reset() {
+	*x = GetERNHistoryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_core_v1_types_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4381,13 +4703,13 @@ func (x *GetRewardResponse) Reset() {
 	}
 }
 
-func (x *GetRewardResponse) String() string {
+func (x *GetERNHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRewardResponse) ProtoMessage() {}
+func (*GetERNHistoryRequest) ProtoMessage() {}
 
-func (x *GetRewardResponse) ProtoReflect() protoreflect.Message {
+func (x *GetERNHistoryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_core_v1_types_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4399,90 +4721,120 @@ func (x *GetRewardResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRewardResponse.ProtoReflect.Descriptor instead.
-func (*GetRewardResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetERNHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetERNHistoryRequest) Descriptor() ([]byte, []int) {
 	return file_core_v1_types_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *GetRewardResponse) GetAddress() string {
+func (x *GetERNHistoryRequest) GetAddress() string {
 	if x != nil {
 		return x.Address
 	}
 	return ""
 }
 
-func (x *GetRewardResponse) GetRewardId() string {
+func (x *GetERNHistoryRequest) GetDiffFromVersion() uint32 {
 	if x != nil {
-		return x.RewardId
+		return x.DiffFromVersion
 	}
-	return ""
+	return 0
 }
 
-func (x *GetRewardResponse) GetName() string {
+func (x *GetERNHistoryRequest) GetDiffToVersion() uint32 {
 	if x != nil {
-		return x.Name
+		return x.DiffToVersion
 	}
-	return ""
+	return 0
 }
 
-func (x *GetRewardResponse) GetAmount() uint64 {
-	if x != nil {
-		return x.Amount
+type GetERNHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Versions []*ERNVersion `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+	Diff     *ERNDiff      `protobuf:"bytes,2,opt,name=diff,proto3" json:"diff,omitempty"`
+}
+
+func (x *GetERNHistoryResponse) Reset() {
+	*x = GetERNHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_v1_types_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *GetRewardResponse) GetClaimAuthorities() []string {
-	if x != nil {
-		return x.ClaimAuthorities
+func (x *GetERNHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetERNHistoryResponse) ProtoMessage() {}
+
+func (x *GetERNHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_core_v1_types_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *GetRewardResponse) GetSender() string {
+// Deprecated: Use GetERNHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetERNHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_core_v1_types_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GetERNHistoryResponse) GetVersions() []*ERNVersion {
 	if x != nil {
-		return x.Sender
+		return x.Versions
 	}
-	return ""
+	return nil
 }
 
-func (x *GetRewardResponse) GetBlockHeight() int64 {
+func (x *GetERNHistoryResponse) GetDiff() *ERNDiff {
 	if x != nil {
-		return x.BlockHeight
+		return x.Diff
 	}
-	return 0
+	return nil
 }
 
-type RewardAttestationSignature struct {
+// a message finalized for an ERN address
+type ERNVersion struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	EthRecipientAddress string `protobuf:"bytes,1,opt,name=eth_recipient_address,json=ethRecipientAddress,proto3" json:"eth_recipient_address,omitempty"`
-	Amount              uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	RewardAddress       string `protobuf:"bytes,3,opt,name=reward_address,json=rewardAddress,proto3" json:"reward_address,omitempty"` // Binds signature to specific deployed reward
-	RewardId            string `protobuf:"bytes,4,opt,name=reward_id,json=rewardId,proto3" json:"reward_id,omitempty"`
-	Specifier           string `protobuf:"bytes,5,opt,name=specifier,proto3" json:"specifier,omitempty"`
-	ClaimAuthority      string `protobuf:"bytes,6,opt,name=claim_authority,json=claimAuthority,proto3" json:"claim_authority,omitempty"`
+	// numbered from 1 in the order the messages were finalized
+	Version            uint32                      `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	MessageControlType v1beta11.MessageControlType `protobuf:"varint,2,opt,name=message_control_type,json=messageControlType,proto3,enum=ddex.v1beta1.MessageControlType" json:"message_control_type,omitempty"`
+	BlockHeight        int64                       `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	TxHash             string                      `protobuf:"bytes,4,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// position of the message in its transaction
+	MessageIndex int64  `protobuf:"varint,5,opt,name=message_index,json=messageIndex,proto3" json:"message_index,omitempty"`
+	Sender       string `protobuf:"bytes,6,opt,name=sender,proto3" json:"sender,omitempty"`
 }
 
-func (x *RewardAttestationSignature) Reset() {
-	*x = RewardAttestationSignature{}
+func (x *ERNVersion) Reset() {
+	*x = ERNVersion{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_core_v1_types_proto_msgTypes[70]
+		mi := &file_core_v1_types_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RewardAttestationSignature) String() string {
+func (x *ERNVersion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RewardAttestationSignature) ProtoMessage() {}
+func (*ERNVersion) ProtoMessage() {}
 
-func (x *RewardAttestationSignature) ProtoReflect() protoreflect.Message {
-	mi := &file_core_v1_types_proto_msgTypes[70]
+func (x *ERNVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_core_v1_types_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4493,78 +4845,85 @@ func (x *RewardAttestationSignature) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RewardAttestationSignature.ProtoReflect.Descriptor instead.
-func (*RewardAttestationSignature) Descriptor() ([]byte, []int) {
-	return file_core_v1_types_proto_rawDescGZIP(), []int{70}
+// Deprecated: Use ERNVersion.ProtoReflect.Descriptor instead.
+func (*ERNVersion) Descriptor() ([]byte, []int) {
+	return file_core_v1_types_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *RewardAttestationSignature) GetEthRecipientAddress() string {
+func (x *ERNVersion) GetVersion() uint32 {
 	if x != nil {
-		return x.EthRecipientAddress
+		return x.Version
 	}
-	return ""
+	return 0
 }
 
-func (x *RewardAttestationSignature) GetAmount() uint64 {
+func (x *ERNVersion) GetMessageControlType() v1beta11.MessageControlType {
 	if x != nil {
-		return x.Amount
+		return x.MessageControlType
 	}
-	return 0
+	return v1beta11.MessageControlType(0)
 }
 
-func (x *RewardAttestationSignature) GetRewardAddress() string {
+func (x *ERNVersion) GetBlockHeight() int64 {
 	if x != nil {
-		return x.RewardAddress
+		return x.BlockHeight
 	}
-	return ""
+	return 0
 }
 
-func (x *RewardAttestationSignature) GetRewardId() string {
+func (x *ERNVersion) GetTxHash() string {
 	if x != nil {
-		return x.RewardId
+		return x.TxHash
 	}
 	return ""
 }
 
-func (x *RewardAttestationSignature) GetSpecifier() string {
+func (x *ERNVersion) GetMessageIndex() int64 {
 	if x != nil {
-		return x.Specifier
+		return x.MessageIndex
 	}
-	return ""
+	return 0
 }
 
-func (x *RewardAttestationSignature) GetClaimAuthority() string {
+func (x *ERNVersion) GetSender() string {
 	if x != nil {
-		return x.ClaimAuthority
+		return x.Sender
 	}
 	return ""
 }
 
-type UploadSignature struct {
+// what changed in an ERN between two versions, resources and titles are keyed by their
+// reference and deals by the releases, commercial model and use type they cover
+type ERNDiff struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Cid string `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	FromVersion      uint32            `protobuf:"varint,1,opt,name=from_version,json=fromVersion,proto3" json:"from_version,omitempty"`
+	ToVersion        uint32            `protobuf:"varint,2,opt,name=to_version,json=toVersion,proto3" json:"to_version,omitempty"`
+	AddedResources   []string          `protobuf:"bytes,3,rep,name=added_resources,json=addedResources,proto3" json:"added_resources,omitempty"`
+	RemovedResources []string          `protobuf:"bytes,4,rep,name=removed_resources,json=removedResources,proto3" json:"removed_resources,omitempty"`
+	DealChanges      []*ERNDealChange  `protobuf:"bytes,5,rep,name=deal_changes,json=dealChanges,proto3" json:"deal_changes,omitempty"`
+	TitleChanges     []*ERNTitleChange `protobuf:"bytes,6,rep,name=title_changes,json=titleChanges,proto3" json:"title_changes,omitempty"`
 }
 
-func (x *UploadSignature) Reset() {
-	*x = UploadSignature{}
+func (x *ERNDiff) Reset() {
+	*x = ERNDiff{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_core_v1_types_proto_msgTypes[71]
+		mi := &file_core_v1_types_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UploadSignature) String() string {
+func (x *ERNDiff) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadSignature) ProtoMessage() {}
+func (*ERNDiff) ProtoMessage() {}
 
-func (x *UploadSignature) ProtoReflect() protoreflect.Message {
-	mi := &file_core_v1_types_proto_msgTypes[71]
+func (x *ERNDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_core_v1_types_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4575,134 +4934,66 @@ func (x *UploadSignature) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadSignature.ProtoReflect.Descriptor instead.
-func (*UploadSignature) Descriptor() ([]byte, []int) {
-	return file_core_v1_types_proto_rawDescGZIP(), []int{71}
-}
-
-func (x *UploadSignature) GetCid() string {
-	if x != nil {
-		return x.Cid
-	}
-	return ""
-}
-
-// reported by validators to chain on a file upload that contains a signature
-// this tx is used to tie ERNs to CIDs and confirm ownership
-type FileUpload struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// address that was recovered from the signature
-	UploaderAddress string `protobuf:"bytes,1,opt,name=uploader_address,json=uploaderAddress,proto3" json:"uploader_address,omitempty"`
-	// hex stringified signature that was passed to mediorum on upload
-	UploadSignature string `protobuf:"bytes,2,opt,name=upload_signature,json=uploadSignature,proto3" json:"upload_signature,omitempty"`
-	// cid of file that was uploaded, orig_cid in mediorum
-	Cid string `protobuf:"bytes,3,opt,name=cid,proto3" json:"cid,omitempty"`
-	// mediorum specific upload id
-	UploadId string `protobuf:"bytes,4,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
-	// transcoded cid (e.g., 320kbps version for audio)
-	TranscodedCid string `protobuf:"bytes,5,opt,name=transcoded_cid,json=transcodedCid,proto3" json:"transcoded_cid,omitempty"`
-	// validator address that processed the upload
-	ValidatorAddress string `protobuf:"bytes,6,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
-	// signature from the validator node
-	ValidatorSignature string `protobuf:"bytes,7,opt,name=validator_signature,json=validatorSignature,proto3" json:"validator_signature,omitempty"`
-}
-
-func (x *FileUpload) Reset() {
-	*x = FileUpload{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_core_v1_types_proto_msgTypes[72]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *FileUpload) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*FileUpload) ProtoMessage() {}
-
-func (x *FileUpload) ProtoReflect() protoreflect.Message {
-	mi := &file_core_v1_types_proto_msgTypes[72]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use FileUpload.ProtoReflect.Descriptor instead.
-func (*FileUpload) Descriptor() ([]byte, []int) {
+// Deprecated: Use ERNDiff.ProtoReflect.Descriptor instead.
+func (*ERNDiff) Descriptor() ([]byte, []int) {
 	return file_core_v1_types_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *FileUpload) GetUploaderAddress() string {
-	if x != nil {
-		return x.UploaderAddress
-	}
-	return ""
-}
-
-func (x *FileUpload) GetUploadSignature() string {
+func (x *ERNDiff) GetFromVersion() uint32 {
 	if x != nil {
-		return x.UploadSignature
+		return x.FromVersion
 	}
-	return ""
+	return 0
 }
 
-func (x *FileUpload) GetCid() string {
+func (x *ERNDiff) GetToVersion() uint32 {
 	if x != nil {
-		return x.Cid
+		return x.ToVersion
 	}
-	return ""
+	return 0
 }
 
-func (x *FileUpload) GetUploadId() string {
+func (x *ERNDiff) GetAddedResources() []string {
 	if x != nil {
-		return x.UploadId
+		return x.AddedResources
 	}
-	return ""
+	return nil
 }
 
-func (x *FileUpload) GetTranscodedCid() string {
+func (x *ERNDiff) GetRemovedResources() []string {
 	if x != nil {
-		return x.TranscodedCid
+		return x.RemovedResources
 	}
-	return ""
+	return nil
 }
 
-func (x *FileUpload) GetValidatorAddress() string {
+func (x *ERNDiff) GetDealChanges() []*ERNDealChange {
 	if x != nil {
-		return x.ValidatorAddress
+		return x.DealChanges
 	}
-	return ""
+	return nil
 }
 
-func (x *FileUpload) GetValidatorSignature() string {
+func (x *ERNDiff) GetTitleChanges() []*ERNTitleChange {
 	if x != nil {
-		return x.ValidatorSignature
+		return x.TitleChanges
 	}
-	return ""
+	return nil
 }
 
-type GetStreamURLsSignature struct {
+type ERNDealChange struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// addresses to stream (can be ERN, release, or resource addresses)
-	Addresses []string               `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
-	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// unset when the deal was added
+	From *v1beta11.Deal `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// unset when the deal was removed
+	To *v1beta11.Deal `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
 }
 
-func (x *GetStreamURLsSignature) Reset() {
-	*x = GetStreamURLsSignature{}
+func (x *ERNDealChange) Reset() {
+	*x = ERNDealChange{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_core_v1_types_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4710,13 +5001,13 @@ func (x *GetStreamURLsSignature) Reset() {
 	}
 }
 
-func (x *GetStreamURLsSignature) String() string {
+func (x *ERNDealChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStreamURLsSignature) ProtoMessage() {}
+func (*ERNDealChange) ProtoMessage() {}
 
-func (x *GetStreamURLsSignature) ProtoReflect() protoreflect.Message {
+func (x *ERNDealChange) ProtoReflect() protoreflect.Message {
 	mi := &file_core_v1_types_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4728,44 +5019,38 @@ func (x *GetStreamURLsSignature) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStreamURLsSignature.ProtoReflect.Descriptor instead.
-func (*GetStreamURLsSignature) Descriptor() ([]byte, []int) {
+// Deprecated: Use ERNDealChange.ProtoReflect.Descriptor instead.
+func (*ERNDealChange) Descriptor() ([]byte, []int) {
 	return file_core_v1_types_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *GetStreamURLsSignature) GetAddresses() []string {
+func (x *ERNDealChange) GetFrom() *v1beta11.Deal {
 	if x != nil {
-		return x.Addresses
+		return x.From
 	}
 	return nil
 }
 
-func (x *GetStreamURLsSignature) GetExpiresAt() *timestamppb.Timestamp {
+func (x *ERNDealChange) GetTo() *v1beta11.Deal {
 	if x != nil {
-		return x.ExpiresAt
+		return x.To
 	}
 	return nil
 }
 
-// requests stream urls from the content node
-// signature signed by owner of ERN
-type GetStreamURLsRequest struct {
+type ERNTitleChange struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Signature string `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
-	// addresses to stream (can be ERN, release, or resource addresses)
-	// - ERN address: returns all streamable resources in the ERN
-	// - release address: returns all resources associated with that release
-	// - resource address: returns that specific resource
-	// all addresses are resolved back to their parent ERN to verify ownership
-	Addresses []string               `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
-	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// reference of the release or resource
+	Reference string `protobuf:"bytes,1,opt,name=reference,proto3" json:"reference,omitempty"`
+	From      string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To        string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
 }
 
-func (x *GetStreamURLsRequest) Reset() {
-	*x = GetStreamURLsRequest{}
+func (x *ERNTitleChange) Reset() {
+	*x = ERNTitleChange{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_core_v1_types_proto_msgTypes[74]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4773,13 +5058,13 @@ func (x *GetStreamURLsRequest) Reset() {
 	}
 }
 
-func (x *GetStreamURLsRequest) String() string {
+func (x *ERNTitleChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStreamURLsRequest) ProtoMessage() {}
+func (*ERNTitleChange) ProtoMessage() {}
 
-func (x *GetStreamURLsRequest) ProtoReflect() protoreflect.Message {
+func (x *ERNTitleChange) ProtoReflect() protoreflect.Message {
 	mi := &file_core_v1_types_proto_msgTypes[74]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4791,44 +5076,43 @@ func (x *GetStreamURLsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStreamURLsRequest.ProtoReflect.Descriptor instead.
-func (*GetStreamURLsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ERNTitleChange.ProtoReflect.Descriptor instead.
+func (*ERNTitleChange) Descriptor() ([]byte, []int) {
 	return file_core_v1_types_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *GetStreamURLsRequest) GetSignature() string {
+func (x *ERNTitleChange) GetReference() string {
 	if x != nil {
-		return x.Signature
+		return x.Reference
 	}
 	return ""
 }
 
-func (x *GetStreamURLsRequest) GetAddresses() []string {
+func (x *ERNTitleChange) GetFrom() string {
 	if x != nil {
-		return x.Addresses
+		return x.From
 	}
-	return nil
+	return ""
 }
 
-func (x *GetStreamURLsRequest) GetExpiresAt() *timestamppb.Timestamp {
+func (x *ERNTitleChange) GetTo() string {
 	if x != nil {
-		return x.ExpiresAt
+		return x.To
 	}
-	return nil
+	return ""
 }
 
-type GetStreamURLsResponse struct {
+type ParseERNXMLRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Key is the entity address (ERN, release, or resource address)
-	// Value contains the streaming URLs and metadata for that entity
-	EntityStreamUrls map[string]*GetStreamURLsResponse_EntityStreamURLs `protobuf:"bytes,1,rep,name=entity_stream_urls,json=entityStreamUrls,proto3" json:"entity_stream_urls,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// an ERN 4.x NewReleaseMessage
+	Xml []byte `protobuf:"bytes,1,opt,name=xml,proto3" json:"xml,omitempty"`
 }
 
-func (x *GetStreamURLsResponse) Reset() {
-	*x = GetStreamURLsResponse{}
+func (x *ParseERNXMLRequest) Reset() {
+	*x = ParseERNXMLRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_core_v1_types_proto_msgTypes[75]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4836,13 +5120,13 @@ func (x *GetStreamURLsResponse) Reset() {
 	}
 }
 
-func (x *GetStreamURLsResponse) String() string {
+func (x *ParseERNXMLRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStreamURLsResponse) ProtoMessage() {}
+func (*ParseERNXMLRequest) ProtoMessage() {}
 
-func (x *GetStreamURLsResponse) ProtoReflect() protoreflect.Message {
+func (x *ParseERNXMLRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_core_v1_types_proto_msgTypes[75]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4854,28 +5138,28 @@ func (x *GetStreamURLsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStreamURLsResponse.ProtoReflect.Descriptor instead.
-func (*GetStreamURLsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ParseERNXMLRequest.ProtoReflect.Descriptor instead.
+func (*ParseERNXMLRequest) Descriptor() ([]byte, []int) {
 	return file_core_v1_types_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *GetStreamURLsResponse) GetEntityStreamUrls() map[string]*GetStreamURLsResponse_EntityStreamURLs {
+func (x *ParseERNXMLRequest) GetXml() []byte {
 	if x != nil {
-		return x.EntityStreamUrls
+		return x.Xml
 	}
 	return nil
 }
 
-type GetUploadByCIDRequest struct {
+type ParseERNXMLResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Cid string `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	Ern *v1beta11.NewReleaseMessage `protobuf:"bytes,1,opt,name=ern,proto3" json:"ern,omitempty"`
 }
 
-func (x *GetUploadByCIDRequest) Reset() {
-	*x = GetUploadByCIDRequest{}
+func (x *ParseERNXMLResponse) Reset() {
+	*x = ParseERNXMLResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_core_v1_types_proto_msgTypes[76]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4883,13 +5167,13 @@ func (x *GetUploadByCIDRequest) Reset() {
 	}
 }
 
-func (x *GetUploadByCIDRequest) String() string {
+func (x *ParseERNXMLResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUploadByCIDRequest) ProtoMessage() {}
+func (*ParseERNXMLResponse) ProtoMessage() {}
 
-func (x *GetUploadByCIDRequest) ProtoReflect() protoreflect.Message {
+func (x *ParseERNXMLResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_core_v1_types_proto_msgTypes[76]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4901,31 +5185,32 @@ func (x *GetUploadByCIDRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUploadByCIDRequest.ProtoReflect.Descriptor instead.
-func (*GetUploadByCIDRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ParseERNXMLResponse.ProtoReflect.Descriptor instead.
+func (*ParseERNXMLResponse) Descriptor() ([]byte, []int) {
 	return file_core_v1_types_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *GetUploadByCIDRequest) GetCid() string {
+func (x *ParseERNXMLResponse) GetErn() *v1beta11.NewReleaseMessage {
 	if x != nil {
-		return x.Cid
+		return x.Ern
 	}
-	return ""
+	return nil
 }
 
-type GetUploadByCIDResponse struct {
+// attached to the invalid argument error ParseERNXML returns, one per problem
+type ERNXMLValidationError struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Exists          bool   `protobuf:"varint,1,opt,name=exists,proto3" json:"exists,omitempty"`
-	UploaderAddress string `protobuf:"bytes,2,opt,name=uploader_address,json=uploaderAddress,proto3" json:"uploader_address,omitempty"`
-	OriginalCid     string `protobuf:"bytes,3,opt,name=original_cid,json=originalCid,proto3" json:"original_cid,omitempty"`
-	TranscodedCid   string `protobuf:"bytes,4,opt,name=transcoded_cid,json=transcodedCid,proto3" json:"transcoded_cid,omitempty"`
+	// e.g. /NewReleaseMessage/ResourceList/SoundRecording[2]/Duration
+	Path    string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Line    int32  `protobuf:"varint,2,opt,name=line,proto3" json:"line,omitempty"`
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *GetUploadByCIDResponse) Reset() {
-	*x = GetUploadByCIDResponse{}
+func (x *ERNXMLValidationError) Reset() {
+	*x = ERNXMLValidationError{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_core_v1_types_proto_msgTypes[77]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4933,13 +5218,13 @@ func (x *GetUploadByCIDResponse) Reset() {
 	}
 }
 
-func (x *GetUploadByCIDResponse) String() string {
+func (x *ERNXMLValidationError) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUploadByCIDResponse) ProtoMessage() {}
+func (*ERNXMLValidationError) ProtoMessage() {}
 
-func (x *GetUploadByCIDResponse) ProtoReflect() protoreflect.Message {
+func (x *ERNXMLValidationError) ProtoReflect() protoreflect.Message {
 	mi := &file_core_v1_types_proto_msgTypes[77]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4951,58 +5236,42 @@ func (x *GetUploadByCIDResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUploadByCIDResponse.ProtoReflect.Descriptor instead.
-func (*GetUploadByCIDResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ERNXMLValidationError.ProtoReflect.Descriptor instead.
+func (*ERNXMLValidationError) Descriptor() ([]byte, []int) {
 	return file_core_v1_types_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *GetUploadByCIDResponse) GetExists() bool {
-	if x != nil {
-		return x.Exists
-	}
-	return false
-}
-
-func (x *GetUploadByCIDResponse) GetUploaderAddress() string {
+func (x *ERNXMLValidationError) GetPath() string {
 	if x != nil {
-		return x.UploaderAddress
+		return x.Path
 	}
 	return ""
 }
 
-func (x *GetUploadByCIDResponse) GetOriginalCid() string {
+func (x *ERNXMLValidationError) GetLine() int32 {
 	if x != nil {
-		return x.OriginalCid
+		return x.Line
 	}
-	return ""
+	return 0
 }
 
-func (x *GetUploadByCIDResponse) GetTranscodedCid() string {
+func (x *ERNXMLValidationError) GetMessage() string {
 	if x != nil {
-		return x.TranscodedCid
+		return x.Message
 	}
 	return ""
 }
 
-type GetStatusResponse_ProcessInfo struct {
+type GetPartyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Abci           *GetStatusResponse_ProcessInfo_ProcessStateInfo `protobuf:"bytes,1,opt,name=abci,proto3" json:"abci,omitempty"`
-	RegistryBridge *GetStatusResponse_ProcessInfo_ProcessStateInfo `protobuf:"bytes,2,opt,name=registry_bridge,json=registryBridge,proto3" json:"registry_bridge,omitempty"`
-	EchoServer     *GetStatusResponse_ProcessInfo_ProcessStateInfo `protobuf:"bytes,3,opt,name=echo_server,json=echoServer,proto3" json:"echo_server,omitempty"`
-	SyncTasks      *GetStatusResponse_ProcessInfo_ProcessStateInfo `protobuf:"bytes,4,opt,name=sync_tasks,json=syncTasks,proto3" json:"sync_tasks,omitempty"`
-	PeerManager    *GetStatusResponse_ProcessInfo_ProcessStateInfo `protobuf:"bytes,5,opt,name=peer_manager,json=peerManager,proto3" json:"peer_manager,omitempty"`
-	DataCompanion  *GetStatusResponse_ProcessInfo_ProcessStateInfo `protobuf:"bytes,6,opt,name=data_companion,json=dataCompanion,proto3" json:"data_companion,omitempty"`
-	Cache          *GetStatusResponse_ProcessInfo_ProcessStateInfo `protobuf:"bytes,7,opt,name=cache,proto3" json:"cache,omitempty"`
-	LogSync        *GetStatusResponse_ProcessInfo_ProcessStateInfo `protobuf:"bytes,8,opt,name=log_sync,json=logSync,proto3" json:"log_sync,omitempty"`
-	StateSync      *GetStatusResponse_ProcessInfo_ProcessStateInfo `protobuf:"bytes,9,opt,name=state_sync,json=stateSync,proto3" json:"state_sync,omitempty"`
-	MempoolCache   *GetStatusResponse_ProcessInfo_ProcessStateInfo `protobuf:"bytes,10,opt,name=mempool_cache,json=mempoolCache,proto3" json:"mempool_cache,omitempty"`
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *GetStatusResponse_ProcessInfo) Reset() {
-	*x = GetStatusResponse_ProcessInfo{}
+func (x *GetPartyRequest) Reset() {
+	*x = GetPartyRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_core_v1_types_proto_msgTypes[78]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5010,13 +5279,13 @@ func (x *GetStatusResponse_ProcessInfo) Reset() {
 	}
 }
 
-func (x *GetStatusResponse_ProcessInfo) String() string {
+func (x *GetPartyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStatusResponse_ProcessInfo) ProtoMessage() {}
+func (*GetPartyRequest) ProtoMessage() {}
 
-func (x *GetStatusResponse_ProcessInfo) ProtoReflect() protoreflect.Message {
+func (x *GetPartyRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_core_v1_types_proto_msgTypes[78]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5028,109 +5297,90 @@ func (x *GetStatusResponse_ProcessInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStatusResponse_ProcessInfo.ProtoReflect.Descriptor instead.
-func (*GetStatusResponse_ProcessInfo) Descriptor() ([]byte, []int) {
-	return file_core_v1_types_proto_rawDescGZIP(), []int{5, 0}
+// Deprecated: Use GetPartyRequest.ProtoReflect.Descriptor instead.
+func (*GetPartyRequest) Descriptor() ([]byte, []int) {
+	return file_core_v1_types_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *GetStatusResponse_ProcessInfo) GetAbci() *GetStatusResponse_ProcessInfo_ProcessStateInfo {
+func (x *GetPartyRequest) GetAddress() string {
 	if x != nil {
-		return x.Abci
+		return x.Address
 	}
-	return nil
+	return ""
 }
 
-func (x *GetStatusResponse_ProcessInfo) GetRegistryBridge() *GetStatusResponse_ProcessInfo_ProcessStateInfo {
-	if x != nil {
-		return x.RegistryBridge
-	}
-	return nil
-}
+type GetPartyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *GetStatusResponse_ProcessInfo) GetEchoServer() *GetStatusResponse_ProcessInfo_ProcessStateInfo {
-	if x != nil {
-		return x.EchoServer
-	}
-	return nil
+	Party *v1beta11.Party `protobuf:"bytes,1,opt,name=party,proto3" json:"party,omitempty"`
 }
 
-func (x *GetStatusResponse_ProcessInfo) GetSyncTasks() *GetStatusResponse_ProcessInfo_ProcessStateInfo {
-	if x != nil {
-		return x.SyncTasks
+func (x *GetPartyResponse) Reset() {
+	*x = GetPartyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_v1_types_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *GetStatusResponse_ProcessInfo) GetPeerManager() *GetStatusResponse_ProcessInfo_ProcessStateInfo {
-	if x != nil {
-		return x.PeerManager
-	}
-	return nil
+func (x *GetPartyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GetStatusResponse_ProcessInfo) GetDataCompanion() *GetStatusResponse_ProcessInfo_ProcessStateInfo {
-	if x != nil {
-		return x.DataCompanion
-	}
-	return nil
-}
-
-func (x *GetStatusResponse_ProcessInfo) GetCache() *GetStatusResponse_ProcessInfo_ProcessStateInfo {
-	if x != nil {
-		return x.Cache
-	}
-	return nil
-}
+func (*GetPartyResponse) ProtoMessage() {}
 
-func (x *GetStatusResponse_ProcessInfo) GetLogSync() *GetStatusResponse_ProcessInfo_ProcessStateInfo {
-	if x != nil {
-		return x.LogSync
+func (x *GetPartyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_core_v1_types_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *GetStatusResponse_ProcessInfo) GetStateSync() *GetStatusResponse_ProcessInfo_ProcessStateInfo {
-	if x != nil {
-		return x.StateSync
-	}
-	return nil
+// Deprecated: Use GetPartyResponse.ProtoReflect.Descriptor instead.
+func (*GetPartyResponse) Descriptor() ([]byte, []int) {
+	return file_core_v1_types_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *GetStatusResponse_ProcessInfo) GetMempoolCache() *GetStatusResponse_ProcessInfo_ProcessStateInfo {
+func (x *GetPartyResponse) GetParty() *v1beta11.Party {
 	if x != nil {
-		return x.MempoolCache
+		return x.Party
 	}
 	return nil
 }
 
-type GetStatusResponse_NodeInfo struct {
+type GetResourceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Endpoint     string `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
-	CometAddress string `protobuf:"bytes,2,opt,name=comet_address,json=cometAddress,proto3" json:"comet_address,omitempty"`
-	EthAddress   string `protobuf:"bytes,3,opt,name=eth_address,json=ethAddress,proto3" json:"eth_address,omitempty"`
-	NodeType     string `protobuf:"bytes,4,opt,name=node_type,json=nodeType,proto3" json:"node_type,omitempty"`
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *GetStatusResponse_NodeInfo) Reset() {
-	*x = GetStatusResponse_NodeInfo{}
+func (x *GetResourceRequest) Reset() {
+	*x = GetResourceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_core_v1_types_proto_msgTypes[79]
+		mi := &file_core_v1_types_proto_msgTypes[80]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetStatusResponse_NodeInfo) String() string {
+func (x *GetResourceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStatusResponse_NodeInfo) ProtoMessage() {}
+func (*GetResourceRequest) ProtoMessage() {}
 
-func (x *GetStatusResponse_NodeInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_core_v1_types_proto_msgTypes[79]
+func (x *GetResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_core_v1_types_proto_msgTypes[80]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5141,67 +5391,43 @@ func (x *GetStatusResponse_NodeInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStatusResponse_NodeInfo.ProtoReflect.Descriptor instead.
-func (*GetStatusResponse_NodeInfo) Descriptor() ([]byte, []int) {
-	return file_core_v1_types_proto_rawDescGZIP(), []int{5, 1}
-}
-
-func (x *GetStatusResponse_NodeInfo) GetEndpoint() string {
-	if x != nil {
-		return x.Endpoint
-	}
-	return ""
-}
-
-func (x *GetStatusResponse_NodeInfo) GetCometAddress() string {
-	if x != nil {
-		return x.CometAddress
-	}
-	return ""
-}
-
-func (x *GetStatusResponse_NodeInfo) GetEthAddress() string {
-	if x != nil {
-		return x.EthAddress
-	}
-	return ""
+// Deprecated: Use GetResourceRequest.ProtoReflect.Descriptor instead.
+func (*GetResourceRequest) Descriptor() ([]byte, []int) {
+	return file_core_v1_types_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *GetStatusResponse_NodeInfo) GetNodeType() string {
+func (x *GetResourceRequest) GetAddress() string {
 	if x != nil {
-		return x.NodeType
+		return x.Address
 	}
 	return ""
 }
 
-type GetStatusResponse_ChainInfo struct {
+type GetResourceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ChainId          string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	CurrentHeight    int64  `protobuf:"varint,2,opt,name=current_height,json=currentHeight,proto3" json:"current_height,omitempty"`
-	CurrentBlockHash string `protobuf:"bytes,3,opt,name=current_block_hash,json=currentBlockHash,proto3" json:"current_block_hash,omitempty"`
-	TotalTxCount     int64  `protobuf:"varint,4,opt,name=total_tx_count,json=totalTxCount,proto3" json:"total_tx_count,omitempty"`
+	Resource *v1beta11.Resource `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
 }
 
-func (x *GetStatusResponse_ChainInfo) Reset() {
-	*x = GetStatusResponse_ChainInfo{}
+func (x *GetResourceResponse) Reset() {
+	*x = GetResourceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_core_v1_types_proto_msgTypes[80]
+		mi := &file_core_v1_types_proto_msgTypes[81]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetStatusResponse_ChainInfo) String() string {
+func (x *GetResourceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStatusResponse_ChainInfo) ProtoMessage() {}
+func (*GetResourceResponse) ProtoMessage() {}
 
-func (x *GetStatusResponse_ChainInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_core_v1_types_proto_msgTypes[80]
+func (x *GetResourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_core_v1_types_proto_msgTypes[81]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5212,69 +5438,43 @@ func (x *GetStatusResponse_ChainInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStatusResponse_ChainInfo.ProtoReflect.Descriptor instead.
-func (*GetStatusResponse_ChainInfo) Descriptor() ([]byte, []int) {
-	return file_core_v1_types_proto_rawDescGZIP(), []int{5, 2}
-}
-
-func (x *GetStatusResponse_ChainInfo) GetChainId() string {
-	if x != nil {
-		return x.ChainId
-	}
-	return ""
-}
-
-func (x *GetStatusResponse_ChainInfo) GetCurrentHeight() int64 {
-	if x != nil {
-		return x.CurrentHeight
-	}
-	return 0
-}
-
-func (x *GetStatusResponse_ChainInfo) GetCurrentBlockHash() string {
-	if x != nil {
-		return x.CurrentBlockHash
-	}
-	return ""
+// Deprecated: Use GetResourceResponse.ProtoReflect.Descriptor instead.
+func (*GetResourceResponse) Descriptor() ([]byte, []int) {
+	return file_core_v1_types_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *GetStatusResponse_ChainInfo) GetTotalTxCount() int64 {
+func (x *GetResourceResponse) GetResource() *v1beta11.Resource {
 	if x != nil {
-		return x.TotalTxCount
+		return x.Resource
 	}
-	return 0
+	return nil
 }
 
-type GetStatusResponse_SyncInfo struct {
+type GetReleaseRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Synced bool `protobuf:"varint,1,opt,name=synced,proto3" json:"synced,omitempty"`
-	// Types that are assignable to SyncMode:
-	//
-	//	*GetStatusResponse_SyncInfo_StateSync
-	//	*GetStatusResponse_SyncInfo_BlockSync
-	SyncMode isGetStatusResponse_SyncInfo_SyncMode `protobuf_oneof:"sync_mode"`
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *GetStatusResponse_SyncInfo) Reset() {
-	*x = GetStatusResponse_SyncInfo{}
+func (x *GetReleaseRequest) Reset() {
+	*x = GetReleaseRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_core_v1_types_proto_msgTypes[81]
+		mi := &file_core_v1_types_proto_msgTypes[82]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetStatusResponse_SyncInfo) String() string {
+func (x *GetReleaseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStatusResponse_SyncInfo) ProtoMessage() {}
+func (*GetReleaseRequest) ProtoMessage() {}
 
-func (x *GetStatusResponse_SyncInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_core_v1_types_proto_msgTypes[81]
+func (x *GetReleaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_core_v1_types_proto_msgTypes[82]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5285,82 +5485,90 @@ func (x *GetStatusResponse_SyncInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStatusResponse_SyncInfo.ProtoReflect.Descriptor instead.
-func (*GetStatusResponse_SyncInfo) Descriptor() ([]byte, []int) {
-	return file_core_v1_types_proto_rawDescGZIP(), []int{5, 3}
+// Deprecated: Use GetReleaseRequest.ProtoReflect.Descriptor instead.
+func (*GetReleaseRequest) Descriptor() ([]byte, []int) {
+	return file_core_v1_types_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *GetStatusResponse_SyncInfo) GetSynced() bool {
+func (x *GetReleaseRequest) GetAddress() string {
 	if x != nil {
-		return x.Synced
+		return x.Address
 	}
-	return false
+	return ""
 }
 
-func (m *GetStatusResponse_SyncInfo) GetSyncMode() isGetStatusResponse_SyncInfo_SyncMode {
-	if m != nil {
-		return m.SyncMode
-	}
-	return nil
-}
+type GetReleaseResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *GetStatusResponse_SyncInfo) GetStateSync() *GetStatusResponse_SyncInfo_StateSyncInfo {
-	if x, ok := x.GetSyncMode().(*GetStatusResponse_SyncInfo_StateSync); ok {
-		return x.StateSync
-	}
-	return nil
+	Release *v1beta11.Release `protobuf:"bytes,1,opt,name=release,proto3" json:"release,omitempty"`
 }
 
-func (x *GetStatusResponse_SyncInfo) GetBlockSync() *GetStatusResponse_SyncInfo_BlockSyncInfo {
-	if x, ok := x.GetSyncMode().(*GetStatusResponse_SyncInfo_BlockSync); ok {
-		return x.BlockSync
+func (x *GetReleaseResponse) Reset() {
+	*x = GetReleaseResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_v1_types_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-type isGetStatusResponse_SyncInfo_SyncMode interface {
-	isGetStatusResponse_SyncInfo_SyncMode()
+func (x *GetReleaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type GetStatusResponse_SyncInfo_StateSync struct {
-	StateSync *GetStatusResponse_SyncInfo_StateSyncInfo `protobuf:"bytes,2,opt,name=state_sync,json=stateSync,proto3,oneof"`
-}
+func (*GetReleaseResponse) ProtoMessage() {}
 
-type GetStatusResponse_SyncInfo_BlockSync struct {
-	BlockSync *GetStatusResponse_SyncInfo_BlockSyncInfo `protobuf:"bytes,3,opt,name=block_sync,json=blockSync,proto3,oneof"`
+func (x *GetReleaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_core_v1_types_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (*GetStatusResponse_SyncInfo_StateSync) isGetStatusResponse_SyncInfo_SyncMode() {}
+// Deprecated: Use GetReleaseResponse.ProtoReflect.Descriptor instead.
+func (*GetReleaseResponse) Descriptor() ([]byte, []int) {
+	return file_core_v1_types_proto_rawDescGZIP(), []int{83}
+}
 
-func (*GetStatusResponse_SyncInfo_BlockSync) isGetStatusResponse_SyncInfo_SyncMode() {}
+func (x *GetReleaseResponse) GetRelease() *v1beta11.Release {
+	if x != nil {
+		return x.Release
+	}
+	return nil
+}
 
-type GetStatusResponse_PruningInfo struct {
+type GetDealRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Enabled        bool  `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	RetainBlocks   int64 `protobuf:"varint,2,opt,name=retain_blocks,json=retainBlocks,proto3" json:"retain_blocks,omitempty"`
-	EarliestHeight int64 `protobuf:"varint,3,opt,name=earliest_height,json=earliestHeight,proto3" json:"earliest_height,omitempty"`
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *GetStatusResponse_PruningInfo) Reset() {
-	*x = GetStatusResponse_PruningInfo{}
+func (x *GetDealRequest) Reset() {
+	*x = GetDealRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_core_v1_types_proto_msgTypes[82]
+		mi := &file_core_v1_types_proto_msgTypes[84]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetStatusResponse_PruningInfo) String() string {
+func (x *GetDealRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStatusResponse_PruningInfo) ProtoMessage() {}
+func (*GetDealRequest) ProtoMessage() {}
 
-func (x *GetStatusResponse_PruningInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_core_v1_types_proto_msgTypes[82]
+func (x *GetDealRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_core_v1_types_proto_msgTypes[84]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5371,63 +5579,43 @@ func (x *GetStatusResponse_PruningInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStatusResponse_PruningInfo.ProtoReflect.Descriptor instead.
-func (*GetStatusResponse_PruningInfo) Descriptor() ([]byte, []int) {
-	return file_core_v1_types_proto_rawDescGZIP(), []int{5, 4}
+// Deprecated: Use GetDealRequest.ProtoReflect.Descriptor instead.
+func (*GetDealRequest) Descriptor() ([]byte, []int) {
+	return file_core_v1_types_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *GetStatusResponse_PruningInfo) GetEnabled() bool {
-	if x != nil {
-		return x.Enabled
-	}
-	return false
-}
-
-func (x *GetStatusResponse_PruningInfo) GetRetainBlocks() int64 {
-	if x != nil {
-		return x.RetainBlocks
-	}
-	return 0
-}
-
-func (x *GetStatusResponse_PruningInfo) GetEarliestHeight() int64 {
+func (x *GetDealRequest) GetAddress() string {
 	if x != nil {
-		return x.EarliestHeight
+		return x.Address
 	}
-	return 0
+	return ""
 }
 
-type GetStatusResponse_ResourceInfo struct {
+type GetDealResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DbSize    int64 `protobuf:"varint,1,opt,name=db_size,json=dbSize,proto3" json:"db_size,omitempty"`
-	ChainSize int64 `protobuf:"varint,2,opt,name=chain_size,json=chainSize,proto3" json:"chain_size,omitempty"`
-	MemSize   int64 `protobuf:"varint,3,opt,name=mem_size,json=memSize,proto3" json:"mem_size,omitempty"`
-	CpuUsage  int64 `protobuf:"varint,4,opt,name=cpu_usage,json=cpuUsage,proto3" json:"cpu_usage,omitempty"`
-	MemUsage  int64 `protobuf:"varint,5,opt,name=mem_usage,json=memUsage,proto3" json:"mem_usage,omitempty"`
-	DiskUsage int64 `protobuf:"varint,6,opt,name=disk_usage,json=diskUsage,proto3" json:"disk_usage,omitempty"`
-	DiskFree  int64 `protobuf:"varint,7,opt,name=disk_free,json=diskFree,proto3" json:"disk_free,omitempty"`
+	Deal *v1beta11.Deal `protobuf:"bytes,1,opt,name=deal,proto3" json:"deal,omitempty"`
 }
 
-func (x *GetStatusResponse_ResourceInfo) Reset() {
-	*x = GetStatusResponse_ResourceInfo{}
+func (x *GetDealResponse) Reset() {
+	*x = GetDealResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_core_v1_types_proto_msgTypes[83]
+		mi := &file_core_v1_types_proto_msgTypes[85]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetStatusResponse_ResourceInfo) String() string {
+func (x *GetDealResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStatusResponse_ResourceInfo) ProtoMessage() {}
+func (*GetDealResponse) ProtoMessage() {}
 
-func (x *GetStatusResponse_ResourceInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_core_v1_types_proto_msgTypes[83]
+func (x *GetDealResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_core_v1_types_proto_msgTypes[85]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5438,88 +5626,90 @@ func (x *GetStatusResponse_ResourceInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStatusResponse_ResourceInfo.ProtoReflect.Descriptor instead.
-func (*GetStatusResponse_ResourceInfo) Descriptor() ([]byte, []int) {
-	return file_core_v1_types_proto_rawDescGZIP(), []int{5, 5}
+// Deprecated: Use GetDealResponse.ProtoReflect.Descriptor instead.
+func (*GetDealResponse) Descriptor() ([]byte, []int) {
+	return file_core_v1_types_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *GetStatusResponse_ResourceInfo) GetDbSize() int64 {
+func (x *GetDealResponse) GetDeal() *v1beta11.Deal {
 	if x != nil {
-		return x.DbSize
+		return x.Deal
 	}
-	return 0
+	return nil
 }
 
-func (x *GetStatusResponse_ResourceInfo) GetChainSize() int64 {
-	if x != nil {
-		return x.ChainSize
-	}
-	return 0
+type GetMEADRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *GetStatusResponse_ResourceInfo) GetMemSize() int64 {
-	if x != nil {
-		return x.MemSize
+func (x *GetMEADRequest) Reset() {
+	*x = GetMEADRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_v1_types_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *GetStatusResponse_ResourceInfo) GetCpuUsage() int64 {
-	if x != nil {
-		return x.CpuUsage
-	}
-	return 0
+func (x *GetMEADRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GetStatusResponse_ResourceInfo) GetMemUsage() int64 {
-	if x != nil {
-		return x.MemUsage
+func (*GetMEADRequest) ProtoMessage() {}
+
+func (x *GetMEADRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_core_v1_types_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GetStatusResponse_ResourceInfo) GetDiskUsage() int64 {
-	if x != nil {
-		return x.DiskUsage
-	}
-	return 0
+// Deprecated: Use GetMEADRequest.ProtoReflect.Descriptor instead.
+func (*GetMEADRequest) Descriptor() ([]byte, []int) {
+	return file_core_v1_types_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *GetStatusResponse_ResourceInfo) GetDiskFree() int64 {
+func (x *GetMEADRequest) GetAddress() string {
 	if x != nil {
-		return x.DiskFree
+		return x.Address
 	}
-	return 0
+	return ""
 }
 
-type GetStatusResponse_MempoolInfo struct {
+type GetMEADResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TxCount    int64 `protobuf:"varint,1,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
-	TxSize     int64 `protobuf:"varint,2,opt,name=tx_size,json=txSize,proto3" json:"tx_size,omitempty"`
-	MaxTxCount int64 `protobuf:"varint,3,opt,name=max_tx_count,json=maxTxCount,proto3" json:"max_tx_count,omitempty"`
-	MaxTxSize  int64 `protobuf:"varint,4,opt,name=max_tx_size,json=maxTxSize,proto3" json:"max_tx_size,omitempty"`
+	Mead *v1beta11.MeadMessage `protobuf:"bytes,1,opt,name=mead,proto3" json:"mead,omitempty"`
 }
 
-func (x *GetStatusResponse_MempoolInfo) Reset() {
-	*x = GetStatusResponse_MempoolInfo{}
+func (x *GetMEADResponse) Reset() {
+	*x = GetMEADResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_core_v1_types_proto_msgTypes[84]
+		mi := &file_core_v1_types_proto_msgTypes[87]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetStatusResponse_MempoolInfo) String() string {
+func (x *GetMEADResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStatusResponse_MempoolInfo) ProtoMessage() {}
+func (*GetMEADResponse) ProtoMessage() {}
 
-func (x *GetStatusResponse_MempoolInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_core_v1_types_proto_msgTypes[84]
+func (x *GetMEADResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_core_v1_types_proto_msgTypes[87]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5530,65 +5720,90 @@ func (x *GetStatusResponse_MempoolInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStatusResponse_MempoolInfo.ProtoReflect.Descriptor instead.
-func (*GetStatusResponse_MempoolInfo) Descriptor() ([]byte, []int) {
-	return file_core_v1_types_proto_rawDescGZIP(), []int{5, 6}
+// Deprecated: Use GetMEADResponse.ProtoReflect.Descriptor instead.
+func (*GetMEADResponse) Descriptor() ([]byte, []int) {
+	return file_core_v1_types_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *GetStatusResponse_MempoolInfo) GetTxCount() int64 {
+func (x *GetMEADResponse) GetMead() *v1beta11.MeadMessage {
 	if x != nil {
-		return x.TxCount
+		return x.Mead
 	}
-	return 0
+	return nil
 }
 
-func (x *GetStatusResponse_MempoolInfo) GetTxSize() int64 {
-	if x != nil {
-		return x.TxSize
+type GetPIERequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *GetPIERequest) Reset() {
+	*x = GetPIERequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_v1_types_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *GetStatusResponse_MempoolInfo) GetMaxTxCount() int64 {
-	if x != nil {
-		return x.MaxTxCount
+func (x *GetPIERequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPIERequest) ProtoMessage() {}
+
+func (x *GetPIERequest) ProtoReflect() protoreflect.Message {
+	mi := &file_core_v1_types_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GetStatusResponse_MempoolInfo) GetMaxTxSize() int64 {
+// Deprecated: Use GetPIERequest.ProtoReflect.Descriptor instead.
+func (*GetPIERequest) Descriptor() ([]byte, []int) {
+	return file_core_v1_types_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *GetPIERequest) GetAddress() string {
 	if x != nil {
-		return x.MaxTxSize
+		return x.Address
 	}
-	return 0
+	return ""
 }
 
-type GetStatusResponse_SnapshotInfo struct {
+type GetPIEResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Enabled   bool                `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	Snapshots []*SnapshotMetadata `protobuf:"bytes,2,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+	Pie *v1beta11.PieMessage `protobuf:"bytes,1,opt,name=pie,proto3" json:"pie,omitempty"`
 }
 
-func (x *GetStatusResponse_SnapshotInfo) Reset() {
-	*x = GetStatusResponse_SnapshotInfo{}
+func (x *GetPIEResponse) Reset() {
+	*x = GetPIEResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_core_v1_types_proto_msgTypes[85]
+		mi := &file_core_v1_types_proto_msgTypes[89]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetStatusResponse_SnapshotInfo) String() string {
+func (x *GetPIEResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStatusResponse_SnapshotInfo) ProtoMessage() {}
+func (*GetPIEResponse) ProtoMessage() {}
 
-func (x *GetStatusResponse_SnapshotInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_core_v1_types_proto_msgTypes[85]
+func (x *GetPIEResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_core_v1_types_proto_msgTypes[89]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5599,50 +5814,44 @@ func (x *GetStatusResponse_SnapshotInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStatusResponse_SnapshotInfo.ProtoReflect.Descriptor instead.
-func (*GetStatusResponse_SnapshotInfo) Descriptor() ([]byte, []int) {
-	return file_core_v1_types_proto_rawDescGZIP(), []int{5, 7}
-}
-
-func (x *GetStatusResponse_SnapshotInfo) GetEnabled() bool {
-	if x != nil {
-		return x.Enabled
-	}
-	return false
+// Deprecated: Use GetPIEResponse.ProtoReflect.Descriptor instead.
+func (*GetPIEResponse) Descriptor() ([]byte, []int) {
+	return file_core_v1_types_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *GetStatusResponse_SnapshotInfo) GetSnapshots() []*SnapshotMetadata {
+func (x *GetPIEResponse) GetPie() *v1beta11.PieMessage {
 	if x != nil {
-		return x.Snapshots
+		return x.Pie
 	}
 	return nil
 }
 
-type GetStatusResponse_PeerInfo struct {
+type GetEnrichmentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Peers []*GetStatusResponse_PeerInfo_Peer `protobuf:"bytes,1,rep,name=peers,proto3" json:"peers,omitempty"`
+	// resource, release or party address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *GetStatusResponse_PeerInfo) Reset() {
-	*x = GetStatusResponse_PeerInfo{}
+func (x *GetEnrichmentRequest) Reset() {
+	*x = GetEnrichmentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_core_v1_types_proto_msgTypes[86]
+		mi := &file_core_v1_types_proto_msgTypes[90]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetStatusResponse_PeerInfo) String() string {
+func (x *GetEnrichmentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStatusResponse_PeerInfo) ProtoMessage() {}
+func (*GetEnrichmentRequest) ProtoMessage() {}
 
-func (x *GetStatusResponse_PeerInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_core_v1_types_proto_msgTypes[86]
+func (x *GetEnrichmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_core_v1_types_proto_msgTypes[90]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5653,47 +5862,57 @@ func (x *GetStatusResponse_PeerInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStatusResponse_PeerInfo.ProtoReflect.Descriptor instead.
-func (*GetStatusResponse_PeerInfo) Descriptor() ([]byte, []int) {
-	return file_core_v1_types_proto_rawDescGZIP(), []int{5, 8}
+// Deprecated: Use GetEnrichmentRequest.ProtoReflect.Descriptor instead.
+func (*GetEnrichmentRequest) Descriptor() ([]byte, []int) {
+	return file_core_v1_types_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *GetStatusResponse_PeerInfo) GetPeers() []*GetStatusResponse_PeerInfo_Peer {
+func (x *GetEnrichmentRequest) GetAddress() string {
 	if x != nil {
-		return x.Peers
+		return x.Address
 	}
-	return nil
+	return ""
 }
 
-type GetStatusResponse_ProcessInfo_ProcessStateInfo struct {
+type GetEnrichmentResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	State       GetStatusResponse_ProcessInfo_ProcessState `protobuf:"varint,1,opt,name=state,proto3,enum=core.v1.GetStatusResponse_ProcessInfo_ProcessState" json:"state,omitempty"`
-	StartedAt   *timestamppb.Timestamp                     `protobuf:"bytes,2,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
-	CompletedAt *timestamppb.Timestamp                     `protobuf:"bytes,3,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
-	Error       string                                     `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
-	Metadata    string                                     `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	ErnAddress string `protobuf:"bytes,1,opt,name=ern_address,json=ernAddress,proto3" json:"ern_address,omitempty"`
+	// resource, release or party
+	EntityType string `protobuf:"bytes,2,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
+	// every MEAD or PIE enrichment of the entity merged in the order they were finalized,
+	// fields a later message sets replace earlier values and repeated fields collect
+	// every distinct value, unset when the entity was never enriched
+	//
+	// Types that are assignable to Enrichment:
+	//
+	//	*GetEnrichmentResponse_Resource
+	//	*GetEnrichmentResponse_Release
+	//	*GetEnrichmentResponse_Party
+	Enrichment isGetEnrichmentResponse_Enrichment `protobuf_oneof:"enrichment"`
+	// the messages that enriched the entity, oldest first
+	Sources []*EnrichmentSource `protobuf:"bytes,6,rep,name=sources,proto3" json:"sources,omitempty"`
 }
 
-func (x *GetStatusResponse_ProcessInfo_ProcessStateInfo) Reset() {
-	*x = GetStatusResponse_ProcessInfo_ProcessStateInfo{}
+func (x *GetEnrichmentResponse) Reset() {
+	*x = GetEnrichmentResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_core_v1_types_proto_msgTypes[87]
+		mi := &file_core_v1_types_proto_msgTypes[91]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetStatusResponse_ProcessInfo_ProcessStateInfo) String() string {
+func (x *GetEnrichmentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStatusResponse_ProcessInfo_ProcessStateInfo) ProtoMessage() {}
+func (*GetEnrichmentResponse) ProtoMessage() {}
 
-func (x *GetStatusResponse_ProcessInfo_ProcessStateInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_core_v1_types_proto_msgTypes[87]
+func (x *GetEnrichmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_core_v1_types_proto_msgTypes[91]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5704,76 +5923,111 @@ func (x *GetStatusResponse_ProcessInfo_ProcessStateInfo) ProtoReflect() protoref
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStatusResponse_ProcessInfo_ProcessStateInfo.ProtoReflect.Descriptor instead.
-func (*GetStatusResponse_ProcessInfo_ProcessStateInfo) Descriptor() ([]byte, []int) {
-	return file_core_v1_types_proto_rawDescGZIP(), []int{5, 0, 0}
+// Deprecated: Use GetEnrichmentResponse.ProtoReflect.Descriptor instead.
+func (*GetEnrichmentResponse) Descriptor() ([]byte, []int) {
+	return file_core_v1_types_proto_rawDescGZIP(), []int{91}
 }
 
-func (x *GetStatusResponse_ProcessInfo_ProcessStateInfo) GetState() GetStatusResponse_ProcessInfo_ProcessState {
+func (x *GetEnrichmentResponse) GetErnAddress() string {
 	if x != nil {
-		return x.State
+		return x.ErnAddress
 	}
-	return GetStatusResponse_ProcessInfo_PROCESS_STATE_UNSPECIFIED
+	return ""
 }
 
-func (x *GetStatusResponse_ProcessInfo_ProcessStateInfo) GetStartedAt() *timestamppb.Timestamp {
+func (x *GetEnrichmentResponse) GetEntityType() string {
 	if x != nil {
-		return x.StartedAt
+		return x.EntityType
+	}
+	return ""
+}
+
+func (m *GetEnrichmentResponse) GetEnrichment() isGetEnrichmentResponse_Enrichment {
+	if m != nil {
+		return m.Enrichment
 	}
 	return nil
 }
 
-func (x *GetStatusResponse_ProcessInfo_ProcessStateInfo) GetCompletedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CompletedAt
+func (x *GetEnrichmentResponse) GetResource() *v1beta11.MeadMessage_ResourceInformation {
+	if x, ok := x.GetEnrichment().(*GetEnrichmentResponse_Resource); ok {
+		return x.Resource
 	}
 	return nil
 }
 
-func (x *GetStatusResponse_ProcessInfo_ProcessStateInfo) GetError() string {
-	if x != nil {
-		return x.Error
+func (x *GetEnrichmentResponse) GetRelease() *v1beta11.MeadMessage_ReleaseInformation {
+	if x, ok := x.GetEnrichment().(*GetEnrichmentResponse_Release); ok {
+		return x.Release
 	}
-	return ""
+	return nil
 }
 
-func (x *GetStatusResponse_ProcessInfo_ProcessStateInfo) GetMetadata() string {
+func (x *GetEnrichmentResponse) GetParty() *v1beta11.PieMessage_Party {
+	if x, ok := x.GetEnrichment().(*GetEnrichmentResponse_Party); ok {
+		return x.Party
+	}
+	return nil
+}
+
+func (x *GetEnrichmentResponse) GetSources() []*EnrichmentSource {
 	if x != nil {
-		return x.Metadata
+		return x.Sources
 	}
-	return ""
+	return nil
 }
 
-type GetStatusResponse_SyncInfo_StateSyncInfo struct {
+type isGetEnrichmentResponse_Enrichment interface {
+	isGetEnrichmentResponse_Enrichment()
+}
+
+type GetEnrichmentResponse_Resource struct {
+	Resource *v1beta11.MeadMessage_ResourceInformation `protobuf:"bytes,3,opt,name=resource,proto3,oneof"`
+}
+
+type GetEnrichmentResponse_Release struct {
+	Release *v1beta11.MeadMessage_ReleaseInformation `protobuf:"bytes,4,opt,name=release,proto3,oneof"`
+}
+
+type GetEnrichmentResponse_Party struct {
+	Party *v1beta11.PieMessage_Party `protobuf:"bytes,5,opt,name=party,proto3,oneof"`
+}
+
+func (*GetEnrichmentResponse_Resource) isGetEnrichmentResponse_Enrichment() {}
+
+func (*GetEnrichmentResponse_Release) isGetEnrichmentResponse_Enrichment() {}
+
+func (*GetEnrichmentResponse_Party) isGetEnrichmentResponse_Enrichment() {}
+
+type EnrichmentSource struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Phase            GetStatusResponse_SyncInfo_StateSyncInfo_Phase `protobuf:"varint,1,opt,name=phase,proto3,enum=core.v1.GetStatusResponse_SyncInfo_StateSyncInfo_Phase" json:"phase,omitempty"`
-	Snapshot         *SnapshotMetadata                              `protobuf:"bytes,2,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
-	DownloadedChunks int64                                          `protobuf:"varint,3,opt,name=downloaded_chunks,json=downloadedChunks,proto3" json:"downloaded_chunks,omitempty"`
-	ChunkFetchers    int64                                          `protobuf:"varint,4,opt,name=chunk_fetchers,json=chunkFetchers,proto3" json:"chunk_fetchers,omitempty"`
-	StartedAt        *timestamppb.Timestamp                         `protobuf:"bytes,5,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
-	CompletedAt      *timestamppb.Timestamp                         `protobuf:"bytes,6,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	// MEAD or PIE address
+	Address     string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Sender      string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	TxHash      string `protobuf:"bytes,3,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	BlockHeight int64  `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
 }
 
-func (x *GetStatusResponse_SyncInfo_StateSyncInfo) Reset() {
-	*x = GetStatusResponse_SyncInfo_StateSyncInfo{}
+func (x *EnrichmentSource) Reset() {
+	*x = EnrichmentSource{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_core_v1_types_proto_msgTypes[88]
+		mi := &file_core_v1_types_proto_msgTypes[92]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetStatusResponse_SyncInfo_StateSyncInfo) String() string {
+func (x *EnrichmentSource) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStatusResponse_SyncInfo_StateSyncInfo) ProtoMessage() {}
+func (*EnrichmentSource) ProtoMessage() {}
 
-func (x *GetStatusResponse_SyncInfo_StateSyncInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_core_v1_types_proto_msgTypes[88]
+func (x *EnrichmentSource) ProtoReflect() protoreflect.Message {
+	mi := &file_core_v1_types_proto_msgTypes[92]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5784,83 +6038,69 @@ func (x *GetStatusResponse_SyncInfo_StateSyncInfo) ProtoReflect() protoreflect.M
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStatusResponse_SyncInfo_StateSyncInfo.ProtoReflect.Descriptor instead.
-func (*GetStatusResponse_SyncInfo_StateSyncInfo) Descriptor() ([]byte, []int) {
-	return file_core_v1_types_proto_rawDescGZIP(), []int{5, 3, 0}
+// Deprecated: Use EnrichmentSource.ProtoReflect.Descriptor instead.
+func (*EnrichmentSource) Descriptor() ([]byte, []int) {
+	return file_core_v1_types_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *GetStatusResponse_SyncInfo_StateSyncInfo) GetPhase() GetStatusResponse_SyncInfo_StateSyncInfo_Phase {
+func (x *EnrichmentSource) GetAddress() string {
 	if x != nil {
-		return x.Phase
+		return x.Address
 	}
-	return GetStatusResponse_SyncInfo_StateSyncInfo_PHASE_UNSPECIFIED
+	return ""
 }
 
-func (x *GetStatusResponse_SyncInfo_StateSyncInfo) GetSnapshot() *SnapshotMetadata {
+func (x *EnrichmentSource) GetSender() string {
 	if x != nil {
-		return x.Snapshot
+		return x.Sender
 	}
-	return nil
+	return ""
 }
 
-func (x *GetStatusResponse_SyncInfo_StateSyncInfo) GetDownloadedChunks() int64 {
+func (x *EnrichmentSource) GetTxHash() string {
 	if x != nil {
-		return x.DownloadedChunks
+		return x.TxHash
 	}
-	return 0
+	return ""
 }
 
-func (x *GetStatusResponse_SyncInfo_StateSyncInfo) GetChunkFetchers() int64 {
+func (x *EnrichmentSource) GetBlockHeight() int64 {
 	if x != nil {
-		return x.ChunkFetchers
+		return x.BlockHeight
 	}
 	return 0
 }
 
-func (x *GetStatusResponse_SyncInfo_StateSyncInfo) GetStartedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.StartedAt
-	}
-	return nil
-}
-
-func (x *GetStatusResponse_SyncInfo_StateSyncInfo) GetCompletedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CompletedAt
-	}
-	return nil
-}
-
-type GetStatusResponse_SyncInfo_BlockSyncInfo struct {
+type RewardMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BlockDiff   int64                       `protobuf:"varint,1,opt,name=block_diff,json=blockDiff,proto3" json:"block_diff,omitempty"`
-	HeadSource  *GetStatusResponse_NodeInfo `protobuf:"bytes,2,opt,name=head_source,json=headSource,proto3" json:"head_source,omitempty"`
-	HeadHeight  int64                       `protobuf:"varint,3,opt,name=head_height,json=headHeight,proto3" json:"head_height,omitempty"`
-	SyncHeight  int64                       `protobuf:"varint,4,opt,name=sync_height,json=syncHeight,proto3" json:"sync_height,omitempty"`
-	StartedAt   *timestamppb.Timestamp      `protobuf:"bytes,5,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
-	CompletedAt *timestamppb.Timestamp      `protobuf:"bytes,6,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	// Types that are assignable to Action:
+	//
+	//	*RewardMessage_Create
+	//	*RewardMessage_Delete
+	//	*RewardMessage_Attest
+	Action isRewardMessage_Action `protobuf_oneof:"action"`
 }
 
-func (x *GetStatusResponse_SyncInfo_BlockSyncInfo) Reset() {
-	*x = GetStatusResponse_SyncInfo_BlockSyncInfo{}
+func (x *RewardMessage) Reset() {
+	*x = RewardMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_core_v1_types_proto_msgTypes[89]
+		mi := &file_core_v1_types_proto_msgTypes[93]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetStatusResponse_SyncInfo_BlockSyncInfo) String() string {
+func (x *RewardMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStatusResponse_SyncInfo_BlockSyncInfo) ProtoMessage() {}
+func (*RewardMessage) ProtoMessage() {}
 
-func (x *GetStatusResponse_SyncInfo_BlockSyncInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_core_v1_types_proto_msgTypes[89]
+func (x *RewardMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_core_v1_types_proto_msgTypes[93]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5871,87 +6111,91 @@ func (x *GetStatusResponse_SyncInfo_BlockSyncInfo) ProtoReflect() protoreflect.M
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStatusResponse_SyncInfo_BlockSyncInfo.ProtoReflect.Descriptor instead.
-func (*GetStatusResponse_SyncInfo_BlockSyncInfo) Descriptor() ([]byte, []int) {
-	return file_core_v1_types_proto_rawDescGZIP(), []int{5, 3, 1}
+// Deprecated: Use RewardMessage.ProtoReflect.Descriptor instead.
+func (*RewardMessage) Descriptor() ([]byte, []int) {
+	return file_core_v1_types_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *GetStatusResponse_SyncInfo_BlockSyncInfo) GetBlockDiff() int64 {
-	if x != nil {
-		return x.BlockDiff
+func (m *RewardMessage) GetAction() isRewardMessage_Action {
+	if m != nil {
+		return m.Action
 	}
-	return 0
+	return nil
 }
 
-func (x *GetStatusResponse_SyncInfo_BlockSyncInfo) GetHeadSource() *GetStatusResponse_NodeInfo {
-	if x != nil {
-		return x.HeadSource
+func (x *RewardMessage) GetCreate() *CreateReward {
+	if x, ok := x.GetAction().(*RewardMessage_Create); ok {
+		return x.Create
 	}
 	return nil
 }
 
-func (x *GetStatusResponse_SyncInfo_BlockSyncInfo) GetHeadHeight() int64 {
-	if x != nil {
-		return x.HeadHeight
+func (x *RewardMessage) GetDelete() *DeleteReward {
+	if x, ok := x.GetAction().(*RewardMessage_Delete); ok {
+		return x.Delete
 	}
-	return 0
+	return nil
 }
 
-func (x *GetStatusResponse_SyncInfo_BlockSyncInfo) GetSyncHeight() int64 {
-	if x != nil {
-		return x.SyncHeight
+func (x *RewardMessage) GetAttest() *AttestRewardClaim {
+	if x, ok := x.GetAction().(*RewardMessage_Attest); ok {
+		return x.Attest
 	}
-	return 0
+	return nil
 }
 
-func (x *GetStatusResponse_SyncInfo_BlockSyncInfo) GetStartedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.StartedAt
-	}
-	return nil
+type isRewardMessage_Action interface {
+	isRewardMessage_Action()
 }
 
-func (x *GetStatusResponse_SyncInfo_BlockSyncInfo) GetCompletedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CompletedAt
-	}
-	return nil
+type RewardMessage_Create struct {
+	Create *CreateReward `protobuf:"bytes,1000,opt,name=create,proto3,oneof"`
 }
 
-type GetStatusResponse_PeerInfo_Peer struct {
+type RewardMessage_Delete struct {
+	Delete *DeleteReward `protobuf:"bytes,1001,opt,name=delete,proto3,oneof"`
+}
+
+type RewardMessage_Attest struct {
+	Attest *AttestRewardClaim `protobuf:"bytes,1002,opt,name=attest,proto3,oneof"`
+}
+
+func (*RewardMessage_Create) isRewardMessage_Action() {}
+
+func (*RewardMessage_Delete) isRewardMessage_Action() {}
+
+func (*RewardMessage_Attest) isRewardMessage_Action() {}
+
+type CreateReward struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Endpoint          string `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
-	CometAddress      string `protobuf:"bytes,2,opt,name=comet_address,json=cometAddress,proto3" json:"comet_address,omitempty"`
-	EthAddress        string `protobuf:"bytes,3,opt,name=eth_address,json=ethAddress,proto3" json:"eth_address,omitempty"`
-	NodeType          string `protobuf:"bytes,4,opt,name=node_type,json=nodeType,proto3" json:"node_type,omitempty"`
-	ConnectrpcClient  bool   `protobuf:"varint,5,opt,name=connectrpc_client,json=connectrpcClient,proto3" json:"connectrpc_client,omitempty"`
-	ConnectrpcHealthy bool   `protobuf:"varint,6,opt,name=connectrpc_healthy,json=connectrpcHealthy,proto3" json:"connectrpc_healthy,omitempty"`
-	CometrpcClient    bool   `protobuf:"varint,7,opt,name=cometrpc_client,json=cometrpcClient,proto3" json:"cometrpc_client,omitempty"`
-	CometrpcHealthy   bool   `protobuf:"varint,8,opt,name=cometrpc_healthy,json=cometrpcHealthy,proto3" json:"cometrpc_healthy,omitempty"`
-	P2PConnected      bool   `protobuf:"varint,9,opt,name=p2p_connected,json=p2pConnected,proto3" json:"p2p_connected,omitempty"`
-	P2PAccessible     bool   `protobuf:"varint,10,opt,name=p2p_accessible,json=p2pAccessible,proto3" json:"p2p_accessible,omitempty"`
+	RewardId            string            `protobuf:"bytes,1,opt,name=reward_id,json=rewardId,proto3" json:"reward_id,omitempty"`
+	Name                string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Amount              uint64            `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	ClaimAuthorities    []*ClaimAuthority `protobuf:"bytes,4,rep,name=claim_authorities,json=claimAuthorities,proto3" json:"claim_authorities,omitempty"`
+	DeadlineBlockHeight int64             `protobuf:"varint,5,opt,name=deadline_block_height,json=deadlineBlockHeight,proto3" json:"deadline_block_height,omitempty"`
+	Signature           string            `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"` // Signature over deterministic reward data
 }
 
-func (x *GetStatusResponse_PeerInfo_Peer) Reset() {
-	*x = GetStatusResponse_PeerInfo_Peer{}
+func (x *CreateReward) Reset() {
+	*x = CreateReward{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_core_v1_types_proto_msgTypes[90]
+		mi := &file_core_v1_types_proto_msgTypes[94]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetStatusResponse_PeerInfo_Peer) String() string {
+func (x *CreateReward) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStatusResponse_PeerInfo_Peer) ProtoMessage() {}
+func (*CreateReward) ProtoMessage() {}
 
-func (x *GetStatusResponse_PeerInfo_Peer) ProtoReflect() protoreflect.Message {
-	mi := &file_core_v1_types_proto_msgTypes[90]
+func (x *CreateReward) ProtoReflect() protoreflect.Message {
+	mi := &file_core_v1_types_proto_msgTypes[94]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5962,113 +6206,149 @@ func (x *GetStatusResponse_PeerInfo_Peer) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStatusResponse_PeerInfo_Peer.ProtoReflect.Descriptor instead.
-func (*GetStatusResponse_PeerInfo_Peer) Descriptor() ([]byte, []int) {
-	return file_core_v1_types_proto_rawDescGZIP(), []int{5, 8, 0}
+// Deprecated: Use CreateReward.ProtoReflect.Descriptor instead.
+func (*CreateReward) Descriptor() ([]byte, []int) {
+	return file_core_v1_types_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *GetStatusResponse_PeerInfo_Peer) GetEndpoint() string {
+func (x *CreateReward) GetRewardId() string {
 	if x != nil {
-		return x.Endpoint
+		return x.RewardId
 	}
 	return ""
 }
 
-func (x *GetStatusResponse_PeerInfo_Peer) GetCometAddress() string {
+func (x *CreateReward) GetName() string {
 	if x != nil {
-		return x.CometAddress
+		return x.Name
 	}
 	return ""
 }
 
-func (x *GetStatusResponse_PeerInfo_Peer) GetEthAddress() string {
+func (x *CreateReward) GetAmount() uint64 {
 	if x != nil {
-		return x.EthAddress
+		return x.Amount
 	}
-	return ""
+	return 0
 }
 
-func (x *GetStatusResponse_PeerInfo_Peer) GetNodeType() string {
+func (x *CreateReward) GetClaimAuthorities() []*ClaimAuthority {
 	if x != nil {
-		return x.NodeType
+		return x.ClaimAuthorities
 	}
-	return ""
+	return nil
 }
 
-func (x *GetStatusResponse_PeerInfo_Peer) GetConnectrpcClient() bool {
+func (x *CreateReward) GetDeadlineBlockHeight() int64 {
 	if x != nil {
-		return x.ConnectrpcClient
+		return x.DeadlineBlockHeight
 	}
-	return false
+	return 0
 }
 
-func (x *GetStatusResponse_PeerInfo_Peer) GetConnectrpcHealthy() bool {
+func (x *CreateReward) GetSignature() string {
 	if x != nil {
-		return x.ConnectrpcHealthy
+		return x.Signature
 	}
-	return false
+	return ""
 }
 
-func (x *GetStatusResponse_PeerInfo_Peer) GetCometrpcClient() bool {
-	if x != nil {
-		return x.CometrpcClient
-	}
-	return false
-}
+type DeleteReward struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *GetStatusResponse_PeerInfo_Peer) GetCometrpcHealthy() bool {
+	Address             string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"` // The deployed reward address
+	DeadlineBlockHeight int64  `protobuf:"varint,2,opt,name=deadline_block_height,json=deadlineBlockHeight,proto3" json:"deadline_block_height,omitempty"`
+	Signature           string `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"` // Signature over deterministic delete data
+}
+
+func (x *DeleteReward) Reset() {
+	*x = DeleteReward{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_v1_types_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteReward) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReward) ProtoMessage() {}
+
+func (x *DeleteReward) ProtoReflect() protoreflect.Message {
+	mi := &file_core_v1_types_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteReward.ProtoReflect.Descriptor instead.
+func (*DeleteReward) Descriptor() ([]byte, []int) {
+	return file_core_v1_types_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *DeleteReward) GetAddress() string {
 	if x != nil {
-		return x.CometrpcHealthy
+		return x.Address
 	}
-	return false
+	return ""
 }
 
-func (x *GetStatusResponse_PeerInfo_Peer) GetP2PConnected() bool {
+func (x *DeleteReward) GetDeadlineBlockHeight() int64 {
 	if x != nil {
-		return x.P2PConnected
+		return x.DeadlineBlockHeight
 	}
-	return false
+	return 0
 }
 
-func (x *GetStatusResponse_PeerInfo_Peer) GetP2PAccessible() bool {
+func (x *DeleteReward) GetSignature() string {
 	if x != nil {
-		return x.P2PAccessible
+		return x.Signature
 	}
-	return false
+	return ""
 }
 
-type GetStreamURLsResponse_EntityStreamURLs struct {
+// recorded by a validator for every reward claim it attests to, validators refuse to
+// attest to a specifier once its attested claims add up to the reward amount
+type AttestRewardClaim struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// type of entity (ern, release, or resource)
-	EntityType string `protobuf:"bytes,1,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
-	// reference from the ERN (e.g., "R1" for release or "A1" for resource)
-	EntityReference string `protobuf:"bytes,2,opt,name=entity_reference,json=entityReference,proto3" json:"entity_reference,omitempty"`
-	// signed content URLs to stream the content
-	Urls []string `protobuf:"bytes,3,rep,name=urls,proto3" json:"urls,omitempty"`
-	// parent ERN address this entity belongs to
-	ErnAddress string `protobuf:"bytes,4,opt,name=ern_address,json=ernAddress,proto3" json:"ern_address,omitempty"`
+	RewardAddress       string `protobuf:"bytes,1,opt,name=reward_address,json=rewardAddress,proto3" json:"reward_address,omitempty"` // The deployed reward address, empty for rewards from node config
+	RewardId            string `protobuf:"bytes,2,opt,name=reward_id,json=rewardId,proto3" json:"reward_id,omitempty"`
+	Specifier           string `protobuf:"bytes,3,opt,name=specifier,proto3" json:"specifier,omitempty"`
+	EthRecipientAddress string `protobuf:"bytes,4,opt,name=eth_recipient_address,json=ethRecipientAddress,proto3" json:"eth_recipient_address,omitempty"`
+	Amount              uint64 `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	DeadlineBlockHeight int64  `protobuf:"varint,6,opt,name=deadline_block_height,json=deadlineBlockHeight,proto3" json:"deadline_block_height,omitempty"`
+	Signature           string `protobuf:"bytes,7,opt,name=signature,proto3" json:"signature,omitempty"` // Signature by the attesting validator over deterministic claim data
 }
 
-func (x *GetStreamURLsResponse_EntityStreamURLs) Reset() {
-	*x = GetStreamURLsResponse_EntityStreamURLs{}
+func (x *AttestRewardClaim) Reset() {
+	*x = AttestRewardClaim{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_core_v1_types_proto_msgTypes[92]
+		mi := &file_core_v1_types_proto_msgTypes[96]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetStreamURLsResponse_EntityStreamURLs) String() string {
+func (x *AttestRewardClaim) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStreamURLsResponse_EntityStreamURLs) ProtoMessage() {}
+func (*AttestRewardClaim) ProtoMessage() {}
 
-func (x *GetStreamURLsResponse_EntityStreamURLs) ProtoReflect() protoreflect.Message {
-	mi := &file_core_v1_types_proto_msgTypes[92]
+func (x *AttestRewardClaim) ProtoReflect() protoreflect.Message {
+	mi := &file_core_v1_types_proto_msgTypes[96]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6079,1174 +6359,3597 @@ func (x *GetStreamURLsResponse_EntityStreamURLs) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStreamURLsResponse_EntityStreamURLs.ProtoReflect.Descriptor instead.
-func (*GetStreamURLsResponse_EntityStreamURLs) Descriptor() ([]byte, []int) {
-	return file_core_v1_types_proto_rawDescGZIP(), []int{75, 0}
+// Deprecated: Use AttestRewardClaim.ProtoReflect.Descriptor instead.
+func (*AttestRewardClaim) Descriptor() ([]byte, []int) {
+	return file_core_v1_types_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *GetStreamURLsResponse_EntityStreamURLs) GetEntityType() string {
+func (x *AttestRewardClaim) GetRewardAddress() string {
 	if x != nil {
-		return x.EntityType
+		return x.RewardAddress
 	}
 	return ""
 }
 
-func (x *GetStreamURLsResponse_EntityStreamURLs) GetEntityReference() string {
+func (x *AttestRewardClaim) GetRewardId() string {
 	if x != nil {
-		return x.EntityReference
+		return x.RewardId
 	}
 	return ""
 }
 
-func (x *GetStreamURLsResponse_EntityStreamURLs) GetUrls() []string {
+func (x *AttestRewardClaim) GetSpecifier() string {
 	if x != nil {
-		return x.Urls
+		return x.Specifier
 	}
-	return nil
+	return ""
 }
 
-func (x *GetStreamURLsResponse_EntityStreamURLs) GetErnAddress() string {
+func (x *AttestRewardClaim) GetEthRecipientAddress() string {
 	if x != nil {
-		return x.ErnAddress
+		return x.EthRecipientAddress
 	}
 	return ""
 }
 
-var File_core_v1_types_proto protoreflect.FileDescriptor
-
-var file_core_v1_types_proto_rawDesc = []byte{
-	0x0a, 0x13, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x18,
-	0x63, 0x6f, 0x72, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x64, 0x64, 0x65, 0x78, 0x2f, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x64, 0x65, 0x61, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x1a, 0x16, 0x64, 0x64, 0x65, 0x78, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f,
-	0x65, 0x72, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x64, 0x64, 0x65, 0x78, 0x2f,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x6d, 0x65, 0x61, 0x64, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x18, 0x64, 0x64, 0x65, 0x78, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x2f, 0x70, 0x61, 0x72, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x64, 0x64,
-	0x65, 0x78, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x69, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x64, 0x64, 0x65, 0x78, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x1a, 0x1b, 0x64, 0x64, 0x65, 0x78, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x72,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x0d,
-	0x0a, 0x0b, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x28, 0x0a,
-	0x0c, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x12, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x48, 0x65,
-	0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x13, 0x0a, 0x11, 0x47,
-	0x65, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x12, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0xf3, 0x22, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69,
-	0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x72,
-	0x65, 0x61, 0x64, 0x79, 0x12, 0x40, 0x0a, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x6e, 0x66,
-	0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x6e, 0x6f,
-	0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x43, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f,
-	0x69, 0x6e, 0x66, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x72,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x09, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x40, 0x0a, 0x09, 0x73,
-	0x79, 0x6e, 0x63, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23,
-	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x08, 0x73, 0x79, 0x6e, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x49, 0x0a,
-	0x0c, 0x70, 0x72, 0x75, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
-	0x50, 0x72, 0x75, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x70, 0x72, 0x75,
-	0x6e, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x4c, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x27, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x49, 0x0a, 0x0c, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f,
-	0x6c, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63,
-	0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x6e, 0x66,
-	0x6f, 0x12, 0x39, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x23, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x65, 0x65,
-	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x12, 0x4c, 0x0a, 0x0d,
-	0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
-	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x73, 0x6e,
-	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x49, 0x0a, 0x0c, 0x70, 0x72,
-	0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x26, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x72, 0x6f,
-	0x63, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73,
-	0x73, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0xc8, 0x0a, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
-	0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x4b, 0x0a, 0x04, 0x61, 0x62, 0x63, 0x69, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
-	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x63,
-	0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x61, 0x62,
-	0x63, 0x69, 0x12, 0x60, 0x0a, 0x0f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x5f, 0x62,
-	0x72, 0x69, 0x64, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x63, 0x6f,
-	0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49,
-	0x6e, 0x66, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x42, 0x72,
-	0x69, 0x64, 0x67, 0x65, 0x12, 0x58, 0x0a, 0x0b, 0x65, 0x63, 0x68, 0x6f, 0x5f, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x63, 0x6f, 0x72, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66,
-	0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x0a, 0x65, 0x63, 0x68, 0x6f, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x56,
-	0x0a, 0x0a, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x37, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50,
-	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65,
-	0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x73, 0x79, 0x6e,
-	0x63, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x5a, 0x0a, 0x0c, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x6d,
-	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x63,
-	0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
-	0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x70, 0x65, 0x65, 0x72, 0x4d, 0x61, 0x6e, 0x61, 0x67,
-	0x65, 0x72, 0x12, 0x5e, 0x0a, 0x0e, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61,
-	0x6e, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x63, 0x6f, 0x72,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x6e,
-	0x66, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x0d, 0x64, 0x61, 0x74, 0x61, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69,
-	0x6f, 0x6e, 0x12, 0x4d, 0x0a, 0x05, 0x63, 0x61, 0x63, 0x68, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x37, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x72,
-	0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
-	0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x63, 0x61, 0x63, 0x68,
-	0x65, 0x12, 0x52, 0x0a, 0x08, 0x6c, 0x6f, 0x67, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
-	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x63,
-	0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x6c, 0x6f,
-	0x67, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x56, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x73,
-	0x79, 0x6e, 0x63, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x63, 0x6f, 0x72, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66,
-	0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x5c, 0x0a,
-	0x0d, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x50, 0x72, 0x6f,
-	0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x6d,
-	0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x43, 0x61, 0x63, 0x68, 0x65, 0x1a, 0x89, 0x02, 0x0a, 0x10,
-	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f,
-	0x12, 0x49, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x33, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x63,
-	0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
-	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0xb6, 0x01, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x63,
-	0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x19, 0x50, 0x52, 0x4f, 0x43,
-	0x45, 0x53, 0x53, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
-	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x52, 0x4f, 0x43, 0x45,
-	0x53, 0x53, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x49, 0x4e,
-	0x47, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x53,
-	0x54, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x1a,
-	0x0a, 0x16, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f,
-	0x53, 0x4c, 0x45, 0x45, 0x50, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x52,
-	0x4f, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x50,
-	0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x04, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x52, 0x4f, 0x43, 0x45,
-	0x53, 0x53, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x05,
-	0x1a, 0x89, 0x01, 0x0a, 0x08, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a,
-	0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6d,
-	0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f,
-	0x0a, 0x0b, 0x65, 0x74, 0x68, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x74, 0x68, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x1b, 0x0a, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x1a, 0xa1, 0x01, 0x0a,
-	0x09, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
-	0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x63,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2c, 0x0a, 0x12,
-	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61,
-	0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x5f, 0x74, 0x78, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x1a, 0xac, 0x08, 0x0a, 0x08, 0x53, 0x79, 0x6e, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a,
-	0x06, 0x73, 0x79, 0x6e, 0x63, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73,
-	0x79, 0x6e, 0x63, 0x65, 0x64, 0x12, 0x52, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x73,
-	0x79, 0x6e, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x63, 0x6f, 0x72, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x09,
-	0x73, 0x74, 0x61, 0x74, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x52, 0x0a, 0x0a, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e,
-	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x49, 0x6e,
-	0x66, 0x6f, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x79, 0x6e, 0x63, 0x49, 0x6e, 0x66, 0x6f,
-	0x48, 0x00, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x79, 0x6e, 0x63, 0x1a, 0x9f, 0x04,
-	0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x12,
-	0x4d, 0x0a, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x37,
-	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x49,
-	0x6e, 0x66, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x49, 0x6e, 0x66,
-	0x6f, 0x2e, 0x50, 0x68, 0x61, 0x73, 0x65, 0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x12, 0x35,
-	0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73,
-	0x68, 0x6f, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x73, 0x6e, 0x61,
-	0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
-	0x64, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x10, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x43, 0x68, 0x75, 0x6e,
-	0x6b, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x66, 0x65, 0x74, 0x63,
-	0x68, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x63, 0x68, 0x75, 0x6e,
-	0x6b, 0x46, 0x65, 0x74, 0x63, 0x68, 0x65, 0x72, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
-	0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x22, 0xb9, 0x01, 0x0a, 0x05, 0x50, 0x68, 0x61, 0x73, 0x65, 0x12, 0x15, 0x0a,
-	0x11, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
-	0x45, 0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x53, 0x54,
-	0x41, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x48, 0x41, 0x53,
-	0x45, 0x5f, 0x44, 0x4f, 0x57, 0x4e, 0x4c, 0x4f, 0x41, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x48,
-	0x55, 0x4e, 0x4b, 0x53, 0x10, 0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f,
-	0x52, 0x45, 0x43, 0x4f, 0x4e, 0x53, 0x54, 0x52, 0x55, 0x43, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x43,
-	0x48, 0x55, 0x4e, 0x4b, 0x53, 0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x48, 0x41, 0x53, 0x45,
-	0x5f, 0x52, 0x45, 0x53, 0x54, 0x4f, 0x52, 0x49, 0x4e, 0x47, 0x5f, 0x50, 0x47, 0x5f, 0x44, 0x55,
-	0x4d, 0x50, 0x10, 0x04, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x42, 0x4c,
-	0x4f, 0x43, 0x4b, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0x05, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x48,
-	0x41, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x06, 0x1a,
-	0xb0, 0x02, 0x0a, 0x0d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x79, 0x6e, 0x63, 0x49, 0x6e, 0x66,
-	0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x64, 0x69, 0x66, 0x66, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x69, 0x66, 0x66,
-	0x12, 0x44, 0x0a, 0x0b, 0x68, 0x65, 0x61, 0x64, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x68, 0x65, 0x61, 0x64,
-	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x68, 0x65, 0x61, 0x64, 0x5f, 0x68,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x68, 0x65, 0x61,
-	0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x6e, 0x63, 0x5f,
-	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x79,
-	0x6e, 0x63, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72,
-	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64,
-	0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64,
-	0x41, 0x74, 0x42, 0x0b, 0x0a, 0x09, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x1a,
-	0x75, 0x0a, 0x0b, 0x50, 0x72, 0x75, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18,
-	0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x74, 0x61,
-	0x69, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0c, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x27, 0x0a,
-	0x0f, 0x65, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x65, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74,
-	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x1a, 0xd7, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x62, 0x5f, 0x73, 0x69,
-	0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x64, 0x62, 0x53, 0x69, 0x7a, 0x65,
-	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x69, 0x7a, 0x65, 0x12,
-	0x19, 0x0a, 0x08, 0x6d, 0x65, 0x6d, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x70,
-	0x75, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63,
-	0x70, 0x75, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x6d, 0x5f, 0x75,
-	0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x55,
-	0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x75, 0x73, 0x61,
-	0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x55, 0x73,
-	0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x66, 0x72, 0x65, 0x65,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x46, 0x72, 0x65, 0x65,
-	0x1a, 0x83, 0x01, 0x0a, 0x0b, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f,
-	0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x07, 0x74, 0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x74,
-	0x78, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x74, 0x78,
-	0x53, 0x69, 0x7a, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x78, 0x5f, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x54,
-	0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x78,
-	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6d, 0x61, 0x78,
-	0x54, 0x78, 0x53, 0x69, 0x7a, 0x65, 0x1a, 0x61, 0x0a, 0x0c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
-	0x6f, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
-	0x12, 0x37, 0x0a, 0x09, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6e,
-	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x09,
-	0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x1a, 0xce, 0x03, 0x0a, 0x08, 0x50, 0x65,
-	0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3e, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52,
-	0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x1a, 0x81, 0x03, 0x0a, 0x04, 0x50, 0x65, 0x65, 0x72, 0x12,
-	0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63,
-	0x6f, 0x6d, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x74, 0x68, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x74, 0x68, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2b,
-	0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x72, 0x70, 0x63, 0x5f, 0x63, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
-	0x63, 0x74, 0x72, 0x70, 0x63, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x63,
-	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x72, 0x70, 0x63, 0x5f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
-	0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x72, 0x70, 0x63, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f,
-	0x6d, 0x65, 0x74, 0x72, 0x70, 0x63, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x70, 0x63, 0x43, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x70, 0x63, 0x5f,
-	0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x63,
-	0x6f, 0x6d, 0x65, 0x74, 0x72, 0x70, 0x63, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x12, 0x23,
-	0x0a, 0x0d, 0x70, 0x32, 0x70, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x70, 0x32, 0x70, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
-	0x74, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x32, 0x70, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x69, 0x62, 0x6c, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x70, 0x32, 0x70,
-	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x47, 0x65,
-	0x74, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x22, 0xb4, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6e, 0x63, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x06, 0x73, 0x79, 0x6e, 0x63, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f,
-	0x6d, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x1f, 0x0a, 0x0b, 0x65, 0x74, 0x68, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x74, 0x68, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x12, 0x25, 0x0a, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x29, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65,
-	0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x22, 0x5f, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x25, 0x0a, 0x0e,
-	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x48, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x22, 0x2a, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22,
-	0xc5, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
-	0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x63,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x1a, 0x49, 0x0a, 0x0b,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x24, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63,
-	0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x30, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x22, 0x50, 0x0a, 0x16, 0x47, 0x65, 0x74,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x97, 0x01, 0x0a, 0x16,
-	0x53, 0x65, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f,
-	0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x76, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f,
-	0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x76, 0x32, 0x22, 0xa4, 0x01, 0x0a, 0x17, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x36, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x51, 0x0a, 0x13, 0x74, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x12, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x22, 0x9a, 0x01, 0x0a,
-	0x19, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x0b, 0x74, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x76, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x19, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x76, 0x32, 0x22, 0x1c, 0x0a, 0x1a, 0x46, 0x6f, 0x72,
-	0x77, 0x61, 0x72, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x67, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x52, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x42, 0x0a, 0x0c,
-	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x0c, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x22, 0x86, 0x01, 0x0a, 0x22, 0x47, 0x65, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e,
-	0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x42, 0x0a, 0x0c, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6f,
-	0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x72, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x6f, 0x0a, 0x23, 0x47, 0x65, 0x74,
-	0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x74,
-	0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x48, 0x0a, 0x0e, 0x64, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x44, 0x65, 0x72, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x64, 0x65, 0x72, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x8e, 0x01, 0x0a, 0x24, 0x47,
-	0x65, 0x74, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
-	0x65, 0x12, 0x48, 0x0a, 0x0e, 0x64, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6f, 0x72, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x44, 0x65, 0x72,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x64, 0x65, 0x72,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xde, 0x01, 0x0a, 0x05,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73,
-	0x68, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
-	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x12, 0x38, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xac, 0x02, 0x0a,
-	0x0b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04,
-	0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68,
-	0x12, 0x3c, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19,
-	0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68,
-	0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x3f, 0x0a, 0x0d, 0x74, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x76, 0x32, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x74, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x76, 0x32, 0x22, 0xdb, 0x06, 0x0a, 0x11,
-	0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12,
-	0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x2c,
-	0x0a, 0x05, 0x70, 0x6c, 0x61, 0x79, 0x73, 0x18, 0xe8, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
-	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x50, 0x6c,
-	0x61, 0x79, 0x73, 0x48, 0x00, 0x52, 0x05, 0x70, 0x6c, 0x61, 0x79, 0x73, 0x12, 0x5e, 0x0a, 0x16,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0xe9, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
-	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
-	0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x67,
-	0x61, 0x63, 0x79, 0x48, 0x00, 0x52, 0x15, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x0a,
-	0x73, 0x6c, 0x61, 0x5f, 0x72, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x18, 0xea, 0x07, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6c, 0x61, 0x52,
-	0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x48, 0x00, 0x52, 0x09, 0x73, 0x6c, 0x61, 0x52, 0x6f, 0x6c, 0x6c,
-	0x75, 0x70, 0x12, 0x43, 0x0a, 0x0d, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x5f, 0x65, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x18, 0xeb, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x72,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x48, 0x00, 0x52, 0x0c, 0x6d, 0x61, 0x6e, 0x61, 0x67,
-	0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x69, 0x0a, 0x18, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x5f, 0x64, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0xec, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x63, 0x6f, 0x72,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x4d, 0x69,
-	0x73, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73,
-	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x0d, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x72,
-	0x6f, 0x6f, 0x66, 0x18, 0xed, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x72,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x6f,
-	0x66, 0x48, 0x00, 0x52, 0x0c, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x6f,
-	0x66, 0x12, 0x62, 0x0a, 0x1a, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x72, 0x6f,
-	0x6f, 0x66, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0xee, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x56, 0x65, 0x72,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x18, 0x73, 0x74, 0x6f,
-	0x72, 0x61, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0xef, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x6f,
-	0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x48, 0x00, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x3c, 0x0a, 0x07, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x18, 0xf0, 0x07, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2e, 0x4e, 0x65, 0x77, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x07, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x31,
-	0x0a, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0xf1, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x16, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72,
-	0x64, 0x12, 0x37, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64,
-	0x18, 0xf2, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x48, 0x00, 0x52, 0x0a,
-	0x66, 0x69, 0x6c, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x74, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x36, 0x0a, 0x0a, 0x54, 0x72, 0x61,
-	0x63, 0x6b, 0x50, 0x6c, 0x61, 0x79, 0x73, 0x12, 0x28, 0x0a, 0x05, 0x70, 0x6c, 0x61, 0x79, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x50, 0x6c, 0x61, 0x79, 0x52, 0x05, 0x70, 0x6c, 0x61, 0x79,
-	0x73, 0x22, 0xdc, 0x01, 0x0a, 0x1b, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x67, 0x61, 0x63,
-	0x79, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x23, 0x0a,
-	0x0d, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x74, 0x68, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x74, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
-	0x1b, 0x0a, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x13, 0x0a, 0x05,
-	0x73, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x70, 0x49,
-	0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6f,
-	0x77, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72,
-	0x22, 0xdd, 0x01, 0x0a, 0x09, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x50, 0x6c, 0x61, 0x79, 0x12, 0x17,
-	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x63, 0x6b,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x72, 0x61, 0x63, 0x6b,
-	0x49, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1c, 0x0a, 0x09,
-	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69,
-	0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x12, 0x16,
-	0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72,
-	0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79,
-	0x22, 0xb5, 0x01, 0x0a, 0x09, 0x53, 0x6c, 0x61, 0x52, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x12, 0x38,
-	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x45, 0x6e, 0x64, 0x12, 0x30, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
-	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x53, 0x6c, 0x61, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52,
-	0x07, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x22, 0x59, 0x0a, 0x0d, 0x53, 0x6c, 0x61, 0x4e,
-	0x6f, 0x64, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x6e, 0x75, 0x6d, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x73, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x11, 0x6e, 0x75, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x50, 0x72, 0x6f, 0x70, 0x6f,
-	0x73, 0x65, 0x64, 0x22, 0xeb, 0x01, 0x0a, 0x12, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x45, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
-	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49,
-	0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
-	0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
-	0x75, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6e,
-	0x6f, 0x6e, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63,
-	0x65, 0x22, 0x62, 0x0a, 0x22, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x4d, 0x69,
-	0x73, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73,
-	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x65, 0x74,
-	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
-	0x63, 0x6f, 0x6d, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x17, 0x0a, 0x07,
-	0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70,
-	0x75, 0x62, 0x4b, 0x65, 0x79, 0x22, 0xa6, 0x01, 0x0a, 0x0c, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
-	0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x18,
-	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x76,
-	0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x65, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x73,
-	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e,
-	0x70, 0x72, 0x6f, 0x6f, 0x66, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x48,
-	0x0a, 0x18, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x56, 0x65,
-	0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65,
-	0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0xef, 0x01, 0x0a, 0x0b, 0x41, 0x74, 0x74,
-	0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e,
-	0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x69,
-	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x58, 0x0a, 0x16, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0xe8, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6f, 0x72, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x15, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x5e, 0x0a, 0x18, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f,
-	0x64, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0xe9,
-	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73,
-	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x42, 0x06, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x9b, 0x02, 0x0a, 0x15, 0x56,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65,
-	0x5f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x64,
-	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x1a, 0x0a,
-	0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x6f, 0x64,
-	0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x6f,
-	0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x13, 0x0a, 0x05, 0x73, 0x70, 0x5f, 0x69, 0x64, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x70, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x65,
-	0x74, 0x68, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
-	0x65, 0x74, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x65,
-	0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x17, 0x0a,
-	0x07, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06,
-	0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08,
-	0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
-	0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x73, 0x0a, 0x17, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x65,
-	0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x75, 0x62, 0x5f,
-	0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65,
-	0x79, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x1b, 0x0a,
-	0x19, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
-	0x6f, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x55, 0x0a, 0x1a, 0x47, 0x65,
-	0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x09, 0x73, 0x6e, 0x61, 0x70,
-	0x73, 0x68, 0x6f, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f,
-	0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x09, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
-	0x73, 0x22, 0x7a, 0x0a, 0x10, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73,
-	0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x22, 0x3e, 0x0a,
-	0x0e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12,
-	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x97, 0x01,
-	0x0a, 0x06, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x77,
-	0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x44, 0x0a, 0x11, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f,
-	0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63,
-	0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x41, 0x75, 0x74, 0x68,
-	0x6f, 0x72, 0x69, 0x74, 0x79, 0x52, 0x10, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x41, 0x75, 0x74, 0x68,
-	0x6f, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3c, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x52, 0x65,
-	0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f,
-	0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x41, 0x75, 0x74, 0x68,
-	0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x4a, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x72,
-	0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63,
-	0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
-	0x73, 0x22, 0x92, 0x02, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x41,
-	0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x32, 0x0a, 0x15, 0x65, 0x74, 0x68, 0x5f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65,
-	0x6e, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x13, 0x65, 0x74, 0x68, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72,
-	0x65, 0x77, 0x61, 0x72, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09,
-	0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6c,
-	0x61, 0x69, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x69, 0x74, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
-	0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x77,
-	0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65,
-	0x77, 0x61, 0x72, 0x64, 0x49, 0x64, 0x22, 0x56, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x52, 0x65, 0x77,
-	0x61, 0x72, 0x64, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b,
-	0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xc7,
-	0x01, 0x0a, 0x13, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x65, 0x6e, 0x64,
-	0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x53, 0x4c, 0x41, 0x73, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x53, 0x4c, 0x41, 0x73,
-	0x12, 0x16, 0x0a, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x4e, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x53,
-	0x6c, 0x61, 0x73, 0x68, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53,
-	0x6c, 0x61, 0x73, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x57, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x53,
-	0x6c, 0x61, 0x73, 0x68, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e,
-	0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x22, 0x5c, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x41, 0x74, 0x74,
-	0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x3d, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53,
-	0x6c, 0x61, 0x73, 0x68, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
-	0x68, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x41, 0x74, 0x74, 0x65, 0x73,
-	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x48, 0x0a, 0x0c, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0c, 0x61, 0x74, 0x74,
-	0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x29, 0x0a, 0x0d, 0x47, 0x65, 0x74,
-	0x45, 0x52, 0x4e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x22, 0x43, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x45, 0x52, 0x4e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x03, 0x65, 0x72, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2e, 0x4e, 0x65, 0x77, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x52, 0x03, 0x65, 0x72, 0x6e, 0x22, 0x2b, 0x0a, 0x0f, 0x47, 0x65, 0x74,
-	0x50, 0x61, 0x72, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x3d, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x61, 0x72,
-	0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x70, 0x61,
-	0x72, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x64, 0x65, 0x78,
-	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x52, 0x05,
-	0x70, 0x61, 0x72, 0x74, 0x79, 0x22, 0x2e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x49, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x08,
-	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
-	0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x52, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x22, 0x2d, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22,
-	0x45, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x07, 0x72,
-	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x22, 0x2a, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x61,
-	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x22, 0x39, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x44, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x04, 0x64, 0x65, 0x61, 0x6c, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2e, 0x44, 0x65, 0x61, 0x6c, 0x52, 0x04, 0x64, 0x65, 0x61, 0x6c, 0x22, 0x2a, 0x0a,
-	0x0e, 0x47, 0x65, 0x74, 0x4d, 0x45, 0x41, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x40, 0x0a, 0x0f, 0x47, 0x65, 0x74,
-	0x4d, 0x45, 0x41, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x04,
-	0x6d, 0x65, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x64, 0x65,
-	0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x61, 0x64, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x04, 0x6d, 0x65, 0x61, 0x64, 0x22, 0x29, 0x0a, 0x0d, 0x47,
-	0x65, 0x74, 0x50, 0x49, 0x45, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x3c, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x49, 0x45,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x03, 0x70, 0x69, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x69, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52,
-	0x03, 0x70, 0x69, 0x65, 0x22, 0x7d, 0x0a, 0x0d, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x18,
-	0xe8, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x48, 0x00, 0x52,
-	0x06, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x64, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x18, 0xe9, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x48,
-	0x00, 0x52, 0x06, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x22, 0xef, 0x01, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x77, 0x61, 0x72, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x49,
-	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x44, 0x0a,
-	0x11, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x69,
-	0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74,
-	0x79, 0x52, 0x10, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74,
-	0x69, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x5f,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x13, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e,
-	0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x7a, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
-	0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x32, 0x0a, 0x15, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13,
-	0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
-	0x65, 0x22, 0x44, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x16, 0x0a, 0x06, 0x74, 0x78, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x74, 0x78, 0x68, 0x61, 0x73, 0x68, 0x22, 0xde, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x52,
-	0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x77, 0x61, 0x72,
-	0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x63, 0x6c, 0x61,
-	0x69, 0x6d, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x16, 0x0a,
-	0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
-	0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xf3, 0x01, 0x0a, 0x1a, 0x52, 0x65, 0x77,
-	0x61, 0x72, 0x64, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69,
-	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x65, 0x74, 0x68, 0x5f, 0x72,
-	0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x65, 0x74, 0x68, 0x52, 0x65, 0x63, 0x69, 0x70,
-	0x69, 0x65, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x77,
-	0x61, 0x72, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65,
-	0x77, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72,
-	0x65, 0x77, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x70, 0x65, 0x63, 0x69,
-	0x66, 0x69, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x70, 0x65, 0x63,
-	0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x61,
-	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
-	0x63, 0x6c, 0x61, 0x69, 0x6d, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x23,
-	0x0a, 0x0f, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
-	0x65, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x63, 0x69, 0x64, 0x22, 0x96, 0x02, 0x0a, 0x0a, 0x46, 0x69, 0x6c, 0x65, 0x55, 0x70, 0x6c, 0x6f,
-	0x61, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x75, 0x70,
-	0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x29, 0x0a,
-	0x10, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53,
-	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x70,
-	0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75,
-	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73,
-	0x63, 0x6f, 0x64, 0x65, 0x64, 0x5f, 0x63, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x43, 0x69, 0x64, 0x12, 0x2b,
-	0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
-	0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x71, 0x0a, 0x16,
-	0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x55, 0x52, 0x4c, 0x73, 0x53, 0x69, 0x67,
-	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f,
-	0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22,
-	0x8d, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x55, 0x52, 0x4c,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e,
-	0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67,
-	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f,
-	0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22,
-	0x87, 0x03, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x55, 0x52, 0x4c,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x12, 0x65, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x75, 0x72, 0x6c, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x55, 0x72, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x10, 0x65, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x55, 0x72, 0x6c, 0x73, 0x1a, 0x93, 0x01,
-	0x0a, 0x10, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x55, 0x52,
-	0x4c, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x72, 0x65,
-	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x65,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x75, 0x72, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x75, 0x72,
-	0x6c, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x72, 0x6e, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x72, 0x6e, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x1a, 0x74, 0x0a, 0x15, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x55, 0x72, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x45,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e,
-	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x29, 0x0a, 0x15, 0x47, 0x65, 0x74,
-	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x79, 0x43, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x63, 0x69, 0x64, 0x22, 0xa5, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x55, 0x70, 0x6c, 0x6f,
-	0x61, 0x64, 0x42, 0x79, 0x43, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x16, 0x0a, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x75, 0x70, 0x6c, 0x6f, 0x61,
-	0x64, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0f, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x63,
-	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
-	0x61, 0x6c, 0x43, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x6f,
-	0x64, 0x65, 0x64, 0x5f, 0x63, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74,
-	0x72, 0x61, 0x6e, 0x73, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x43, 0x69, 0x64, 0x42, 0x32, 0x5a, 0x30,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x41, 0x75, 0x64, 0x69, 0x75,
-	0x73, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f, 0x61, 0x75, 0x64, 0x69, 0x75, 0x73, 0x64,
-	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x76, 0x31,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *AttestRewardClaim) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
 }
 
-var (
-	file_core_v1_types_proto_rawDescOnce sync.Once
-	file_core_v1_types_proto_rawDescData = file_core_v1_types_proto_rawDesc
-)
+func (x *AttestRewardClaim) GetDeadlineBlockHeight() int64 {
+	if x != nil {
+		return x.DeadlineBlockHeight
+	}
+	return 0
+}
 
-func file_core_v1_types_proto_rawDescGZIP() []byte {
-	file_core_v1_types_proto_rawDescOnce.Do(func() {
-		file_core_v1_types_proto_rawDescData = protoimpl.X.CompressGZIP(file_core_v1_types_proto_rawDescData)
-	})
-	return file_core_v1_types_proto_rawDescData
+func (x *AttestRewardClaim) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
 }
 
-var file_core_v1_types_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_core_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 94)
-var file_core_v1_types_proto_goTypes = []interface{}{
-	(GetStatusResponse_ProcessInfo_ProcessState)(0),     // 0: core.v1.GetStatusResponse.ProcessInfo.ProcessState
-	(GetStatusResponse_SyncInfo_StateSyncInfo_Phase)(0), // 1: core.v1.GetStatusResponse.SyncInfo.StateSyncInfo.Phase
-	(*PingRequest)(nil),                                    // 2: core.v1.PingRequest
-	(*PingResponse)(nil),                                   // 3: core.v1.PingResponse
-	(*GetHealthRequest)(nil),                               // 4: core.v1.GetHealthRequest
-	(*GetHealthResponse)(nil),                              // 5: core.v1.GetHealthResponse
-	(*GetStatusRequest)(nil),                               // 6: core.v1.GetStatusRequest
-	(*GetStatusResponse)(nil),                              // 7: core.v1.GetStatusResponse
-	(*GetNodeInfoRequest)(nil),                             // 8: core.v1.GetNodeInfoRequest
-	(*GetNodeInfoResponse)(nil),                            // 9: core.v1.GetNodeInfoResponse
-	(*GetBlockRequest)(nil),                                // 10: core.v1.GetBlockRequest
-	(*GetBlockResponse)(nil),                               // 11: core.v1.GetBlockResponse
-	(*GetBlocksRequest)(nil),                               // 12: core.v1.GetBlocksRequest
-	(*GetBlocksResponse)(nil),                              // 13: core.v1.GetBlocksResponse
-	(*GetTransactionRequest)(nil),                          // 14: core.v1.GetTransactionRequest
-	(*GetTransactionResponse)(nil),                         // 15: core.v1.GetTransactionResponse
-	(*SendTransactionRequest)(nil),                         // 16: core.v1.SendTransactionRequest
-	(*SendTransactionResponse)(nil),                        // 17: core.v1.SendTransactionResponse
-	(*ForwardTransactionRequest)(nil),                      // 18: core.v1.ForwardTransactionRequest
-	(*ForwardTransactionResponse)(nil),                     // 19: core.v1.ForwardTransactionResponse
-	(*GetRegistrationAttestationRequest)(nil),              // 20: core.v1.GetRegistrationAttestationRequest
-	(*GetRegistrationAttestationResponse)(nil),             // 21: core.v1.GetRegistrationAttestationResponse
-	(*GetDeregistrationAttestationRequest)(nil),            // 22: core.v1.GetDeregistrationAttestationRequest
-	(*GetDeregistrationAttestationResponse)(nil),           // 23: core.v1.GetDeregistrationAttestationResponse
-	(*Block)(nil),                                          // 24: core.v1.Block
-	(*Transaction)(nil),                                    // 25: core.v1.Transaction
-	(*SignedTransaction)(nil),                              // 26: core.v1.SignedTransaction
-	(*TrackPlays)(nil),                                     // 27: core.v1.TrackPlays
-	(*ValidatorRegistrationLegacy)(nil),                    // 28: core.v1.ValidatorRegistrationLegacy
-	(*TrackPlay)(nil),                                      // 29: core.v1.TrackPlay
-	(*SlaRollup)(nil),                                      // 30: core.v1.SlaRollup
-	(*SlaNodeReport)(nil),                                  // 31: core.v1.SlaNodeReport
-	(*ManageEntityLegacy)(nil),                             // 32: core.v1.ManageEntityLegacy
-	(*ValidatorMisbehaviorDeregistration)(nil),             // 33: core.v1.ValidatorMisbehaviorDeregistration
-	(*StorageProof)(nil),                                   // 34: core.v1.StorageProof
-	(*StorageProofVerification)(nil),                       // 35: core.v1.StorageProofVerification
-	(*Attestation)(nil),                                    // 36: core.v1.Attestation
-	(*ValidatorRegistration)(nil),                          // 37: core.v1.ValidatorRegistration
-	(*ValidatorDeregistration)(nil),                        // 38: core.v1.ValidatorDeregistration
-	(*GetStoredSnapshotsRequest)(nil),                      // 39: core.v1.GetStoredSnapshotsRequest
-	(*GetStoredSnapshotsResponse)(nil),                     // 40: core.v1.GetStoredSnapshotsResponse
-	(*SnapshotMetadata)(nil),                               // 41: core.v1.SnapshotMetadata
-	(*ClaimAuthority)(nil),                                 // 42: core.v1.ClaimAuthority
-	(*Reward)(nil),                                         // 43: core.v1.Reward
-	(*GetRewardsRequest)(nil),                              // 44: core.v1.GetRewardsRequest
-	(*GetRewardsResponse)(nil),                             // 45: core.v1.GetRewardsResponse
-	(*GetRewardAttestationRequest)(nil),                    // 46: core.v1.GetRewardAttestationRequest
-	(*GetRewardAttestationResponse)(nil),                   // 47: core.v1.GetRewardAttestationResponse
-	(*SlashRecommendation)(nil),                            // 48: core.v1.SlashRecommendation
-	(*GetSlashAttestationRequest)(nil),                     // 49: core.v1.GetSlashAttestationRequest
-	(*GetSlashAttestationResponse)(nil),                    // 50: core.v1.GetSlashAttestationResponse
-	(*GetSlashAttestationsRequest)(nil),                    // 51: core.v1.GetSlashAttestationsRequest
-	(*GetSlashAttestationsResponse)(nil),                   // 52: core.v1.GetSlashAttestationsResponse
-	(*GetERNRequest)(nil),                                  // 53: core.v1.GetERNRequest
-	(*GetERNResponse)(nil),                                 // 54: core.v1.GetERNResponse
-	(*GetPartyRequest)(nil),                                // 55: core.v1.GetPartyRequest
-	(*GetPartyResponse)(nil),                               // 56: core.v1.GetPartyResponse
-	(*GetResourceRequest)(nil),                             // 57: core.v1.GetResourceRequest
-	(*GetResourceResponse)(nil),                            // 58: core.v1.GetResourceResponse
-	(*GetReleaseRequest)(nil),                              // 59: core.v1.GetReleaseRequest
-	(*GetReleaseResponse)(nil),                             // 60: core.v1.GetReleaseResponse
-	(*GetDealRequest)(nil),                                 // 61: core.v1.GetDealRequest
-	(*GetDealResponse)(nil),                                // 62: core.v1.GetDealResponse
-	(*GetMEADRequest)(nil),                                 // 63: core.v1.GetMEADRequest
-	(*GetMEADResponse)(nil),                                // 64: core.v1.GetMEADResponse
-	(*GetPIERequest)(nil),                                  // 65: core.v1.GetPIERequest
-	(*GetPIEResponse)(nil),                                 // 66: core.v1.GetPIEResponse
-	(*RewardMessage)(nil),                                  // 67: core.v1.RewardMessage
-	(*CreateReward)(nil),                                   // 68: core.v1.CreateReward
-	(*DeleteReward)(nil),                                   // 69: core.v1.DeleteReward
-	(*GetRewardRequest)(nil),                               // 70: core.v1.GetRewardRequest
-	(*GetRewardResponse)(nil),                              // 71: core.v1.GetRewardResponse
-	(*RewardAttestationSignature)(nil),                     // 72: core.v1.RewardAttestationSignature
-	(*UploadSignature)(nil),                                // 73: core.v1.UploadSignature
-	(*FileUpload)(nil),                                     // 74: core.v1.FileUpload
-	(*GetStreamURLsSignature)(nil),                         // 75: core.v1.GetStreamURLsSignature
-	(*GetStreamURLsRequest)(nil),                           // 76: core.v1.GetStreamURLsRequest
-	(*GetStreamURLsResponse)(nil),                          // 77: core.v1.GetStreamURLsResponse
-	(*GetUploadByCIDRequest)(nil),                          // 78: core.v1.GetUploadByCIDRequest
-	(*GetUploadByCIDResponse)(nil),                         // 79: core.v1.GetUploadByCIDResponse
-	(*GetStatusResponse_ProcessInfo)(nil),                  // 80: core.v1.GetStatusResponse.ProcessInfo
-	(*GetStatusResponse_NodeInfo)(nil),                     // 81: core.v1.GetStatusResponse.NodeInfo
-	(*GetStatusResponse_ChainInfo)(nil),                    // 82: core.v1.GetStatusResponse.ChainInfo
-	(*GetStatusResponse_SyncInfo)(nil),                     // 83: core.v1.GetStatusResponse.SyncInfo
-	(*GetStatusResponse_PruningInfo)(nil),                  // 84: core.v1.GetStatusResponse.PruningInfo
-	(*GetStatusResponse_ResourceInfo)(nil),                 // 85: core.v1.GetStatusResponse.ResourceInfo
-	(*GetStatusResponse_MempoolInfo)(nil),                  // 86: core.v1.GetStatusResponse.MempoolInfo
-	(*GetStatusResponse_SnapshotInfo)(nil),                 // 87: core.v1.GetStatusResponse.SnapshotInfo
-	(*GetStatusResponse_PeerInfo)(nil),                     // 88: core.v1.GetStatusResponse.PeerInfo
-	(*GetStatusResponse_ProcessInfo_ProcessStateInfo)(nil), // 89: core.v1.GetStatusResponse.ProcessInfo.ProcessStateInfo
-	(*GetStatusResponse_SyncInfo_StateSyncInfo)(nil),       // 90: core.v1.GetStatusResponse.SyncInfo.StateSyncInfo
-	(*GetStatusResponse_SyncInfo_BlockSyncInfo)(nil),       // 91: core.v1.GetStatusResponse.SyncInfo.BlockSyncInfo
-	(*GetStatusResponse_PeerInfo_Peer)(nil),                // 92: core.v1.GetStatusResponse.PeerInfo.Peer
-	nil,                                                    // 93: core.v1.GetBlocksResponse.BlocksEntry
-	(*GetStreamURLsResponse_EntityStreamURLs)(nil),         // 94: core.v1.GetStreamURLsResponse.EntityStreamURLs
-	nil,                                // 95: core.v1.GetStreamURLsResponse.EntityStreamUrlsEntry
-	(*v1beta1.Transaction)(nil),        // 96: core.v1beta1.Transaction
-	(*v1beta1.TransactionReceipt)(nil), // 97: core.v1beta1.TransactionReceipt
-	(*timestamppb.Timestamp)(nil),      // 98: google.protobuf.Timestamp
-	(*v1beta11.NewReleaseMessage)(nil), // 99: ddex.v1beta1.NewReleaseMessage
-	(*v1beta11.Party)(nil),             // 100: ddex.v1beta1.Party
-	(*v1beta11.Resource)(nil),          // 101: ddex.v1beta1.Resource
-	(*v1beta11.Release)(nil),           // 102: ddex.v1beta1.Release
-	(*v1beta11.Deal)(nil),              // 103: ddex.v1beta1.Deal
-	(*v1beta11.MeadMessage)(nil),       // 104: ddex.v1beta1.MeadMessage
-	(*v1beta11.PieMessage)(nil),        // 105: ddex.v1beta1.PieMessage
+type GetRewardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"` // The deployed reward address
+	Txhash  string `protobuf:"bytes,2,opt,name=txhash,proto3" json:"txhash,omitempty"`
 }
-var file_core_v1_types_proto_depIdxs = []int32{
-	81,  // 0: core.v1.GetStatusResponse.node_info:type_name -> core.v1.GetStatusResponse.NodeInfo
-	82,  // 1: core.v1.GetStatusResponse.chain_info:type_name -> core.v1.GetStatusResponse.ChainInfo
-	83,  // 2: core.v1.GetStatusResponse.sync_info:type_name -> core.v1.GetStatusResponse.SyncInfo
-	84,  // 3: core.v1.GetStatusResponse.pruning_info:type_name -> core.v1.GetStatusResponse.PruningInfo
-	85,  // 4: core.v1.GetStatusResponse.resource_info:type_name -> core.v1.GetStatusResponse.ResourceInfo
-	86,  // 5: core.v1.GetStatusResponse.mempool_info:type_name -> core.v1.GetStatusResponse.MempoolInfo
-	88,  // 6: core.v1.GetStatusResponse.peers:type_name -> core.v1.GetStatusResponse.PeerInfo
-	87,  // 7: core.v1.GetStatusResponse.snapshot_info:type_name -> core.v1.GetStatusResponse.SnapshotInfo
-	80,  // 8: core.v1.GetStatusResponse.process_info:type_name -> core.v1.GetStatusResponse.ProcessInfo
-	24,  // 9: core.v1.GetBlockResponse.block:type_name -> core.v1.Block
-	93,  // 10: core.v1.GetBlocksResponse.blocks:type_name -> core.v1.GetBlocksResponse.BlocksEntry
-	25,  // 11: core.v1.GetTransactionResponse.transaction:type_name -> core.v1.Transaction
-	26,  // 12: core.v1.SendTransactionRequest.transaction:type_name -> core.v1.SignedTransaction
-	96,  // 13: core.v1.SendTransactionRequest.transactionv2:type_name -> core.v1beta1.Transaction
-	25,  // 14: core.v1.SendTransactionResponse.transaction:type_name -> core.v1.Transaction
-	97,  // 15: core.v1.SendTransactionResponse.transaction_receipt:type_name -> core.v1beta1.TransactionReceipt
-	26,  // 16: core.v1.ForwardTransactionRequest.transaction:type_name -> core.v1.SignedTransaction
-	96,  // 17: core.v1.ForwardTransactionRequest.transactionv2:type_name -> core.v1beta1.Transaction
-	37,  // 18: core.v1.GetRegistrationAttestationRequest.registration:type_name -> core.v1.ValidatorRegistration
-	37,  // 19: core.v1.GetRegistrationAttestationResponse.registration:type_name -> core.v1.ValidatorRegistration
-	38,  // 20: core.v1.GetDeregistrationAttestationRequest.deregistration:type_name -> core.v1.ValidatorDeregistration
-	38,  // 21: core.v1.GetDeregistrationAttestationResponse.deregistration:type_name -> core.v1.ValidatorDeregistration
-	98,  // 22: core.v1.Block.timestamp:type_name -> google.protobuf.Timestamp
-	25,  // 23: core.v1.Block.transactions:type_name -> core.v1.Transaction
-	26,  // 24: core.v1.Transaction.transaction:type_name -> core.v1.SignedTransaction
-	98,  // 25: core.v1.Transaction.timestamp:type_name -> google.protobuf.Timestamp
-	96,  // 26: core.v1.Transaction.transactionv2:type_name -> core.v1beta1.Transaction
-	27,  // 27: core.v1.SignedTransaction.plays:type_name -> core.v1.TrackPlays
-	28,  // 28: core.v1.SignedTransaction.validator_registration:type_name -> core.v1.ValidatorRegistrationLegacy
-	30,  // 29: core.v1.SignedTransaction.sla_rollup:type_name -> core.v1.SlaRollup
-	32,  // 30: core.v1.SignedTransaction.manage_entity:type_name -> core.v1.ManageEntityLegacy
-	33,  // 31: core.v1.SignedTransaction.validator_deregistration:type_name -> core.v1.ValidatorMisbehaviorDeregistration
-	34,  // 32: core.v1.SignedTransaction.storage_proof:type_name -> core.v1.StorageProof
-	35,  // 33: core.v1.SignedTransaction.storage_proof_verification:type_name -> core.v1.StorageProofVerification
-	36,  // 34: core.v1.SignedTransaction.attestation:type_name -> core.v1.Attestation
-	99,  // 35: core.v1.SignedTransaction.release:type_name -> ddex.v1beta1.NewReleaseMessage
-	67,  // 36: core.v1.SignedTransaction.reward:type_name -> core.v1.RewardMessage
-	74,  // 37: core.v1.SignedTransaction.file_upload:type_name -> core.v1.FileUpload
-	29,  // 38: core.v1.TrackPlays.plays:type_name -> core.v1.TrackPlay
-	98,  // 39: core.v1.TrackPlay.timestamp:type_name -> google.protobuf.Timestamp
-	98,  // 40: core.v1.SlaRollup.timestamp:type_name -> google.protobuf.Timestamp
-	31,  // 41: core.v1.SlaRollup.reports:type_name -> core.v1.SlaNodeReport
-	37,  // 42: core.v1.Attestation.validator_registration:type_name -> core.v1.ValidatorRegistration
-	38,  // 43: core.v1.Attestation.validator_deregistration:type_name -> core.v1.ValidatorDeregistration
-	41,  // 44: core.v1.GetStoredSnapshotsResponse.snapshots:type_name -> core.v1.SnapshotMetadata
-	42,  // 45: core.v1.Reward.claim_authorities:type_name -> core.v1.ClaimAuthority
-	71,  // 46: core.v1.GetRewardsResponse.rewards:type_name -> core.v1.GetRewardResponse
-	98,  // 47: core.v1.SlashRecommendation.start:type_name -> google.protobuf.Timestamp
-	98,  // 48: core.v1.SlashRecommendation.end:type_name -> google.protobuf.Timestamp
-	48,  // 49: core.v1.GetSlashAttestationRequest.data:type_name -> core.v1.SlashRecommendation
-	49,  // 50: core.v1.GetSlashAttestationsRequest.request:type_name -> core.v1.GetSlashAttestationRequest
-	50,  // 51: core.v1.GetSlashAttestationsResponse.attestations:type_name -> core.v1.GetSlashAttestationResponse
-	99,  // 52: core.v1.GetERNResponse.ern:type_name -> ddex.v1beta1.NewReleaseMessage
-	100, // 53: core.v1.GetPartyResponse.party:type_name -> ddex.v1beta1.Party
-	101, // 54: core.v1.GetResourceResponse.resource:type_name -> ddex.v1beta1.Resource
-	102, // 55: core.v1.GetReleaseResponse.release:type_name -> ddex.v1beta1.Release
-	103, // 56: core.v1.GetDealResponse.deal:type_name -> ddex.v1beta1.Deal
-	104, // 57: core.v1.GetMEADResponse.mead:type_name -> ddex.v1beta1.MeadMessage
-	105, // 58: core.v1.GetPIEResponse.pie:type_name -> ddex.v1beta1.PieMessage
-	68,  // 59: core.v1.RewardMessage.create:type_name -> core.v1.CreateReward
-	69,  // 60: core.v1.RewardMessage.delete:type_name -> core.v1.DeleteReward
-	42,  // 61: core.v1.CreateReward.claim_authorities:type_name -> core.v1.ClaimAuthority
-	98,  // 62: core.v1.GetStreamURLsSignature.expires_at:type_name -> google.protobuf.Timestamp
-	98,  // 63: core.v1.GetStreamURLsRequest.expires_at:type_name -> google.protobuf.Timestamp
-	95,  // 64: core.v1.GetStreamURLsResponse.entity_stream_urls:type_name -> core.v1.GetStreamURLsResponse.EntityStreamUrlsEntry
-	89,  // 65: core.v1.GetStatusResponse.ProcessInfo.abci:type_name -> core.v1.GetStatusResponse.ProcessInfo.ProcessStateInfo
-	89,  // 66: core.v1.GetStatusResponse.ProcessInfo.registry_bridge:type_name -> core.v1.GetStatusResponse.ProcessInfo.ProcessStateInfo
-	89,  // 67: core.v1.GetStatusResponse.ProcessInfo.echo_server:type_name -> core.v1.GetStatusResponse.ProcessInfo.ProcessStateInfo
-	89,  // 68: core.v1.GetStatusResponse.ProcessInfo.sync_tasks:type_name -> core.v1.GetStatusResponse.ProcessInfo.ProcessStateInfo
-	89,  // 69: core.v1.GetStatusResponse.ProcessInfo.peer_manager:type_name -> core.v1.GetStatusResponse.ProcessInfo.ProcessStateInfo
-	89,  // 70: core.v1.GetStatusResponse.ProcessInfo.data_companion:type_name -> core.v1.GetStatusResponse.ProcessInfo.ProcessStateInfo
-	89,  // 71: core.v1.GetStatusResponse.ProcessInfo.cache:type_name -> core.v1.GetStatusResponse.ProcessInfo.ProcessStateInfo
-	89,  // 72: core.v1.GetStatusResponse.ProcessInfo.log_sync:type_name -> core.v1.GetStatusResponse.ProcessInfo.ProcessStateInfo
-	89,  // 73: core.v1.GetStatusResponse.ProcessInfo.state_sync:type_name -> core.v1.GetStatusResponse.ProcessInfo.ProcessStateInfo
-	89,  // 74: core.v1.GetStatusResponse.ProcessInfo.mempool_cache:type_name -> core.v1.GetStatusResponse.ProcessInfo.ProcessStateInfo
-	90,  // 75: core.v1.GetStatusResponse.SyncInfo.state_sync:type_name -> core.v1.GetStatusResponse.SyncInfo.StateSyncInfo
-	91,  // 76: core.v1.GetStatusResponse.SyncInfo.block_sync:type_name -> core.v1.GetStatusResponse.SyncInfo.BlockSyncInfo
-	41,  // 77: core.v1.GetStatusResponse.SnapshotInfo.snapshots:type_name -> core.v1.SnapshotMetadata
-	92,  // 78: core.v1.GetStatusResponse.PeerInfo.peers:type_name -> core.v1.GetStatusResponse.PeerInfo.Peer
-	0,   // 79: core.v1.GetStatusResponse.ProcessInfo.ProcessStateInfo.state:type_name -> core.v1.GetStatusResponse.ProcessInfo.ProcessState
-	98,  // 80: core.v1.GetStatusResponse.ProcessInfo.ProcessStateInfo.started_at:type_name -> google.protobuf.Timestamp
-	98,  // 81: core.v1.GetStatusResponse.ProcessInfo.ProcessStateInfo.completed_at:type_name -> google.protobuf.Timestamp
-	1,   // 82: core.v1.GetStatusResponse.SyncInfo.StateSyncInfo.phase:type_name -> core.v1.GetStatusResponse.SyncInfo.StateSyncInfo.Phase
-	41,  // 83: core.v1.GetStatusResponse.SyncInfo.StateSyncInfo.snapshot:type_name -> core.v1.SnapshotMetadata
-	98,  // 84: core.v1.GetStatusResponse.SyncInfo.StateSyncInfo.started_at:type_name -> google.protobuf.Timestamp
-	98,  // 85: core.v1.GetStatusResponse.SyncInfo.StateSyncInfo.completed_at:type_name -> google.protobuf.Timestamp
-	81,  // 86: core.v1.GetStatusResponse.SyncInfo.BlockSyncInfo.head_source:type_name -> core.v1.GetStatusResponse.NodeInfo
-	98,  // 87: core.v1.GetStatusResponse.SyncInfo.BlockSyncInfo.started_at:type_name -> google.protobuf.Timestamp
-	98,  // 88: core.v1.GetStatusResponse.SyncInfo.BlockSyncInfo.completed_at:type_name -> google.protobuf.Timestamp
-	24,  // 89: core.v1.GetBlocksResponse.BlocksEntry.value:type_name -> core.v1.Block
-	94,  // 90: core.v1.GetStreamURLsResponse.EntityStreamUrlsEntry.value:type_name -> core.v1.GetStreamURLsResponse.EntityStreamURLs
-	91,  // [91:91] is the sub-list for method output_type
-	91,  // [91:91] is the sub-list for method input_type
-	91,  // [91:91] is the sub-list for extension type_name
-	91,  // [91:91] is the sub-list for extension extendee
-	0,   // [0:91] is the sub-list for field type_name
+
+func (x *GetRewardRequest) Reset() {
+	*x = GetRewardRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_v1_types_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRewardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRewardRequest) ProtoMessage() {}
+
+func (x *GetRewardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_core_v1_types_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRewardRequest.ProtoReflect.Descriptor instead.
+func (*GetRewardRequest) Descriptor() ([]byte, []int) {
+	return file_core_v1_types_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *GetRewardRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *GetRewardRequest) GetTxhash() string {
+	if x != nil {
+		return x.Txhash
+	}
+	return ""
+}
+
+type GetRewardResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address          string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	RewardId         string   `protobuf:"bytes,2,opt,name=reward_id,json=rewardId,proto3" json:"reward_id,omitempty"`
+	Name             string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Amount           uint64   `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	ClaimAuthorities []string `protobuf:"bytes,5,rep,name=claim_authorities,json=claimAuthorities,proto3" json:"claim_authorities,omitempty"`
+	Sender           string   `protobuf:"bytes,6,opt,name=sender,proto3" json:"sender,omitempty"`
+	BlockHeight      int64    `protobuf:"varint,7,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (x *GetRewardResponse) Reset() {
+	*x = GetRewardResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_v1_types_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRewardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRewardResponse) ProtoMessage() {}
+
+func (x *GetRewardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_core_v1_types_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRewardResponse.ProtoReflect.Descriptor instead.
+func (*GetRewardResponse) Descriptor() ([]byte, []int) {
+	return file_core_v1_types_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *GetRewardResponse) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *GetRewardResponse) GetRewardId() string {
+	if x != nil {
+		return x.RewardId
+	}
+	return ""
+}
+
+func (x *GetRewardResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetRewardResponse) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *GetRewardResponse) GetClaimAuthorities() []string {
+	if x != nil {
+		return x.ClaimAuthorities
+	}
+	return nil
+}
+
+func (x *GetRewardResponse) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *GetRewardResponse) GetBlockHeight() int64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+type RewardAttestationSignature struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EthRecipientAddress string `protobuf:"bytes,1,opt,name=eth_recipient_address,json=ethRecipientAddress,proto3" json:"eth_recipient_address,omitempty"`
+	Amount              uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	RewardAddress       string `protobuf:"bytes,3,opt,name=reward_address,json=rewardAddress,proto3" json:"reward_address,omitempty"` // Binds signature to specific deployed reward
+	RewardId            string `protobuf:"bytes,4,opt,name=reward_id,json=rewardId,proto3" json:"reward_id,omitempty"`
+	Specifier           string `protobuf:"bytes,5,opt,name=specifier,proto3" json:"specifier,omitempty"`
+	ClaimAuthority      string `protobuf:"bytes,6,opt,name=claim_authority,json=claimAuthority,proto3" json:"claim_authority,omitempty"`
+}
+
+func (x *RewardAttestationSignature) Reset() {
+	*x = RewardAttestationSignature{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_v1_types_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RewardAttestationSignature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RewardAttestationSignature) ProtoMessage() {}
+
+func (x *RewardAttestationSignature) ProtoReflect() protoreflect.Message {
+	mi := &file_core_v1_types_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RewardAttestationSignature.ProtoReflect.Descriptor instead.
+func (*RewardAttestationSignature) Descriptor() ([]byte, []int) {
+	return file_core_v1_types_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *RewardAttestationSignature) GetEthRecipientAddress() string {
+	if x != nil {
+		return x.EthRecipientAddress
+	}
+	return ""
+}
+
+func (x *RewardAttestationSignature) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RewardAttestationSignature) GetRewardAddress() string {
+	if x != nil {
+		return x.RewardAddress
+	}
+	return ""
+}
+
+func (x *RewardAttestationSignature) GetRewardId() string {
+	if x != nil {
+		return x.RewardId
+	}
+	return ""
+}
+
+func (x *RewardAttestationSignature) GetSpecifier() string {
+	if x != nil {
+		return x.Specifier
+	}
+	return ""
+}
+
+func (x *RewardAttestationSignature) GetClaimAuthority() string {
+	if x != nil {
+		return x.ClaimAuthority
+	}
+	return ""
+}
+
+type UploadSignature struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cid string `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+}
+
+func (x *UploadSignature) Reset() {
+	*x = UploadSignature{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_v1_types_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadSignature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadSignature) ProtoMessage() {}
+
+func (x *UploadSignature) ProtoReflect() protoreflect.Message {
+	mi := &file_core_v1_types_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadSignature.ProtoReflect.Descriptor instead.
+func (*UploadSignature) Descriptor() ([]byte, []int) {
+	return file_core_v1_types_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *UploadSignature) GetCid() string {
+	if x != nil {
+		return x.Cid
+	}
+	return ""
+}
+
+// reported by validators to chain on a file upload that contains a signature
+// this tx is used to tie ERNs to CIDs and confirm ownership
+type FileUpload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// address that was recovered from the signature
+	UploaderAddress string `protobuf:"bytes,1,opt,name=uploader_address,json=uploaderAddress,proto3" json:"uploader_address,omitempty"`
+	// hex stringified signature that was passed to mediorum on upload
+	UploadSignature string `protobuf:"bytes,2,opt,name=upload_signature,json=uploadSignature,proto3" json:"upload_signature,omitempty"`
+	// cid of file that was uploaded, orig_cid in mediorum
+	Cid string `protobuf:"bytes,3,opt,name=cid,proto3" json:"cid,omitempty"`
+	// mediorum specific upload id
+	UploadId string `protobuf:"bytes,4,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	// transcoded cid (e.g., 320kbps version for audio)
+	TranscodedCid string `protobuf:"bytes,5,opt,name=transcoded_cid,json=transcodedCid,proto3" json:"transcoded_cid,omitempty"`
+	// validator address that processed the upload
+	ValidatorAddress string `protobuf:"bytes,6,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// signature from the validator node
+	ValidatorSignature string `protobuf:"bytes,7,opt,name=validator_signature,json=validatorSignature,proto3" json:"validator_signature,omitempty"`
+}
+
+func (x *FileUpload) Reset() {
+	*x = FileUpload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_v1_types_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileUpload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileUpload) ProtoMessage() {}
+
+func (x *FileUpload) ProtoReflect() protoreflect.Message {
+	mi := &file_core_v1_types_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileUpload.ProtoReflect.Descriptor instead.
+func (*FileUpload) Descriptor() ([]byte, []int) {
+	return file_core_v1_types_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *FileUpload) GetUploaderAddress() string {
+	if x != nil {
+		return x.UploaderAddress
+	}
+	return ""
+}
+
+func (x *FileUpload) GetUploadSignature() string {
+	if x != nil {
+		return x.UploadSignature
+	}
+	return ""
+}
+
+func (x *FileUpload) GetCid() string {
+	if x != nil {
+		return x.Cid
+	}
+	return ""
+}
+
+func (x *FileUpload) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+func (x *FileUpload) GetTranscodedCid() string {
+	if x != nil {
+		return x.TranscodedCid
+	}
+	return ""
+}
+
+func (x *FileUpload) GetValidatorAddress() string {
+	if x != nil {
+		return x.ValidatorAddress
+	}
+	return ""
+}
+
+func (x *FileUpload) GetValidatorSignature() string {
+	if x != nil {
+		return x.ValidatorSignature
+	}
+	return ""
+}
+
+type GetStreamURLsSignature struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// addresses to stream (can be ERN, release, or resource addresses)
+	Addresses []string                  `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	ExpiresAt *timestamppb.Timestamp    `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Mode      GetStreamURLsRequest_Mode `protobuf:"varint,3,opt,name=mode,proto3,enum=core.v1.GetStreamURLsRequest_Mode" json:"mode,omitempty"`
+}
+
+func (x *GetStreamURLsSignature) Reset() {
+	*x = GetStreamURLsSignature{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_v1_types_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStreamURLsSignature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStreamURLsSignature) ProtoMessage() {}
+
+func (x *GetStreamURLsSignature) ProtoReflect() protoreflect.Message {
+	mi := &file_core_v1_types_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStreamURLsSignature.ProtoReflect.Descriptor instead.
+func (*GetStreamURLsSignature) Descriptor() ([]byte, []int) {
+	return file_core_v1_types_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *GetStreamURLsSignature) GetAddresses() []string {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+func (x *GetStreamURLsSignature) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *GetStreamURLsSignature) GetMode() GetStreamURLsRequest_Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return GetStreamURLsRequest_MODE_UNSPECIFIED
+}
+
+// requests stream urls from the content node
+// signature signed by owner of ERN, or by the listener in listener mode
+type GetStreamURLsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Signature string `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+	// addresses to stream (can be ERN, release, or resource addresses)
+	// - ERN address: returns all streamable resources in the ERN
+	// - release address: returns all resources associated with that release
+	// - resource address: returns that specific resource
+	// all addresses are resolved back to their parent ERN to verify ownership
+	Addresses []string                  `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	ExpiresAt *timestamppb.Timestamp    `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Mode      GetStreamURLsRequest_Mode `protobuf:"varint,4,opt,name=mode,proto3,enum=core.v1.GetStreamURLsRequest_Mode" json:"mode,omitempty"`
+	// DDEX use type the listener is requesting, defaults to OnDemandStream
+	UseType string `protobuf:"bytes,5,opt,name=use_type,json=useType,proto3" json:"use_type,omitempty"`
+	// DDEX commercial model the listener is accessing under, any model if empty
+	CommercialModelType string `protobuf:"bytes,6,opt,name=commercial_model_type,json=commercialModelType,proto3" json:"commercial_model_type,omitempty"`
+}
+
+func (x *GetStreamURLsRequest) Reset() {
+	*x = GetStreamURLsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_v1_types_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStreamURLsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStreamURLsRequest) ProtoMessage() {}
+
+func (x *GetStreamURLsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_core_v1_types_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStreamURLsRequest.ProtoReflect.Descriptor instead.
+func (*GetStreamURLsRequest) Descriptor() ([]byte, []int) {
+	return file_core_v1_types_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *GetStreamURLsRequest) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *GetStreamURLsRequest) GetAddresses() []string {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+func (x *GetStreamURLsRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *GetStreamURLsRequest) GetMode() GetStreamURLsRequest_Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return GetStreamURLsRequest_MODE_UNSPECIFIED
+}
+
+func (x *GetStreamURLsRequest) GetUseType() string {
+	if x != nil {
+		return x.UseType
+	}
+	return ""
+}
+
+func (x *GetStreamURLsRequest) GetCommercialModelType() string {
+	if x != nil {
+		return x.CommercialModelType
+	}
+	return ""
+}
+
+type GetStreamURLsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Key is the entity address (ERN, release, or resource address)
+	// Value contains the streaming URLs and metadata for that entity
+	EntityStreamUrls map[string]*GetStreamURLsResponse_EntityStreamURLs `protobuf:"bytes,1,rep,name=entity_stream_urls,json=entityStreamUrls,proto3" json:"entity_stream_urls,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// listener mode only, addresses no deal permits streaming and why
+	Denials map[string]*GetStreamURLsResponse_StreamDenial `protobuf:"bytes,2,rep,name=denials,proto3" json:"denials,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *GetStreamURLsResponse) Reset() {
+	*x = GetStreamURLsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_v1_types_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStreamURLsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStreamURLsResponse) ProtoMessage() {}
+
+func (x *GetStreamURLsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_core_v1_types_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStreamURLsResponse.ProtoReflect.Descriptor instead.
+func (*GetStreamURLsResponse) Descriptor() ([]byte, []int) {
+	return file_core_v1_types_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *GetStreamURLsResponse) GetEntityStreamUrls() map[string]*GetStreamURLsResponse_EntityStreamURLs {
+	if x != nil {
+		return x.EntityStreamUrls
+	}
+	return nil
+}
+
+func (x *GetStreamURLsResponse) GetDenials() map[string]*GetStreamURLsResponse_StreamDenial {
+	if x != nil {
+		return x.Denials
+	}
+	return nil
+}
+
+type GetUploadByCIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cid string `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+}
+
+func (x *GetUploadByCIDRequest) Reset() {
+	*x = GetUploadByCIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_v1_types_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUploadByCIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUploadByCIDRequest) ProtoMessage() {}
+
+func (x *GetUploadByCIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_core_v1_types_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUploadByCIDRequest.ProtoReflect.Descriptor instead.
+func (*GetUploadByCIDRequest) Descriptor() ([]byte, []int) {
+	return file_core_v1_types_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *GetUploadByCIDRequest) GetCid() string {
+	if x != nil {
+		return x.Cid
+	}
+	return ""
+}
+
+type GetUploadByCIDResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Exists          bool   `protobuf:"varint,1,opt,name=exists,proto3" json:"exists,omitempty"`
+	UploaderAddress string `protobuf:"bytes,2,opt,name=uploader_address,json=uploaderAddress,proto3" json:"uploader_address,omitempty"`
+	OriginalCid     string `protobuf:"bytes,3,opt,name=original_cid,json=originalCid,proto3" json:"original_cid,omitempty"`
+	TranscodedCid   string `protobuf:"bytes,4,opt,name=transcoded_cid,json=transcodedCid,proto3" json:"transcoded_cid,omitempty"`
+}
+
+func (x *GetUploadByCIDResponse) Reset() {
+	*x = GetUploadByCIDResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_v1_types_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUploadByCIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUploadByCIDResponse) ProtoMessage() {}
+
+func (x *GetUploadByCIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_core_v1_types_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUploadByCIDResponse.ProtoReflect.Descriptor instead.
+func (*GetUploadByCIDResponse) Descriptor() ([]byte, []int) {
+	return file_core_v1_types_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *GetUploadByCIDResponse) GetExists() bool {
+	if x != nil {
+		return x.Exists
+	}
+	return false
+}
+
+func (x *GetUploadByCIDResponse) GetUploaderAddress() string {
+	if x != nil {
+		return x.UploaderAddress
+	}
+	return ""
+}
+
+func (x *GetUploadByCIDResponse) GetOriginalCid() string {
+	if x != nil {
+		return x.OriginalCid
+	}
+	return ""
+}
+
+func (x *GetUploadByCIDResponse) GetTranscodedCid() string {
+	if x != nil {
+		return x.TranscodedCid
+	}
+	return ""
+}
+
+type GetStatusResponse_ProcessInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Abci           *GetStatusResponse_ProcessInfo_ProcessStateInfo `protobuf:"bytes,1,opt,name=abci,proto3" json:"abci,omitempty"`
+	RegistryBridge *GetStatusResponse_ProcessInfo_ProcessStateInfo `protobuf:"bytes,2,opt,name=registry_bridge,json=registryBridge,proto3" json:"registry_bridge,omitempty"`
+	EchoServer     *GetStatusResponse_ProcessInfo_ProcessStateInfo `protobuf:"bytes,3,opt,name=echo_server,json=echoServer,proto3" json:"echo_server,omitempty"`
+	SyncTasks      *GetStatusResponse_ProcessInfo_ProcessStateInfo `protobuf:"bytes,4,opt,name=sync_tasks,json=syncTasks,proto3" json:"sync_tasks,omitempty"`
+	PeerManager    *GetStatusResponse_ProcessInfo_ProcessStateInfo `protobuf:"bytes,5,opt,name=peer_manager,json=peerManager,proto3" json:"peer_manager,omitempty"`
+	DataCompanion  *GetStatusResponse_ProcessInfo_ProcessStateInfo `protobuf:"bytes,6,opt,name=data_companion,json=dataCompanion,proto3" json:"data_companion,omitempty"`
+	Cache          *GetStatusResponse_ProcessInfo_ProcessStateInfo `protobuf:"bytes,7,opt,name=cache,proto3" json:"cache,omitempty"`
+	LogSync        *GetStatusResponse_ProcessInfo_ProcessStateInfo `protobuf:"bytes,8,opt,name=log_sync,json=logSync,proto3" json:"log_sync,omitempty"`
+	StateSync      *GetStatusResponse_ProcessInfo_ProcessStateInfo `protobuf:"bytes,9,opt,name=state_sync,json=stateSync,proto3" json:"state_sync,omitempty"`
+	MempoolCache   *GetStatusResponse_ProcessInfo_ProcessStateInfo `protobuf:"bytes,10,opt,name=mempool_cache,json=mempoolCache,proto3" json:"mempool_cache,omitempty"`
+}
+
+func (x *GetStatusResponse_ProcessInfo) Reset() {
+	*x = GetStatusResponse_ProcessInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_v1_types_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStatusResponse_ProcessInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatusResponse_ProcessInfo) ProtoMessage() {}
+
+func (x *GetStatusResponse_ProcessInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_core_v1_types_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatusResponse_ProcessInfo.ProtoReflect.Descriptor instead.
+func (*GetStatusResponse_ProcessInfo) Descriptor() ([]byte, []int) {
+	return file_core_v1_types_proto_rawDescGZIP(), []int{5, 0}
+}
+
+func (x *GetStatusResponse_ProcessInfo) GetAbci() *GetStatusResponse_ProcessInfo_ProcessStateInfo {
+	if x != nil {
+		return x.Abci
+	}
+	return nil
+}
+
+func (x *GetStatusResponse_ProcessInfo) GetRegistryBridge() *GetStatusResponse_ProcessInfo_ProcessStateInfo {
+	if x != nil {
+		return x.RegistryBridge
+	}
+	return nil
+}
+
+func (x *GetStatusResponse_ProcessInfo) GetEchoServer() *GetStatusResponse_ProcessInfo_ProcessStateInfo {
+	if x != nil {
+		return x.EchoServer
+	}
+	return nil
+}
+
+func (x *GetStatusResponse_ProcessInfo) GetSyncTasks() *GetStatusResponse_ProcessInfo_ProcessStateInfo {
+	if x != nil {
+		return x.SyncTasks
+	}
+	return nil
+}
+
+func (x *GetStatusResponse_ProcessInfo) GetPeerManager() *GetStatusResponse_ProcessInfo_ProcessStateInfo {
+	if x != nil {
+		return x.PeerManager
+	}
+	return nil
+}
+
+func (x *GetStatusResponse_ProcessInfo) GetDataCompanion() *GetStatusResponse_ProcessInfo_ProcessStateInfo {
+	if x != nil {
+		return x.DataCompanion
+	}
+	return nil
+}
+
+func (x *GetStatusResponse_ProcessInfo) GetCache() *GetStatusResponse_ProcessInfo_ProcessStateInfo {
+	if x != nil {
+		return x.Cache
+	}
+	return nil
+}
+
+func (x *GetStatusResponse_ProcessInfo) GetLogSync() *GetStatusResponse_ProcessInfo_ProcessStateInfo {
+	if x != nil {
+		return x.LogSync
+	}
+	return nil
+}
+
+func (x *GetStatusResponse_ProcessInfo) GetStateSync() *GetStatusResponse_ProcessInfo_ProcessStateInfo {
+	if x != nil {
+		return x.StateSync
+	}
+	return nil
+}
+
+func (x *GetStatusResponse_ProcessInfo) GetMempoolCache() *GetStatusResponse_ProcessInfo_ProcessStateInfo {
+	if x != nil {
+		return x.MempoolCache
+	}
+	return nil
+}
+
+type GetStatusResponse_NodeInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Endpoint     string `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	CometAddress string `protobuf:"bytes,2,opt,name=comet_address,json=cometAddress,proto3" json:"comet_address,omitempty"`
+	EthAddress   string `protobuf:"bytes,3,opt,name=eth_address,json=ethAddress,proto3" json:"eth_address,omitempty"`
+	NodeType     string `protobuf:"bytes,4,opt,name=node_type,json=nodeType,proto3" json:"node_type,omitempty"`
+}
+
+func (x *GetStatusResponse_NodeInfo) Reset() {
+	*x = GetStatusResponse_NodeInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_v1_types_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStatusResponse_NodeInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatusResponse_NodeInfo) ProtoMessage() {}
+
+func (x *GetStatusResponse_NodeInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_core_v1_types_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatusResponse_NodeInfo.ProtoReflect.Descriptor instead.
+func (*GetStatusResponse_NodeInfo) Descriptor() ([]byte, []int) {
+	return file_core_v1_types_proto_rawDescGZIP(), []int{5, 1}
+}
+
+func (x *GetStatusResponse_NodeInfo) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *GetStatusResponse_NodeInfo) GetCometAddress() string {
+	if x != nil {
+		return x.CometAddress
+	}
+	return ""
+}
+
+func (x *GetStatusResponse_NodeInfo) GetEthAddress() string {
+	if x != nil {
+		return x.EthAddress
+	}
+	return ""
+}
+
+func (x *GetStatusResponse_NodeInfo) GetNodeType() string {
+	if x != nil {
+		return x.NodeType
+	}
+	return ""
+}
+
+type GetStatusResponse_ChainInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChainId          string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	CurrentHeight    int64  `protobuf:"varint,2,opt,name=current_height,json=currentHeight,proto3" json:"current_height,omitempty"`
+	CurrentBlockHash string `protobuf:"bytes,3,opt,name=current_block_hash,json=currentBlockHash,proto3" json:"current_block_hash,omitempty"`
+	TotalTxCount     int64  `protobuf:"varint,4,opt,name=total_tx_count,json=totalTxCount,proto3" json:"total_tx_count,omitempty"`
+}
+
+func (x *GetStatusResponse_ChainInfo) Reset() {
+	*x = GetStatusResponse_ChainInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_v1_types_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStatusResponse_ChainInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatusResponse_ChainInfo) ProtoMessage() {}
+
+func (x *GetStatusResponse_ChainInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_core_v1_types_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatusResponse_ChainInfo.ProtoReflect.Descriptor instead.
+func (*GetStatusResponse_ChainInfo) Descriptor() ([]byte, []int) {
+	return file_core_v1_types_proto_rawDescGZIP(), []int{5, 2}
+}
+
+func (x *GetStatusResponse_ChainInfo) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *GetStatusResponse_ChainInfo) GetCurrentHeight() int64 {
+	if x != nil {
+		return x.CurrentHeight
+	}
+	return 0
+}
+
+func (x *GetStatusResponse_ChainInfo) GetCurrentBlockHash() string {
+	if x != nil {
+		return x.CurrentBlockHash
+	}
+	return ""
+}
+
+func (x *GetStatusResponse_ChainInfo) GetTotalTxCount() int64 {
+	if x != nil {
+		return x.TotalTxCount
+	}
+	return 0
+}
+
+type GetStatusResponse_SyncInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Synced bool `protobuf:"varint,1,opt,name=synced,proto3" json:"synced,omitempty"`
+	// Types that are assignable to SyncMode:
+	//
+	//	*GetStatusResponse_SyncInfo_StateSync
+	//	*GetStatusResponse_SyncInfo_BlockSync
+	SyncMode isGetStatusResponse_SyncInfo_SyncMode `protobuf_oneof:"sync_mode"`
+}
+
+func (x *GetStatusResponse_SyncInfo) Reset() {
+	*x = GetStatusResponse_SyncInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_v1_types_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStatusResponse_SyncInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatusResponse_SyncInfo) ProtoMessage() {}
+
+func (x *GetStatusResponse_SyncInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_core_v1_types_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatusResponse_SyncInfo.ProtoReflect.Descriptor instead.
+func (*GetStatusResponse_SyncInfo) Descriptor() ([]byte, []int) {
+	return file_core_v1_types_proto_rawDescGZIP(), []int{5, 3}
+}
+
+func (x *GetStatusResponse_SyncInfo) GetSynced() bool {
+	if x != nil {
+		return x.Synced
+	}
+	return false
+}
+
+func (m *GetStatusResponse_SyncInfo) GetSyncMode() isGetStatusResponse_SyncInfo_SyncMode {
+	if m != nil {
+		return m.SyncMode
+	}
+	return nil
+}
+
+func (x *GetStatusResponse_SyncInfo) GetStateSync() *GetStatusResponse_SyncInfo_StateSyncInfo {
+	if x, ok := x.GetSyncMode().(*GetStatusResponse_SyncInfo_StateSync); ok {
+		return x.StateSync
+	}
+	return nil
+}
+
+func (x *GetStatusResponse_SyncInfo) GetBlockSync() *GetStatusResponse_SyncInfo_BlockSyncInfo {
+	if x, ok := x.GetSyncMode().(*GetStatusResponse_SyncInfo_BlockSync); ok {
+		return x.BlockSync
+	}
+	return nil
+}
+
+type isGetStatusResponse_SyncInfo_SyncMode interface {
+	isGetStatusResponse_SyncInfo_SyncMode()
+}
+
+type GetStatusResponse_SyncInfo_StateSync struct {
+	StateSync *GetStatusResponse_SyncInfo_StateSyncInfo `protobuf:"bytes,2,opt,name=state_sync,json=stateSync,proto3,oneof"`
+}
+
+type GetStatusResponse_SyncInfo_BlockSync struct {
+	BlockSync *GetStatusResponse_SyncInfo_BlockSyncInfo `protobuf:"bytes,3,opt,name=block_sync,json=blockSync,proto3,oneof"`
+}
+
+func (*GetStatusResponse_SyncInfo_StateSync) isGetStatusResponse_SyncInfo_SyncMode() {}
+
+func (*GetStatusResponse_SyncInfo_BlockSync) isGetStatusResponse_SyncInfo_SyncMode() {}
+
+type GetStatusResponse_PruningInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled        bool  `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	RetainBlocks   int64 `protobuf:"varint,2,opt,name=retain_blocks,json=retainBlocks,proto3" json:"retain_blocks,omitempty"`
+	EarliestHeight int64 `protobuf:"varint,3,opt,name=earliest_height,json=earliestHeight,proto3" json:"earliest_height,omitempty"`
+}
+
+func (x *GetStatusResponse_PruningInfo) Reset() {
+	*x = GetStatusResponse_PruningInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_v1_types_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStatusResponse_PruningInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatusResponse_PruningInfo) ProtoMessage() {}
+
+func (x *GetStatusResponse_PruningInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_core_v1_types_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatusResponse_PruningInfo.ProtoReflect.Descriptor instead.
+func (*GetStatusResponse_PruningInfo) Descriptor() ([]byte, []int) {
+	return file_core_v1_types_proto_rawDescGZIP(), []int{5, 4}
+}
+
+func (x *GetStatusResponse_PruningInfo) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *GetStatusResponse_PruningInfo) GetRetainBlocks() int64 {
+	if x != nil {
+		return x.RetainBlocks
+	}
+	return 0
+}
+
+func (x *GetStatusResponse_PruningInfo) GetEarliestHeight() int64 {
+	if x != nil {
+		return x.EarliestHeight
+	}
+	return 0
+}
+
+type GetStatusResponse_ResourceInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DbSize    int64 `protobuf:"varint,1,opt,name=db_size,json=dbSize,proto3" json:"db_size,omitempty"`
+	ChainSize int64 `protobuf:"varint,2,opt,name=chain_size,json=chainSize,proto3" json:"chain_size,omitempty"`
+	MemSize   int64 `protobuf:"varint,3,opt,name=mem_size,json=memSize,proto3" json:"mem_size,omitempty"`
+	CpuUsage  int64 `protobuf:"varint,4,opt,name=cpu_usage,json=cpuUsage,proto3" json:"cpu_usage,omitempty"`
+	MemUsage  int64 `protobuf:"varint,5,opt,name=mem_usage,json=memUsage,proto3" json:"mem_usage,omitempty"`
+	DiskUsage int64 `protobuf:"varint,6,opt,name=disk_usage,json=diskUsage,proto3" json:"disk_usage,omitempty"`
+	DiskFree  int64 `protobuf:"varint,7,opt,name=disk_free,json=diskFree,proto3" json:"disk_free,omitempty"`
+}
+
+func (x *GetStatusResponse_ResourceInfo) Reset() {
+	*x = GetStatusResponse_ResourceInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_v1_types_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStatusResponse_ResourceInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatusResponse_ResourceInfo) ProtoMessage() {}
+
+func (x *GetStatusResponse_ResourceInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_core_v1_types_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatusResponse_ResourceInfo.ProtoReflect.Descriptor instead.
+func (*GetStatusResponse_ResourceInfo) Descriptor() ([]byte, []int) {
+	return file_core_v1_types_proto_rawDescGZIP(), []int{5, 5}
+}
+
+func (x *GetStatusResponse_ResourceInfo) GetDbSize() int64 {
+	if x != nil {
+		return x.DbSize
+	}
+	return 0
+}
+
+func (x *GetStatusResponse_ResourceInfo) GetChainSize() int64 {
+	if x != nil {
+		return x.ChainSize
+	}
+	return 0
+}
+
+func (x *GetStatusResponse_ResourceInfo) GetMemSize() int64 {
+	if x != nil {
+		return x.MemSize
+	}
+	return 0
+}
+
+func (x *GetStatusResponse_ResourceInfo) GetCpuUsage() int64 {
+	if x != nil {
+		return x.CpuUsage
+	}
+	return 0
+}
+
+func (x *GetStatusResponse_ResourceInfo) GetMemUsage() int64 {
+	if x != nil {
+		return x.MemUsage
+	}
+	return 0
+}
+
+func (x *GetStatusResponse_ResourceInfo) GetDiskUsage() int64 {
+	if x != nil {
+		return x.DiskUsage
+	}
+	return 0
+}
+
+func (x *GetStatusResponse_ResourceInfo) GetDiskFree() int64 {
+	if x != nil {
+		return x.DiskFree
+	}
+	return 0
+}
+
+type GetStatusResponse_MempoolInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxCount               int64 `protobuf:"varint,1,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+	TxSize                int64 `protobuf:"varint,2,opt,name=tx_size,json=txSize,proto3" json:"tx_size,omitempty"`
+	MaxTxCount            int64 `protobuf:"varint,3,opt,name=max_tx_count,json=maxTxCount,proto3" json:"max_tx_count,omitempty"`
+	MaxTxSize             int64 `protobuf:"varint,4,opt,name=max_tx_size,json=maxTxSize,proto3" json:"max_tx_size,omitempty"`
+	HighPriorityTxCount   int64 `protobuf:"varint,5,opt,name=high_priority_tx_count,json=highPriorityTxCount,proto3" json:"high_priority_tx_count,omitempty"`
+	NormalPriorityTxCount int64 `protobuf:"varint,6,opt,name=normal_priority_tx_count,json=normalPriorityTxCount,proto3" json:"normal_priority_tx_count,omitempty"`
+	LowPriorityTxCount    int64 `protobuf:"varint,7,opt,name=low_priority_tx_count,json=lowPriorityTxCount,proto3" json:"low_priority_tx_count,omitempty"`
+	// dropped to make room for a higher priority or more urgent transaction
+	EvictedTxCount int64 `protobuf:"varint,8,opt,name=evicted_tx_count,json=evictedTxCount,proto3" json:"evicted_tx_count,omitempty"`
+	// rejected because nothing could be evicted
+	RejectedFullTxCount int64 `protobuf:"varint,9,opt,name=rejected_full_tx_count,json=rejectedFullTxCount,proto3" json:"rejected_full_tx_count,omitempty"`
+	// rejected because the sender was over its quota
+	RejectedSenderLimitTxCount int64 `protobuf:"varint,10,opt,name=rejected_sender_limit_tx_count,json=rejectedSenderLimitTxCount,proto3" json:"rejected_sender_limit_tx_count,omitempty"`
+	// dropped after their deadline passed
+	ExpiredTxCount int64 `protobuf:"varint,11,opt,name=expired_tx_count,json=expiredTxCount,proto3" json:"expired_tx_count,omitempty"`
+}
+
+func (x *GetStatusResponse_MempoolInfo) Reset() {
+	*x = GetStatusResponse_MempoolInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_v1_types_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStatusResponse_MempoolInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatusResponse_MempoolInfo) ProtoMessage() {}
+
+func (x *GetStatusResponse_MempoolInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_core_v1_types_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatusResponse_MempoolInfo.ProtoReflect.Descriptor instead.
+func (*GetStatusResponse_MempoolInfo) Descriptor() ([]byte, []int) {
+	return file_core_v1_types_proto_rawDescGZIP(), []int{5, 6}
+}
+
+func (x *GetStatusResponse_MempoolInfo) GetTxCount() int64 {
+	if x != nil {
+		return x.TxCount
+	}
+	return 0
+}
+
+func (x *GetStatusResponse_MempoolInfo) GetTxSize() int64 {
+	if x != nil {
+		return x.TxSize
+	}
+	return 0
+}
+
+func (x *GetStatusResponse_MempoolInfo) GetMaxTxCount() int64 {
+	if x != nil {
+		return x.MaxTxCount
+	}
+	return 0
+}
+
+func (x *GetStatusResponse_MempoolInfo) GetMaxTxSize() int64 {
+	if x != nil {
+		return x.MaxTxSize
+	}
+	return 0
+}
+
+func (x *GetStatusResponse_MempoolInfo) GetHighPriorityTxCount() int64 {
+	if x != nil {
+		return x.HighPriorityTxCount
+	}
+	return 0
+}
+
+func (x *GetStatusResponse_MempoolInfo) GetNormalPriorityTxCount() int64 {
+	if x != nil {
+		return x.NormalPriorityTxCount
+	}
+	return 0
+}
+
+func (x *GetStatusResponse_MempoolInfo) GetLowPriorityTxCount() int64 {
+	if x != nil {
+		return x.LowPriorityTxCount
+	}
+	return 0
+}
+
+func (x *GetStatusResponse_MempoolInfo) GetEvictedTxCount() int64 {
+	if x != nil {
+		return x.EvictedTxCount
+	}
+	return 0
+}
+
+func (x *GetStatusResponse_MempoolInfo) GetRejectedFullTxCount() int64 {
+	if x != nil {
+		return x.RejectedFullTxCount
+	}
+	return 0
+}
+
+func (x *GetStatusResponse_MempoolInfo) GetRejectedSenderLimitTxCount() int64 {
+	if x != nil {
+		return x.RejectedSenderLimitTxCount
+	}
+	return 0
+}
+
+func (x *GetStatusResponse_MempoolInfo) GetExpiredTxCount() int64 {
+	if x != nil {
+		return x.ExpiredTxCount
+	}
+	return 0
+}
+
+type GetStatusResponse_SnapshotInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled   bool                `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Snapshots []*SnapshotMetadata `protobuf:"bytes,2,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+}
+
+func (x *GetStatusResponse_SnapshotInfo) Reset() {
+	*x = GetStatusResponse_SnapshotInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_v1_types_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStatusResponse_SnapshotInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatusResponse_SnapshotInfo) ProtoMessage() {}
+
+func (x *GetStatusResponse_SnapshotInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_core_v1_types_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatusResponse_SnapshotInfo.ProtoReflect.Descriptor instead.
+func (*GetStatusResponse_SnapshotInfo) Descriptor() ([]byte, []int) {
+	return file_core_v1_types_proto_rawDescGZIP(), []int{5, 7}
+}
+
+func (x *GetStatusResponse_SnapshotInfo) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *GetStatusResponse_SnapshotInfo) GetSnapshots() []*SnapshotMetadata {
+	if x != nil {
+		return x.Snapshots
+	}
+	return nil
+}
+
+type GetStatusResponse_PeerInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Peers []*GetStatusResponse_PeerInfo_Peer `protobuf:"bytes,1,rep,name=peers,proto3" json:"peers,omitempty"`
+}
+
+func (x *GetStatusResponse_PeerInfo) Reset() {
+	*x = GetStatusResponse_PeerInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_v1_types_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStatusResponse_PeerInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatusResponse_PeerInfo) ProtoMessage() {}
+
+func (x *GetStatusResponse_PeerInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_core_v1_types_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatusResponse_PeerInfo.ProtoReflect.Descriptor instead.
+func (*GetStatusResponse_PeerInfo) Descriptor() ([]byte, []int) {
+	return file_core_v1_types_proto_rawDescGZIP(), []int{5, 8}
+}
+
+func (x *GetStatusResponse_PeerInfo) GetPeers() []*GetStatusResponse_PeerInfo_Peer {
+	if x != nil {
+		return x.Peers
+	}
+	return nil
+}
+
+type GetStatusResponse_ProcessInfo_ProcessStateInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State       GetStatusResponse_ProcessInfo_ProcessState `protobuf:"varint,1,opt,name=state,proto3,enum=core.v1.GetStatusResponse_ProcessInfo_ProcessState" json:"state,omitempty"`
+	StartedAt   *timestamppb.Timestamp                     `protobuf:"bytes,2,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	CompletedAt *timestamppb.Timestamp                     `protobuf:"bytes,3,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	Error       string                                     `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	Metadata    string                                     `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *GetStatusResponse_ProcessInfo_ProcessStateInfo) Reset() {
+	*x = GetStatusResponse_ProcessInfo_ProcessStateInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_v1_types_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStatusResponse_ProcessInfo_ProcessStateInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatusResponse_ProcessInfo_ProcessStateInfo) ProtoMessage() {}
+
+func (x *GetStatusResponse_ProcessInfo_ProcessStateInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_core_v1_types_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatusResponse_ProcessInfo_ProcessStateInfo.ProtoReflect.Descriptor instead.
+func (*GetStatusResponse_ProcessInfo_ProcessStateInfo) Descriptor() ([]byte, []int) {
+	return file_core_v1_types_proto_rawDescGZIP(), []int{5, 0, 0}
+}
+
+func (x *GetStatusResponse_ProcessInfo_ProcessStateInfo) GetState() GetStatusResponse_ProcessInfo_ProcessState {
+	if x != nil {
+		return x.State
+	}
+	return GetStatusResponse_ProcessInfo_PROCESS_STATE_UNSPECIFIED
+}
+
+func (x *GetStatusResponse_ProcessInfo_ProcessStateInfo) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *GetStatusResponse_ProcessInfo_ProcessStateInfo) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *GetStatusResponse_ProcessInfo_ProcessStateInfo) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *GetStatusResponse_ProcessInfo_ProcessStateInfo) GetMetadata() string {
+	if x != nil {
+		return x.Metadata
+	}
+	return ""
+}
+
+type GetStatusResponse_SyncInfo_StateSyncInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Phase            GetStatusResponse_SyncInfo_StateSyncInfo_Phase `protobuf:"varint,1,opt,name=phase,proto3,enum=core.v1.GetStatusResponse_SyncInfo_StateSyncInfo_Phase" json:"phase,omitempty"`
+	Snapshot         *SnapshotMetadata                              `protobuf:"bytes,2,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	DownloadedChunks int64                                          `protobuf:"varint,3,opt,name=downloaded_chunks,json=downloadedChunks,proto3" json:"downloaded_chunks,omitempty"`
+	ChunkFetchers    int64                                          `protobuf:"varint,4,opt,name=chunk_fetchers,json=chunkFetchers,proto3" json:"chunk_fetchers,omitempty"`
+	StartedAt        *timestamppb.Timestamp                         `protobuf:"bytes,5,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	CompletedAt      *timestamppb.Timestamp                         `protobuf:"bytes,6,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+}
+
+func (x *GetStatusResponse_SyncInfo_StateSyncInfo) Reset() {
+	*x = GetStatusResponse_SyncInfo_StateSyncInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_v1_types_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStatusResponse_SyncInfo_StateSyncInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatusResponse_SyncInfo_StateSyncInfo) ProtoMessage() {}
+
+func (x *GetStatusResponse_SyncInfo_StateSyncInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_core_v1_types_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatusResponse_SyncInfo_StateSyncInfo.ProtoReflect.Descriptor instead.
+func (*GetStatusResponse_SyncInfo_StateSyncInfo) Descriptor() ([]byte, []int) {
+	return file_core_v1_types_proto_rawDescGZIP(), []int{5, 3, 0}
+}
+
+func (x *GetStatusResponse_SyncInfo_StateSyncInfo) GetPhase() GetStatusResponse_SyncInfo_StateSyncInfo_Phase {
+	if x != nil {
+		return x.Phase
+	}
+	return GetStatusResponse_SyncInfo_StateSyncInfo_PHASE_UNSPECIFIED
+}
+
+func (x *GetStatusResponse_SyncInfo_StateSyncInfo) GetSnapshot() *SnapshotMetadata {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+func (x *GetStatusResponse_SyncInfo_StateSyncInfo) GetDownloadedChunks() int64 {
+	if x != nil {
+		return x.DownloadedChunks
+	}
+	return 0
+}
+
+func (x *GetStatusResponse_SyncInfo_StateSyncInfo) GetChunkFetchers() int64 {
+	if x != nil {
+		return x.ChunkFetchers
+	}
+	return 0
+}
+
+func (x *GetStatusResponse_SyncInfo_StateSyncInfo) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *GetStatusResponse_SyncInfo_StateSyncInfo) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+type GetStatusResponse_SyncInfo_BlockSyncInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockDiff   int64                       `protobuf:"varint,1,opt,name=block_diff,json=blockDiff,proto3" json:"block_diff,omitempty"`
+	HeadSource  *GetStatusResponse_NodeInfo `protobuf:"bytes,2,opt,name=head_source,json=headSource,proto3" json:"head_source,omitempty"`
+	HeadHeight  int64                       `protobuf:"varint,3,opt,name=head_height,json=headHeight,proto3" json:"head_height,omitempty"`
+	SyncHeight  int64                       `protobuf:"varint,4,opt,name=sync_height,json=syncHeight,proto3" json:"sync_height,omitempty"`
+	StartedAt   *timestamppb.Timestamp      `protobuf:"bytes,5,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	CompletedAt *timestamppb.Timestamp      `protobuf:"bytes,6,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+}
+
+func (x *GetStatusResponse_SyncInfo_BlockSyncInfo) Reset() {
+	*x = GetStatusResponse_SyncInfo_BlockSyncInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_v1_types_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStatusResponse_SyncInfo_BlockSyncInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatusResponse_SyncInfo_BlockSyncInfo) ProtoMessage() {}
+
+func (x *GetStatusResponse_SyncInfo_BlockSyncInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_core_v1_types_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatusResponse_SyncInfo_BlockSyncInfo.ProtoReflect.Descriptor instead.
+func (*GetStatusResponse_SyncInfo_BlockSyncInfo) Descriptor() ([]byte, []int) {
+	return file_core_v1_types_proto_rawDescGZIP(), []int{5, 3, 1}
+}
+
+func (x *GetStatusResponse_SyncInfo_BlockSyncInfo) GetBlockDiff() int64 {
+	if x != nil {
+		return x.BlockDiff
+	}
+	return 0
+}
+
+func (x *GetStatusResponse_SyncInfo_BlockSyncInfo) GetHeadSource() *GetStatusResponse_NodeInfo {
+	if x != nil {
+		return x.HeadSource
+	}
+	return nil
+}
+
+func (x *GetStatusResponse_SyncInfo_BlockSyncInfo) GetHeadHeight() int64 {
+	if x != nil {
+		return x.HeadHeight
+	}
+	return 0
+}
+
+func (x *GetStatusResponse_SyncInfo_BlockSyncInfo) GetSyncHeight() int64 {
+	if x != nil {
+		return x.SyncHeight
+	}
+	return 0
+}
+
+func (x *GetStatusResponse_SyncInfo_BlockSyncInfo) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *GetStatusResponse_SyncInfo_BlockSyncInfo) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+type GetStatusResponse_PeerInfo_Peer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Endpoint          string `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	CometAddress      string `protobuf:"bytes,2,opt,name=comet_address,json=cometAddress,proto3" json:"comet_address,omitempty"`
+	EthAddress        string `protobuf:"bytes,3,opt,name=eth_address,json=ethAddress,proto3" json:"eth_address,omitempty"`
+	NodeType          string `protobuf:"bytes,4,opt,name=node_type,json=nodeType,proto3" json:"node_type,omitempty"`
+	ConnectrpcClient  bool   `protobuf:"varint,5,opt,name=connectrpc_client,json=connectrpcClient,proto3" json:"connectrpc_client,omitempty"`
+	ConnectrpcHealthy bool   `protobuf:"varint,6,opt,name=connectrpc_healthy,json=connectrpcHealthy,proto3" json:"connectrpc_healthy,omitempty"`
+	CometrpcClient    bool   `protobuf:"varint,7,opt,name=cometrpc_client,json=cometrpcClient,proto3" json:"cometrpc_client,omitempty"`
+	CometrpcHealthy   bool   `protobuf:"varint,8,opt,name=cometrpc_healthy,json=cometrpcHealthy,proto3" json:"cometrpc_healthy,omitempty"`
+	P2PConnected      bool   `protobuf:"varint,9,opt,name=p2p_connected,json=p2pConnected,proto3" json:"p2p_connected,omitempty"`
+	P2PAccessible     bool   `protobuf:"varint,10,opt,name=p2p_accessible,json=p2pAccessible,proto3" json:"p2p_accessible,omitempty"`
+}
+
+func (x *GetStatusResponse_PeerInfo_Peer) Reset() {
+	*x = GetStatusResponse_PeerInfo_Peer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_v1_types_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStatusResponse_PeerInfo_Peer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatusResponse_PeerInfo_Peer) ProtoMessage() {}
+
+func (x *GetStatusResponse_PeerInfo_Peer) ProtoReflect() protoreflect.Message {
+	mi := &file_core_v1_types_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatusResponse_PeerInfo_Peer.ProtoReflect.Descriptor instead.
+func (*GetStatusResponse_PeerInfo_Peer) Descriptor() ([]byte, []int) {
+	return file_core_v1_types_proto_rawDescGZIP(), []int{5, 8, 0}
+}
+
+func (x *GetStatusResponse_PeerInfo_Peer) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *GetStatusResponse_PeerInfo_Peer) GetCometAddress() string {
+	if x != nil {
+		return x.CometAddress
+	}
+	return ""
+}
+
+func (x *GetStatusResponse_PeerInfo_Peer) GetEthAddress() string {
+	if x != nil {
+		return x.EthAddress
+	}
+	return ""
+}
+
+func (x *GetStatusResponse_PeerInfo_Peer) GetNodeType() string {
+	if x != nil {
+		return x.NodeType
+	}
+	return ""
+}
+
+func (x *GetStatusResponse_PeerInfo_Peer) GetConnectrpcClient() bool {
+	if x != nil {
+		return x.ConnectrpcClient
+	}
+	return false
+}
+
+func (x *GetStatusResponse_PeerInfo_Peer) GetConnectrpcHealthy() bool {
+	if x != nil {
+		return x.ConnectrpcHealthy
+	}
+	return false
+}
+
+func (x *GetStatusResponse_PeerInfo_Peer) GetCometrpcClient() bool {
+	if x != nil {
+		return x.CometrpcClient
+	}
+	return false
+}
+
+func (x *GetStatusResponse_PeerInfo_Peer) GetCometrpcHealthy() bool {
+	if x != nil {
+		return x.CometrpcHealthy
+	}
+	return false
+}
+
+func (x *GetStatusResponse_PeerInfo_Peer) GetP2PConnected() bool {
+	if x != nil {
+		return x.P2PConnected
+	}
+	return false
+}
+
+func (x *GetStatusResponse_PeerInfo_Peer) GetP2PAccessible() bool {
+	if x != nil {
+		return x.P2PAccessible
+	}
+	return false
+}
+
+type GetStreamURLsResponse_EntityStreamURLs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// type of entity (ern, release, or resource)
+	EntityType string `protobuf:"bytes,1,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
+	// reference from the ERN (e.g., "R1" for release or "A1" for resource)
+	EntityReference string `protobuf:"bytes,2,opt,name=entity_reference,json=entityReference,proto3" json:"entity_reference,omitempty"`
+	// signed content URLs to stream the content
+	Urls []string `protobuf:"bytes,3,rep,name=urls,proto3" json:"urls,omitempty"`
+	// parent ERN address this entity belongs to
+	ErnAddress string `protobuf:"bytes,4,opt,name=ern_address,json=ernAddress,proto3" json:"ern_address,omitempty"`
+}
+
+func (x *GetStreamURLsResponse_EntityStreamURLs) Reset() {
+	*x = GetStreamURLsResponse_EntityStreamURLs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_v1_types_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStreamURLsResponse_EntityStreamURLs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStreamURLsResponse_EntityStreamURLs) ProtoMessage() {}
+
+func (x *GetStreamURLsResponse_EntityStreamURLs) ProtoReflect() protoreflect.Message {
+	mi := &file_core_v1_types_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStreamURLsResponse_EntityStreamURLs.ProtoReflect.Descriptor instead.
+func (*GetStreamURLsResponse_EntityStreamURLs) Descriptor() ([]byte, []int) {
+	return file_core_v1_types_proto_rawDescGZIP(), []int{104, 0}
+}
+
+func (x *GetStreamURLsResponse_EntityStreamURLs) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+func (x *GetStreamURLsResponse_EntityStreamURLs) GetEntityReference() string {
+	if x != nil {
+		return x.EntityReference
+	}
+	return ""
+}
+
+func (x *GetStreamURLsResponse_EntityStreamURLs) GetUrls() []string {
+	if x != nil {
+		return x.Urls
+	}
+	return nil
+}
+
+func (x *GetStreamURLsResponse_EntityStreamURLs) GetErnAddress() string {
+	if x != nil {
+		return x.ErnAddress
+	}
+	return ""
+}
+
+type GetStreamURLsResponse_StreamDenial struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reason  GetStreamURLsResponse_StreamDenial_Reason `protobuf:"varint,1,opt,name=reason,proto3,enum=core.v1.GetStreamURLsResponse_StreamDenial_Reason" json:"reason,omitempty"`
+	Message string                                    `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// parent ERN address, empty if the address was not found
+	ErnAddress string `protobuf:"bytes,3,opt,name=ern_address,json=ernAddress,proto3" json:"ern_address,omitempty"`
+}
+
+func (x *GetStreamURLsResponse_StreamDenial) Reset() {
+	*x = GetStreamURLsResponse_StreamDenial{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_core_v1_types_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStreamURLsResponse_StreamDenial) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStreamURLsResponse_StreamDenial) ProtoMessage() {}
+
+func (x *GetStreamURLsResponse_StreamDenial) ProtoReflect() protoreflect.Message {
+	mi := &file_core_v1_types_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStreamURLsResponse_StreamDenial.ProtoReflect.Descriptor instead.
+func (*GetStreamURLsResponse_StreamDenial) Descriptor() ([]byte, []int) {
+	return file_core_v1_types_proto_rawDescGZIP(), []int{104, 1}
+}
+
+func (x *GetStreamURLsResponse_StreamDenial) GetReason() GetStreamURLsResponse_StreamDenial_Reason {
+	if x != nil {
+		return x.Reason
+	}
+	return GetStreamURLsResponse_StreamDenial_REASON_UNSPECIFIED
+}
+
+func (x *GetStreamURLsResponse_StreamDenial) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetStreamURLsResponse_StreamDenial) GetErnAddress() string {
+	if x != nil {
+		return x.ErnAddress
+	}
+	return ""
+}
+
+var File_core_v1_types_proto protoreflect.FileDescriptor
+
+var file_core_v1_types_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x18,
+	0x63, 0x6f, 0x72, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x64, 0x64, 0x65, 0x78, 0x2f, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x64, 0x64, 0x65, 0x78, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2f, 0x64, 0x65, 0x61, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x64, 0x64,
+	0x65, 0x78, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x65, 0x72, 0x6e, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x64, 0x64, 0x65, 0x78, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2f, 0x6d, 0x65, 0x61, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x64,
+	0x64, 0x65, 0x78, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x74,
+	0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x64, 0x64, 0x65, 0x78, 0x2f, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x69, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1a, 0x64, 0x64, 0x65, 0x78, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x72, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x64, 0x64, 0x65,
+	0x78, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x0d, 0x0a, 0x0b, 0x50, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x28, 0x0a, 0x0c, 0x50, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0x12, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x13, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x12, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0xe1, 0x25, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x61,
+	0x64, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x12,
+	0x40, 0x0a, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4e,
+	0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x43, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x40, 0x0a, 0x09, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x69,
+	0x6e, 0x66, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08,
+	0x73, 0x79, 0x6e, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x49, 0x0a, 0x0c, 0x70, 0x72, 0x75, 0x6e,
+	0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x72, 0x75, 0x6e, 0x69,
+	0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x70, 0x72, 0x75, 0x6e, 0x69, 0x6e, 0x67, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x4c, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
+	0x69, 0x6e, 0x66, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x49, 0x0a, 0x0c, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x69, 0x6e, 0x66,
+	0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x0b, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x39, 0x0a, 0x05,
+	0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x12, 0x4c, 0x0a, 0x0d, 0x73, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x49, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f,
+	0x1a, 0xc8, 0x0a, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x4b, 0x0a, 0x04, 0x61, 0x62, 0x63, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x61, 0x62, 0x63, 0x69, 0x12, 0x60, 0x0a,
+	0x0f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x5f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x50,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x0e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x12,
+	0x58, 0x0a, 0x0b, 0x65, 0x63, 0x68, 0x6f, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x50, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x65,
+	0x63, 0x68, 0x6f, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x56, 0x0a, 0x0a, 0x73, 0x79, 0x6e,
+	0x63, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x73, 0x79, 0x6e, 0x63, 0x54, 0x61, 0x73, 0x6b,
+	0x73, 0x12, 0x5a, 0x0a, 0x0c, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x2e,
+	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x0b, 0x70, 0x65, 0x65, 0x72, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x12, 0x5e, 0x0a,
+	0x0e, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x50, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0d,
+	0x64, 0x61, 0x74, 0x61, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x12, 0x4d, 0x0a,
+	0x05, 0x63, 0x61, 0x63, 0x68, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x63, 0x61, 0x63, 0x68, 0x65, 0x12, 0x52, 0x0a, 0x08,
+	0x6c, 0x6f, 0x67, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x6c, 0x6f, 0x67, 0x53, 0x79, 0x6e, 0x63,
+	0x12, 0x56, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x50, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x5c, 0x0a, 0x0d, 0x6d, 0x65, 0x6d, 0x70,
+	0x6f, 0x6f, 0x6c, 0x5f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x37, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x6d, 0x65, 0x6d, 0x70, 0x6f, 0x6f,
+	0x6c, 0x43, 0x61, 0x63, 0x68, 0x65, 0x1a, 0x89, 0x02, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x49, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x33, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x6e,
+	0x66, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x22, 0xb6, 0x01, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x19, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x19,
+	0x0a, 0x15, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f,
+	0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x52, 0x4f,
+	0x43, 0x45, 0x53, 0x53, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x4c, 0x45, 0x45, 0x50,
+	0x49, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44,
+	0x10, 0x04, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x05, 0x1a, 0x89, 0x01, 0x0a, 0x08,
+	0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6d,
+	0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x74, 0x68,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x65, 0x74, 0x68, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x6f,
+	0x64, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e,
+	0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x1a, 0xa1, 0x01, 0x0a, 0x09, 0x43, 0x68, 0x61, 0x69,
+	0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64,
+	0x12, 0x25, 0x0a, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x74,
+	0x78, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x54, 0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0xac, 0x08, 0x0a, 0x08,
+	0x53, 0x79, 0x6e, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6e, 0x63,
+	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x79, 0x6e, 0x63, 0x65, 0x64,
+	0x12, 0x52, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x53, 0x79, 0x6e, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53,
+	0x79, 0x6e, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x53, 0x79, 0x6e, 0x63, 0x12, 0x52, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x73, 0x79,
+	0x6e, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x53, 0x79, 0x6e, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x09, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x79, 0x6e, 0x63, 0x1a, 0x9f, 0x04, 0x0a, 0x0d, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x4d, 0x0a, 0x05, 0x70, 0x68,
+	0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x37, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x50, 0x68, 0x61,
+	0x73, 0x65, 0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x73, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x12, 0x2b, 0x0a, 0x11, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x5f, 0x63,
+	0x68, 0x75, 0x6e, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x64, 0x6f, 0x77,
+	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x12, 0x25, 0x0a,
+	0x0e, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x72, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x46, 0x65, 0x74, 0x63,
+	0x68, 0x65, 0x72, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x3d, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xb9,
+	0x01, 0x0a, 0x05, 0x50, 0x68, 0x61, 0x73, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x48, 0x41, 0x53,
+	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x12, 0x0a, 0x0e, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x49, 0x4e,
+	0x47, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x44, 0x4f, 0x57,
+	0x4e, 0x4c, 0x4f, 0x41, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x48, 0x55, 0x4e, 0x4b, 0x53, 0x10,
+	0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x4e,
+	0x53, 0x54, 0x52, 0x55, 0x43, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x48, 0x55, 0x4e, 0x4b, 0x53,
+	0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x52, 0x45, 0x53, 0x54,
+	0x4f, 0x52, 0x49, 0x4e, 0x47, 0x5f, 0x50, 0x47, 0x5f, 0x44, 0x55, 0x4d, 0x50, 0x10, 0x04, 0x12,
+	0x14, 0x0a, 0x10, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x53,
+	0x59, 0x4e, 0x43, 0x10, 0x05, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x43,
+	0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x06, 0x1a, 0xb0, 0x02, 0x0a, 0x0d, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x79, 0x6e, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1d, 0x0a, 0x0a,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x64, 0x69, 0x66, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x69, 0x66, 0x66, 0x12, 0x44, 0x0a, 0x0b, 0x68,
+	0x65, 0x61, 0x64, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x23, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4e, 0x6f, 0x64,
+	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x68, 0x65, 0x61, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x68, 0x65, 0x61, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x68, 0x65, 0x61, 0x64, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x79, 0x6e, 0x63, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3d,
+	0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x42, 0x0b, 0x0a,
+	0x09, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x1a, 0x75, 0x0a, 0x0b, 0x50, 0x72,
+	0x75, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6e, 0x5f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x65, 0x74, 0x61,
+	0x69, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x61, 0x72, 0x6c,
+	0x69, 0x65, 0x73, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0e, 0x65, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x1a, 0xd7, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x62, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x64, 0x62, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x65,
+	0x6d, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6d, 0x65,
+	0x6d, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x70, 0x75, 0x5f, 0x75, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x70, 0x75, 0x55, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x6d, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x66, 0x72, 0x65, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x46, 0x72, 0x65, 0x65, 0x1a, 0xf1, 0x03, 0x0a, 0x0b,
+	0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x19, 0x0a, 0x08, 0x74,
+	0x78, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x74,
+	0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x74, 0x78, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x20, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x78, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x54, 0x78, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1e, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x78, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x54, 0x78, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x33, 0x0a, 0x16, 0x68, 0x69, 0x67, 0x68, 0x5f, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x5f, 0x74, 0x78, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x13, 0x68, 0x69, 0x67, 0x68, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x54,
+	0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x37, 0x0a, 0x18, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c,
+	0x5f, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x78, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c,
+	0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x54, 0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x31, 0x0a, 0x15, 0x6c, 0x6f, 0x77, 0x5f, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x5f,
+	0x74, 0x78, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12,
+	0x6c, 0x6f, 0x77, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x54, 0x78, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x76, 0x69, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x78,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x65, 0x76,
+	0x69, 0x63, 0x74, 0x65, 0x64, 0x54, 0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x16,
+	0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x74, 0x78,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x72, 0x65,
+	0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x46, 0x75, 0x6c, 0x6c, 0x54, 0x78, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x42, 0x0a, 0x1e, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x74, 0x78, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x1a, 0x72, 0x65, 0x6a, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x54, 0x78,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64,
+	0x5f, 0x74, 0x78, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0e, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x54, 0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x1a,
+	0x61, 0x0a, 0x0c, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x37, 0x0a, 0x09, 0x73, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x09, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x73, 0x1a, 0xce, 0x03, 0x0a, 0x08, 0x50, 0x65, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x3e, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x49,
+	0x6e, 0x66, 0x6f, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x1a,
+	0x81, 0x03, 0x0a, 0x04, 0x50, 0x65, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6d,
+	0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x74, 0x68,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x65, 0x74, 0x68, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x6f,
+	0x64, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e,
+	0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x72, 0x70, 0x63, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x72, 0x70, 0x63, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x72,
+	0x70, 0x63, 0x5f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x11, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x72, 0x70, 0x63, 0x48, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x70, 0x63, 0x5f,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x63, 0x6f,
+	0x6d, 0x65, 0x74, 0x72, 0x70, 0x63, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x10,
+	0x63, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x70, 0x63, 0x5f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x72, 0x70, 0x63,
+	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x32, 0x70, 0x5f, 0x63,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
+	0x70, 0x32, 0x70, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e,
+	0x70, 0x32, 0x70, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x70, 0x32, 0x70, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69,
+	0x62, 0x6c, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xb4, 0x01, 0x0a, 0x13, 0x47, 0x65,
+	0x74, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x79, 0x6e, 0x63, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x79, 0x6e,
+	0x63, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x65,
+	0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x74, 0x68, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65,
+	0x74, 0x68, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x22, 0x29, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x5f, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x24, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x05,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x2a, 0x0a, 0x10,
+	0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03,
+	0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xc5, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e,
+	0x0a, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x25,
+	0x0a, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x1a, 0x49, 0x0a, 0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x24, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x47, 0x0a, 0x0c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x07, 0x74, 0x78, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0x67, 0x0a, 0x13, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x2d, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x22, 0x63, 0x0a, 0x14, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x05, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x12, 0x25, 0x0a, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x6d, 0x0a, 0x19, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2d, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x7b, 0x0a, 0x1a, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x22, 0x30, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74,
+	0x78, 0x48, 0x61, 0x73, 0x68, 0x22, 0x50, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x36, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x97, 0x01, 0x0a, 0x16, 0x53, 0x65, 0x6e, 0x64,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x3c, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x3f, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x76,
+	0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x76,
+	0x32, 0x22, 0xa4, 0x01, 0x0a, 0x17, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a,
+	0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x51, 0x0a, 0x13, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63,
+	0x65, 0x69, 0x70, 0x74, 0x52, 0x12, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x22, 0x9a, 0x01, 0x0a, 0x19, 0x46, 0x6f, 0x72,
+	0x77, 0x61, 0x72, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x76, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x76, 0x32, 0x22, 0x1c, 0x0a, 0x1a, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x67, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x42, 0x0a, 0x0c, 0x72, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c,
+	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x86, 0x01, 0x0a,
+	0x22, 0x47, 0x65, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x12, 0x42, 0x0a, 0x0c, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x6f, 0x0a, 0x23, 0x47, 0x65, 0x74, 0x44, 0x65, 0x72, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x48, 0x0a, 0x0e,
+	0x64, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x64, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x8e, 0x01, 0x0a, 0x24, 0x47, 0x65, 0x74, 0x44, 0x65,
+	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x65,
+	0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x48, 0x0a,
+	0x0e, 0x64, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x64, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x4f, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x55, 0x6e,
+	0x6a, 0x61, 0x69, 0x6c, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x75, 0x6e, 0x6a, 0x61, 0x69, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x55, 0x6e, 0x6a, 0x61, 0x69, 0x6c, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x52, 0x06, 0x75, 0x6e, 0x6a, 0x61, 0x69, 0x6c, 0x22, 0x6e, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x55,
+	0x6e, 0x6a, 0x61, 0x69, 0x6c, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x75, 0x6e, 0x6a, 0x61, 0x69, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x55, 0x6e, 0x6a, 0x61, 0x69, 0x6c, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x52, 0x06, 0x75, 0x6e, 0x6a, 0x61, 0x69, 0x6c, 0x22, 0xde, 0x01, 0x0a, 0x05, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61,
+	0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x19,
+	0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12,
+	0x38, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xbf, 0x03, 0x0a, 0x0b, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x3c, 0x0a,
+	0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67,
+	0x6e, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x38, 0x0a,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x3f, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x76, 0x32, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x76, 0x32, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x5f, 0x6c, 0x6f, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x4c, 0x6f, 0x67, 0x12, 0x51, 0x0a, 0x13, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x12, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x22, 0xa0, 0x07, 0x0a, 0x11,
+	0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x2c,
+	0x0a, 0x05, 0x70, 0x6c, 0x61, 0x79, 0x73, 0x18, 0xe8, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x50, 0x6c,
+	0x61, 0x79, 0x73, 0x48, 0x00, 0x52, 0x05, 0x70, 0x6c, 0x61, 0x79, 0x73, 0x12, 0x5e, 0x0a, 0x16,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0xe9, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x65, 0x67,
+	0x61, 0x63, 0x79, 0x48, 0x00, 0x52, 0x15, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x0a,
+	0x73, 0x6c, 0x61, 0x5f, 0x72, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x18, 0xea, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6c, 0x61, 0x52,
+	0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x48, 0x00, 0x52, 0x09, 0x73, 0x6c, 0x61, 0x52, 0x6f, 0x6c, 0x6c,
+	0x75, 0x70, 0x12, 0x43, 0x0a, 0x0d, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x5f, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x18, 0xeb, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x48, 0x00, 0x52, 0x0c, 0x6d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x69, 0x0a, 0x18, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x5f, 0x64, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0xec, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x4d, 0x69,
+	0x73, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x0d, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x72,
+	0x6f, 0x6f, 0x66, 0x18, 0xed, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x48, 0x00, 0x52, 0x0c, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x12, 0x62, 0x0a, 0x1a, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x72, 0x6f,
+	0x6f, 0x66, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0xee, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x18, 0x73, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0xef, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x48, 0x00, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x3c, 0x0a, 0x07, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x18, 0xf0, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x4e, 0x65, 0x77, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x07, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x31,
+	0x0a, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0xf1, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x12, 0x37, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x18, 0xf2, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x48, 0x00, 0x52, 0x0a,
+	0x66, 0x69, 0x6c, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x43, 0x0a, 0x0f, 0x76, 0x6f,
+	0x74, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xf3, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56,
+	0x6f, 0x74, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52,
+	0x0e, 0x76, 0x6f, 0x74, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x42,
+	0x0d, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x36,
+	0x0a, 0x0a, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x50, 0x6c, 0x61, 0x79, 0x73, 0x12, 0x28, 0x0a, 0x05,
+	0x70, 0x6c, 0x61, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x50, 0x6c, 0x61, 0x79, 0x52,
+	0x05, 0x70, 0x6c, 0x61, 0x79, 0x73, 0x22, 0xdc, 0x01, 0x0a, 0x1b, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x65, 0x74,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x74, 0x68, 0x5f, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x74, 0x68, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x13, 0x0a, 0x05, 0x73, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x73, 0x70, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x70, 0x6f, 0x77, 0x65, 0x72, 0x22, 0xdd, 0x01, 0x0a, 0x09, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x50,
+	0x6c, 0x61, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08,
+	0x74, 0x72, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x74, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63,
+	0x69, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x72, 0x79, 0x22, 0xb5, 0x01, 0x0a, 0x09, 0x53, 0x6c, 0x61, 0x52, 0x6f, 0x6c,
+	0x6c, 0x75, 0x70, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1f, 0x0a,
+	0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x6e, 0x64, 0x12, 0x30, 0x0a, 0x07, 0x72,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6c, 0x61, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x22, 0xa9, 0x02,
+	0x0a, 0x0d, 0x53, 0x6c, 0x61, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x6e, 0x75, 0x6d,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x6e, 0x75, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x73, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x12, 0x2f, 0x0a, 0x13, 0x63, 0x68, 0x61,
+	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67,
+	0x65, 0x73, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x68,
+	0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x73, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65,
+	0x73, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x35, 0x0a, 0x16, 0x72, 0x65, 0x61, 0x63, 0x68,
+	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x15, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62,
+	0x6c, 0x65, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x39,
+	0x0a, 0x18, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6f, 0x62,
+	0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x17, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x4f, 0x62, 0x73,
+	0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xeb, 0x01, 0x0a, 0x12, 0x4d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x22, 0x62, 0x0a, 0x22, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x4d, 0x69, 0x73, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x44,
+	0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a,
+	0x0d, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x22, 0xa6, 0x01, 0x0a, 0x0c,
+	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x16, 0x0a, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x29,
+	0x0a, 0x10, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x70,
+	0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x22, 0x48, 0x0a, 0x18, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50,
+	0x72, 0x6f, 0x6f, 0x66, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f,
+	0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0x86,
+	0x02, 0x0a, 0x0d, 0x56, 0x6f, 0x74, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x3c, 0x0a, 0x0e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x6f,
+	0x66, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52,
+	0x0d, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x12, 0x61,
+	0x0a, 0x1b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f,
+	0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x19, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50,
+	0x72, 0x6f, 0x6f, 0x66, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70,
+	0x65, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x61, 0x63,
+	0x68, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x65, 0x65, 0x72, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x75, 0x6e,
+	0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62,
+	0x6c, 0x65, 0x50, 0x65, 0x65, 0x72, 0x73, 0x22, 0x7e, 0x0a, 0x13, 0x53, 0x69, 0x67, 0x6e, 0x65,
+	0x64, 0x56, 0x6f, 0x74, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2b,
+	0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x65,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
+	0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x7c, 0x0a, 0x0e, 0x56, 0x6f, 0x74, 0x65, 0x45,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x3c, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x56, 0x6f, 0x74, 0x65,
+	0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xb7, 0x02, 0x0a, 0x0b, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x58, 0x0a, 0x16, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0xe8, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x15, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x5e, 0x0a, 0x18, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x64, 0x65, 0x72,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0xe9, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x46, 0x0a, 0x10, 0x75, 0x6e, 0x6a, 0x61, 0x69, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x18, 0xea, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x6a, 0x61, 0x69, 0x6c, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x0f, 0x75, 0x6e, 0x6a, 0x61, 0x69, 0x6c, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x06, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22,
+	0x9b, 0x02, 0x0a, 0x15, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x65, 0x6c,
+	0x65, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x57, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x13, 0x0a, 0x05, 0x73,
+	0x70, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x70, 0x49, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x65, 0x74, 0x68, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x65, 0x74, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x23, 0x0a,
+	0x0d, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x70,
+	0x6f, 0x77, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x6f, 0x77, 0x65,
+	0x72, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x73, 0x0a,
+	0x17, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x65,
+	0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x17, 0x0a,
+	0x07, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06,
+	0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69,
+	0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69,
+	0x6e, 0x65, 0x22, 0x6b, 0x0a, 0x0f, 0x55, 0x6e, 0x6a, 0x61, 0x69, 0x6c, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f,
+	0x6d, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x75,
+	0x62, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62,
+	0x4b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x22,
+	0x1b, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x55, 0x0a, 0x1a,
+	0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x09, 0x73, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x09, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x73, 0x22, 0x7a, 0x0a, 0x10, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68,
+	0x61, 0x73, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x22,
+	0x3e, 0x0a, 0x0e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
+	0x97, 0x01, 0x0a, 0x06, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x44, 0x0a, 0x11, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x52, 0x10, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0x3c, 0x0a, 0x11, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27,
+	0x0a, 0x0f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x4a, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a,
+	0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x73, 0x22, 0x4c, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x43, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a,
+	0x15, 0x65, 0x74, 0x68, 0x5f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x65, 0x74,
+	0x68, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x22, 0x47, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x43, 0x6c,
+	0x61, 0x69, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x06,
+	0x63, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x43, 0x6c, 0x61,
+	0x69, 0x6d, 0x52, 0x06, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x22, 0xfc, 0x01, 0x0a, 0x0b, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x15,
+	0x65, 0x74, 0x68, 0x5f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x65, 0x74, 0x68,
+	0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x74, 0x74, 0x65,
+	0x73, 0x74, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x74, 0x74,
+	0x65, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x92, 0x02, 0x0a, 0x1b, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x65, 0x74, 0x68,
+	0x5f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x65, 0x74, 0x68, 0x52, 0x65, 0x63,
+	0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x25, 0x0a,
+	0x0e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6c, 0x61,
+	0x69, 0x6d, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x49, 0x64, 0x22, 0x56,
+	0x0a, 0x1c, 0x47, 0x65, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x41, 0x74, 0x74, 0x65, 0x73,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x65, 0x73,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xc7, 0x01, 0x0a, 0x13, 0x53, 0x6c, 0x61, 0x73, 0x68,
+	0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x65, 0x6e,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x69, 0x73, 0x73,
+	0x65, 0x64, 0x53, 0x4c, 0x41, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x69,
+	0x73, 0x73, 0x65, 0x64, 0x53, 0x4c, 0x41, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x4e, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x41, 0x74, 0x74, 0x65,
+	0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x52, 0x65, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x22, 0x57, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x41, 0x74, 0x74, 0x65,
+	0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0x5c, 0x0a, 0x1b, 0x47, 0x65, 0x74,
+	0x53, 0x6c, 0x61, 0x73, 0x68, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x41, 0x74, 0x74, 0x65,
+	0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x68, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x53, 0x6c,
+	0x61, 0x73, 0x68, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0c, 0x61, 0x74, 0x74, 0x65, 0x73,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6c, 0x61, 0x73, 0x68,
+	0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x52, 0x0c, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0x29, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x45, 0x52, 0x4e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x43, 0x0a, 0x0e,
+	0x47, 0x65, 0x74, 0x45, 0x52, 0x4e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31,
+	0x0a, 0x03, 0x65, 0x72, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x64, 0x64,
+	0x65, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4e, 0x65, 0x77, 0x52, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x03, 0x65, 0x72,
+	0x6e, 0x22, 0x81, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x45, 0x52, 0x4e, 0x58, 0x4d, 0x4c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x2c, 0x0a, 0x12, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61,
+	0x72, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65,
+	0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x12, 0x25,
+	0x0a, 0x0e, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x25, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x45, 0x52, 0x4e, 0x58,
+	0x4d, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x78, 0x6d,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x78, 0x6d, 0x6c, 0x22, 0x84, 0x01, 0x0a,
+	0x14, 0x47, 0x65, 0x74, 0x45, 0x52, 0x4e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x2a, 0x0a, 0x11, 0x64, 0x69, 0x66, 0x66, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x64, 0x69, 0x66, 0x66,
+	0x46, 0x72, 0x6f, 0x6d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x64,
+	0x69, 0x66, 0x66, 0x5f, 0x74, 0x6f, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x64, 0x69, 0x66, 0x66, 0x54, 0x6f, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x22, 0x6e, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x45, 0x52, 0x4e, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x08,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x52, 0x4e, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x24, 0x0a,
+	0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x52, 0x4e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x04, 0x64,
+	0x69, 0x66, 0x66, 0x22, 0xf3, 0x01, 0x0a, 0x0a, 0x45, 0x52, 0x4e, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x52, 0x0a, 0x14,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x64, 0x64, 0x65,
+	0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x12, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x23, 0x0a, 0x0d,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x22, 0x9a, 0x02, 0x0a, 0x07, 0x45, 0x52,
+	0x4e, 0x44, 0x69, 0x66, 0x66, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x66, 0x72, 0x6f,
+	0x6d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x6f,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x64, 0x64, 0x65, 0x64,
+	0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0e, 0x61, 0x64, 0x64, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
+	0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x39, 0x0a,
+	0x0c, 0x64, 0x65, 0x61, 0x6c, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x52,
+	0x4e, 0x44, 0x65, 0x61, 0x6c, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0b, 0x64, 0x65, 0x61,
+	0x6c, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x0d, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x52, 0x4e, 0x54, 0x69, 0x74,
+	0x6c, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0c, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0x5b, 0x0a, 0x0d, 0x45, 0x52, 0x4e, 0x44, 0x65, 0x61,
+	0x6c, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x26, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x61, 0x6c, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12,
+	0x22, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x64, 0x64,
+	0x65, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x61, 0x6c, 0x52,
+	0x02, 0x74, 0x6f, 0x22, 0x52, 0x0a, 0x0e, 0x45, 0x52, 0x4e, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x22, 0x26, 0x0a, 0x12, 0x50, 0x61, 0x72, 0x73, 0x65,
+	0x45, 0x52, 0x4e, 0x58, 0x4d, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x78, 0x6d, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x78, 0x6d, 0x6c, 0x22,
+	0x48, 0x0a, 0x13, 0x50, 0x61, 0x72, 0x73, 0x65, 0x45, 0x52, 0x4e, 0x58, 0x4d, 0x4c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x03, 0x65, 0x72, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x4e, 0x65, 0x77, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x03, 0x65, 0x72, 0x6e, 0x22, 0x59, 0x0a, 0x15, 0x45, 0x52, 0x4e,
+	0x58, 0x4d, 0x4c, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0x2b, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x22, 0x3d, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x70, 0x61, 0x72, 0x74, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x79, 0x52, 0x05, 0x70, 0x61, 0x72, 0x74, 0x79,
+	0x22, 0x2e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x22, 0x49, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x64, 0x65, 0x78,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x2d, 0x0a, 0x11, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x45, 0x0a, 0x12, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2f, 0x0a, 0x07, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x07, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x22, 0x2a, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x39, 0x0a,
+	0x0f, 0x47, 0x65, 0x74, 0x44, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x26, 0x0a, 0x04, 0x64, 0x65, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65,
+	0x61, 0x6c, 0x52, 0x04, 0x64, 0x65, 0x61, 0x6c, 0x22, 0x2a, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4d,
+	0x45, 0x41, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x22, 0x40, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4d, 0x45, 0x41, 0x44, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x04, 0x6d, 0x65, 0x61, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x04, 0x6d, 0x65, 0x61, 0x64, 0x22, 0x29, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x50, 0x49, 0x45,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x22, 0x3c, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x49, 0x45, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x03, 0x70, 0x69, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x50, 0x69, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x03, 0x70, 0x69, 0x65, 0x22,
+	0x30, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x22, 0xeb, 0x02, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x65,
+	0x72, 0x6e, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x65, 0x72, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x4b, 0x0a,
+	0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2d, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d,
+	0x65, 0x61, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00,
+	0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x48, 0x0a, 0x07, 0x72, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x64, 0x64,
+	0x65, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x65, 0x61, 0x64, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x07, 0x72, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x05, 0x70, 0x61, 0x72, 0x74, 0x79, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x50, 0x69, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x50, 0x61,
+	0x72, 0x74, 0x79, 0x48, 0x00, 0x52, 0x05, 0x70, 0x61, 0x72, 0x74, 0x79, 0x12, 0x33, 0x0a, 0x07,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x6d, 0x65,
+	0x6e, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x73, 0x42, 0x0c, 0x0a, 0x0a, 0x65, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x22,
+	0x80, 0x01, 0x0a, 0x10, 0x45, 0x6e, 0x72, 0x69, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12,
+	0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x22, 0xb4, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x18, 0xe8,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x48, 0x00, 0x52, 0x06,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x18, 0xe9, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x48, 0x00,
+	0x52, 0x06, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x06, 0x61, 0x74, 0x74, 0x65,
+	0x73, 0x74, 0x18, 0xea, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x43, 0x6c, 0x61, 0x69, 0x6d, 0x48, 0x00, 0x52, 0x06, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x42,
+	0x08, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xef, 0x01, 0x0a, 0x0c, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x44, 0x0a, 0x11, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x52, 0x10, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x64, 0x65, 0x61,
+	0x64, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69,
+	0x6e, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x7a, 0x0a, 0x0c, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e,
+	0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x93, 0x02, 0x0a, 0x11, 0x41, 0x74, 0x74, 0x65,
+	0x73, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x12, 0x25, 0x0a,
+	0x0e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x49,
+	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12,
+	0x32, 0x0a, 0x15, 0x65, 0x74, 0x68, 0x5f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13,
+	0x65, 0x74, 0x68, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x64,
+	0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x64, 0x65, 0x61, 0x64,
+	0x6c, 0x69, 0x6e, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x44, 0x0a,
+	0x10, 0x47, 0x65, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x74,
+	0x78, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x68,
+	0x61, 0x73, 0x68, 0x22, 0xde, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x11,
+	0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x22, 0xf3, 0x01, 0x0a, 0x1a, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x41,
+	0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x65, 0x74, 0x68, 0x5f, 0x72, 0x65, 0x63, 0x69, 0x70,
+	0x69, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x13, 0x65, 0x74, 0x68, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x25, 0x0a, 0x0e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6c, 0x61, 0x69,
+	0x6d, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x23, 0x0a, 0x0f, 0x55, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x22,
+	0x96, 0x02, 0x0a, 0x0a, 0x46, 0x69, 0x6c, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x29,
+	0x0a, 0x10, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x75, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x70, 0x6c, 0x6f, 0x61,
+	0x64, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x6f, 0x64, 0x65,
+	0x64, 0x5f, 0x63, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x43, 0x69, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xa9, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x55, 0x52, 0x4c, 0x73, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65,
+	0x73, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x36, 0x0a, 0x04,
+	0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x55, 0x52,
+	0x4c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04,
+	0x6d, 0x6f, 0x64, 0x65, 0x22, 0xd5, 0x02, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x73, 0x41, 0x74, 0x12, 0x36, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x22, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x19, 0x0a, 0x08,
+	0x75, 0x73, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x75, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x72, 0x63, 0x69, 0x61, 0x6c, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x72, 0x63, 0x69,
+	0x61, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3f, 0x0a, 0x04, 0x4d,
+	0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x4d, 0x4f, 0x44,
+	0x45, 0x5f, 0x4f, 0x57, 0x4e, 0x45, 0x52, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x4d, 0x4f, 0x44,
+	0x45, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x45, 0x4e, 0x45, 0x52, 0x10, 0x02, 0x22, 0xfd, 0x07, 0x0a,
+	0x15, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x12, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x75, 0x72, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x34, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x55,
+	0x72, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x10, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x55, 0x72, 0x6c, 0x73, 0x12, 0x45, 0x0a, 0x07, 0x64, 0x65,
+	0x6e, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x55,
+	0x52, 0x4c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x65, 0x6e, 0x69,
+	0x61, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x64, 0x65, 0x6e, 0x69, 0x61, 0x6c,
+	0x73, 0x1a, 0x93, 0x01, 0x0a, 0x10, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x55, 0x52, 0x4c, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x72, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x04, 0x75, 0x72, 0x6c, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x72, 0x6e, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x72, 0x6e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x1a, 0xc3, 0x03, 0x0a, 0x0c, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x44, 0x65, 0x6e, 0x69, 0x61, 0x6c, 0x12, 0x4a, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x32, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x55, 0x52, 0x4c, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44,
+	0x65, 0x6e, 0x69, 0x61, 0x6c, 0x2e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x65, 0x72, 0x6e, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x72, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22,
+	0xab, 0x02, 0x0a, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x12, 0x52, 0x45,
+	0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x54,
+	0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x52, 0x45, 0x41, 0x53,
+	0x4f, 0x4e, 0x5f, 0x54, 0x41, 0x4b, 0x45, 0x4e, 0x5f, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0x02, 0x12,
+	0x12, 0x0a, 0x0e, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x5f, 0x44, 0x45, 0x41,
+	0x4c, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x54, 0x45,
+	0x52, 0x52, 0x49, 0x54, 0x4f, 0x52, 0x59, 0x10, 0x04, 0x12, 0x1b, 0x0a, 0x17, 0x52, 0x45, 0x41,
+	0x53, 0x4f, 0x4e, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x4b,
+	0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x05, 0x12, 0x18, 0x0a, 0x14, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e,
+	0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x59, 0x45, 0x54, 0x5f, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x06,
+	0x12, 0x12, 0x0a, 0x0e, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52,
+	0x45, 0x44, 0x10, 0x07, 0x12, 0x1b, 0x0a, 0x17, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x43,
+	0x4f, 0x4d, 0x4d, 0x45, 0x52, 0x43, 0x49, 0x41, 0x4c, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x4c, 0x10,
+	0x08, 0x12, 0x13, 0x0a, 0x0f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x53, 0x45, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x10, 0x09, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e,
+	0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x52, 0x45, 0x4c, 0x45, 0x41, 0x53, 0x45,
+	0x10, 0x0a, 0x12, 0x19, 0x0a, 0x15, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x54,
+	0x5f, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x0b, 0x1a, 0x74, 0x0a,
+	0x15, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x55, 0x72, 0x6c,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x45, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x55, 0x52, 0x4c, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x1a, 0x67, 0x0a, 0x0c, 0x44, 0x65, 0x6e, 0x69, 0x61, 0x6c, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x41, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x55, 0x52, 0x4c, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x65, 0x6e, 0x69, 0x61,
+	0x6c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x29, 0x0a, 0x15,
+	0x47, 0x65, 0x74, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x79, 0x43, 0x49, 0x44, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x22, 0xa5, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x55,
+	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x79, 0x43, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x75, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61,
+	0x6c, 0x5f, 0x63, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x61, 0x6c, 0x43, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x5f, 0x63, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x43, 0x69, 0x64, 0x42,
+	0x32, 0x5a, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x41, 0x75,
+	0x64, 0x69, 0x75, 0x73, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f, 0x61, 0x75, 0x64, 0x69,
+	0x75, 0x73, 0x64, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x72, 0x65,
+	0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_core_v1_types_proto_rawDescOnce sync.Once
+	file_core_v1_types_proto_rawDescData = file_core_v1_types_proto_rawDesc
+)
+
+func file_core_v1_types_proto_rawDescGZIP() []byte {
+	file_core_v1_types_proto_rawDescOnce.Do(func() {
+		file_core_v1_types_proto_rawDescData = protoimpl.X.CompressGZIP(file_core_v1_types_proto_rawDescData)
+	})
+	return file_core_v1_types_proto_rawDescData
+}
+
+var file_core_v1_types_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_core_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 125)
+var file_core_v1_types_proto_goTypes = []interface{}{
+	(GetStatusResponse_ProcessInfo_ProcessState)(0),        // 0: core.v1.GetStatusResponse.ProcessInfo.ProcessState
+	(GetStatusResponse_SyncInfo_StateSyncInfo_Phase)(0),    // 1: core.v1.GetStatusResponse.SyncInfo.StateSyncInfo.Phase
+	(GetStreamURLsRequest_Mode)(0),                         // 2: core.v1.GetStreamURLsRequest.Mode
+	(GetStreamURLsResponse_StreamDenial_Reason)(0),         // 3: core.v1.GetStreamURLsResponse.StreamDenial.Reason
+	(*PingRequest)(nil),                                    // 4: core.v1.PingRequest
+	(*PingResponse)(nil),                                   // 5: core.v1.PingResponse
+	(*GetHealthRequest)(nil),                               // 6: core.v1.GetHealthRequest
+	(*GetHealthResponse)(nil),                              // 7: core.v1.GetHealthResponse
+	(*GetStatusRequest)(nil),                               // 8: core.v1.GetStatusRequest
+	(*GetStatusResponse)(nil),                              // 9: core.v1.GetStatusResponse
+	(*GetNodeInfoRequest)(nil),                             // 10: core.v1.GetNodeInfoRequest
+	(*GetNodeInfoResponse)(nil),                            // 11: core.v1.GetNodeInfoResponse
+	(*GetBlockRequest)(nil),                                // 12: core.v1.GetBlockRequest
+	(*GetBlockResponse)(nil),                               // 13: core.v1.GetBlockResponse
+	(*GetBlocksRequest)(nil),                               // 14: core.v1.GetBlocksRequest
+	(*GetBlocksResponse)(nil),                              // 15: core.v1.GetBlocksResponse
+	(*StreamFilter)(nil),                                   // 16: core.v1.StreamFilter
+	(*StreamBlocksRequest)(nil),                            // 17: core.v1.StreamBlocksRequest
+	(*StreamBlocksResponse)(nil),                           // 18: core.v1.StreamBlocksResponse
+	(*StreamTransactionsRequest)(nil),                      // 19: core.v1.StreamTransactionsRequest
+	(*StreamTransactionsResponse)(nil),                     // 20: core.v1.StreamTransactionsResponse
+	(*GetTransactionRequest)(nil),                          // 21: core.v1.GetTransactionRequest
+	(*GetTransactionResponse)(nil),                         // 22: core.v1.GetTransactionResponse
+	(*SendTransactionRequest)(nil),                         // 23: core.v1.SendTransactionRequest
+	(*SendTransactionResponse)(nil),                        // 24: core.v1.SendTransactionResponse
+	(*ForwardTransactionRequest)(nil),                      // 25: core.v1.ForwardTransactionRequest
+	(*ForwardTransactionResponse)(nil),                     // 26: core.v1.ForwardTransactionResponse
+	(*GetRegistrationAttestationRequest)(nil),              // 27: core.v1.GetRegistrationAttestationRequest
+	(*GetRegistrationAttestationResponse)(nil),             // 28: core.v1.GetRegistrationAttestationResponse
+	(*GetDeregistrationAttestationRequest)(nil),            // 29: core.v1.GetDeregistrationAttestationRequest
+	(*GetDeregistrationAttestationResponse)(nil),           // 30: core.v1.GetDeregistrationAttestationResponse
+	(*GetUnjailAttestationRequest)(nil),                    // 31: core.v1.GetUnjailAttestationRequest
+	(*GetUnjailAttestationResponse)(nil),                   // 32: core.v1.GetUnjailAttestationResponse
+	(*Block)(nil),                                          // 33: core.v1.Block
+	(*Transaction)(nil),                                    // 34: core.v1.Transaction
+	(*SignedTransaction)(nil),                              // 35: core.v1.SignedTransaction
+	(*TrackPlays)(nil),                                     // 36: core.v1.TrackPlays
+	(*ValidatorRegistrationLegacy)(nil),                    // 37: core.v1.ValidatorRegistrationLegacy
+	(*TrackPlay)(nil),                                      // 38: core.v1.TrackPlay
+	(*SlaRollup)(nil),                                      // 39: core.v1.SlaRollup
+	(*SlaNodeReport)(nil),                                  // 40: core.v1.SlaNodeReport
+	(*ManageEntityLegacy)(nil),                             // 41: core.v1.ManageEntityLegacy
+	(*ValidatorMisbehaviorDeregistration)(nil),             // 42: core.v1.ValidatorMisbehaviorDeregistration
+	(*StorageProof)(nil),                                   // 43: core.v1.StorageProof
+	(*StorageProofVerification)(nil),                       // 44: core.v1.StorageProofVerification
+	(*VoteExtension)(nil),                                  // 45: core.v1.VoteExtension
+	(*SignedVoteExtension)(nil),                            // 46: core.v1.SignedVoteExtension
+	(*VoteExtensions)(nil),                                 // 47: core.v1.VoteExtensions
+	(*Attestation)(nil),                                    // 48: core.v1.Attestation
+	(*ValidatorRegistration)(nil),                          // 49: core.v1.ValidatorRegistration
+	(*ValidatorDeregistration)(nil),                        // 50: core.v1.ValidatorDeregistration
+	(*UnjailValidator)(nil),                                // 51: core.v1.UnjailValidator
+	(*GetStoredSnapshotsRequest)(nil),                      // 52: core.v1.GetStoredSnapshotsRequest
+	(*GetStoredSnapshotsResponse)(nil),                     // 53: core.v1.GetStoredSnapshotsResponse
+	(*SnapshotMetadata)(nil),                               // 54: core.v1.SnapshotMetadata
+	(*ClaimAuthority)(nil),                                 // 55: core.v1.ClaimAuthority
+	(*Reward)(nil),                                         // 56: core.v1.Reward
+	(*GetRewardsRequest)(nil),                              // 57: core.v1.GetRewardsRequest
+	(*GetRewardsResponse)(nil),                             // 58: core.v1.GetRewardsResponse
+	(*GetRewardClaimsRequest)(nil),                         // 59: core.v1.GetRewardClaimsRequest
+	(*GetRewardClaimsResponse)(nil),                        // 60: core.v1.GetRewardClaimsResponse
+	(*RewardClaim)(nil),                                    // 61: core.v1.RewardClaim
+	(*GetRewardAttestationRequest)(nil),                    // 62: core.v1.GetRewardAttestationRequest
+	(*GetRewardAttestationResponse)(nil),                   // 63: core.v1.GetRewardAttestationResponse
+	(*SlashRecommendation)(nil),                            // 64: core.v1.SlashRecommendation
+	(*GetSlashAttestationRequest)(nil),                     // 65: core.v1.GetSlashAttestationRequest
+	(*GetSlashAttestationResponse)(nil),                    // 66: core.v1.GetSlashAttestationResponse
+	(*GetSlashAttestationsRequest)(nil),                    // 67: core.v1.GetSlashAttestationsRequest
+	(*GetSlashAttestationsResponse)(nil),                   // 68: core.v1.GetSlashAttestationsResponse
+	(*GetERNRequest)(nil),                                  // 69: core.v1.GetERNRequest
+	(*GetERNResponse)(nil),                                 // 70: core.v1.GetERNResponse
+	(*GetERNXMLRequest)(nil),                               // 71: core.v1.GetERNXMLRequest
+	(*GetERNXMLResponse)(nil),                              // 72: core.v1.GetERNXMLResponse
+	(*GetERNHistoryRequest)(nil),                           // 73: core.v1.GetERNHistoryRequest
+	(*GetERNHistoryResponse)(nil),                          // 74: core.v1.GetERNHistoryResponse
+	(*ERNVersion)(nil),                                     // 75: core.v1.ERNVersion
+	(*ERNDiff)(nil),                                        // 76: core.v1.ERNDiff
+	(*ERNDealChange)(nil),                                  // 77: core.v1.ERNDealChange
+	(*ERNTitleChange)(nil),                                 // 78: core.v1.ERNTitleChange
+	(*ParseERNXMLRequest)(nil),                             // 79: core.v1.ParseERNXMLRequest
+	(*ParseERNXMLResponse)(nil),                            // 80: core.v1.ParseERNXMLResponse
+	(*ERNXMLValidationError)(nil),                          // 81: core.v1.ERNXMLValidationError
+	(*GetPartyRequest)(nil),                                // 82: core.v1.GetPartyRequest
+	(*GetPartyResponse)(nil),                               // 83: core.v1.GetPartyResponse
+	(*GetResourceRequest)(nil),                             // 84: core.v1.GetResourceRequest
+	(*GetResourceResponse)(nil),                            // 85: core.v1.GetResourceResponse
+	(*GetReleaseRequest)(nil),                              // 86: core.v1.GetReleaseRequest
+	(*GetReleaseResponse)(nil),                             // 87: core.v1.GetReleaseResponse
+	(*GetDealRequest)(nil),                                 // 88: core.v1.GetDealRequest
+	(*GetDealResponse)(nil),                                // 89: core.v1.GetDealResponse
+	(*GetMEADRequest)(nil),                                 // 90: core.v1.GetMEADRequest
+	(*GetMEADResponse)(nil),                                // 91: core.v1.GetMEADResponse
+	(*GetPIERequest)(nil),                                  // 92: core.v1.GetPIERequest
+	(*GetPIEResponse)(nil),                                 // 93: core.v1.GetPIEResponse
+	(*GetEnrichmentRequest)(nil),                           // 94: core.v1.GetEnrichmentRequest
+	(*GetEnrichmentResponse)(nil),                          // 95: core.v1.GetEnrichmentResponse
+	(*EnrichmentSource)(nil),                               // 96: core.v1.EnrichmentSource
+	(*RewardMessage)(nil),                                  // 97: core.v1.RewardMessage
+	(*CreateReward)(nil),                                   // 98: core.v1.CreateReward
+	(*DeleteReward)(nil),                                   // 99: core.v1.DeleteReward
+	(*AttestRewardClaim)(nil),                              // 100: core.v1.AttestRewardClaim
+	(*GetRewardRequest)(nil),                               // 101: core.v1.GetRewardRequest
+	(*GetRewardResponse)(nil),                              // 102: core.v1.GetRewardResponse
+	(*RewardAttestationSignature)(nil),                     // 103: core.v1.RewardAttestationSignature
+	(*UploadSignature)(nil),                                // 104: core.v1.UploadSignature
+	(*FileUpload)(nil),                                     // 105: core.v1.FileUpload
+	(*GetStreamURLsSignature)(nil),                         // 106: core.v1.GetStreamURLsSignature
+	(*GetStreamURLsRequest)(nil),                           // 107: core.v1.GetStreamURLsRequest
+	(*GetStreamURLsResponse)(nil),                          // 108: core.v1.GetStreamURLsResponse
+	(*GetUploadByCIDRequest)(nil),                          // 109: core.v1.GetUploadByCIDRequest
+	(*GetUploadByCIDResponse)(nil),                         // 110: core.v1.GetUploadByCIDResponse
+	(*GetStatusResponse_ProcessInfo)(nil),                  // 111: core.v1.GetStatusResponse.ProcessInfo
+	(*GetStatusResponse_NodeInfo)(nil),                     // 112: core.v1.GetStatusResponse.NodeInfo
+	(*GetStatusResponse_ChainInfo)(nil),                    // 113: core.v1.GetStatusResponse.ChainInfo
+	(*GetStatusResponse_SyncInfo)(nil),                     // 114: core.v1.GetStatusResponse.SyncInfo
+	(*GetStatusResponse_PruningInfo)(nil),                  // 115: core.v1.GetStatusResponse.PruningInfo
+	(*GetStatusResponse_ResourceInfo)(nil),                 // 116: core.v1.GetStatusResponse.ResourceInfo
+	(*GetStatusResponse_MempoolInfo)(nil),                  // 117: core.v1.GetStatusResponse.MempoolInfo
+	(*GetStatusResponse_SnapshotInfo)(nil),                 // 118: core.v1.GetStatusResponse.SnapshotInfo
+	(*GetStatusResponse_PeerInfo)(nil),                     // 119: core.v1.GetStatusResponse.PeerInfo
+	(*GetStatusResponse_ProcessInfo_ProcessStateInfo)(nil), // 120: core.v1.GetStatusResponse.ProcessInfo.ProcessStateInfo
+	(*GetStatusResponse_SyncInfo_StateSyncInfo)(nil),       // 121: core.v1.GetStatusResponse.SyncInfo.StateSyncInfo
+	(*GetStatusResponse_SyncInfo_BlockSyncInfo)(nil),       // 122: core.v1.GetStatusResponse.SyncInfo.BlockSyncInfo
+	(*GetStatusResponse_PeerInfo_Peer)(nil),                // 123: core.v1.GetStatusResponse.PeerInfo.Peer
+	nil,                                                    // 124: core.v1.GetBlocksResponse.BlocksEntry
+	(*GetStreamURLsResponse_EntityStreamURLs)(nil),         // 125: core.v1.GetStreamURLsResponse.EntityStreamURLs
+	(*GetStreamURLsResponse_StreamDenial)(nil),             // 126: core.v1.GetStreamURLsResponse.StreamDenial
+	nil,                                              // 127: core.v1.GetStreamURLsResponse.EntityStreamUrlsEntry
+	nil,                                              // 128: core.v1.GetStreamURLsResponse.DenialsEntry
+	(*v1beta1.Transaction)(nil),                      // 129: core.v1beta1.Transaction
+	(*v1beta1.TransactionReceipt)(nil),               // 130: core.v1beta1.TransactionReceipt
+	(*timestamppb.Timestamp)(nil),                    // 131: google.protobuf.Timestamp
+	(*v1beta11.NewReleaseMessage)(nil),               // 132: ddex.v1beta1.NewReleaseMessage
+	(v1beta11.MessageControlType)(0),                 // 133: ddex.v1beta1.MessageControlType
+	(*v1beta11.Deal)(nil),                            // 134: ddex.v1beta1.Deal
+	(*v1beta11.Party)(nil),                           // 135: ddex.v1beta1.Party
+	(*v1beta11.Resource)(nil),                        // 136: ddex.v1beta1.Resource
+	(*v1beta11.Release)(nil),                         // 137: ddex.v1beta1.Release
+	(*v1beta11.MeadMessage)(nil),                     // 138: ddex.v1beta1.MeadMessage
+	(*v1beta11.PieMessage)(nil),                      // 139: ddex.v1beta1.PieMessage
+	(*v1beta11.MeadMessage_ResourceInformation)(nil), // 140: ddex.v1beta1.MeadMessage.ResourceInformation
+	(*v1beta11.MeadMessage_ReleaseInformation)(nil),  // 141: ddex.v1beta1.MeadMessage.ReleaseInformation
+	(*v1beta11.PieMessage_Party)(nil),                // 142: ddex.v1beta1.PieMessage.Party
+}
+var file_core_v1_types_proto_depIdxs = []int32{
+	112, // 0: core.v1.GetStatusResponse.node_info:type_name -> core.v1.GetStatusResponse.NodeInfo
+	113, // 1: core.v1.GetStatusResponse.chain_info:type_name -> core.v1.GetStatusResponse.ChainInfo
+	114, // 2: core.v1.GetStatusResponse.sync_info:type_name -> core.v1.GetStatusResponse.SyncInfo
+	115, // 3: core.v1.GetStatusResponse.pruning_info:type_name -> core.v1.GetStatusResponse.PruningInfo
+	116, // 4: core.v1.GetStatusResponse.resource_info:type_name -> core.v1.GetStatusResponse.ResourceInfo
+	117, // 5: core.v1.GetStatusResponse.mempool_info:type_name -> core.v1.GetStatusResponse.MempoolInfo
+	119, // 6: core.v1.GetStatusResponse.peers:type_name -> core.v1.GetStatusResponse.PeerInfo
+	118, // 7: core.v1.GetStatusResponse.snapshot_info:type_name -> core.v1.GetStatusResponse.SnapshotInfo
+	111, // 8: core.v1.GetStatusResponse.process_info:type_name -> core.v1.GetStatusResponse.ProcessInfo
+	33,  // 9: core.v1.GetBlockResponse.block:type_name -> core.v1.Block
+	124, // 10: core.v1.GetBlocksResponse.blocks:type_name -> core.v1.GetBlocksResponse.BlocksEntry
+	16,  // 11: core.v1.StreamBlocksRequest.filter:type_name -> core.v1.StreamFilter
+	33,  // 12: core.v1.StreamBlocksResponse.block:type_name -> core.v1.Block
+	16,  // 13: core.v1.StreamTransactionsRequest.filter:type_name -> core.v1.StreamFilter
+	34,  // 14: core.v1.StreamTransactionsResponse.transaction:type_name -> core.v1.Transaction
+	34,  // 15: core.v1.GetTransactionResponse.transaction:type_name -> core.v1.Transaction
+	35,  // 16: core.v1.SendTransactionRequest.transaction:type_name -> core.v1.SignedTransaction
+	129, // 17: core.v1.SendTransactionRequest.transactionv2:type_name -> core.v1beta1.Transaction
+	34,  // 18: core.v1.SendTransactionResponse.transaction:type_name -> core.v1.Transaction
+	130, // 19: core.v1.SendTransactionResponse.transaction_receipt:type_name -> core.v1beta1.TransactionReceipt
+	35,  // 20: core.v1.ForwardTransactionRequest.transaction:type_name -> core.v1.SignedTransaction
+	129, // 21: core.v1.ForwardTransactionRequest.transactionv2:type_name -> core.v1beta1.Transaction
+	49,  // 22: core.v1.GetRegistrationAttestationRequest.registration:type_name -> core.v1.ValidatorRegistration
+	49,  // 23: core.v1.GetRegistrationAttestationResponse.registration:type_name -> core.v1.ValidatorRegistration
+	50,  // 24: core.v1.GetDeregistrationAttestationRequest.deregistration:type_name -> core.v1.ValidatorDeregistration
+	50,  // 25: core.v1.GetDeregistrationAttestationResponse.deregistration:type_name -> core.v1.ValidatorDeregistration
+	51,  // 26: core.v1.GetUnjailAttestationRequest.unjail:type_name -> core.v1.UnjailValidator
+	51,  // 27: core.v1.GetUnjailAttestationResponse.unjail:type_name -> core.v1.UnjailValidator
+	131, // 28: core.v1.Block.timestamp:type_name -> google.protobuf.Timestamp
+	34,  // 29: core.v1.Block.transactions:type_name -> core.v1.Transaction
+	35,  // 30: core.v1.Transaction.transaction:type_name -> core.v1.SignedTransaction
+	131, // 31: core.v1.Transaction.timestamp:type_name -> google.protobuf.Timestamp
+	129, // 32: core.v1.Transaction.transactionv2:type_name -> core.v1beta1.Transaction
+	130, // 33: core.v1.Transaction.transaction_receipt:type_name -> core.v1beta1.TransactionReceipt
+	36,  // 34: core.v1.SignedTransaction.plays:type_name -> core.v1.TrackPlays
+	37,  // 35: core.v1.SignedTransaction.validator_registration:type_name -> core.v1.ValidatorRegistrationLegacy
+	39,  // 36: core.v1.SignedTransaction.sla_rollup:type_name -> core.v1.SlaRollup
+	41,  // 37: core.v1.SignedTransaction.manage_entity:type_name -> core.v1.ManageEntityLegacy
+	42,  // 38: core.v1.SignedTransaction.validator_deregistration:type_name -> core.v1.ValidatorMisbehaviorDeregistration
+	43,  // 39: core.v1.SignedTransaction.storage_proof:type_name -> core.v1.StorageProof
+	44,  // 40: core.v1.SignedTransaction.storage_proof_verification:type_name -> core.v1.StorageProofVerification
+	48,  // 41: core.v1.SignedTransaction.attestation:type_name -> core.v1.Attestation
+	132, // 42: core.v1.SignedTransaction.release:type_name -> ddex.v1beta1.NewReleaseMessage
+	97,  // 43: core.v1.SignedTransaction.reward:type_name -> core.v1.RewardMessage
+	105, // 44: core.v1.SignedTransaction.file_upload:type_name -> core.v1.FileUpload
+	47,  // 45: core.v1.SignedTransaction.vote_extensions:type_name -> core.v1.VoteExtensions
+	38,  // 46: core.v1.TrackPlays.plays:type_name -> core.v1.TrackPlay
+	131, // 47: core.v1.TrackPlay.timestamp:type_name -> google.protobuf.Timestamp
+	131, // 48: core.v1.SlaRollup.timestamp:type_name -> google.protobuf.Timestamp
+	40,  // 49: core.v1.SlaRollup.reports:type_name -> core.v1.SlaNodeReport
+	43,  // 50: core.v1.VoteExtension.storage_proofs:type_name -> core.v1.StorageProof
+	44,  // 51: core.v1.VoteExtension.storage_proof_verifications:type_name -> core.v1.StorageProofVerification
+	46,  // 52: core.v1.VoteExtensions.extensions:type_name -> core.v1.SignedVoteExtension
+	49,  // 53: core.v1.Attestation.validator_registration:type_name -> core.v1.ValidatorRegistration
+	50,  // 54: core.v1.Attestation.validator_deregistration:type_name -> core.v1.ValidatorDeregistration
+	51,  // 55: core.v1.Attestation.unjail_validator:type_name -> core.v1.UnjailValidator
+	54,  // 56: core.v1.GetStoredSnapshotsResponse.snapshots:type_name -> core.v1.SnapshotMetadata
+	55,  // 57: core.v1.Reward.claim_authorities:type_name -> core.v1.ClaimAuthority
+	102, // 58: core.v1.GetRewardsResponse.rewards:type_name -> core.v1.GetRewardResponse
+	61,  // 59: core.v1.GetRewardClaimsResponse.claims:type_name -> core.v1.RewardClaim
+	131, // 60: core.v1.SlashRecommendation.start:type_name -> google.protobuf.Timestamp
+	131, // 61: core.v1.SlashRecommendation.end:type_name -> google.protobuf.Timestamp
+	64,  // 62: core.v1.GetSlashAttestationRequest.data:type_name -> core.v1.SlashRecommendation
+	65,  // 63: core.v1.GetSlashAttestationsRequest.request:type_name -> core.v1.GetSlashAttestationRequest
+	66,  // 64: core.v1.GetSlashAttestationsResponse.attestations:type_name -> core.v1.GetSlashAttestationResponse
+	132, // 65: core.v1.GetERNResponse.ern:type_name -> ddex.v1beta1.NewReleaseMessage
+	75,  // 66: core.v1.GetERNHistoryResponse.versions:type_name -> core.v1.ERNVersion
+	76,  // 67: core.v1.GetERNHistoryResponse.diff:type_name -> core.v1.ERNDiff
+	133, // 68: core.v1.ERNVersion.message_control_type:type_name -> ddex.v1beta1.MessageControlType
+	77,  // 69: core.v1.ERNDiff.deal_changes:type_name -> core.v1.ERNDealChange
+	78,  // 70: core.v1.ERNDiff.title_changes:type_name -> core.v1.ERNTitleChange
+	134, // 71: core.v1.ERNDealChange.from:type_name -> ddex.v1beta1.Deal
+	134, // 72: core.v1.ERNDealChange.to:type_name -> ddex.v1beta1.Deal
+	132, // 73: core.v1.ParseERNXMLResponse.ern:type_name -> ddex.v1beta1.NewReleaseMessage
+	135, // 74: core.v1.GetPartyResponse.party:type_name -> ddex.v1beta1.Party
+	136, // 75: core.v1.GetResourceResponse.resource:type_name -> ddex.v1beta1.Resource
+	137, // 76: core.v1.GetReleaseResponse.release:type_name -> ddex.v1beta1.Release
+	134, // 77: core.v1.GetDealResponse.deal:type_name -> ddex.v1beta1.Deal
+	138, // 78: core.v1.GetMEADResponse.mead:type_name -> ddex.v1beta1.MeadMessage
+	139, // 79: core.v1.GetPIEResponse.pie:type_name -> ddex.v1beta1.PieMessage
+	140, // 80: core.v1.GetEnrichmentResponse.resource:type_name -> ddex.v1beta1.MeadMessage.ResourceInformation
+	141, // 81: core.v1.GetEnrichmentResponse.release:type_name -> ddex.v1beta1.MeadMessage.ReleaseInformation
+	142, // 82: core.v1.GetEnrichmentResponse.party:type_name -> ddex.v1beta1.PieMessage.Party
+	96,  // 83: core.v1.GetEnrichmentResponse.sources:type_name -> core.v1.EnrichmentSource
+	98,  // 84: core.v1.RewardMessage.create:type_name -> core.v1.CreateReward
+	99,  // 85: core.v1.RewardMessage.delete:type_name -> core.v1.DeleteReward
+	100, // 86: core.v1.RewardMessage.attest:type_name -> core.v1.AttestRewardClaim
+	55,  // 87: core.v1.CreateReward.claim_authorities:type_name -> core.v1.ClaimAuthority
+	131, // 88: core.v1.GetStreamURLsSignature.expires_at:type_name -> google.protobuf.Timestamp
+	2,   // 89: core.v1.GetStreamURLsSignature.mode:type_name -> core.v1.GetStreamURLsRequest.Mode
+	131, // 90: core.v1.GetStreamURLsRequest.expires_at:type_name -> google.protobuf.Timestamp
+	2,   // 91: core.v1.GetStreamURLsRequest.mode:type_name -> core.v1.GetStreamURLsRequest.Mode
+	127, // 92: core.v1.GetStreamURLsResponse.entity_stream_urls:type_name -> core.v1.GetStreamURLsResponse.EntityStreamUrlsEntry
+	128, // 93: core.v1.GetStreamURLsResponse.denials:type_name -> core.v1.GetStreamURLsResponse.DenialsEntry
+	120, // 94: core.v1.GetStatusResponse.ProcessInfo.abci:type_name -> core.v1.GetStatusResponse.ProcessInfo.ProcessStateInfo
+	120, // 95: core.v1.GetStatusResponse.ProcessInfo.registry_bridge:type_name -> core.v1.GetStatusResponse.ProcessInfo.ProcessStateInfo
+	120, // 96: core.v1.GetStatusResponse.ProcessInfo.echo_server:type_name -> core.v1.GetStatusResponse.ProcessInfo.ProcessStateInfo
+	120, // 97: core.v1.GetStatusResponse.ProcessInfo.sync_tasks:type_name -> core.v1.GetStatusResponse.ProcessInfo.ProcessStateInfo
+	120, // 98: core.v1.GetStatusResponse.ProcessInfo.peer_manager:type_name -> core.v1.GetStatusResponse.ProcessInfo.ProcessStateInfo
+	120, // 99: core.v1.GetStatusResponse.ProcessInfo.data_companion:type_name -> core.v1.GetStatusResponse.ProcessInfo.ProcessStateInfo
+	120, // 100: core.v1.GetStatusResponse.ProcessInfo.cache:type_name -> core.v1.GetStatusResponse.ProcessInfo.ProcessStateInfo
+	120, // 101: core.v1.GetStatusResponse.ProcessInfo.log_sync:type_name -> core.v1.GetStatusResponse.ProcessInfo.ProcessStateInfo
+	120, // 102: core.v1.GetStatusResponse.ProcessInfo.state_sync:type_name -> core.v1.GetStatusResponse.ProcessInfo.ProcessStateInfo
+	120, // 103: core.v1.GetStatusResponse.ProcessInfo.mempool_cache:type_name -> core.v1.GetStatusResponse.ProcessInfo.ProcessStateInfo
+	121, // 104: core.v1.GetStatusResponse.SyncInfo.state_sync:type_name -> core.v1.GetStatusResponse.SyncInfo.StateSyncInfo
+	122, // 105: core.v1.GetStatusResponse.SyncInfo.block_sync:type_name -> core.v1.GetStatusResponse.SyncInfo.BlockSyncInfo
+	54,  // 106: core.v1.GetStatusResponse.SnapshotInfo.snapshots:type_name -> core.v1.SnapshotMetadata
+	123, // 107: core.v1.GetStatusResponse.PeerInfo.peers:type_name -> core.v1.GetStatusResponse.PeerInfo.Peer
+	0,   // 108: core.v1.GetStatusResponse.ProcessInfo.ProcessStateInfo.state:type_name -> core.v1.GetStatusResponse.ProcessInfo.ProcessState
+	131, // 109: core.v1.GetStatusResponse.ProcessInfo.ProcessStateInfo.started_at:type_name -> google.protobuf.Timestamp
+	131, // 110: core.v1.GetStatusResponse.ProcessInfo.ProcessStateInfo.completed_at:type_name -> google.protobuf.Timestamp
+	1,   // 111: core.v1.GetStatusResponse.SyncInfo.StateSyncInfo.phase:type_name -> core.v1.GetStatusResponse.SyncInfo.StateSyncInfo.Phase
+	54,  // 112: core.v1.GetStatusResponse.SyncInfo.StateSyncInfo.snapshot:type_name -> core.v1.SnapshotMetadata
+	131, // 113: core.v1.GetStatusResponse.SyncInfo.StateSyncInfo.started_at:type_name -> google.protobuf.Timestamp
+	131, // 114: core.v1.GetStatusResponse.SyncInfo.StateSyncInfo.completed_at:type_name -> google.protobuf.Timestamp
+	112, // 115: core.v1.GetStatusResponse.SyncInfo.BlockSyncInfo.head_source:type_name -> core.v1.GetStatusResponse.NodeInfo
+	131, // 116: core.v1.GetStatusResponse.SyncInfo.BlockSyncInfo.started_at:type_name -> google.protobuf.Timestamp
+	131, // 117: core.v1.GetStatusResponse.SyncInfo.BlockSyncInfo.completed_at:type_name -> google.protobuf.Timestamp
+	33,  // 118: core.v1.GetBlocksResponse.BlocksEntry.value:type_name -> core.v1.Block
+	3,   // 119: core.v1.GetStreamURLsResponse.StreamDenial.reason:type_name -> core.v1.GetStreamURLsResponse.StreamDenial.Reason
+	125, // 120: core.v1.GetStreamURLsResponse.EntityStreamUrlsEntry.value:type_name -> core.v1.GetStreamURLsResponse.EntityStreamURLs
+	126, // 121: core.v1.GetStreamURLsResponse.DenialsEntry.value:type_name -> core.v1.GetStreamURLsResponse.StreamDenial
+	122, // [122:122] is the sub-list for method output_type
+	122, // [122:122] is the sub-list for method input_type
+	122, // [122:122] is the sub-list for extension type_name
+	122, // [122:122] is the sub-list for extension extendee
+	0,   // [0:122] is the sub-list for field type_name
 }
 
 func init() { file_core_v1_types_proto_init() }
@@ -7267,8 +9970,116 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PingResponse); i {
+		file_core_v1_types_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_core_v1_types_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHealthRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_core_v1_types_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHealthResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_core_v1_types_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_core_v1_types_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_core_v1_types_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNodeInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_core_v1_types_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNodeInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_core_v1_types_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlockRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_core_v1_types_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlockResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_core_v1_types_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlocksRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7279,8 +10090,8 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetHealthRequest); i {
+		file_core_v1_types_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlocksResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7291,8 +10102,8 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetHealthResponse); i {
+		file_core_v1_types_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamFilter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7303,8 +10114,8 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetStatusRequest); i {
+		file_core_v1_types_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamBlocksRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7315,8 +10126,248 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetStatusResponse); i {
+		file_core_v1_types_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamBlocksResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_core_v1_types_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamTransactionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_core_v1_types_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamTransactionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_core_v1_types_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTransactionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_core_v1_types_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTransactionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_core_v1_types_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendTransactionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_core_v1_types_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendTransactionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_core_v1_types_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForwardTransactionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_core_v1_types_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForwardTransactionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_core_v1_types_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRegistrationAttestationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_core_v1_types_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRegistrationAttestationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_core_v1_types_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDeregistrationAttestationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_core_v1_types_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDeregistrationAttestationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_core_v1_types_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUnjailAttestationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_core_v1_types_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUnjailAttestationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_core_v1_types_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Block); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_core_v1_types_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Transaction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_core_v1_types_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignedTransaction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_core_v1_types_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrackPlays); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_core_v1_types_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorRegistrationLegacy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_core_v1_types_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrackPlay); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7327,8 +10378,8 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetNodeInfoRequest); i {
+		file_core_v1_types_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SlaRollup); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7339,8 +10390,8 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetNodeInfoResponse); i {
+		file_core_v1_types_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SlaNodeReport); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7351,8 +10402,8 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBlockRequest); i {
+		file_core_v1_types_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ManageEntityLegacy); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7363,8 +10414,8 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBlockResponse); i {
+		file_core_v1_types_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorMisbehaviorDeregistration); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7375,8 +10426,8 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBlocksRequest); i {
+		file_core_v1_types_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StorageProof); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7387,8 +10438,8 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBlocksResponse); i {
+		file_core_v1_types_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StorageProofVerification); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7399,8 +10450,8 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTransactionRequest); i {
+		file_core_v1_types_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VoteExtension); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7411,8 +10462,8 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTransactionResponse); i {
+		file_core_v1_types_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignedVoteExtension); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7423,8 +10474,8 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendTransactionRequest); i {
+		file_core_v1_types_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VoteExtensions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7435,8 +10486,8 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendTransactionResponse); i {
+		file_core_v1_types_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Attestation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7447,8 +10498,8 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ForwardTransactionRequest); i {
+		file_core_v1_types_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorRegistration); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7459,8 +10510,8 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ForwardTransactionResponse); i {
+		file_core_v1_types_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorDeregistration); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7471,8 +10522,8 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRegistrationAttestationRequest); i {
+		file_core_v1_types_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnjailValidator); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7483,8 +10534,8 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRegistrationAttestationResponse); i {
+		file_core_v1_types_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStoredSnapshotsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7495,8 +10546,8 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDeregistrationAttestationRequest); i {
+		file_core_v1_types_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStoredSnapshotsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7507,8 +10558,8 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDeregistrationAttestationResponse); i {
+		file_core_v1_types_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnapshotMetadata); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7519,8 +10570,8 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Block); i {
+		file_core_v1_types_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClaimAuthority); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7531,8 +10582,8 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Transaction); i {
+		file_core_v1_types_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Reward); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7543,8 +10594,8 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignedTransaction); i {
+		file_core_v1_types_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRewardsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7555,8 +10606,8 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TrackPlays); i {
+		file_core_v1_types_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRewardsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7567,8 +10618,8 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidatorRegistrationLegacy); i {
+		file_core_v1_types_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRewardClaimsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7579,8 +10630,8 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TrackPlay); i {
+		file_core_v1_types_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRewardClaimsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7591,8 +10642,8 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SlaRollup); i {
+		file_core_v1_types_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RewardClaim); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7603,8 +10654,8 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SlaNodeReport); i {
+		file_core_v1_types_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRewardAttestationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7615,8 +10666,8 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ManageEntityLegacy); i {
+		file_core_v1_types_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRewardAttestationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7627,8 +10678,8 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidatorMisbehaviorDeregistration); i {
+		file_core_v1_types_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SlashRecommendation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7639,8 +10690,8 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StorageProof); i {
+		file_core_v1_types_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSlashAttestationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7651,8 +10702,8 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StorageProofVerification); i {
+		file_core_v1_types_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSlashAttestationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7663,8 +10714,8 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Attestation); i {
+		file_core_v1_types_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSlashAttestationsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7675,8 +10726,8 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidatorRegistration); i {
+		file_core_v1_types_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSlashAttestationsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7687,8 +10738,8 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidatorDeregistration); i {
+		file_core_v1_types_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetERNRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7699,8 +10750,8 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetStoredSnapshotsRequest); i {
+		file_core_v1_types_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetERNResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7711,8 +10762,8 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetStoredSnapshotsResponse); i {
+		file_core_v1_types_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetERNXMLRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7723,8 +10774,8 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SnapshotMetadata); i {
+		file_core_v1_types_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetERNXMLResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7735,8 +10786,8 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClaimAuthority); i {
+		file_core_v1_types_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetERNHistoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7747,8 +10798,8 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Reward); i {
+		file_core_v1_types_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetERNHistoryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7759,8 +10810,8 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRewardsRequest); i {
+		file_core_v1_types_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ERNVersion); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7771,8 +10822,8 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRewardsResponse); i {
+		file_core_v1_types_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ERNDiff); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7783,8 +10834,8 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRewardAttestationRequest); i {
+		file_core_v1_types_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ERNDealChange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7795,8 +10846,8 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRewardAttestationResponse); i {
+		file_core_v1_types_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ERNTitleChange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7807,8 +10858,8 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SlashRecommendation); i {
+		file_core_v1_types_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParseERNXMLRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7819,8 +10870,8 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSlashAttestationRequest); i {
+		file_core_v1_types_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParseERNXMLResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7831,8 +10882,8 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSlashAttestationResponse); i {
+		file_core_v1_types_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ERNXMLValidationError); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7843,8 +10894,8 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSlashAttestationsRequest); i {
+		file_core_v1_types_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPartyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7855,8 +10906,8 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSlashAttestationsResponse); i {
+		file_core_v1_types_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPartyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7867,8 +10918,8 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetERNRequest); i {
+		file_core_v1_types_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetResourceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7879,8 +10930,8 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetERNResponse); i {
+		file_core_v1_types_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetResourceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7891,8 +10942,8 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPartyRequest); i {
+		file_core_v1_types_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetReleaseRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7903,8 +10954,8 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPartyResponse); i {
+		file_core_v1_types_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetReleaseResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7915,8 +10966,8 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetResourceRequest); i {
+		file_core_v1_types_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDealRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7927,8 +10978,8 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetResourceResponse); i {
+		file_core_v1_types_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDealResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7939,8 +10990,8 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetReleaseRequest); i {
+		file_core_v1_types_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMEADRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7951,8 +11002,8 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetReleaseResponse); i {
+		file_core_v1_types_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMEADResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7963,8 +11014,8 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDealRequest); i {
+		file_core_v1_types_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPIERequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7975,8 +11026,8 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDealResponse); i {
+		file_core_v1_types_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPIEResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7987,8 +11038,8 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMEADRequest); i {
+		file_core_v1_types_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEnrichmentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7999,8 +11050,8 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMEADResponse); i {
+		file_core_v1_types_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEnrichmentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8011,8 +11062,8 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPIERequest); i {
+		file_core_v1_types_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnrichmentSource); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8023,8 +11074,8 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPIEResponse); i {
+		file_core_v1_types_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RewardMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8035,8 +11086,8 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RewardMessage); i {
+		file_core_v1_types_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateReward); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8047,8 +11098,8 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateReward); i {
+		file_core_v1_types_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteReward); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8059,8 +11110,8 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteReward); i {
+		file_core_v1_types_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttestRewardClaim); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8071,7 +11122,7 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+		file_core_v1_types_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetRewardRequest); i {
 			case 0:
 				return &v.state
@@ -8083,7 +11134,7 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+		file_core_v1_types_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetRewardResponse); i {
 			case 0:
 				return &v.state
@@ -8095,7 +11146,7 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+		file_core_v1_types_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RewardAttestationSignature); i {
 			case 0:
 				return &v.state
@@ -8107,7 +11158,7 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+		file_core_v1_types_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UploadSignature); i {
 			case 0:
 				return &v.state
@@ -8119,7 +11170,7 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+		file_core_v1_types_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FileUpload); i {
 			case 0:
 				return &v.state
@@ -8131,7 +11182,7 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+		file_core_v1_types_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetStreamURLsSignature); i {
 			case 0:
 				return &v.state
@@ -8143,7 +11194,7 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+		file_core_v1_types_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetStreamURLsRequest); i {
 			case 0:
 				return &v.state
@@ -8155,7 +11206,7 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+		file_core_v1_types_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetStreamURLsResponse); i {
 			case 0:
 				return &v.state
@@ -8167,7 +11218,7 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+		file_core_v1_types_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetUploadByCIDRequest); i {
 			case 0:
 				return &v.state
@@ -8179,7 +11230,7 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+		file_core_v1_types_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetUploadByCIDResponse); i {
 			case 0:
 				return &v.state
@@ -8191,7 +11242,7 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+		file_core_v1_types_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetStatusResponse_ProcessInfo); i {
 			case 0:
 				return &v.state
@@ -8203,7 +11254,7 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+		file_core_v1_types_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetStatusResponse_NodeInfo); i {
 			case 0:
 				return &v.state
@@ -8215,7 +11266,7 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+		file_core_v1_types_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetStatusResponse_ChainInfo); i {
 			case 0:
 				return &v.state
@@ -8227,7 +11278,7 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+		file_core_v1_types_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetStatusResponse_SyncInfo); i {
 			case 0:
 				return &v.state
@@ -8239,7 +11290,7 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+		file_core_v1_types_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetStatusResponse_PruningInfo); i {
 			case 0:
 				return &v.state
@@ -8251,7 +11302,7 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+		file_core_v1_types_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetStatusResponse_ResourceInfo); i {
 			case 0:
 				return &v.state
@@ -8263,7 +11314,7 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+		file_core_v1_types_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetStatusResponse_MempoolInfo); i {
 			case 0:
 				return &v.state
@@ -8275,7 +11326,7 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+		file_core_v1_types_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetStatusResponse_SnapshotInfo); i {
 			case 0:
 				return &v.state
@@ -8287,7 +11338,7 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+		file_core_v1_types_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetStatusResponse_PeerInfo); i {
 			case 0:
 				return &v.state
@@ -8299,7 +11350,7 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+		file_core_v1_types_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetStatusResponse_ProcessInfo_ProcessStateInfo); i {
 			case 0:
 				return &v.state
@@ -8311,7 +11362,7 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+		file_core_v1_types_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetStatusResponse_SyncInfo_StateSyncInfo); i {
 			case 0:
 				return &v.state
@@ -8323,7 +11374,7 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+		file_core_v1_types_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetStatusResponse_SyncInfo_BlockSyncInfo); i {
 			case 0:
 				return &v.state
@@ -8335,7 +11386,7 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+		file_core_v1_types_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetStatusResponse_PeerInfo_Peer); i {
 			case 0:
 				return &v.state
@@ -8347,7 +11398,7 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
-		file_core_v1_types_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+		file_core_v1_types_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetStreamURLsResponse_EntityStreamURLs); i {
 			case 0:
 				return &v.state
@@ -8359,8 +11410,20 @@ func file_core_v1_types_proto_init() {
 				return nil
 			}
 		}
+		file_core_v1_types_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStreamURLsResponse_StreamDenial); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_core_v1_types_proto_msgTypes[24].OneofWrappers = []interface{}{
+	file_core_v1_types_proto_msgTypes[31].OneofWrappers = []interface{}{
 		(*SignedTransaction_Plays)(nil),
 		(*SignedTransaction_ValidatorRegistration)(nil),
 		(*SignedTransaction_SlaRollup)(nil),
@@ -8372,16 +11435,24 @@ func file_core_v1_types_proto_init() {
 		(*SignedTransaction_Release)(nil),
 		(*SignedTransaction_Reward)(nil),
 		(*SignedTransaction_FileUpload)(nil),
+		(*SignedTransaction_VoteExtensions)(nil),
 	}
-	file_core_v1_types_proto_msgTypes[34].OneofWrappers = []interface{}{
+	file_core_v1_types_proto_msgTypes[44].OneofWrappers = []interface{}{
 		(*Attestation_ValidatorRegistration)(nil),
 		(*Attestation_ValidatorDeregistration)(nil),
+		(*Attestation_UnjailValidator)(nil),
+	}
+	file_core_v1_types_proto_msgTypes[91].OneofWrappers = []interface{}{
+		(*GetEnrichmentResponse_Resource)(nil),
+		(*GetEnrichmentResponse_Release)(nil),
+		(*GetEnrichmentResponse_Party)(nil),
 	}
-	file_core_v1_types_proto_msgTypes[65].OneofWrappers = []interface{}{
+	file_core_v1_types_proto_msgTypes[93].OneofWrappers = []interface{}{
 		(*RewardMessage_Create)(nil),
 		(*RewardMessage_Delete)(nil),
+		(*RewardMessage_Attest)(nil),
 	}
-	file_core_v1_types_proto_msgTypes[81].OneofWrappers = []interface{}{
+	file_core_v1_types_proto_msgTypes[110].OneofWrappers = []interface{}{
 		(*GetStatusResponse_SyncInfo_StateSync)(nil),
 		(*GetStatusResponse_SyncInfo_BlockSync)(nil),
 	}
@@ -8390,8 +11461,8 @@ func file_core_v1_types_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_core_v1_types_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   94,
+			NumEnums:      4,
+			NumMessages:   125,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
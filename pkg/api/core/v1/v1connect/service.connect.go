@@ -45,6 +45,12 @@ const (
 	CoreServiceGetBlockProcedure = "/core.v1.CoreService/GetBlock"
 	// CoreServiceGetBlocksProcedure is the fully-qualified name of the CoreService's GetBlocks RPC.
 	CoreServiceGetBlocksProcedure = "/core.v1.CoreService/GetBlocks"
+	// CoreServiceStreamBlocksProcedure is the fully-qualified name of the CoreService's StreamBlocks
+	// RPC.
+	CoreServiceStreamBlocksProcedure = "/core.v1.CoreService/StreamBlocks"
+	// CoreServiceStreamTransactionsProcedure is the fully-qualified name of the CoreService's
+	// StreamTransactions RPC.
+	CoreServiceStreamTransactionsProcedure = "/core.v1.CoreService/StreamTransactions"
 	// CoreServiceGetTransactionProcedure is the fully-qualified name of the CoreService's
 	// GetTransaction RPC.
 	CoreServiceGetTransactionProcedure = "/core.v1.CoreService/GetTransaction"
@@ -60,6 +66,9 @@ const (
 	// CoreServiceGetDeregistrationAttestationProcedure is the fully-qualified name of the CoreService's
 	// GetDeregistrationAttestation RPC.
 	CoreServiceGetDeregistrationAttestationProcedure = "/core.v1.CoreService/GetDeregistrationAttestation"
+	// CoreServiceGetUnjailAttestationProcedure is the fully-qualified name of the CoreService's
+	// GetUnjailAttestation RPC.
+	CoreServiceGetUnjailAttestationProcedure = "/core.v1.CoreService/GetUnjailAttestation"
 	// CoreServiceGetStoredSnapshotsProcedure is the fully-qualified name of the CoreService's
 	// GetStoredSnapshots RPC.
 	CoreServiceGetStoredSnapshotsProcedure = "/core.v1.CoreService/GetStoredSnapshots"
@@ -71,10 +80,20 @@ const (
 	CoreServiceGetSlashAttestationsProcedure = "/core.v1.CoreService/GetSlashAttestations"
 	// CoreServiceGetERNProcedure is the fully-qualified name of the CoreService's GetERN RPC.
 	CoreServiceGetERNProcedure = "/core.v1.CoreService/GetERN"
+	// CoreServiceGetERNXMLProcedure is the fully-qualified name of the CoreService's GetERNXML RPC.
+	CoreServiceGetERNXMLProcedure = "/core.v1.CoreService/GetERNXML"
+	// CoreServiceGetERNHistoryProcedure is the fully-qualified name of the CoreService's GetERNHistory
+	// RPC.
+	CoreServiceGetERNHistoryProcedure = "/core.v1.CoreService/GetERNHistory"
+	// CoreServiceParseERNXMLProcedure is the fully-qualified name of the CoreService's ParseERNXML RPC.
+	CoreServiceParseERNXMLProcedure = "/core.v1.CoreService/ParseERNXML"
 	// CoreServiceGetMEADProcedure is the fully-qualified name of the CoreService's GetMEAD RPC.
 	CoreServiceGetMEADProcedure = "/core.v1.CoreService/GetMEAD"
 	// CoreServiceGetPIEProcedure is the fully-qualified name of the CoreService's GetPIE RPC.
 	CoreServiceGetPIEProcedure = "/core.v1.CoreService/GetPIE"
+	// CoreServiceGetEnrichmentProcedure is the fully-qualified name of the CoreService's GetEnrichment
+	// RPC.
+	CoreServiceGetEnrichmentProcedure = "/core.v1.CoreService/GetEnrichment"
 	// CoreServiceGetRewardProcedure is the fully-qualified name of the CoreService's GetReward RPC.
 	CoreServiceGetRewardProcedure = "/core.v1.CoreService/GetReward"
 	// CoreServiceGetRewardsProcedure is the fully-qualified name of the CoreService's GetRewards RPC.
@@ -82,6 +101,9 @@ const (
 	// CoreServiceGetRewardAttestationProcedure is the fully-qualified name of the CoreService's
 	// GetRewardAttestation RPC.
 	CoreServiceGetRewardAttestationProcedure = "/core.v1.CoreService/GetRewardAttestation"
+	// CoreServiceGetRewardClaimsProcedure is the fully-qualified name of the CoreService's
+	// GetRewardClaims RPC.
+	CoreServiceGetRewardClaimsProcedure = "/core.v1.CoreService/GetRewardClaims"
 	// CoreServiceGetStreamURLsProcedure is the fully-qualified name of the CoreService's GetStreamURLs
 	// RPC.
 	CoreServiceGetStreamURLsProcedure = "/core.v1.CoreService/GetStreamURLs"
@@ -98,20 +120,28 @@ type CoreServiceClient interface {
 	GetNodeInfo(context.Context, *connect.Request[v1.GetNodeInfoRequest]) (*connect.Response[v1.GetNodeInfoResponse], error)
 	GetBlock(context.Context, *connect.Request[v1.GetBlockRequest]) (*connect.Response[v1.GetBlockResponse], error)
 	GetBlocks(context.Context, *connect.Request[v1.GetBlocksRequest]) (*connect.Response[v1.GetBlocksResponse], error)
+	StreamBlocks(context.Context, *connect.Request[v1.StreamBlocksRequest]) (*connect.ServerStreamForClient[v1.StreamBlocksResponse], error)
+	StreamTransactions(context.Context, *connect.Request[v1.StreamTransactionsRequest]) (*connect.ServerStreamForClient[v1.StreamTransactionsResponse], error)
 	GetTransaction(context.Context, *connect.Request[v1.GetTransactionRequest]) (*connect.Response[v1.GetTransactionResponse], error)
 	SendTransaction(context.Context, *connect.Request[v1.SendTransactionRequest]) (*connect.Response[v1.SendTransactionResponse], error)
 	ForwardTransaction(context.Context, *connect.Request[v1.ForwardTransactionRequest]) (*connect.Response[v1.ForwardTransactionResponse], error)
 	GetRegistrationAttestation(context.Context, *connect.Request[v1.GetRegistrationAttestationRequest]) (*connect.Response[v1.GetRegistrationAttestationResponse], error)
 	GetDeregistrationAttestation(context.Context, *connect.Request[v1.GetDeregistrationAttestationRequest]) (*connect.Response[v1.GetDeregistrationAttestationResponse], error)
+	GetUnjailAttestation(context.Context, *connect.Request[v1.GetUnjailAttestationRequest]) (*connect.Response[v1.GetUnjailAttestationResponse], error)
 	GetStoredSnapshots(context.Context, *connect.Request[v1.GetStoredSnapshotsRequest]) (*connect.Response[v1.GetStoredSnapshotsResponse], error)
 	GetSlashAttestation(context.Context, *connect.Request[v1.GetSlashAttestationRequest]) (*connect.Response[v1.GetSlashAttestationResponse], error)
 	GetSlashAttestations(context.Context, *connect.Request[v1.GetSlashAttestationsRequest]) (*connect.Response[v1.GetSlashAttestationsResponse], error)
 	GetERN(context.Context, *connect.Request[v1.GetERNRequest]) (*connect.Response[v1.GetERNResponse], error)
+	GetERNXML(context.Context, *connect.Request[v1.GetERNXMLRequest]) (*connect.Response[v1.GetERNXMLResponse], error)
+	GetERNHistory(context.Context, *connect.Request[v1.GetERNHistoryRequest]) (*connect.Response[v1.GetERNHistoryResponse], error)
+	ParseERNXML(context.Context, *connect.Request[v1.ParseERNXMLRequest]) (*connect.Response[v1.ParseERNXMLResponse], error)
 	GetMEAD(context.Context, *connect.Request[v1.GetMEADRequest]) (*connect.Response[v1.GetMEADResponse], error)
 	GetPIE(context.Context, *connect.Request[v1.GetPIERequest]) (*connect.Response[v1.GetPIEResponse], error)
+	GetEnrichment(context.Context, *connect.Request[v1.GetEnrichmentRequest]) (*connect.Response[v1.GetEnrichmentResponse], error)
 	GetReward(context.Context, *connect.Request[v1.GetRewardRequest]) (*connect.Response[v1.GetRewardResponse], error)
 	GetRewards(context.Context, *connect.Request[v1.GetRewardsRequest]) (*connect.Response[v1.GetRewardsResponse], error)
 	GetRewardAttestation(context.Context, *connect.Request[v1.GetRewardAttestationRequest]) (*connect.Response[v1.GetRewardAttestationResponse], error)
+	GetRewardClaims(context.Context, *connect.Request[v1.GetRewardClaimsRequest]) (*connect.Response[v1.GetRewardClaimsResponse], error)
 	GetStreamURLs(context.Context, *connect.Request[v1.GetStreamURLsRequest]) (*connect.Response[v1.GetStreamURLsResponse], error)
 	GetUploadByCID(context.Context, *connect.Request[v1.GetUploadByCIDRequest]) (*connect.Response[v1.GetUploadByCIDResponse], error)
 }
@@ -163,6 +193,18 @@ func NewCoreServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(coreServiceMethods.ByName("GetBlocks")),
 			connect.WithClientOptions(opts...),
 		),
+		streamBlocks: connect.NewClient[v1.StreamBlocksRequest, v1.StreamBlocksResponse](
+			httpClient,
+			baseURL+CoreServiceStreamBlocksProcedure,
+			connect.WithSchema(coreServiceMethods.ByName("StreamBlocks")),
+			connect.WithClientOptions(opts...),
+		),
+		streamTransactions: connect.NewClient[v1.StreamTransactionsRequest, v1.StreamTransactionsResponse](
+			httpClient,
+			baseURL+CoreServiceStreamTransactionsProcedure,
+			connect.WithSchema(coreServiceMethods.ByName("StreamTransactions")),
+			connect.WithClientOptions(opts...),
+		),
 		getTransaction: connect.NewClient[v1.GetTransactionRequest, v1.GetTransactionResponse](
 			httpClient,
 			baseURL+CoreServiceGetTransactionProcedure,
@@ -193,6 +235,12 @@ func NewCoreServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(coreServiceMethods.ByName("GetDeregistrationAttestation")),
 			connect.WithClientOptions(opts...),
 		),
+		getUnjailAttestation: connect.NewClient[v1.GetUnjailAttestationRequest, v1.GetUnjailAttestationResponse](
+			httpClient,
+			baseURL+CoreServiceGetUnjailAttestationProcedure,
+			connect.WithSchema(coreServiceMethods.ByName("GetUnjailAttestation")),
+			connect.WithClientOptions(opts...),
+		),
 		getStoredSnapshots: connect.NewClient[v1.GetStoredSnapshotsRequest, v1.GetStoredSnapshotsResponse](
 			httpClient,
 			baseURL+CoreServiceGetStoredSnapshotsProcedure,
@@ -217,6 +265,24 @@ func NewCoreServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(coreServiceMethods.ByName("GetERN")),
 			connect.WithClientOptions(opts...),
 		),
+		getERNXML: connect.NewClient[v1.GetERNXMLRequest, v1.GetERNXMLResponse](
+			httpClient,
+			baseURL+CoreServiceGetERNXMLProcedure,
+			connect.WithSchema(coreServiceMethods.ByName("GetERNXML")),
+			connect.WithClientOptions(opts...),
+		),
+		getERNHistory: connect.NewClient[v1.GetERNHistoryRequest, v1.GetERNHistoryResponse](
+			httpClient,
+			baseURL+CoreServiceGetERNHistoryProcedure,
+			connect.WithSchema(coreServiceMethods.ByName("GetERNHistory")),
+			connect.WithClientOptions(opts...),
+		),
+		parseERNXML: connect.NewClient[v1.ParseERNXMLRequest, v1.ParseERNXMLResponse](
+			httpClient,
+			baseURL+CoreServiceParseERNXMLProcedure,
+			connect.WithSchema(coreServiceMethods.ByName("ParseERNXML")),
+			connect.WithClientOptions(opts...),
+		),
 		getMEAD: connect.NewClient[v1.GetMEADRequest, v1.GetMEADResponse](
 			httpClient,
 			baseURL+CoreServiceGetMEADProcedure,
@@ -229,6 +295,12 @@ func NewCoreServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(coreServiceMethods.ByName("GetPIE")),
 			connect.WithClientOptions(opts...),
 		),
+		getEnrichment: connect.NewClient[v1.GetEnrichmentRequest, v1.GetEnrichmentResponse](
+			httpClient,
+			baseURL+CoreServiceGetEnrichmentProcedure,
+			connect.WithSchema(coreServiceMethods.ByName("GetEnrichment")),
+			connect.WithClientOptions(opts...),
+		),
 		getReward: connect.NewClient[v1.GetRewardRequest, v1.GetRewardResponse](
 			httpClient,
 			baseURL+CoreServiceGetRewardProcedure,
@@ -247,6 +319,12 @@ func NewCoreServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(coreServiceMethods.ByName("GetRewardAttestation")),
 			connect.WithClientOptions(opts...),
 		),
+		getRewardClaims: connect.NewClient[v1.GetRewardClaimsRequest, v1.GetRewardClaimsResponse](
+			httpClient,
+			baseURL+CoreServiceGetRewardClaimsProcedure,
+			connect.WithSchema(coreServiceMethods.ByName("GetRewardClaims")),
+			connect.WithClientOptions(opts...),
+		),
 		getStreamURLs: connect.NewClient[v1.GetStreamURLsRequest, v1.GetStreamURLsResponse](
 			httpClient,
 			baseURL+CoreServiceGetStreamURLsProcedure,
@@ -270,20 +348,28 @@ type coreServiceClient struct {
 	getNodeInfo                  *connect.Client[v1.GetNodeInfoRequest, v1.GetNodeInfoResponse]
 	getBlock                     *connect.Client[v1.GetBlockRequest, v1.GetBlockResponse]
 	getBlocks                    *connect.Client[v1.GetBlocksRequest, v1.GetBlocksResponse]
+	streamBlocks                 *connect.Client[v1.StreamBlocksRequest, v1.StreamBlocksResponse]
+	streamTransactions           *connect.Client[v1.StreamTransactionsRequest, v1.StreamTransactionsResponse]
 	getTransaction               *connect.Client[v1.GetTransactionRequest, v1.GetTransactionResponse]
 	sendTransaction              *connect.Client[v1.SendTransactionRequest, v1.SendTransactionResponse]
 	forwardTransaction           *connect.Client[v1.ForwardTransactionRequest, v1.ForwardTransactionResponse]
 	getRegistrationAttestation   *connect.Client[v1.GetRegistrationAttestationRequest, v1.GetRegistrationAttestationResponse]
 	getDeregistrationAttestation *connect.Client[v1.GetDeregistrationAttestationRequest, v1.GetDeregistrationAttestationResponse]
+	getUnjailAttestation         *connect.Client[v1.GetUnjailAttestationRequest, v1.GetUnjailAttestationResponse]
 	getStoredSnapshots           *connect.Client[v1.GetStoredSnapshotsRequest, v1.GetStoredSnapshotsResponse]
 	getSlashAttestation          *connect.Client[v1.GetSlashAttestationRequest, v1.GetSlashAttestationResponse]
 	getSlashAttestations         *connect.Client[v1.GetSlashAttestationsRequest, v1.GetSlashAttestationsResponse]
 	getERN                       *connect.Client[v1.GetERNRequest, v1.GetERNResponse]
+	getERNXML                    *connect.Client[v1.GetERNXMLRequest, v1.GetERNXMLResponse]
+	getERNHistory                *connect.Client[v1.GetERNHistoryRequest, v1.GetERNHistoryResponse]
+	parseERNXML                  *connect.Client[v1.ParseERNXMLRequest, v1.ParseERNXMLResponse]
 	getMEAD                      *connect.Client[v1.GetMEADRequest, v1.GetMEADResponse]
 	getPIE                       *connect.Client[v1.GetPIERequest, v1.GetPIEResponse]
+	getEnrichment                *connect.Client[v1.GetEnrichmentRequest, v1.GetEnrichmentResponse]
 	getReward                    *connect.Client[v1.GetRewardRequest, v1.GetRewardResponse]
 	getRewards                   *connect.Client[v1.GetRewardsRequest, v1.GetRewardsResponse]
 	getRewardAttestation         *connect.Client[v1.GetRewardAttestationRequest, v1.GetRewardAttestationResponse]
+	getRewardClaims              *connect.Client[v1.GetRewardClaimsRequest, v1.GetRewardClaimsResponse]
 	getStreamURLs                *connect.Client[v1.GetStreamURLsRequest, v1.GetStreamURLsResponse]
 	getUploadByCID               *connect.Client[v1.GetUploadByCIDRequest, v1.GetUploadByCIDResponse]
 }
@@ -318,6 +404,16 @@ func (c *coreServiceClient) GetBlocks(ctx context.Context, req *connect.Request[
 	return c.getBlocks.CallUnary(ctx, req)
 }
 
+// StreamBlocks calls core.v1.CoreService.StreamBlocks.
+func (c *coreServiceClient) StreamBlocks(ctx context.Context, req *connect.Request[v1.StreamBlocksRequest]) (*connect.ServerStreamForClient[v1.StreamBlocksResponse], error) {
+	return c.streamBlocks.CallServerStream(ctx, req)
+}
+
+// StreamTransactions calls core.v1.CoreService.StreamTransactions.
+func (c *coreServiceClient) StreamTransactions(ctx context.Context, req *connect.Request[v1.StreamTransactionsRequest]) (*connect.ServerStreamForClient[v1.StreamTransactionsResponse], error) {
+	return c.streamTransactions.CallServerStream(ctx, req)
+}
+
 // GetTransaction calls core.v1.CoreService.GetTransaction.
 func (c *coreServiceClient) GetTransaction(ctx context.Context, req *connect.Request[v1.GetTransactionRequest]) (*connect.Response[v1.GetTransactionResponse], error) {
 	return c.getTransaction.CallUnary(ctx, req)
@@ -343,6 +439,11 @@ func (c *coreServiceClient) GetDeregistrationAttestation(ctx context.Context, re
 	return c.getDeregistrationAttestation.CallUnary(ctx, req)
 }
 
+// GetUnjailAttestation calls core.v1.CoreService.GetUnjailAttestation.
+func (c *coreServiceClient) GetUnjailAttestation(ctx context.Context, req *connect.Request[v1.GetUnjailAttestationRequest]) (*connect.Response[v1.GetUnjailAttestationResponse], error) {
+	return c.getUnjailAttestation.CallUnary(ctx, req)
+}
+
 // GetStoredSnapshots calls core.v1.CoreService.GetStoredSnapshots.
 func (c *coreServiceClient) GetStoredSnapshots(ctx context.Context, req *connect.Request[v1.GetStoredSnapshotsRequest]) (*connect.Response[v1.GetStoredSnapshotsResponse], error) {
 	return c.getStoredSnapshots.CallUnary(ctx, req)
@@ -363,6 +464,21 @@ func (c *coreServiceClient) GetERN(ctx context.Context, req *connect.Request[v1.
 	return c.getERN.CallUnary(ctx, req)
 }
 
+// GetERNXML calls core.v1.CoreService.GetERNXML.
+func (c *coreServiceClient) GetERNXML(ctx context.Context, req *connect.Request[v1.GetERNXMLRequest]) (*connect.Response[v1.GetERNXMLResponse], error) {
+	return c.getERNXML.CallUnary(ctx, req)
+}
+
+// GetERNHistory calls core.v1.CoreService.GetERNHistory.
+func (c *coreServiceClient) GetERNHistory(ctx context.Context, req *connect.Request[v1.GetERNHistoryRequest]) (*connect.Response[v1.GetERNHistoryResponse], error) {
+	return c.getERNHistory.CallUnary(ctx, req)
+}
+
+// ParseERNXML calls core.v1.CoreService.ParseERNXML.
+func (c *coreServiceClient) ParseERNXML(ctx context.Context, req *connect.Request[v1.ParseERNXMLRequest]) (*connect.Response[v1.ParseERNXMLResponse], error) {
+	return c.parseERNXML.CallUnary(ctx, req)
+}
+
 // GetMEAD calls core.v1.CoreService.GetMEAD.
 func (c *coreServiceClient) GetMEAD(ctx context.Context, req *connect.Request[v1.GetMEADRequest]) (*connect.Response[v1.GetMEADResponse], error) {
 	return c.getMEAD.CallUnary(ctx, req)
@@ -373,6 +489,11 @@ func (c *coreServiceClient) GetPIE(ctx context.Context, req *connect.Request[v1.
 	return c.getPIE.CallUnary(ctx, req)
 }
 
+// GetEnrichment calls core.v1.CoreService.GetEnrichment.
+func (c *coreServiceClient) GetEnrichment(ctx context.Context, req *connect.Request[v1.GetEnrichmentRequest]) (*connect.Response[v1.GetEnrichmentResponse], error) {
+	return c.getEnrichment.CallUnary(ctx, req)
+}
+
 // GetReward calls core.v1.CoreService.GetReward.
 func (c *coreServiceClient) GetReward(ctx context.Context, req *connect.Request[v1.GetRewardRequest]) (*connect.Response[v1.GetRewardResponse], error) {
 	return c.getReward.CallUnary(ctx, req)
@@ -388,6 +509,11 @@ func (c *coreServiceClient) GetRewardAttestation(ctx context.Context, req *conne
 	return c.getRewardAttestation.CallUnary(ctx, req)
 }
 
+// GetRewardClaims calls core.v1.CoreService.GetRewardClaims.
+func (c *coreServiceClient) GetRewardClaims(ctx context.Context, req *connect.Request[v1.GetRewardClaimsRequest]) (*connect.Response[v1.GetRewardClaimsResponse], error) {
+	return c.getRewardClaims.CallUnary(ctx, req)
+}
+
 // GetStreamURLs calls core.v1.CoreService.GetStreamURLs.
 func (c *coreServiceClient) GetStreamURLs(ctx context.Context, req *connect.Request[v1.GetStreamURLsRequest]) (*connect.Response[v1.GetStreamURLsResponse], error) {
 	return c.getStreamURLs.CallUnary(ctx, req)
@@ -406,20 +532,28 @@ type CoreServiceHandler interface {
 	GetNodeInfo(context.Context, *connect.Request[v1.GetNodeInfoRequest]) (*connect.Response[v1.GetNodeInfoResponse], error)
 	GetBlock(context.Context, *connect.Request[v1.GetBlockRequest]) (*connect.Response[v1.GetBlockResponse], error)
 	GetBlocks(context.Context, *connect.Request[v1.GetBlocksRequest]) (*connect.Response[v1.GetBlocksResponse], error)
+	StreamBlocks(context.Context, *connect.Request[v1.StreamBlocksRequest], *connect.ServerStream[v1.StreamBlocksResponse]) error
+	StreamTransactions(context.Context, *connect.Request[v1.StreamTransactionsRequest], *connect.ServerStream[v1.StreamTransactionsResponse]) error
 	GetTransaction(context.Context, *connect.Request[v1.GetTransactionRequest]) (*connect.Response[v1.GetTransactionResponse], error)
 	SendTransaction(context.Context, *connect.Request[v1.SendTransactionRequest]) (*connect.Response[v1.SendTransactionResponse], error)
 	ForwardTransaction(context.Context, *connect.Request[v1.ForwardTransactionRequest]) (*connect.Response[v1.ForwardTransactionResponse], error)
 	GetRegistrationAttestation(context.Context, *connect.Request[v1.GetRegistrationAttestationRequest]) (*connect.Response[v1.GetRegistrationAttestationResponse], error)
 	GetDeregistrationAttestation(context.Context, *connect.Request[v1.GetDeregistrationAttestationRequest]) (*connect.Response[v1.GetDeregistrationAttestationResponse], error)
+	GetUnjailAttestation(context.Context, *connect.Request[v1.GetUnjailAttestationRequest]) (*connect.Response[v1.GetUnjailAttestationResponse], error)
 	GetStoredSnapshots(context.Context, *connect.Request[v1.GetStoredSnapshotsRequest]) (*connect.Response[v1.GetStoredSnapshotsResponse], error)
 	GetSlashAttestation(context.Context, *connect.Request[v1.GetSlashAttestationRequest]) (*connect.Response[v1.GetSlashAttestationResponse], error)
 	GetSlashAttestations(context.Context, *connect.Request[v1.GetSlashAttestationsRequest]) (*connect.Response[v1.GetSlashAttestationsResponse], error)
 	GetERN(context.Context, *connect.Request[v1.GetERNRequest]) (*connect.Response[v1.GetERNResponse], error)
+	GetERNXML(context.Context, *connect.Request[v1.GetERNXMLRequest]) (*connect.Response[v1.GetERNXMLResponse], error)
+	GetERNHistory(context.Context, *connect.Request[v1.GetERNHistoryRequest]) (*connect.Response[v1.GetERNHistoryResponse], error)
+	ParseERNXML(context.Context, *connect.Request[v1.ParseERNXMLRequest]) (*connect.Response[v1.ParseERNXMLResponse], error)
 	GetMEAD(context.Context, *connect.Request[v1.GetMEADRequest]) (*connect.Response[v1.GetMEADResponse], error)
 	GetPIE(context.Context, *connect.Request[v1.GetPIERequest]) (*connect.Response[v1.GetPIEResponse], error)
+	GetEnrichment(context.Context, *connect.Request[v1.GetEnrichmentRequest]) (*connect.Response[v1.GetEnrichmentResponse], error)
 	GetReward(context.Context, *connect.Request[v1.GetRewardRequest]) (*connect.Response[v1.GetRewardResponse], error)
 	GetRewards(context.Context, *connect.Request[v1.GetRewardsRequest]) (*connect.Response[v1.GetRewardsResponse], error)
 	GetRewardAttestation(context.Context, *connect.Request[v1.GetRewardAttestationRequest]) (*connect.Response[v1.GetRewardAttestationResponse], error)
+	GetRewardClaims(context.Context, *connect.Request[v1.GetRewardClaimsRequest]) (*connect.Response[v1.GetRewardClaimsResponse], error)
 	GetStreamURLs(context.Context, *connect.Request[v1.GetStreamURLsRequest]) (*connect.Response[v1.GetStreamURLsResponse], error)
 	GetUploadByCID(context.Context, *connect.Request[v1.GetUploadByCIDRequest]) (*connect.Response[v1.GetUploadByCIDResponse], error)
 }
@@ -467,6 +601,18 @@ func NewCoreServiceHandler(svc CoreServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(coreServiceMethods.ByName("GetBlocks")),
 		connect.WithHandlerOptions(opts...),
 	)
+	coreServiceStreamBlocksHandler := connect.NewServerStreamHandler(
+		CoreServiceStreamBlocksProcedure,
+		svc.StreamBlocks,
+		connect.WithSchema(coreServiceMethods.ByName("StreamBlocks")),
+		connect.WithHandlerOptions(opts...),
+	)
+	coreServiceStreamTransactionsHandler := connect.NewServerStreamHandler(
+		CoreServiceStreamTransactionsProcedure,
+		svc.StreamTransactions,
+		connect.WithSchema(coreServiceMethods.ByName("StreamTransactions")),
+		connect.WithHandlerOptions(opts...),
+	)
 	coreServiceGetTransactionHandler := connect.NewUnaryHandler(
 		CoreServiceGetTransactionProcedure,
 		svc.GetTransaction,
@@ -497,6 +643,12 @@ func NewCoreServiceHandler(svc CoreServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(coreServiceMethods.ByName("GetDeregistrationAttestation")),
 		connect.WithHandlerOptions(opts...),
 	)
+	coreServiceGetUnjailAttestationHandler := connect.NewUnaryHandler(
+		CoreServiceGetUnjailAttestationProcedure,
+		svc.GetUnjailAttestation,
+		connect.WithSchema(coreServiceMethods.ByName("GetUnjailAttestation")),
+		connect.WithHandlerOptions(opts...),
+	)
 	coreServiceGetStoredSnapshotsHandler := connect.NewUnaryHandler(
 		CoreServiceGetStoredSnapshotsProcedure,
 		svc.GetStoredSnapshots,
@@ -521,6 +673,24 @@ func NewCoreServiceHandler(svc CoreServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(coreServiceMethods.ByName("GetERN")),
 		connect.WithHandlerOptions(opts...),
 	)
+	coreServiceGetERNXMLHandler := connect.NewUnaryHandler(
+		CoreServiceGetERNXMLProcedure,
+		svc.GetERNXML,
+		connect.WithSchema(coreServiceMethods.ByName("GetERNXML")),
+		connect.WithHandlerOptions(opts...),
+	)
+	coreServiceGetERNHistoryHandler := connect.NewUnaryHandler(
+		CoreServiceGetERNHistoryProcedure,
+		svc.GetERNHistory,
+		connect.WithSchema(coreServiceMethods.ByName("GetERNHistory")),
+		connect.WithHandlerOptions(opts...),
+	)
+	coreServiceParseERNXMLHandler := connect.NewUnaryHandler(
+		CoreServiceParseERNXMLProcedure,
+		svc.ParseERNXML,
+		connect.WithSchema(coreServiceMethods.ByName("ParseERNXML")),
+		connect.WithHandlerOptions(opts...),
+	)
 	coreServiceGetMEADHandler := connect.NewUnaryHandler(
 		CoreServiceGetMEADProcedure,
 		svc.GetMEAD,
@@ -533,6 +703,12 @@ func NewCoreServiceHandler(svc CoreServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(coreServiceMethods.ByName("GetPIE")),
 		connect.WithHandlerOptions(opts...),
 	)
+	coreServiceGetEnrichmentHandler := connect.NewUnaryHandler(
+		CoreServiceGetEnrichmentProcedure,
+		svc.GetEnrichment,
+		connect.WithSchema(coreServiceMethods.ByName("GetEnrichment")),
+		connect.WithHandlerOptions(opts...),
+	)
 	coreServiceGetRewardHandler := connect.NewUnaryHandler(
 		CoreServiceGetRewardProcedure,
 		svc.GetReward,
@@ -551,6 +727,12 @@ func NewCoreServiceHandler(svc CoreServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(coreServiceMethods.ByName("GetRewardAttestation")),
 		connect.WithHandlerOptions(opts...),
 	)
+	coreServiceGetRewardClaimsHandler := connect.NewUnaryHandler(
+		CoreServiceGetRewardClaimsProcedure,
+		svc.GetRewardClaims,
+		connect.WithSchema(coreServiceMethods.ByName("GetRewardClaims")),
+		connect.WithHandlerOptions(opts...),
+	)
 	coreServiceGetStreamURLsHandler := connect.NewUnaryHandler(
 		CoreServiceGetStreamURLsProcedure,
 		svc.GetStreamURLs,
@@ -577,6 +759,10 @@ func NewCoreServiceHandler(svc CoreServiceHandler, opts ...connect.HandlerOption
 			coreServiceGetBlockHandler.ServeHTTP(w, r)
 		case CoreServiceGetBlocksProcedure:
 			coreServiceGetBlocksHandler.ServeHTTP(w, r)
+		case CoreServiceStreamBlocksProcedure:
+			coreServiceStreamBlocksHandler.ServeHTTP(w, r)
+		case CoreServiceStreamTransactionsProcedure:
+			coreServiceStreamTransactionsHandler.ServeHTTP(w, r)
 		case CoreServiceGetTransactionProcedure:
 			coreServiceGetTransactionHandler.ServeHTTP(w, r)
 		case CoreServiceSendTransactionProcedure:
@@ -587,6 +773,8 @@ func NewCoreServiceHandler(svc CoreServiceHandler, opts ...connect.HandlerOption
 			coreServiceGetRegistrationAttestationHandler.ServeHTTP(w, r)
 		case CoreServiceGetDeregistrationAttestationProcedure:
 			coreServiceGetDeregistrationAttestationHandler.ServeHTTP(w, r)
+		case CoreServiceGetUnjailAttestationProcedure:
+			coreServiceGetUnjailAttestationHandler.ServeHTTP(w, r)
 		case CoreServiceGetStoredSnapshotsProcedure:
 			coreServiceGetStoredSnapshotsHandler.ServeHTTP(w, r)
 		case CoreServiceGetSlashAttestationProcedure:
@@ -595,16 +783,26 @@ func NewCoreServiceHandler(svc CoreServiceHandler, opts ...connect.HandlerOption
 			coreServiceGetSlashAttestationsHandler.ServeHTTP(w, r)
 		case CoreServiceGetERNProcedure:
 			coreServiceGetERNHandler.ServeHTTP(w, r)
+		case CoreServiceGetERNXMLProcedure:
+			coreServiceGetERNXMLHandler.ServeHTTP(w, r)
+		case CoreServiceGetERNHistoryProcedure:
+			coreServiceGetERNHistoryHandler.ServeHTTP(w, r)
+		case CoreServiceParseERNXMLProcedure:
+			coreServiceParseERNXMLHandler.ServeHTTP(w, r)
 		case CoreServiceGetMEADProcedure:
 			coreServiceGetMEADHandler.ServeHTTP(w, r)
 		case CoreServiceGetPIEProcedure:
 			coreServiceGetPIEHandler.ServeHTTP(w, r)
+		case CoreServiceGetEnrichmentProcedure:
+			coreServiceGetEnrichmentHandler.ServeHTTP(w, r)
 		case CoreServiceGetRewardProcedure:
 			coreServiceGetRewardHandler.ServeHTTP(w, r)
 		case CoreServiceGetRewardsProcedure:
 			coreServiceGetRewardsHandler.ServeHTTP(w, r)
 		case CoreServiceGetRewardAttestationProcedure:
 			coreServiceGetRewardAttestationHandler.ServeHTTP(w, r)
+		case CoreServiceGetRewardClaimsProcedure:
+			coreServiceGetRewardClaimsHandler.ServeHTTP(w, r)
 		case CoreServiceGetStreamURLsProcedure:
 			coreServiceGetStreamURLsHandler.ServeHTTP(w, r)
 		case CoreServiceGetUploadByCIDProcedure:
@@ -642,6 +840,14 @@ func (UnimplementedCoreServiceHandler) GetBlocks(context.Context, *connect.Reque
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("core.v1.CoreService.GetBlocks is not implemented"))
 }
 
+func (UnimplementedCoreServiceHandler) StreamBlocks(context.Context, *connect.Request[v1.StreamBlocksRequest], *connect.ServerStream[v1.StreamBlocksResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("core.v1.CoreService.StreamBlocks is not implemented"))
+}
+
+func (UnimplementedCoreServiceHandler) StreamTransactions(context.Context, *connect.Request[v1.StreamTransactionsRequest], *connect.ServerStream[v1.StreamTransactionsResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("core.v1.CoreService.StreamTransactions is not implemented"))
+}
+
 func (UnimplementedCoreServiceHandler) GetTransaction(context.Context, *connect.Request[v1.GetTransactionRequest]) (*connect.Response[v1.GetTransactionResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("core.v1.CoreService.GetTransaction is not implemented"))
 }
@@ -662,6 +868,10 @@ func (UnimplementedCoreServiceHandler) GetDeregistrationAttestation(context.Cont
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("core.v1.CoreService.GetDeregistrationAttestation is not implemented"))
 }
 
+func (UnimplementedCoreServiceHandler) GetUnjailAttestation(context.Context, *connect.Request[v1.GetUnjailAttestationRequest]) (*connect.Response[v1.GetUnjailAttestationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("core.v1.CoreService.GetUnjailAttestation is not implemented"))
+}
+
 func (UnimplementedCoreServiceHandler) GetStoredSnapshots(context.Context, *connect.Request[v1.GetStoredSnapshotsRequest]) (*connect.Response[v1.GetStoredSnapshotsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("core.v1.CoreService.GetStoredSnapshots is not implemented"))
 }
@@ -678,6 +888,18 @@ func (UnimplementedCoreServiceHandler) GetERN(context.Context, *connect.Request[
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("core.v1.CoreService.GetERN is not implemented"))
 }
 
+func (UnimplementedCoreServiceHandler) GetERNXML(context.Context, *connect.Request[v1.GetERNXMLRequest]) (*connect.Response[v1.GetERNXMLResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("core.v1.CoreService.GetERNXML is not implemented"))
+}
+
+func (UnimplementedCoreServiceHandler) GetERNHistory(context.Context, *connect.Request[v1.GetERNHistoryRequest]) (*connect.Response[v1.GetERNHistoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("core.v1.CoreService.GetERNHistory is not implemented"))
+}
+
+func (UnimplementedCoreServiceHandler) ParseERNXML(context.Context, *connect.Request[v1.ParseERNXMLRequest]) (*connect.Response[v1.ParseERNXMLResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("core.v1.CoreService.ParseERNXML is not implemented"))
+}
+
 func (UnimplementedCoreServiceHandler) GetMEAD(context.Context, *connect.Request[v1.GetMEADRequest]) (*connect.Response[v1.GetMEADResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("core.v1.CoreService.GetMEAD is not implemented"))
 }
@@ -686,6 +908,10 @@ func (UnimplementedCoreServiceHandler) GetPIE(context.Context, *connect.Request[
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("core.v1.CoreService.GetPIE is not implemented"))
 }
 
+func (UnimplementedCoreServiceHandler) GetEnrichment(context.Context, *connect.Request[v1.GetEnrichmentRequest]) (*connect.Response[v1.GetEnrichmentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("core.v1.CoreService.GetEnrichment is not implemented"))
+}
+
 func (UnimplementedCoreServiceHandler) GetReward(context.Context, *connect.Request[v1.GetRewardRequest]) (*connect.Response[v1.GetRewardResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("core.v1.CoreService.GetReward is not implemented"))
 }
@@ -698,6 +924,10 @@ func (UnimplementedCoreServiceHandler) GetRewardAttestation(context.Context, *co
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("core.v1.CoreService.GetRewardAttestation is not implemented"))
 }
 
+func (UnimplementedCoreServiceHandler) GetRewardClaims(context.Context, *connect.Request[v1.GetRewardClaimsRequest]) (*connect.Response[v1.GetRewardClaimsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("core.v1.CoreService.GetRewardClaims is not implemented"))
+}
+
 func (UnimplementedCoreServiceHandler) GetStreamURLs(context.Context, *connect.Request[v1.GetStreamURLsRequest]) (*connect.Response[v1.GetStreamURLsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("core.v1.CoreService.GetStreamURLs is not implemented"))
 }
@@ -147,6 +147,8 @@ type Deal_ReleaseDeal_Deal struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	DealTerms *Deal_ReleaseDeal_Deal_DealTerms `protobuf:"bytes,1,opt,name=deal_terms,json=dealTerms,proto3" json:"deal_terms,omitempty"`
 }
 
 func (x *Deal_ReleaseDeal_Deal) Reset() {
@@ -181,6 +183,13 @@ func (*Deal_ReleaseDeal_Deal) Descriptor() ([]byte, []int) {
 	return file_ddex_v1beta1_deal_proto_rawDescGZIP(), []int{0, 0, 0}
 }
 
+func (x *Deal_ReleaseDeal_Deal) GetDealTerms() *Deal_ReleaseDeal_Deal_DealTerms {
+	if x != nil {
+		return x.DealTerms
+	}
+	return nil
+}
+
 type Deal_ReleaseDeal_Deal_DealTerms struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -258,6 +267,7 @@ type Deal_ReleaseDeal_Deal_DealTerms_ValidityPeriod struct {
 	unknownFields protoimpl.UnknownFields
 
 	StartDateTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_date_time,json=startDateTime,proto3" json:"start_date_time,omitempty"`
+	EndDateTime   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_date_time,json=endDateTime,proto3" json:"end_date_time,omitempty"`
 }
 
 func (x *Deal_ReleaseDeal_Deal_DealTerms_ValidityPeriod) Reset() {
@@ -299,6 +309,13 @@ func (x *Deal_ReleaseDeal_Deal_DealTerms_ValidityPeriod) GetStartDateTime() *tim
 	return nil
 }
 
+func (x *Deal_ReleaseDeal_Deal_DealTerms_ValidityPeriod) GetEndDateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDateTime
+	}
+	return nil
+}
+
 var File_ddex_v1beta1_deal_proto protoreflect.FileDescriptor
 
 var file_ddex_v1beta1_deal_proto_rawDesc = []byte{
@@ -306,12 +323,12 @@ var file_ddex_v1beta1_deal_proto_rawDesc = []byte{
 	0x65, 0x61, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x64, 0x64, 0x65, 0x78, 0x2e,
 	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9c, 0x04, 0x0a, 0x04, 0x44, 0x65, 0x61,
+	0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xab, 0x05, 0x0a, 0x04, 0x44, 0x65, 0x61,
 	0x6c, 0x12, 0x43, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x61,
 	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x76,
 	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x61, 0x6c, 0x2e, 0x52, 0x65, 0x6c, 0x65,
 	0x61, 0x73, 0x65, 0x44, 0x65, 0x61, 0x6c, 0x48, 0x00, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61,
-	0x73, 0x65, 0x44, 0x65, 0x61, 0x6c, 0x1a, 0xc6, 0x03, 0x0a, 0x0b, 0x52, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x44, 0x65, 0x61, 0x6c, 0x1a, 0xd5, 0x04, 0x0a, 0x0b, 0x52, 0x65, 0x6c, 0x65, 0x61,
 	0x73, 0x65, 0x44, 0x65, 0x61, 0x6c, 0x12, 0x34, 0x0a, 0x16, 0x64, 0x65, 0x61, 0x6c, 0x5f, 0x72,
 	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
 	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x64, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x6c, 0x65,
@@ -319,32 +336,41 @@ var file_ddex_v1beta1_deal_proto_rawDesc = []byte{
 	0x64, 0x65, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x64, 0x64, 0x65,
 	0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x61, 0x6c, 0x2e, 0x52,
 	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x65, 0x61, 0x6c, 0x2e, 0x44, 0x65, 0x61, 0x6c, 0x52,
-	0x04, 0x64, 0x65, 0x61, 0x6c, 0x1a, 0xc7, 0x02, 0x0a, 0x04, 0x44, 0x65, 0x61, 0x6c, 0x1a, 0xbe,
-	0x02, 0x0a, 0x09, 0x44, 0x65, 0x61, 0x6c, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x12, 0x25, 0x0a, 0x0e,
-	0x74, 0x65, 0x72, 0x72, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x65, 0x72, 0x72, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x43,
-	0x6f, 0x64, 0x65, 0x12, 0x65, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f,
-	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x64,
-	0x64, 0x65, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x61, 0x6c,
-	0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x65, 0x61, 0x6c, 0x2e, 0x44, 0x65, 0x61,
-	0x6c, 0x2e, 0x44, 0x65, 0x61, 0x6c, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x69, 0x74, 0x79, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x69, 0x74, 0x79, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x32, 0x0a, 0x15, 0x63, 0x6f,
-	0x6d, 0x6d, 0x65, 0x72, 0x63, 0x69, 0x61, 0x6c, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
-	0x72, 0x63, 0x69, 0x61, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19,
-	0x0a, 0x08, 0x75, 0x73, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x75, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x1a, 0x54, 0x0a, 0x0e, 0x56, 0x61, 0x6c,
-	0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x42, 0x0a, 0x0f, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x0d, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x42,
-	0x06, 0x0a, 0x04, 0x64, 0x65, 0x61, 0x6c, 0x42, 0x37, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x41, 0x75, 0x64, 0x69, 0x75, 0x73, 0x50, 0x72, 0x6f, 0x6a,
-	0x65, 0x63, 0x74, 0x2f, 0x61, 0x75, 0x64, 0x69, 0x75, 0x73, 0x64, 0x2f, 0x70, 0x6b, 0x67, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x64, 0x64, 0x65, 0x78, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x04, 0x64, 0x65, 0x61, 0x6c, 0x1a, 0xd6, 0x03, 0x0a, 0x04, 0x44, 0x65, 0x61, 0x6c, 0x12, 0x4c,
+	0x0a, 0x0a, 0x64, 0x65, 0x61, 0x6c, 0x5f, 0x74, 0x65, 0x72, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x64, 0x64, 0x65, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x44, 0x65, 0x61, 0x6c, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x65,
+	0x61, 0x6c, 0x2e, 0x44, 0x65, 0x61, 0x6c, 0x2e, 0x44, 0x65, 0x61, 0x6c, 0x54, 0x65, 0x72, 0x6d,
+	0x73, 0x52, 0x09, 0x64, 0x65, 0x61, 0x6c, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x1a, 0xff, 0x02, 0x0a,
+	0x09, 0x44, 0x65, 0x61, 0x6c, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x65,
+	0x72, 0x72, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0d, 0x74, 0x65, 0x72, 0x72, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x65, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x64, 0x64, 0x65,
+	0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x61, 0x6c, 0x2e, 0x52,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x65, 0x61, 0x6c, 0x2e, 0x44, 0x65, 0x61, 0x6c, 0x2e,
+	0x44, 0x65, 0x61, 0x6c, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x69,
+	0x74, 0x79, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x69,
+	0x74, 0x79, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x32, 0x0a, 0x15, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x72, 0x63, 0x69, 0x61, 0x6c, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x72, 0x63,
+	0x69, 0x61, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x08,
+	0x75, 0x73, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x75, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x1a, 0x94, 0x01, 0x0a, 0x0e, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x69, 0x74, 0x79, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x42, 0x0a, 0x0f, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0d, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3e,
+	0x0a, 0x0d, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0b, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x06,
+	0x0a, 0x04, 0x64, 0x65, 0x61, 0x6c, 0x42, 0x37, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x41, 0x75, 0x64, 0x69, 0x75, 0x73, 0x50, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x2f, 0x61, 0x75, 0x64, 0x69, 0x75, 0x73, 0x64, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x64, 0x64, 0x65, 0x78, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -371,13 +397,15 @@ var file_ddex_v1beta1_deal_proto_goTypes = []interface{}{
 var file_ddex_v1beta1_deal_proto_depIdxs = []int32{
 	1, // 0: ddex.v1beta1.Deal.release_deal:type_name -> ddex.v1beta1.Deal.ReleaseDeal
 	2, // 1: ddex.v1beta1.Deal.ReleaseDeal.deal:type_name -> ddex.v1beta1.Deal.ReleaseDeal.Deal
-	4, // 2: ddex.v1beta1.Deal.ReleaseDeal.Deal.DealTerms.validity_period:type_name -> ddex.v1beta1.Deal.ReleaseDeal.Deal.DealTerms.ValidityPeriod
-	5, // 3: ddex.v1beta1.Deal.ReleaseDeal.Deal.DealTerms.ValidityPeriod.start_date_time:type_name -> google.protobuf.Timestamp
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	3, // 2: ddex.v1beta1.Deal.ReleaseDeal.Deal.deal_terms:type_name -> ddex.v1beta1.Deal.ReleaseDeal.Deal.DealTerms
+	4, // 3: ddex.v1beta1.Deal.ReleaseDeal.Deal.DealTerms.validity_period:type_name -> ddex.v1beta1.Deal.ReleaseDeal.Deal.DealTerms.ValidityPeriod
+	5, // 4: ddex.v1beta1.Deal.ReleaseDeal.Deal.DealTerms.ValidityPeriod.start_date_time:type_name -> google.protobuf.Timestamp
+	5, // 5: ddex.v1beta1.Deal.ReleaseDeal.Deal.DealTerms.ValidityPeriod.end_date_time:type_name -> google.protobuf.Timestamp
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_ddex_v1beta1_deal_proto_init() }
@@ -0,0 +1,124 @@
+package common
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+
+	corev1beta1 "github.com/AudiusProject/audiusd/pkg/api/core/v1beta1"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	ErrEnvelopeSignatureMissing     = errors.New("envelope signature missing")
+	ErrEnvelopeSignatureUnsupported = errors.New("unsupported envelope signature type")
+)
+
+// EnvelopeSigningBytes returns the canonical encoding of a v1beta1 envelope,
+// this is what the sender signs and what validators recover the signer from
+func EnvelopeSigningBytes(envelope *corev1beta1.Envelope) ([]byte, error) {
+	if envelope == nil {
+		return nil, errors.New("envelope is nil")
+	}
+	return proto.MarshalOptions{Deterministic: true}.Marshal(envelope)
+}
+
+// envelopeTypedData wraps the envelope hash in EIP-712 typed data so wallets
+// can show the chain the envelope is bound to
+func envelopeTypedData(envelope *corev1beta1.Envelope, envelopeBytes []byte) apitypes.TypedData {
+	return apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": []apitypes.Type{
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+			},
+			"Envelope": []apitypes.Type{
+				{Name: "chainId", Type: "string"},
+				{Name: "envelopeHash", Type: "bytes32"},
+			},
+		},
+		Domain: apitypes.TypedDataDomain{
+			Name:    "Audius Core",
+			Version: "1",
+		},
+		PrimaryType: "Envelope",
+		Message: apitypes.TypedDataMessage{
+			"chainId":      envelope.GetHeader().GetChainId(),
+			"envelopeHash": hexutil.Encode(crypto.Keccak256(envelopeBytes)),
+		},
+	}
+}
+
+// EnvelopeSigningHash returns the digest signed for the given signature type
+func EnvelopeSigningHash(sigType corev1beta1.Signature_SignatureType, envelope *corev1beta1.Envelope) ([]byte, error) {
+	envelopeBytes, err := EnvelopeSigningBytes(envelope)
+	if err != nil {
+		return nil, err
+	}
+
+	switch sigType {
+	case corev1beta1.Signature_SIGNATURE_TYPE_PERSONAL:
+		return accounts.TextHash(envelopeBytes), nil
+	case corev1beta1.Signature_SIGNATURE_TYPE_ETHSIGN:
+		return crypto.Keccak256(envelopeBytes), nil
+	case corev1beta1.Signature_SIGNATURE_TYPE_EIP712:
+		hash, _, err := apitypes.TypedDataAndHash(envelopeTypedData(envelope, envelopeBytes))
+		if err != nil {
+			return nil, fmt.Errorf("could not hash typed envelope: %w", err)
+		}
+		return hash, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrEnvelopeSignatureUnsupported, sigType)
+	}
+}
+
+// SignEnvelope signs the canonical envelope with the given signature type
+func SignEnvelope(privKey *ecdsa.PrivateKey, sigType corev1beta1.Signature_SignatureType, envelope *corev1beta1.Envelope) (*corev1beta1.Signature, error) {
+	hash, err := EnvelopeSigningHash(sigType, envelope)
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := crypto.Sign(hash, privKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign envelope: %w", err)
+	}
+
+	return &corev1beta1.Signature{
+		Type:      sigType,
+		Signature: sig,
+	}, nil
+}
+
+// RecoverEnvelopeSigner recovers the address that signed the envelope,
+// accepts recovery ids in both the 0/1 and wallet style 27/28 forms
+func RecoverEnvelopeSigner(sig *corev1beta1.Signature, envelope *corev1beta1.Envelope) (string, error) {
+	if sig == nil || len(sig.GetSignature()) == 0 {
+		return "", ErrEnvelopeSignatureMissing
+	}
+	if len(sig.GetSignature()) != crypto.SignatureLength {
+		return "", fmt.Errorf("signature must be %d bytes, got %d", crypto.SignatureLength, len(sig.GetSignature()))
+	}
+
+	hash, err := EnvelopeSigningHash(sig.GetType(), envelope)
+	if err != nil {
+		return "", err
+	}
+
+	sigBytes := make([]byte, crypto.SignatureLength)
+	copy(sigBytes, sig.GetSignature())
+	if sigBytes[crypto.RecoveryIDOffset] >= 27 {
+		sigBytes[crypto.RecoveryIDOffset] -= 27
+	}
+
+	pubKey, err := crypto.SigToPub(hash, sigBytes)
+	if err != nil {
+		return "", fmt.Errorf("could not recover pubkey: %w", err)
+	}
+
+	return crypto.PubkeyToAddress(*pubKey).Hex(), nil
+}
@@ -0,0 +1,66 @@
+package common
+
+import (
+	"testing"
+
+	corev1beta1 "github.com/AudiusProject/audiusd/pkg/api/core/v1beta1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignAndRecoverEnvelope(t *testing.T) {
+	// development key, do not use in production
+	privKey, err := EthToEthKey("6bc52a1494870c9329324261dbb457db34c8c1369bc9eb336b25965f46f43cc8")
+	require.Nil(t, err)
+	expectedAddress := "0xfAf20A7cAed2Ed9054DcADb09778Ce59bEc3A6AD"
+
+	envelope := &corev1beta1.Envelope{
+		Header: &corev1beta1.EnvelopeHeader{
+			ChainId:    "audius-devnet",
+			From:       expectedAddress,
+			Nonce:      "1",
+			Expiration: 100,
+		},
+	}
+
+	sigTypes := []corev1beta1.Signature_SignatureType{
+		corev1beta1.Signature_SIGNATURE_TYPE_PERSONAL,
+		corev1beta1.Signature_SIGNATURE_TYPE_ETHSIGN,
+		corev1beta1.Signature_SIGNATURE_TYPE_EIP712,
+	}
+
+	for _, sigType := range sigTypes {
+		t.Run(sigType.String(), func(t *testing.T) {
+			sig, err := SignEnvelope(privKey, sigType, envelope)
+			require.Nil(t, err)
+
+			address, err := RecoverEnvelopeSigner(sig, envelope)
+			require.Nil(t, err)
+			require.Equal(t, expectedAddress, address)
+
+			// wallets return 27/28 recovery ids
+			walletSig := &corev1beta1.Signature{Type: sigType, Signature: append([]byte{}, sig.Signature...)}
+			walletSig.Signature[64] += 27
+			address, err = RecoverEnvelopeSigner(walletSig, envelope)
+			require.Nil(t, err)
+			require.Equal(t, expectedAddress, address)
+
+			// any change to the envelope changes the signer
+			tampered := &corev1beta1.Envelope{Header: &corev1beta1.EnvelopeHeader{
+				ChainId:    envelope.Header.ChainId,
+				From:       envelope.Header.From,
+				Nonce:      "2",
+				Expiration: envelope.Header.Expiration,
+			}}
+			address, err = RecoverEnvelopeSigner(sig, tampered)
+			if err == nil {
+				require.NotEqual(t, expectedAddress, address)
+			}
+		})
+	}
+
+	_, err = RecoverEnvelopeSigner(nil, envelope)
+	require.ErrorIs(t, err, ErrEnvelopeSignatureMissing)
+
+	_, err = SignEnvelope(privKey, corev1beta1.Signature_SIGNATURE_TYPE_UNSPECIFIED, envelope)
+	require.ErrorIs(t, err, ErrEnvelopeSignatureUnsupported)
+}
@@ -27,23 +27,30 @@ func (s NodeTuples) Less(i, j int) bool {
 // Returns the first `size` number of addresses from a list of all validators sorted
 // by a hashing function. The hashing is seeded according to the given key.
 func GetAttestorRendezvous(validatorAddresses []string, key []byte, size int) map[string]bool {
-	tuples := make(NodeTuples, len(validatorAddresses))
+	ranked := GetRendezvousRanking(validatorAddresses, key)
+	result := make(map[string]bool, len(validatorAddresses))
+	for _, addr := range ranked[:min(len(ranked), size)] {
+		result[addr] = true
+	}
+	return result
+}
+
+// Returns all of the addresses sorted by a hashing function seeded according to the
+// given key, every node ranks the same set of addresses the same way.
+func GetRendezvousRanking(addresses []string, key []byte) []string {
+	tuples := make(NodeTuples, len(addresses))
 
 	hasher := sha256.New()
-	for i, addr := range validatorAddresses {
+	for i, addr := range addresses {
 		hasher.Reset()
 		io.WriteString(hasher, addr)
 		hasher.Write(key)
 		tuples[i] = NodeTuple{addr, hasher.Sum(nil)}
 	}
 	sort.Sort(tuples)
-	result := make(map[string]bool, len(validatorAddresses))
-	bound := min(len(tuples), size)
+	ranked := make([]string, len(tuples))
 	for i, tup := range tuples {
-		if i >= bound {
-			break
-		}
-		result[tup.addr] = true
+		ranked[i] = tup.addr
 	}
-	return result
+	return ranked
 }
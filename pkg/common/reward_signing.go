@@ -67,3 +67,31 @@ func SignDeleteReward(privateKey *ecdsa.PrivateKey, deleteReward *corev1.DeleteR
 
 	return EthSign(privateKey, dataBytes)
 }
+
+// CreateDeterministicAttestRewardClaimData creates deterministic hex data for signing AttestRewardClaim
+func CreateDeterministicAttestRewardClaimData(attest *corev1.AttestRewardClaim) string {
+	data := fmt.Sprintf("%s|%s|%s|%s|%d|%d",
+		attest.RewardAddress,
+		attest.RewardId,
+		attest.Specifier,
+		attest.EthRecipientAddress,
+		attest.Amount,
+		attest.DeadlineBlockHeight)
+
+	// Hash the data for consistent length
+	hash := sha256.Sum256([]byte(data))
+	return hex.EncodeToString(hash[:])
+}
+
+// SignAttestRewardClaim signs an AttestRewardClaim message using deterministic data
+func SignAttestRewardClaim(privateKey *ecdsa.PrivateKey, attest *corev1.AttestRewardClaim) (string, error) {
+	signatureData := CreateDeterministicAttestRewardClaimData(attest)
+
+	// Convert hex data to bytes for signing
+	dataBytes, err := hex.DecodeString(signatureData)
+	if err != nil {
+		return "", fmt.Errorf("invalid hex data: %w", err)
+	}
+
+	return EthSign(privateKey, dataBytes)
+}
@@ -31,6 +31,15 @@ const (
 	ModuleGraphQL = "graphql"
 )
 
+// SlashProposalMode controls whether the slash proposer submits to governance, see slash_proposer.go
+type SlashProposalMode = string
+
+const (
+	SlashProposalsOff    SlashProposalMode = "off"
+	SlashProposalsDryRun SlashProposalMode = "dryrun"
+	SlashProposalsSubmit SlashProposalMode = "submit"
+)
+
 type RollupInterval struct {
 	BlockInterval int
 }
@@ -78,6 +87,94 @@ const (
 	mainnetRollupInterval       = 2048
 	testnetRollupInterval       = 512
 	devnetRollupInterval        = 16
+
+	// heights at which the app hash switches from hashing block txs to
+	// committing to app state, zero leaves the legacy app hash in place
+	mainnetStateCommitmentHeight = 0
+	testnetStateCommitmentHeight = 0
+	devnetStateCommitmentHeight  = 1
+
+	// heights from which ERN takedowns are validated against the original sender
+	// and recorded, zero keeps accepting them without effect
+	mainnetERNTakedownHeight = 0
+	testnetERNTakedownHeight = 0
+	devnetERNTakedownHeight  = 1
+
+	// heights from which play txs must be signed by a registered storage
+	// validator, zero keeps accepting every play
+	mainnetPlayValidationHeight = 0
+	testnetPlayValidationHeight = 0
+	devnetPlayValidationHeight  = 1
+
+	// heights from which manage entity txs must carry a valid EIP-712 signature
+	// from their signer and an unused nonce, zero keeps accepting them as is
+	mainnetManageEntityValidationHeight = 0
+	testnetManageEntityValidationHeight = 0
+	devnetManageEntityValidationHeight  = 1
+
+	// heights from which validators extend their votes with storage proofs and
+	// peer observations instead of sending them as txs, zero keeps the txs.
+	// enabled through a consensus param update in the block before so must be above 1
+	mainnetVoteExtensionsHeight = 0
+	testnetVoteExtensionsHeight = 0
+	devnetVoteExtensionsHeight  = 2
+
+	// heights from which underperforming and misbehaving validators are jailed
+	// instead of deregistered, zero keeps deregistering them
+	mainnetValidatorJailingHeight = 0
+	testnetValidatorJailingHeight = 0
+	devnetValidatorJailingHeight  = 1
+
+	// heights from which sla rollups carry each validator's storage proof
+	// outcomes, zero keeps reporting only proposed blocks
+	mainnetSlaStorageProofsHeight = 0
+	testnetSlaStorageProofsHeight = 0
+	devnetSlaStorageProofsHeight  = 1
+
+	// heights from which validators record the reward claims they attest to and
+	// refuse claims beyond a reward's amount, zero keeps attesting without a ledger
+	mainnetRewardClaimLedgerHeight = 0
+	testnetRewardClaimLedgerHeight = 0
+	devnetRewardClaimLedgerHeight  = 1
+
+	// heights from which v1beta1 envelopes must be signed by their sender and
+	// rejected txs carry typed error codes, zero keeps accepting unsigned envelopes
+	mainnetEnvelopeSignatureHeight = 0
+	testnetEnvelopeSignatureHeight = 0
+	devnetEnvelopeSignatureHeight  = 1
+
+	// heights from which ERN updates are validated against the original sender
+	// and stored as new versions, zero keeps accepting them without effect
+	mainnetERNVersioningHeight = 0
+	testnetERNVersioningHeight = 0
+	devnetERNVersioningHeight  = 1
+
+	// heights from which MEAD and PIE messages must enrich entities the sender
+	// or its delegates own and delegations are recorded, zero keeps storing
+	// enrichments unbound and ignoring delegations
+	mainnetEnrichmentBindingHeight = 0
+	testnetEnrichmentBindingHeight = 0
+	devnetEnrichmentBindingHeight  = 1
+
+	// heights from which every ERN sound recording must be delivered as an upload
+	// of the sender or its delegates with a matching hash sum, zero keeps matching
+	// uploads against the sender the ERN header claims
+	mainnetERNUploadBindingHeight = 0
+	testnetERNUploadBindingHeight = 0
+	devnetERNUploadBindingHeight  = 1
+
+	// heights from which the app hash also commits to a merkle tree of every
+	// queryable value so abci queries can be proven, needs the state commitment.
+	// zero keeps answering queries at the latest height only and without proofs
+	mainnetStateProofHeight = 0
+	testnetStateProofHeight = 0
+	devnetStateProofHeight  = 1
+
+	// heights from which a proposal may hold only one tx per conflict key, the way
+	// PrepareProposal builds them. zero accepts every tx that is valid on its own
+	mainnetConflictFreeProposalHeight = 0
+	testnetConflictFreeProposalHeight = 0
+	devnetConflictFreeProposalHeight  = 1
 )
 
 const dbUrlLocalPattern string = `^postgresql:\/\/\w+:\w+@(db|localhost|postgres):.*`
@@ -121,8 +218,40 @@ type Config struct {
 	ValidatorVotingPower int
 	UseHttpsForSdk       bool
 
+	// first block whose app hash commits to app state, see state_commitment.go
+	StateCommitmentHeight int64
+	// first block whose ERN takedowns are validated and recorded, see ern.go
+	ERNTakedownHeight int64
+	// first block whose plays are validated, see plays.go
+	PlayValidationHeight int64
+	// first block whose manage entity signatures and nonces are validated, see manage_entity.go
+	ManageEntityValidationHeight int64
+	// first block whose votes carry vote extensions, see vote_extensions.go
+	VoteExtensionsHeight int64
+	// first block that jails validators rather than deregistering them, see jailing.go
+	ValidatorJailingHeight int64
+	// first block whose sla rollups report storage proof outcomes, see auditor.go
+	SlaStorageProofsHeight int64
+	// first block that records attested reward claims, see reward_claims.go
+	RewardClaimLedgerHeight int64
+	// first block whose v1beta1 envelope signatures are enforced, see transaction_v2.go
+	EnvelopeSignatureHeight int64
+	// first block whose ERN updates are validated and versioned, see ern.go
+	ERNVersioningHeight int64
+	// first block whose enrichments are bound to ERN entities and whose delegations are recorded, see enrichment.go
+	EnrichmentBindingHeight int64
+	// first block whose ERN sound recordings are bound to verified uploads, see ern.go
+	ERNUploadBindingHeight int64
+	// first block whose app hash commits to the state tree queries are proven against, see state_tree.go
+	StateProofHeight int64
+	// first block whose proposals may hold only one tx per conflict key, see validate.go
+	ConflictFreeProposalHeight int64
+
 	StateSync *StateSyncConfig
 
+	// max non priority transactions one sender may have in the mempool, 0 disables the limit
+	MempoolMaxTxsPerSender int
+
 	/* Entity Manager Config */
 	AcdcEntityManagerAddress string
 	AcdcChainID              uint
@@ -143,6 +272,7 @@ type Config struct {
 	/* Feature flags */
 	ProgrammableDistributionEnabled bool
 	SkipEthRegistration             bool
+	SlashProposals                  SlashProposalMode
 }
 
 func (c *Config) IsDev() bool {
@@ -160,6 +290,9 @@ type StateSyncConfig struct {
 	Keep int
 	// interval to save snapshots in blocks
 	BlockInterval int64
+	// number of snapshots between full pg_dumps, the ones in between are deltas of the
+	// last full snapshot. one disables deltas.
+	FullSnapshotInterval int
 	// number of chunk fetchers to use
 	ChunkFetchers int32
 }
@@ -189,6 +322,9 @@ func ReadConfig() (*Config, error) {
 	cfg.RetainHeight = int64(getEnvIntWithDefault("retainHeight", 604800))
 	cfg.Archive = GetEnvWithDefault("archive", "false") == "true"
 
+	// (default) a tenth of the mempool
+	cfg.MempoolMaxTxsPerSender = getEnvIntWithDefault("mempoolMaxTxsPerSender", 3000)
+
 	cfg.AttRegistrationMin = 5
 	cfg.AttRegistrationRSize = 10
 	cfg.AttDeregistrationMin = 5
@@ -199,6 +335,7 @@ func ReadConfig() (*Config, error) {
 	cfg.ProgrammableDistributionEnabled = common.IsProgrammableDistributionEnabled(cfg.Environment)
 
 	cfg.SkipEthRegistration = GetEnvWithDefault("skipEthRegistration", "false") == "true"
+	cfg.SlashProposals = GetEnvWithDefault("slashProposals", SlashProposalsOff)
 
 	ssRpcServers := ""
 	switch cfg.Environment {
@@ -209,12 +346,13 @@ func ReadConfig() (*Config, error) {
 	}
 
 	cfg.StateSync = &StateSyncConfig{
-		ServeSnapshots: GetEnvWithDefault("stateSyncServeSnapshots", "false") == "true",
-		Enable:         GetEnvWithDefault("stateSyncEnable", "true") == "true",
-		Keep:           getEnvIntWithDefault("stateSyncKeep", 6),
-		BlockInterval:  int64(getEnvIntWithDefault("stateSyncBlockInterval", 100)),
-		ChunkFetchers:  int32(getEnvIntWithDefault("stateSyncChunkFetchers", 10)),
-		RPCServers:     strings.Split(GetEnvWithDefault("stateSyncRPCServers", ssRpcServers), ","),
+		ServeSnapshots:       GetEnvWithDefault("stateSyncServeSnapshots", "false") == "true",
+		Enable:               GetEnvWithDefault("stateSyncEnable", "true") == "true",
+		Keep:                 getEnvIntWithDefault("stateSyncKeep", 6),
+		BlockInterval:        int64(getEnvIntWithDefault("stateSyncBlockInterval", 100)),
+		ChunkFetchers:        int32(getEnvIntWithDefault("stateSyncChunkFetchers", 10)),
+		RPCServers:           strings.Split(GetEnvWithDefault("stateSyncRPCServers", ssRpcServers), ","),
+		FullSnapshotInterval: getEnvIntWithDefault("stateSyncFullSnapshotInterval", 6),
 	}
 
 	cfg.EthRPCUrl = GetEthRPC()
@@ -259,6 +397,20 @@ func ReadConfig() (*Config, error) {
 		cfg.PersistentPeers = GetEnvWithDefault("persistentPeers", ProdPersistentPeers)
 		cfg.SlaRollupInterval = mainnetRollupInterval
 		cfg.ValidatorVotingPower = mainnetValidatorVotingPower
+		cfg.StateCommitmentHeight = mainnetStateCommitmentHeight
+		cfg.ERNTakedownHeight = mainnetERNTakedownHeight
+		cfg.PlayValidationHeight = mainnetPlayValidationHeight
+		cfg.ManageEntityValidationHeight = mainnetManageEntityValidationHeight
+		cfg.VoteExtensionsHeight = mainnetVoteExtensionsHeight
+		cfg.ValidatorJailingHeight = mainnetValidatorJailingHeight
+		cfg.SlaStorageProofsHeight = mainnetSlaStorageProofsHeight
+		cfg.RewardClaimLedgerHeight = mainnetRewardClaimLedgerHeight
+		cfg.EnvelopeSignatureHeight = mainnetEnvelopeSignatureHeight
+		cfg.ERNVersioningHeight = mainnetERNVersioningHeight
+		cfg.EnrichmentBindingHeight = mainnetEnrichmentBindingHeight
+		cfg.ERNUploadBindingHeight = mainnetERNUploadBindingHeight
+		cfg.StateProofHeight = mainnetStateProofHeight
+		cfg.ConflictFreeProposalHeight = mainnetConflictFreeProposalHeight
 		cfg.Rewards = MakeRewards(ProdClaimAuthorities, ProdRewardExtensions)
 		cfg.AcdcChainID = ProdAcdcChainID
 		cfg.AcdcEntityManagerAddress = ProdAcdcAddress
@@ -267,6 +419,20 @@ func ReadConfig() (*Config, error) {
 		cfg.PersistentPeers = GetEnvWithDefault("persistentPeers", StagePersistentPeers)
 		cfg.SlaRollupInterval = testnetRollupInterval
 		cfg.ValidatorVotingPower = testnetValidatorVotingPower
+		cfg.StateCommitmentHeight = testnetStateCommitmentHeight
+		cfg.ERNTakedownHeight = testnetERNTakedownHeight
+		cfg.PlayValidationHeight = testnetPlayValidationHeight
+		cfg.ManageEntityValidationHeight = testnetManageEntityValidationHeight
+		cfg.VoteExtensionsHeight = testnetVoteExtensionsHeight
+		cfg.ValidatorJailingHeight = testnetValidatorJailingHeight
+		cfg.SlaStorageProofsHeight = testnetSlaStorageProofsHeight
+		cfg.RewardClaimLedgerHeight = testnetRewardClaimLedgerHeight
+		cfg.EnvelopeSignatureHeight = testnetEnvelopeSignatureHeight
+		cfg.ERNVersioningHeight = testnetERNVersioningHeight
+		cfg.EnrichmentBindingHeight = testnetEnrichmentBindingHeight
+		cfg.ERNUploadBindingHeight = testnetERNUploadBindingHeight
+		cfg.StateProofHeight = testnetStateProofHeight
+		cfg.ConflictFreeProposalHeight = testnetConflictFreeProposalHeight
 		cfg.Rewards = MakeRewards(StageClaimAuthorities, StageRewardExtensions)
 		cfg.AcdcChainID = StageAcdcChainID
 		cfg.AcdcEntityManagerAddress = StageAcdcAddress
@@ -276,6 +442,20 @@ func ReadConfig() (*Config, error) {
 		cfg.AddrBookStrict = false
 		cfg.SlaRollupInterval = devnetRollupInterval
 		cfg.ValidatorVotingPower = devnetValidatorVotingPower
+		cfg.StateCommitmentHeight = devnetStateCommitmentHeight
+		cfg.ERNTakedownHeight = devnetERNTakedownHeight
+		cfg.PlayValidationHeight = devnetPlayValidationHeight
+		cfg.ManageEntityValidationHeight = devnetManageEntityValidationHeight
+		cfg.VoteExtensionsHeight = devnetVoteExtensionsHeight
+		cfg.ValidatorJailingHeight = devnetValidatorJailingHeight
+		cfg.SlaStorageProofsHeight = devnetSlaStorageProofsHeight
+		cfg.RewardClaimLedgerHeight = devnetRewardClaimLedgerHeight
+		cfg.EnvelopeSignatureHeight = devnetEnvelopeSignatureHeight
+		cfg.ERNVersioningHeight = devnetERNVersioningHeight
+		cfg.EnrichmentBindingHeight = devnetEnrichmentBindingHeight
+		cfg.ERNUploadBindingHeight = devnetERNUploadBindingHeight
+		cfg.StateProofHeight = devnetStateProofHeight
+		cfg.ConflictFreeProposalHeight = devnetConflictFreeProposalHeight
 		cfg.Rewards = MakeRewards(DevClaimAuthorities, DevRewardExtensions)
 		cfg.AcdcChainID = DevAcdcChainID
 		cfg.AcdcEntityManagerAddress = DevAcdcAddress
@@ -69,6 +69,8 @@ func (cs *Console) adjudicateFragment(c echo.Context) error {
 	storageProofRollups := make(map[string]*pages.StorageProofRollup, len(endpoints))
 	totalChallenges, failedChallenges := int64(0), int64(0)
 	totalMetSlas, totalPartialSlas, totalDeadSlas, totalSlas := 0, 0, 0, 0
+	rollupChallengesReceived, rollupChallengesFailed := 0, 0
+	lastRollupHeight := int64(0)
 	for i, ep := range endpoints {
 		// map the endpoint received from eth service into a a UI endpoint object
 		viewEndpoints[i] = &pages.Endpoint{
@@ -131,6 +133,10 @@ func (cs *Console) adjudicateFragment(c echo.Context) error {
 			}
 			setSlaReportStatus(pageReport, viewEndpoints[i])
 			totalSlas += 1
+			// validators attest to the challenge outcomes carried in rollups, not the ones counted below
+			rollupChallengesReceived += int(rollup.ChallengesReceived.Int32)
+			rollupChallengesFailed += int(rollup.ChallengesFailed.Int32)
+			lastRollupHeight = max(lastRollupHeight, rollup.BlockEnd)
 			switch pageReport.Status {
 			case pages.SlaMet:
 				totalMetSlas += 1
@@ -168,7 +174,9 @@ func (cs *Console) adjudicateFragment(c echo.Context) error {
 		}
 	}
 
-	slashAmount := server.CalculateSlashRecommendation(startTime, endTime, len(endpoints), totalSlas, totalDeadSlas)
+	// recommend what validators attest to, see server.CalculateSlashRecommendation
+	storageProofs := cs.config.SlaStorageProofsHeight > 0 && lastRollupHeight >= cs.config.SlaStorageProofsHeight
+	slashAmount := server.CalculateSlashRecommendation(startTime, endTime, len(endpoints), totalSlas, totalDeadSlas, rollupChallengesReceived, rollupChallengesFailed, storageProofs)
 
 	stakingResp, err := cs.eth.GetStakingMetadataForServiceProvider(
 		ctx,
@@ -232,6 +240,21 @@ func (cs *Console) adjudicateFragment(c echo.Context) error {
 		}
 	}
 
+	// Show what this node's slash proposer last did for the service provider
+	var slashProposal *pages.SlashProposalStatus
+	if latest, err := cs.db.GetLatestSlashProposalForAddress(ctx, serviceProviderAddress); err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		cs.logger.Error("Failed to get latest slash proposal for service provider", zap.String("address", serviceProviderAddress), zap.Error(err))
+	} else if err == nil {
+		slashProposal = &pages.SlashProposalStatus{
+			Amount:       latest.Amount,
+			Attestations: int(latest.Attestations),
+			DryRun:       latest.DryRun,
+			TxHash:       latest.TxHash,
+			Error:        latest.Error,
+			CreatedAt:    latest.CreatedAt.Time,
+		}
+	}
+
 	view := &pages.AdjudicatePageView{
 		ServiceProvider: &pages.ServiceProvider{
 			Address:             serviceProviderAddress,
@@ -253,6 +276,7 @@ func (cs *Console) adjudicateFragment(c echo.Context) error {
 			Attestors: slashAttestors,
 		},
 		ActiveSlashProposalId: slashProposalId,
+		SlashProposal:         slashProposal,
 		DashboardURL:          config.GetProtocolDashboardURL(),
 		ReportingEndpoint: &pages.Endpoint{
 			EthAddress:   cs.config.WalletAddress,
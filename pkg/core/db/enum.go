@@ -5,4 +5,6 @@ type ValidatorEventType string
 const (
 	ValidatorEventRegistered   ValidatorEventType = "registered"
 	ValidatorEventDeregistered ValidatorEventType = "deregistered"
+	ValidatorEventJailed       ValidatorEventType = "jailed"
+	ValidatorEventUnjailed     ValidatorEventType = "unjailed"
 )
@@ -64,6 +64,8 @@ type CoreAppState struct {
 	BlockHeight int64
 	AppHash     []byte
 	CreatedAt   pgtype.Timestamp
+	StateDigest []byte
+	StateRoot   []byte
 }
 
 type CoreBlock struct {
@@ -85,6 +87,15 @@ type CoreDeal struct {
 	CreatedAt   pgtype.Timestamp
 }
 
+type CoreDelegation struct {
+	TxHash      string
+	Index       int64
+	Sender      string
+	Delegate    string
+	Revoked     bool
+	BlockHeight int64
+}
+
 type CoreErn struct {
 	ID                 int64
 	Address            string
@@ -97,6 +108,17 @@ type CoreErn struct {
 	BlockHeight        int64
 }
 
+type CoreErnTakedown struct {
+	ID                int64
+	ErnAddress        string
+	TxHash            string
+	Index             int64
+	Sender            string
+	RawMessage        []byte
+	RawAcknowledgment []byte
+	BlockHeight       int64
+}
+
 type CoreEtlTx struct {
 	ID          int64
 	BlockHeight int64
@@ -191,6 +213,13 @@ type CoreEtlTxValidatorRegistration struct {
 	CreatedAt    pgtype.Timestamptz
 }
 
+type CoreManageEntityNonce struct {
+	Signer      string
+	Nonce       string
+	TxHash      string
+	BlockHeight int64
+}
+
 type CoreMead struct {
 	ID                int64
 	Address           string
@@ -214,6 +243,14 @@ type CoreParty struct {
 	CreatedAt   pgtype.Timestamp
 }
 
+type CorePeerObservation struct {
+	BlockHeight int64
+	Observer    string
+	Reachable   []string
+	Unreachable []string
+	TxHash      string
+}
+
 type CorePie struct {
 	ID                int64
 	Address           string
@@ -226,6 +263,15 @@ type CorePie struct {
 	BlockHeight       int64
 }
 
+type CorePlaySignature struct {
+	Signature   string
+	TrackID     string
+	UserID      string
+	PlayedAt    int64
+	TxHash      string
+	BlockHeight int64
+}
+
 type CoreRelease struct {
 	Address     string
 	ErnAddress  string
@@ -262,6 +308,56 @@ type CoreReward struct {
 	UpdatedAt        pgtype.Timestamptz
 }
 
+type CoreRewardClaim struct {
+	RewardAddress       string
+	RewardID            string
+	Specifier           string
+	EthRecipientAddress string
+	Amount              int64
+	Attester            string
+	TxHash              string
+	BlockHeight         int64
+}
+
+type CoreSlashProposal struct {
+	ID           int64
+	Address      string
+	Amount       int64
+	StartTime    pgtype.Timestamp
+	EndTime      pgtype.Timestamp
+	Attestations int32
+	DryRun       bool
+	TxHash       string
+	Error        string
+	CreatedAt    pgtype.Timestamp
+}
+
+type CoreStateNode struct {
+	Depth       int32
+	Index       int32
+	Hash        []byte
+	BlockHeight int64
+}
+
+type CoreStateTreeRetain struct {
+	ID          bool
+	BlockHeight int64
+}
+
+type CoreStateValue struct {
+	Key         string
+	Bucket      int32
+	Value       []byte
+	ValueHash   []byte
+	BlockHeight int64
+}
+
+type CoreTakedownCid struct {
+	Cid         string
+	ErnAddress  string
+	BlockHeight int64
+}
+
 type CoreTransaction struct {
 	Rowid       int64
 	BlockID     int64
@@ -269,6 +365,8 @@ type CoreTransaction struct {
 	TxHash      string
 	Transaction []byte
 	CreatedAt   pgtype.Timestamp
+	ResultCode  int32
+	ResultLog   string
 }
 
 type CoreTxStat struct {
@@ -293,15 +391,18 @@ type CoreUpload struct {
 }
 
 type CoreValidator struct {
-	Rowid        int32
-	PubKey       string
-	Endpoint     string
-	EthAddress   string
-	CometAddress string
-	EthBlock     string
-	NodeType     string
-	SpID         string
-	CometPubKey  string
+	Rowid           int32
+	PubKey          string
+	Endpoint        string
+	EthAddress      string
+	CometAddress    string
+	EthBlock        string
+	NodeType        string
+	SpID            string
+	CometPubKey     string
+	Jailed          bool
+	JailedAtBlock   int64
+	UnjailedAtBlock int64
 }
 
 type ManagementKey struct {
@@ -311,10 +412,12 @@ type ManagementKey struct {
 }
 
 type SlaNodeReport struct {
-	ID             int32
-	Address        string
-	BlocksProposed int32
-	SlaRollupID    pgtype.Int4
+	ID                 int32
+	Address            string
+	BlocksProposed     int32
+	SlaRollupID        pgtype.Int4
+	ChallengesReceived int32
+	ChallengesFailed   int32
 }
 
 type SlaRollup struct {
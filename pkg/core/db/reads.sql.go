@@ -11,6 +11,19 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const countSlaRollupsSince = `-- name: CountSlaRollupsSince :one
+select count(*)
+from sla_rollups
+where block_start > $1
+`
+
+func (q *Queries) CountSlaRollupsSince(ctx context.Context, blockStart int64) (int64, error) {
+	row := q.db.QueryRow(ctx, countSlaRollupsSince, blockStart)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const getActiveRewards = `-- name: GetActiveRewards :many
 select id, address, index, tx_hash, sender, reward_id, name, amount, claim_authorities, raw_message, block_height, created_at, updated_at
 from core_rewards
@@ -51,6 +64,521 @@ func (q *Queries) GetActiveRewards(ctx context.Context) ([]CoreReward, error) {
 	return items, nil
 }
 
+const getAllCoreDeals = `-- name: GetAllCoreDeals :many
+select address, ern_address, entity_type, entity_index, tx_hash, block_height, created_at from core_deals
+`
+
+func (q *Queries) GetAllCoreDeals(ctx context.Context) ([]CoreDeal, error) {
+	rows, err := q.db.Query(ctx, getAllCoreDeals)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CoreDeal
+	for rows.Next() {
+		var i CoreDeal
+		if err := rows.Scan(
+			&i.Address,
+			&i.ErnAddress,
+			&i.EntityType,
+			&i.EntityIndex,
+			&i.TxHash,
+			&i.BlockHeight,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAllCoreDelegations = `-- name: GetAllCoreDelegations :many
+select tx_hash, index, sender, delegate, revoked, block_height from core_delegations
+`
+
+func (q *Queries) GetAllCoreDelegations(ctx context.Context) ([]CoreDelegation, error) {
+	rows, err := q.db.Query(ctx, getAllCoreDelegations)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CoreDelegation
+	for rows.Next() {
+		var i CoreDelegation
+		if err := rows.Scan(
+			&i.TxHash,
+			&i.Index,
+			&i.Sender,
+			&i.Delegate,
+			&i.Revoked,
+			&i.BlockHeight,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAllCoreERNTakedowns = `-- name: GetAllCoreERNTakedowns :many
+select id, ern_address, tx_hash, index, sender, raw_message, raw_acknowledgment, block_height from core_ern_takedowns
+`
+
+func (q *Queries) GetAllCoreERNTakedowns(ctx context.Context) ([]CoreErnTakedown, error) {
+	rows, err := q.db.Query(ctx, getAllCoreERNTakedowns)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CoreErnTakedown
+	for rows.Next() {
+		var i CoreErnTakedown
+		if err := rows.Scan(
+			&i.ID,
+			&i.ErnAddress,
+			&i.TxHash,
+			&i.Index,
+			&i.Sender,
+			&i.RawMessage,
+			&i.RawAcknowledgment,
+			&i.BlockHeight,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAllCoreERNs = `-- name: GetAllCoreERNs :many
+select id, address, index, tx_hash, sender, message_control_type, raw_message, raw_acknowledgment, block_height from core_ern
+`
+
+func (q *Queries) GetAllCoreERNs(ctx context.Context) ([]CoreErn, error) {
+	rows, err := q.db.Query(ctx, getAllCoreERNs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CoreErn
+	for rows.Next() {
+		var i CoreErn
+		if err := rows.Scan(
+			&i.ID,
+			&i.Address,
+			&i.Index,
+			&i.TxHash,
+			&i.Sender,
+			&i.MessageControlType,
+			&i.RawMessage,
+			&i.RawAcknowledgment,
+			&i.BlockHeight,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAllCoreMEADs = `-- name: GetAllCoreMEADs :many
+select id, address, tx_hash, index, sender, resource_addresses, release_addresses, raw_message, raw_acknowledgment, block_height from core_mead
+`
+
+func (q *Queries) GetAllCoreMEADs(ctx context.Context) ([]CoreMead, error) {
+	rows, err := q.db.Query(ctx, getAllCoreMEADs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CoreMead
+	for rows.Next() {
+		var i CoreMead
+		if err := rows.Scan(
+			&i.ID,
+			&i.Address,
+			&i.TxHash,
+			&i.Index,
+			&i.Sender,
+			&i.ResourceAddresses,
+			&i.ReleaseAddresses,
+			&i.RawMessage,
+			&i.RawAcknowledgment,
+			&i.BlockHeight,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAllCoreManageEntityNonces = `-- name: GetAllCoreManageEntityNonces :many
+select signer, nonce, tx_hash, block_height from core_manage_entity_nonces
+`
+
+func (q *Queries) GetAllCoreManageEntityNonces(ctx context.Context) ([]CoreManageEntityNonce, error) {
+	rows, err := q.db.Query(ctx, getAllCoreManageEntityNonces)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CoreManageEntityNonce
+	for rows.Next() {
+		var i CoreManageEntityNonce
+		if err := rows.Scan(
+			&i.Signer,
+			&i.Nonce,
+			&i.TxHash,
+			&i.BlockHeight,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAllCorePIEs = `-- name: GetAllCorePIEs :many
+select id, address, tx_hash, index, sender, party_addresses, raw_message, raw_acknowledgment, block_height from core_pie
+`
+
+func (q *Queries) GetAllCorePIEs(ctx context.Context) ([]CorePie, error) {
+	rows, err := q.db.Query(ctx, getAllCorePIEs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CorePie
+	for rows.Next() {
+		var i CorePie
+		if err := rows.Scan(
+			&i.ID,
+			&i.Address,
+			&i.TxHash,
+			&i.Index,
+			&i.Sender,
+			&i.PartyAddresses,
+			&i.RawMessage,
+			&i.RawAcknowledgment,
+			&i.BlockHeight,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAllCoreParties = `-- name: GetAllCoreParties :many
+select address, ern_address, entity_type, entity_index, tx_hash, block_height, created_at from core_parties
+`
+
+func (q *Queries) GetAllCoreParties(ctx context.Context) ([]CoreParty, error) {
+	rows, err := q.db.Query(ctx, getAllCoreParties)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CoreParty
+	for rows.Next() {
+		var i CoreParty
+		if err := rows.Scan(
+			&i.Address,
+			&i.ErnAddress,
+			&i.EntityType,
+			&i.EntityIndex,
+			&i.TxHash,
+			&i.BlockHeight,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAllCorePeerObservations = `-- name: GetAllCorePeerObservations :many
+select block_height, observer, reachable, unreachable, tx_hash from core_peer_observations
+`
+
+func (q *Queries) GetAllCorePeerObservations(ctx context.Context) ([]CorePeerObservation, error) {
+	rows, err := q.db.Query(ctx, getAllCorePeerObservations)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CorePeerObservation
+	for rows.Next() {
+		var i CorePeerObservation
+		if err := rows.Scan(
+			&i.BlockHeight,
+			&i.Observer,
+			&i.Reachable,
+			&i.Unreachable,
+			&i.TxHash,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAllCorePlaySignatures = `-- name: GetAllCorePlaySignatures :many
+select signature, track_id, user_id, played_at, tx_hash, block_height from core_play_signatures
+`
+
+func (q *Queries) GetAllCorePlaySignatures(ctx context.Context) ([]CorePlaySignature, error) {
+	rows, err := q.db.Query(ctx, getAllCorePlaySignatures)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CorePlaySignature
+	for rows.Next() {
+		var i CorePlaySignature
+		if err := rows.Scan(
+			&i.Signature,
+			&i.TrackID,
+			&i.UserID,
+			&i.PlayedAt,
+			&i.TxHash,
+			&i.BlockHeight,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAllCoreReleases = `-- name: GetAllCoreReleases :many
+select address, ern_address, entity_type, entity_index, tx_hash, block_height, created_at from core_releases
+`
+
+func (q *Queries) GetAllCoreReleases(ctx context.Context) ([]CoreRelease, error) {
+	rows, err := q.db.Query(ctx, getAllCoreReleases)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CoreRelease
+	for rows.Next() {
+		var i CoreRelease
+		if err := rows.Scan(
+			&i.Address,
+			&i.ErnAddress,
+			&i.EntityType,
+			&i.EntityIndex,
+			&i.TxHash,
+			&i.BlockHeight,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAllCoreResources = `-- name: GetAllCoreResources :many
+select address, ern_address, entity_type, entity_index, tx_hash, block_height, created_at from core_resources
+`
+
+func (q *Queries) GetAllCoreResources(ctx context.Context) ([]CoreResource, error) {
+	rows, err := q.db.Query(ctx, getAllCoreResources)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CoreResource
+	for rows.Next() {
+		var i CoreResource
+		if err := rows.Scan(
+			&i.Address,
+			&i.ErnAddress,
+			&i.EntityType,
+			&i.EntityIndex,
+			&i.TxHash,
+			&i.BlockHeight,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAllCoreRewardClaims = `-- name: GetAllCoreRewardClaims :many
+select reward_address, reward_id, specifier, eth_recipient_address, amount, attester, tx_hash, block_height from core_reward_claims
+`
+
+func (q *Queries) GetAllCoreRewardClaims(ctx context.Context) ([]CoreRewardClaim, error) {
+	rows, err := q.db.Query(ctx, getAllCoreRewardClaims)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CoreRewardClaim
+	for rows.Next() {
+		var i CoreRewardClaim
+		if err := rows.Scan(
+			&i.RewardAddress,
+			&i.RewardID,
+			&i.Specifier,
+			&i.EthRecipientAddress,
+			&i.Amount,
+			&i.Attester,
+			&i.TxHash,
+			&i.BlockHeight,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAllCoreRewards = `-- name: GetAllCoreRewards :many
+select id, address, index, tx_hash, sender, reward_id, name, amount, claim_authorities, raw_message, block_height, created_at, updated_at from core_rewards
+`
+
+func (q *Queries) GetAllCoreRewards(ctx context.Context) ([]CoreReward, error) {
+	rows, err := q.db.Query(ctx, getAllCoreRewards)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CoreReward
+	for rows.Next() {
+		var i CoreReward
+		if err := rows.Scan(
+			&i.ID,
+			&i.Address,
+			&i.Index,
+			&i.TxHash,
+			&i.Sender,
+			&i.RewardID,
+			&i.Name,
+			&i.Amount,
+			&i.ClaimAuthorities,
+			&i.RawMessage,
+			&i.BlockHeight,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAllCoreTakedownCIDs = `-- name: GetAllCoreTakedownCIDs :many
+select cid, ern_address, block_height from core_takedown_cids
+`
+
+func (q *Queries) GetAllCoreTakedownCIDs(ctx context.Context) ([]CoreTakedownCid, error) {
+	rows, err := q.db.Query(ctx, getAllCoreTakedownCIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CoreTakedownCid
+	for rows.Next() {
+		var i CoreTakedownCid
+		if err := rows.Scan(&i.Cid, &i.ErnAddress, &i.BlockHeight); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAllCoreUploads = `-- name: GetAllCoreUploads :many
+select id, uploader_address, cid, transcoded_cid, upid, upload_signature, validator_address, validator_signature, tx_hash, block_height from core_uploads
+`
+
+func (q *Queries) GetAllCoreUploads(ctx context.Context) ([]CoreUpload, error) {
+	rows, err := q.db.Query(ctx, getAllCoreUploads)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CoreUpload
+	for rows.Next() {
+		var i CoreUpload
+		if err := rows.Scan(
+			&i.ID,
+			&i.UploaderAddress,
+			&i.Cid,
+			&i.TranscodedCid,
+			&i.Upid,
+			&i.UploadSignature,
+			&i.ValidatorAddress,
+			&i.ValidatorSignature,
+			&i.TxHash,
+			&i.BlockHeight,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getAllEthAddressesOfRegisteredNodes = `-- name: GetAllEthAddressesOfRegisteredNodes :many
 select eth_address
 from core_validators
@@ -77,7 +605,7 @@ func (q *Queries) GetAllEthAddressesOfRegisteredNodes(ctx context.Context) ([]st
 }
 
 const getAllRegisteredNodes = `-- name: GetAllRegisteredNodes :many
-select rowid, pub_key, endpoint, eth_address, comet_address, eth_block, node_type, sp_id, comet_pub_key
+select rowid, pub_key, endpoint, eth_address, comet_address, eth_block, node_type, sp_id, comet_pub_key, jailed, jailed_at_block, unjailed_at_block
 from core_validators
 `
 
@@ -100,6 +628,9 @@ func (q *Queries) GetAllRegisteredNodes(ctx context.Context) ([]CoreValidator, e
 			&i.NodeType,
 			&i.SpID,
 			&i.CometPubKey,
+			&i.Jailed,
+			&i.JailedAtBlock,
+			&i.UnjailedAtBlock,
 		); err != nil {
 			return nil, err
 		}
@@ -112,7 +643,7 @@ func (q *Queries) GetAllRegisteredNodes(ctx context.Context) ([]CoreValidator, e
 }
 
 const getAllRegisteredNodesSorted = `-- name: GetAllRegisteredNodesSorted :many
-select rowid, pub_key, endpoint, eth_address, comet_address, eth_block, node_type, sp_id, comet_pub_key
+select rowid, pub_key, endpoint, eth_address, comet_address, eth_block, node_type, sp_id, comet_pub_key, jailed, jailed_at_block, unjailed_at_block
 from core_validators
 order by comet_address
 `
@@ -136,6 +667,9 @@ func (q *Queries) GetAllRegisteredNodesSorted(ctx context.Context) ([]CoreValida
 			&i.NodeType,
 			&i.SpID,
 			&i.CometPubKey,
+			&i.Jailed,
+			&i.JailedAtBlock,
+			&i.UnjailedAtBlock,
 		); err != nil {
 			return nil, err
 		}
@@ -190,9 +724,35 @@ func (q *Queries) GetAllRewards(ctx context.Context) ([]CoreReward, error) {
 	return items, nil
 }
 
+const getAllTxHashes = `-- name: GetAllTxHashes :many
+select tx_hash from core_transactions
+`
+
+func (q *Queries) GetAllTxHashes(ctx context.Context) ([]string, error) {
+	rows, err := q.db.Query(ctx, getAllTxHashes)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var tx_hash string
+		if err := rows.Scan(&tx_hash); err != nil {
+			return nil, err
+		}
+		items = append(items, tx_hash)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getAppStateAtHeight = `-- name: GetAppStateAtHeight :one
 select block_height,
-    app_hash
+    app_hash,
+    state_digest,
+    state_root
 from core_app_state
 where block_height = $1
 limit 1
@@ -201,12 +761,19 @@ limit 1
 type GetAppStateAtHeightRow struct {
 	BlockHeight int64
 	AppHash     []byte
+	StateDigest []byte
+	StateRoot   []byte
 }
 
 func (q *Queries) GetAppStateAtHeight(ctx context.Context, blockHeight int64) (GetAppStateAtHeightRow, error) {
 	row := q.db.QueryRow(ctx, getAppStateAtHeight, blockHeight)
 	var i GetAppStateAtHeightRow
-	err := row.Scan(&i.BlockHeight, &i.AppHash)
+	err := row.Scan(
+		&i.BlockHeight,
+		&i.AppHash,
+		&i.StateDigest,
+		&i.StateRoot,
+	)
 	return i, err
 }
 
@@ -352,14 +919,35 @@ func (q *Queries) GetAvailableRegions(ctx context.Context, arg GetAvailableRegio
 	return items, nil
 }
 
-const getBlock = `-- name: GetBlock :one
+const getBlock = `-- name: GetBlock :one
+select rowid, height, chain_id, hash, proposer, created_at
+from core_blocks
+where height = $1
+`
+
+func (q *Queries) GetBlock(ctx context.Context, height int64) (CoreBlock, error) {
+	row := q.db.QueryRow(ctx, getBlock, height)
+	var i CoreBlock
+	err := row.Scan(
+		&i.Rowid,
+		&i.Height,
+		&i.ChainID,
+		&i.Hash,
+		&i.Proposer,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getBlockByHash = `-- name: GetBlockByHash :one
 select rowid, height, chain_id, hash, proposer, created_at
 from core_blocks
-where height = $1
+where hash = $1
+limit 1
 `
 
-func (q *Queries) GetBlock(ctx context.Context, height int64) (CoreBlock, error) {
-	row := q.db.QueryRow(ctx, getBlock, height)
+func (q *Queries) GetBlockByHash(ctx context.Context, hash string) (CoreBlock, error) {
+	row := q.db.QueryRow(ctx, getBlockByHash, hash)
 	var i CoreBlock
 	err := row.Scan(
 		&i.Rowid,
@@ -373,7 +961,7 @@ func (q *Queries) GetBlock(ctx context.Context, height int64) (CoreBlock, error)
 }
 
 const getBlockTransactions = `-- name: GetBlockTransactions :many
-select rowid, block_id, index, tx_hash, transaction, created_at
+select rowid, block_id, index, tx_hash, transaction, created_at, result_code, result_log
 from core_transactions
 where block_id = $1
 order by created_at desc
@@ -395,6 +983,8 @@ func (q *Queries) GetBlockTransactions(ctx context.Context, blockID int64) ([]Co
 			&i.TxHash,
 			&i.Transaction,
 			&i.CreatedAt,
+			&i.ResultCode,
+			&i.ResultLog,
 		); err != nil {
 			return nil, err
 		}
@@ -419,7 +1009,9 @@ select
     t.index as tx_index,
     t.tx_hash,
     t.transaction,
-    t.created_at as tx_created_at
+    t.created_at as tx_created_at,
+    t.result_code,
+    t.result_log
 from core_blocks b
 left join core_transactions t on b.height = t.block_id
 where b.height = any($1::bigint[])
@@ -439,6 +1031,8 @@ type GetBlocksWithTransactionsRow struct {
 	TxHash         pgtype.Text
 	Transaction    []byte
 	TxCreatedAt    pgtype.Timestamp
+	ResultCode     pgtype.Int4
+	ResultLog      pgtype.Text
 }
 
 func (q *Queries) GetBlocksWithTransactions(ctx context.Context, dollar_1 []int64) ([]GetBlocksWithTransactionsRow, error) {
@@ -463,6 +1057,8 @@ func (q *Queries) GetBlocksWithTransactions(ctx context.Context, dollar_1 []int6
 			&i.TxHash,
 			&i.Transaction,
 			&i.TxCreatedAt,
+			&i.ResultCode,
+			&i.ResultLog,
 		); err != nil {
 			return nil, err
 		}
@@ -951,6 +1547,37 @@ func (q *Queries) GetDecodedTxsByType(ctx context.Context, arg GetDecodedTxsByTy
 	return items, nil
 }
 
+const getDelegationReceipts = `-- name: GetDelegationReceipts :many
+select tx_hash, index, sender, delegate, revoked, block_height from core_delegations where tx_hash = $1
+`
+
+func (q *Queries) GetDelegationReceipts(ctx context.Context, txHash string) ([]CoreDelegation, error) {
+	rows, err := q.db.Query(ctx, getDelegationReceipts, txHash)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CoreDelegation
+	for rows.Next() {
+		var i CoreDelegation
+		if err := rows.Scan(
+			&i.TxHash,
+			&i.Index,
+			&i.Sender,
+			&i.Delegate,
+			&i.Revoked,
+			&i.BlockHeight,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getERN = `-- name: GetERN :one
 select id, address, index, tx_hash, sender, message_control_type, raw_message, raw_acknowledgment, block_height from core_ern where address = $1 order by block_height desc limit 1
 `
@@ -984,7 +1611,8 @@ SELECT
         ELSE 'unknown'
     END::text as entity_type,
     COALESCE(r.entity_index, rel.entity_index, p.entity_index, d.entity_index, 0)::int as entity_index,
-    ern.raw_message
+    ern.raw_message,
+    ern.raw_acknowledgment
 FROM core_ern ern
 LEFT JOIN core_resources r ON r.address = $1::text AND r.ern_address = ern.address
 LEFT JOIN core_releases rel ON rel.address = $1::text AND rel.ern_address = ern.address
@@ -996,11 +1624,12 @@ LIMIT 1
 `
 
 type GetERNContainingAddressRow struct {
-	ErnAddress  string
-	Sender      string
-	EntityType  string
-	EntityIndex int32
-	RawMessage  []byte
+	ErnAddress        string
+	Sender            string
+	EntityType        string
+	EntityIndex       int32
+	RawMessage        []byte
+	RawAcknowledgment []byte
 }
 
 func (q *Queries) GetERNContainingAddress(ctx context.Context, dollar_1 string) (GetERNContainingAddressRow, error) {
@@ -1012,6 +1641,7 @@ func (q *Queries) GetERNContainingAddress(ctx context.Context, dollar_1 string)
 		&i.EntityType,
 		&i.EntityIndex,
 		&i.RawMessage,
+		&i.RawAcknowledgment,
 	)
 	return i, err
 }
@@ -1173,8 +1803,157 @@ func (q *Queries) GetERNResources(ctx context.Context, ernAddress string) ([]Cor
 	return items, nil
 }
 
+const getERNTakedown = `-- name: GetERNTakedown :one
+select id, ern_address, tx_hash, index, sender, raw_message, raw_acknowledgment, block_height from core_ern_takedowns where ern_address = $1
+`
+
+func (q *Queries) GetERNTakedown(ctx context.Context, ernAddress string) (CoreErnTakedown, error) {
+	row := q.db.QueryRow(ctx, getERNTakedown, ernAddress)
+	var i CoreErnTakedown
+	err := row.Scan(
+		&i.ID,
+		&i.ErnAddress,
+		&i.TxHash,
+		&i.Index,
+		&i.Sender,
+		&i.RawMessage,
+		&i.RawAcknowledgment,
+		&i.BlockHeight,
+	)
+	return i, err
+}
+
+const getERNTakedownForAddress = `-- name: GetERNTakedownForAddress :one
+select t.id, t.ern_address, t.tx_hash, t.index, t.sender, t.raw_message, t.raw_acknowledgment, t.block_height from core_ern_takedowns t
+where t.ern_address = $1::text
+   or t.ern_address in (
+       select ern_address from core_releases where address = $1::text
+       union all
+       select ern_address from core_resources where address = $1::text
+       union all
+       select ern_address from core_deals where address = $1::text
+   )
+limit 1
+`
+
+func (q *Queries) GetERNTakedownForAddress(ctx context.Context, dollar_1 string) (CoreErnTakedown, error) {
+	row := q.db.QueryRow(ctx, getERNTakedownForAddress, dollar_1)
+	var i CoreErnTakedown
+	err := row.Scan(
+		&i.ID,
+		&i.ErnAddress,
+		&i.TxHash,
+		&i.Index,
+		&i.Sender,
+		&i.RawMessage,
+		&i.RawAcknowledgment,
+		&i.BlockHeight,
+	)
+	return i, err
+}
+
+const getERNTakedownReceipts = `-- name: GetERNTakedownReceipts :many
+select raw_acknowledgment, index from core_ern_takedowns where tx_hash = $1
+`
+
+type GetERNTakedownReceiptsRow struct {
+	RawAcknowledgment []byte
+	Index             int64
+}
+
+func (q *Queries) GetERNTakedownReceipts(ctx context.Context, txHash string) ([]GetERNTakedownReceiptsRow, error) {
+	rows, err := q.db.Query(ctx, getERNTakedownReceipts, txHash)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetERNTakedownReceiptsRow
+	for rows.Next() {
+		var i GetERNTakedownReceiptsRow
+		if err := rows.Scan(&i.RawAcknowledgment, &i.Index); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getERNVersions = `-- name: GetERNVersions :many
+select id, address, index, tx_hash, sender, message_control_type, raw_message, raw_acknowledgment, block_height from core_ern where address = $1 order by block_height asc, index asc
+`
+
+func (q *Queries) GetERNVersions(ctx context.Context, address string) ([]CoreErn, error) {
+	rows, err := q.db.Query(ctx, getERNVersions, address)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CoreErn
+	for rows.Next() {
+		var i CoreErn
+		if err := rows.Scan(
+			&i.ID,
+			&i.Address,
+			&i.Index,
+			&i.TxHash,
+			&i.Sender,
+			&i.MessageControlType,
+			&i.RawMessage,
+			&i.RawAcknowledgment,
+			&i.BlockHeight,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getExistingPlaySignatures = `-- name: GetExistingPlaySignatures :many
+select signature, track_id, user_id, played_at from core_play_signatures
+where signature = any($1::text[])
+`
+
+type GetExistingPlaySignaturesRow struct {
+	Signature string
+	TrackID   string
+	UserID    string
+	PlayedAt  int64
+}
+
+func (q *Queries) GetExistingPlaySignatures(ctx context.Context, dollar_1 []string) ([]GetExistingPlaySignaturesRow, error) {
+	rows, err := q.db.Query(ctx, getExistingPlaySignatures, dollar_1)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetExistingPlaySignaturesRow
+	for rows.Next() {
+		var i GetExistingPlaySignaturesRow
+		if err := rows.Scan(
+			&i.Signature,
+			&i.TrackID,
+			&i.UserID,
+			&i.PlayedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getInProgressRollupReports = `-- name: GetInProgressRollupReports :many
-select id, address, blocks_proposed, sla_rollup_id
+select id, address, blocks_proposed, sla_rollup_id, challenges_received, challenges_failed
 from sla_node_reports
 where sla_rollup_id is null
 order by address
@@ -1194,6 +1973,8 @@ func (q *Queries) GetInProgressRollupReports(ctx context.Context) ([]SlaNodeRepo
 			&i.Address,
 			&i.BlocksProposed,
 			&i.SlaRollupID,
+			&i.ChallengesReceived,
+			&i.ChallengesFailed,
 		); err != nil {
 			return nil, err
 		}
@@ -1207,7 +1988,9 @@ func (q *Queries) GetInProgressRollupReports(ctx context.Context) ([]SlaNodeRepo
 
 const getLatestAppState = `-- name: GetLatestAppState :one
 select block_height,
-    app_hash
+    app_hash,
+    state_digest,
+    state_root
 from core_app_state
 order by block_height desc
 limit 1
@@ -1216,12 +1999,19 @@ limit 1
 type GetLatestAppStateRow struct {
 	BlockHeight int64
 	AppHash     []byte
+	StateDigest []byte
+	StateRoot   []byte
 }
 
 func (q *Queries) GetLatestAppState(ctx context.Context) (GetLatestAppStateRow, error) {
 	row := q.db.QueryRow(ctx, getLatestAppState)
 	var i GetLatestAppStateRow
-	err := row.Scan(&i.BlockHeight, &i.AppHash)
+	err := row.Scan(
+		&i.BlockHeight,
+		&i.AppHash,
+		&i.StateDigest,
+		&i.StateRoot,
+	)
 	return i, err
 }
 
@@ -1287,6 +2077,32 @@ func (q *Queries) GetLatestDecodedTxs(ctx context.Context, limit int32) ([]CoreE
 	return items, nil
 }
 
+const getLatestDelegation = `-- name: GetLatestDelegation :one
+select tx_hash, index, sender, delegate, revoked, block_height from core_delegations
+where lower(sender) = lower($1) and lower(delegate) = lower($2)
+order by block_height desc, index desc
+limit 1
+`
+
+type GetLatestDelegationParams struct {
+	Sender   string
+	Delegate string
+}
+
+func (q *Queries) GetLatestDelegation(ctx context.Context, arg GetLatestDelegationParams) (CoreDelegation, error) {
+	row := q.db.QueryRow(ctx, getLatestDelegation, arg.Sender, arg.Delegate)
+	var i CoreDelegation
+	err := row.Scan(
+		&i.TxHash,
+		&i.Index,
+		&i.Sender,
+		&i.Delegate,
+		&i.Revoked,
+		&i.BlockHeight,
+	)
+	return i, err
+}
+
 const getLatestSlaRollup = `-- name: GetLatestSlaRollup :one
 select id, tx_hash, block_start, block_end, time
 from sla_rollups
@@ -1307,6 +2123,31 @@ func (q *Queries) GetLatestSlaRollup(ctx context.Context) (SlaRollup, error) {
 	return i, err
 }
 
+const getLatestSlashProposalForAddress = `-- name: GetLatestSlashProposalForAddress :one
+select id, address, amount, start_time, end_time, attestations, dry_run, tx_hash, error, created_at from core_slash_proposals
+where lower(address) = lower($1)
+order by created_at desc
+limit 1
+`
+
+func (q *Queries) GetLatestSlashProposalForAddress(ctx context.Context, address string) (CoreSlashProposal, error) {
+	row := q.db.QueryRow(ctx, getLatestSlashProposalForAddress, address)
+	var i CoreSlashProposal
+	err := row.Scan(
+		&i.ID,
+		&i.Address,
+		&i.Amount,
+		&i.StartTime,
+		&i.EndTime,
+		&i.Attestations,
+		&i.DryRun,
+		&i.TxHash,
+		&i.Error,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
 const getMEAD = `-- name: GetMEAD :one
 select id, address, tx_hash, index, sender, resource_addresses, release_addresses, raw_message, raw_acknowledgment, block_height from core_mead where address = $1 order by block_height desc limit 1
 `
@@ -1358,8 +2199,45 @@ func (q *Queries) GetMEADReceipts(ctx context.Context, txHash string) ([]GetMEAD
 	return items, nil
 }
 
+const getMEADsForEntity = `-- name: GetMEADsForEntity :many
+select id, address, tx_hash, index, sender, resource_addresses, release_addresses, raw_message, raw_acknowledgment, block_height from core_mead
+where $1::text = any(resource_addresses) or $1::text = any(release_addresses)
+order by block_height, index
+`
+
+func (q *Queries) GetMEADsForEntity(ctx context.Context, address string) ([]CoreMead, error) {
+	rows, err := q.db.Query(ctx, getMEADsForEntity, address)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CoreMead
+	for rows.Next() {
+		var i CoreMead
+		if err := rows.Scan(
+			&i.ID,
+			&i.Address,
+			&i.TxHash,
+			&i.Index,
+			&i.Sender,
+			&i.ResourceAddresses,
+			&i.ReleaseAddresses,
+			&i.RawMessage,
+			&i.RawAcknowledgment,
+			&i.BlockHeight,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getNodeByEndpoint = `-- name: GetNodeByEndpoint :one
-select rowid, pub_key, endpoint, eth_address, comet_address, eth_block, node_type, sp_id, comet_pub_key
+select rowid, pub_key, endpoint, eth_address, comet_address, eth_block, node_type, sp_id, comet_pub_key, jailed, jailed_at_block, unjailed_at_block
 from core_validators
 where endpoint = $1
 limit 1
@@ -1378,12 +2256,15 @@ func (q *Queries) GetNodeByEndpoint(ctx context.Context, endpoint string) (CoreV
 		&i.NodeType,
 		&i.SpID,
 		&i.CometPubKey,
+		&i.Jailed,
+		&i.JailedAtBlock,
+		&i.UnjailedAtBlock,
 	)
 	return i, err
 }
 
 const getNodesByEndpoints = `-- name: GetNodesByEndpoints :many
-select rowid, pub_key, endpoint, eth_address, comet_address, eth_block, node_type, sp_id, comet_pub_key
+select rowid, pub_key, endpoint, eth_address, comet_address, eth_block, node_type, sp_id, comet_pub_key, jailed, jailed_at_block, unjailed_at_block
 from core_validators
 where endpoint = any($1::text [])
 `
@@ -1407,6 +2288,9 @@ func (q *Queries) GetNodesByEndpoints(ctx context.Context, dollar_1 []string) ([
 			&i.NodeType,
 			&i.SpID,
 			&i.CometPubKey,
+			&i.Jailed,
+			&i.JailedAtBlock,
+			&i.UnjailedAtBlock,
 		); err != nil {
 			return nil, err
 		}
@@ -1439,25 +2323,107 @@ func (q *Queries) GetPIE(ctx context.Context, address string) (CorePie, error) {
 	return i, err
 }
 
-const getPIEReceipts = `-- name: GetPIEReceipts :many
-select raw_acknowledgment, index from core_pie where tx_hash = $1
+const getPIEReceipts = `-- name: GetPIEReceipts :many
+select raw_acknowledgment, index from core_pie where tx_hash = $1
+`
+
+type GetPIEReceiptsRow struct {
+	RawAcknowledgment []byte
+	Index             int64
+}
+
+func (q *Queries) GetPIEReceipts(ctx context.Context, txHash string) ([]GetPIEReceiptsRow, error) {
+	rows, err := q.db.Query(ctx, getPIEReceipts, txHash)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetPIEReceiptsRow
+	for rows.Next() {
+		var i GetPIEReceiptsRow
+		if err := rows.Scan(&i.RawAcknowledgment, &i.Index); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPIEsForEntity = `-- name: GetPIEsForEntity :many
+select id, address, tx_hash, index, sender, party_addresses, raw_message, raw_acknowledgment, block_height from core_pie
+where $1::text = any(party_addresses)
+order by block_height, index
+`
+
+func (q *Queries) GetPIEsForEntity(ctx context.Context, address string) ([]CorePie, error) {
+	rows, err := q.db.Query(ctx, getPIEsForEntity, address)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CorePie
+	for rows.Next() {
+		var i CorePie
+		if err := rows.Scan(
+			&i.ID,
+			&i.Address,
+			&i.TxHash,
+			&i.Index,
+			&i.Sender,
+			&i.PartyAddresses,
+			&i.RawMessage,
+			&i.RawAcknowledgment,
+			&i.BlockHeight,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPeerObservationRollups = `-- name: GetPeerObservationRollups :many
+select peer::text as address,
+    count(*) filter (
+        where peer = any(reachable)
+    ) as reachable_count,
+    count(*) filter (
+        where peer = any(unreachable)
+    ) as unreachable_count
+from core_peer_observations,
+    unnest(reachable || unreachable) as peer
+where block_height >= $1
+    and block_height <= $2
+group by peer
 `
 
-type GetPIEReceiptsRow struct {
-	RawAcknowledgment []byte
-	Index             int64
+type GetPeerObservationRollupsParams struct {
+	StartHeight int64
+	EndHeight   int64
 }
 
-func (q *Queries) GetPIEReceipts(ctx context.Context, txHash string) ([]GetPIEReceiptsRow, error) {
-	rows, err := q.db.Query(ctx, getPIEReceipts, txHash)
+type GetPeerObservationRollupsRow struct {
+	Address          string
+	ReachableCount   int64
+	UnreachableCount int64
+}
+
+func (q *Queries) GetPeerObservationRollups(ctx context.Context, arg GetPeerObservationRollupsParams) ([]GetPeerObservationRollupsRow, error) {
+	rows, err := q.db.Query(ctx, getPeerObservationRollups, arg.StartHeight, arg.EndHeight)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetPIEReceiptsRow
+	var items []GetPeerObservationRollupsRow
 	for rows.Next() {
-		var i GetPIEReceiptsRow
-		if err := rows.Scan(&i.RawAcknowledgment, &i.Index); err != nil {
+		var i GetPeerObservationRollupsRow
+		if err := rows.Scan(&i.Address, &i.ReachableCount, &i.UnreachableCount); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -1654,7 +2620,7 @@ func (q *Queries) GetRecentRollupsForNode(ctx context.Context, arg GetRecentRoll
 }
 
 const getRecentTxs = `-- name: GetRecentTxs :many
-select rowid, block_id, index, tx_hash, transaction, created_at
+select rowid, block_id, index, tx_hash, transaction, created_at, result_code, result_log
 from core_transactions
 order by created_at desc
 limit $1
@@ -1676,6 +2642,8 @@ func (q *Queries) GetRecentTxs(ctx context.Context, limit int32) ([]CoreTransact
 			&i.TxHash,
 			&i.Transaction,
 			&i.CreatedAt,
+			&i.ResultCode,
+			&i.ResultLog,
 		); err != nil {
 			return nil, err
 		}
@@ -1720,7 +2688,7 @@ func (q *Queries) GetRecordingsForTrack(ctx context.Context, trackID string) ([]
 }
 
 const getRegisteredNodeByCometAddress = `-- name: GetRegisteredNodeByCometAddress :one
-select rowid, pub_key, endpoint, eth_address, comet_address, eth_block, node_type, sp_id, comet_pub_key
+select rowid, pub_key, endpoint, eth_address, comet_address, eth_block, node_type, sp_id, comet_pub_key, jailed, jailed_at_block, unjailed_at_block
 from core_validators
 where comet_address = $1
 `
@@ -1738,12 +2706,15 @@ func (q *Queries) GetRegisteredNodeByCometAddress(ctx context.Context, cometAddr
 		&i.NodeType,
 		&i.SpID,
 		&i.CometPubKey,
+		&i.Jailed,
+		&i.JailedAtBlock,
+		&i.UnjailedAtBlock,
 	)
 	return i, err
 }
 
 const getRegisteredNodeByEthAddress = `-- name: GetRegisteredNodeByEthAddress :one
-select rowid, pub_key, endpoint, eth_address, comet_address, eth_block, node_type, sp_id, comet_pub_key
+select rowid, pub_key, endpoint, eth_address, comet_address, eth_block, node_type, sp_id, comet_pub_key, jailed, jailed_at_block, unjailed_at_block
 from core_validators
 where eth_address = $1
 `
@@ -1761,12 +2732,15 @@ func (q *Queries) GetRegisteredNodeByEthAddress(ctx context.Context, ethAddress
 		&i.NodeType,
 		&i.SpID,
 		&i.CometPubKey,
+		&i.Jailed,
+		&i.JailedAtBlock,
+		&i.UnjailedAtBlock,
 	)
 	return i, err
 }
 
 const getRegisteredNodesByCometAddresses = `-- name: GetRegisteredNodesByCometAddresses :many
-select rowid, pub_key, endpoint, eth_address, comet_address, eth_block, node_type, sp_id, comet_pub_key
+select rowid, pub_key, endpoint, eth_address, comet_address, eth_block, node_type, sp_id, comet_pub_key, jailed, jailed_at_block, unjailed_at_block
 from core_validators
 where comet_address = any($1::text [])
 `
@@ -1790,6 +2764,9 @@ func (q *Queries) GetRegisteredNodesByCometAddresses(ctx context.Context, dollar
 			&i.NodeType,
 			&i.SpID,
 			&i.CometPubKey,
+			&i.Jailed,
+			&i.JailedAtBlock,
+			&i.UnjailedAtBlock,
 		); err != nil {
 			return nil, err
 		}
@@ -1802,7 +2779,7 @@ func (q *Queries) GetRegisteredNodesByCometAddresses(ctx context.Context, dollar
 }
 
 const getRegisteredNodesByEthAddresses = `-- name: GetRegisteredNodesByEthAddresses :many
-select rowid, pub_key, endpoint, eth_address, comet_address, eth_block, node_type, sp_id, comet_pub_key
+select rowid, pub_key, endpoint, eth_address, comet_address, eth_block, node_type, sp_id, comet_pub_key, jailed, jailed_at_block, unjailed_at_block
 from core_validators
 where eth_address = any($1::text [])
 `
@@ -1826,6 +2803,9 @@ func (q *Queries) GetRegisteredNodesByEthAddresses(ctx context.Context, dollar_1
 			&i.NodeType,
 			&i.SpID,
 			&i.CometPubKey,
+			&i.Jailed,
+			&i.JailedAtBlock,
+			&i.UnjailedAtBlock,
 		); err != nil {
 			return nil, err
 		}
@@ -1838,7 +2818,7 @@ func (q *Queries) GetRegisteredNodesByEthAddresses(ctx context.Context, dollar_1
 }
 
 const getRegisteredNodesByType = `-- name: GetRegisteredNodesByType :many
-select rowid, pub_key, endpoint, eth_address, comet_address, eth_block, node_type, sp_id, comet_pub_key
+select rowid, pub_key, endpoint, eth_address, comet_address, eth_block, node_type, sp_id, comet_pub_key, jailed, jailed_at_block, unjailed_at_block
 from core_validators
 where node_type = $1
 `
@@ -1862,6 +2842,9 @@ func (q *Queries) GetRegisteredNodesByType(ctx context.Context, nodeType string)
 			&i.NodeType,
 			&i.SpID,
 			&i.CometPubKey,
+			&i.Jailed,
+			&i.JailedAtBlock,
+			&i.UnjailedAtBlock,
 		); err != nil {
 			return nil, err
 		}
@@ -1957,6 +2940,82 @@ func (q *Queries) GetRewardByTxHash(ctx context.Context, txHash string) (CoreRew
 	return i, err
 }
 
+const getRewardClaimsForRecipient = `-- name: GetRewardClaimsForRecipient :many
+select reward_address, reward_id, specifier, eth_recipient_address, amount, attester, tx_hash, block_height from core_reward_claims
+where lower(eth_recipient_address) = lower($1)
+order by block_height, reward_address, reward_id, specifier, attester
+`
+
+func (q *Queries) GetRewardClaimsForRecipient(ctx context.Context, ethRecipientAddress string) ([]CoreRewardClaim, error) {
+	rows, err := q.db.Query(ctx, getRewardClaimsForRecipient, ethRecipientAddress)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CoreRewardClaim
+	for rows.Next() {
+		var i CoreRewardClaim
+		if err := rows.Scan(
+			&i.RewardAddress,
+			&i.RewardID,
+			&i.Specifier,
+			&i.EthRecipientAddress,
+			&i.Amount,
+			&i.Attester,
+			&i.TxHash,
+			&i.BlockHeight,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRewardClaimsForSpecifier = `-- name: GetRewardClaimsForSpecifier :many
+select reward_address, reward_id, specifier, eth_recipient_address, amount, attester, tx_hash, block_height from core_reward_claims
+where reward_address = $1 and reward_id = $2 and specifier = $3
+order by block_height, attester
+`
+
+type GetRewardClaimsForSpecifierParams struct {
+	RewardAddress string
+	RewardID      string
+	Specifier     string
+}
+
+func (q *Queries) GetRewardClaimsForSpecifier(ctx context.Context, arg GetRewardClaimsForSpecifierParams) ([]CoreRewardClaim, error) {
+	rows, err := q.db.Query(ctx, getRewardClaimsForSpecifier, arg.RewardAddress, arg.RewardID, arg.Specifier)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CoreRewardClaim
+	for rows.Next() {
+		var i CoreRewardClaim
+		if err := rows.Scan(
+			&i.RewardAddress,
+			&i.RewardID,
+			&i.Specifier,
+			&i.EthRecipientAddress,
+			&i.Amount,
+			&i.Attester,
+			&i.TxHash,
+			&i.BlockHeight,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getRewardsByClaimAuthority = `-- name: GetRewardsByClaimAuthority :many
 select id, address, index, tx_hash, sender, reward_id, name, amount, claim_authorities, raw_message, block_height, created_at, updated_at
 from core_rewards
@@ -1999,7 +3058,7 @@ func (q *Queries) GetRewardsByClaimAuthority(ctx context.Context, dollar_1 strin
 }
 
 const getRollupReportForNodeAndId = `-- name: GetRollupReportForNodeAndId :one
-select id, address, blocks_proposed, sla_rollup_id
+select id, address, blocks_proposed, sla_rollup_id, challenges_received, challenges_failed
 from sla_node_reports
 where address = $1
     and sla_rollup_id = $2
@@ -2018,12 +3077,14 @@ func (q *Queries) GetRollupReportForNodeAndId(ctx context.Context, arg GetRollup
 		&i.Address,
 		&i.BlocksProposed,
 		&i.SlaRollupID,
+		&i.ChallengesReceived,
+		&i.ChallengesFailed,
 	)
 	return i, err
 }
 
 const getRollupReportsForId = `-- name: GetRollupReportsForId :many
-select id, address, blocks_proposed, sla_rollup_id
+select id, address, blocks_proposed, sla_rollup_id, challenges_received, challenges_failed
 from sla_node_reports
 where sla_rollup_id = $1
 order by address
@@ -2043,6 +3104,8 @@ func (q *Queries) GetRollupReportsForId(ctx context.Context, slaRollupID pgtype.
 			&i.Address,
 			&i.BlocksProposed,
 			&i.SlaRollupID,
+			&i.ChallengesReceived,
+			&i.ChallengesFailed,
 		); err != nil {
 			return nil, err
 		}
@@ -2063,6 +3126,8 @@ select
     sr.time,
     nr.address,
     nr.blocks_proposed,
+    nr.challenges_received,
+    nr.challenges_failed,
     (
         select count(distinct address)
         from sla_node_reports
@@ -2083,14 +3148,16 @@ type GetRollupReportsForNodeInTimeRangeParams struct {
 }
 
 type GetRollupReportsForNodeInTimeRangeRow struct {
-	ID             int32
-	TxHash         string
-	BlockStart     int64
-	BlockEnd       int64
-	Time           pgtype.Timestamp
-	Address        pgtype.Text
-	BlocksProposed pgtype.Int4
-	ValidatorCount int64
+	ID                 int32
+	TxHash             string
+	BlockStart         int64
+	BlockEnd           int64
+	Time               pgtype.Timestamp
+	Address            pgtype.Text
+	BlocksProposed     pgtype.Int4
+	ChallengesReceived pgtype.Int4
+	ChallengesFailed   pgtype.Int4
+	ValidatorCount     int64
 }
 
 func (q *Queries) GetRollupReportsForNodeInTimeRange(ctx context.Context, arg GetRollupReportsForNodeInTimeRangeParams) ([]GetRollupReportsForNodeInTimeRangeRow, error) {
@@ -2110,6 +3177,8 @@ func (q *Queries) GetRollupReportsForNodeInTimeRange(ctx context.Context, arg Ge
 			&i.Time,
 			&i.Address,
 			&i.BlocksProposed,
+			&i.ChallengesReceived,
+			&i.ChallengesFailed,
 			&i.ValidatorCount,
 		); err != nil {
 			return nil, err
@@ -2195,6 +3264,65 @@ func (q *Queries) GetRollupReportsForNodesInTimeRange(ctx context.Context, arg G
 	return items, nil
 }
 
+const getRollupsForNodeSince = `-- name: GetRollupsForNodeSince :many
+with recent_rollups as (
+    select id, tx_hash, block_start, block_end, time
+    from sla_rollups
+    where block_start > $3
+    order by time desc
+    limit $1
+)
+select rr.id,
+    rr.block_start,
+    rr.block_end,
+    nr.address,
+    nr.blocks_proposed
+from recent_rollups rr
+    left join sla_node_reports nr on rr.id = nr.sla_rollup_id
+    and nr.address = $2
+order by rr.time
+`
+
+type GetRollupsForNodeSinceParams struct {
+	Limit      int32
+	Address    string
+	BlockStart int64
+}
+
+type GetRollupsForNodeSinceRow struct {
+	ID             int32
+	BlockStart     int64
+	BlockEnd       int64
+	Address        pgtype.Text
+	BlocksProposed pgtype.Int4
+}
+
+func (q *Queries) GetRollupsForNodeSince(ctx context.Context, arg GetRollupsForNodeSinceParams) ([]GetRollupsForNodeSinceRow, error) {
+	rows, err := q.db.Query(ctx, getRollupsForNodeSince, arg.Limit, arg.Address, arg.BlockStart)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetRollupsForNodeSinceRow
+	for rows.Next() {
+		var i GetRollupsForNodeSinceRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.BlockStart,
+			&i.BlockEnd,
+			&i.Address,
+			&i.BlocksProposed,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getSlaRollupWithBlockEnd = `-- name: GetSlaRollupWithBlockEnd :one
 select id, tx_hash, block_start, block_end, time
 from sla_rollups
@@ -2252,6 +3380,159 @@ func (q *Queries) GetSlaRollupWithTimestamp(ctx context.Context, time pgtype.Tim
 	return i, err
 }
 
+const getStateBucketValues = `-- name: GetStateBucketValues :many
+select l.key, l.value_hash
+from (
+    select distinct on (key) key, value_hash
+    from core_state_values
+    where bucket = $1 and block_height <= $2
+    order by key, block_height desc
+) l
+where l.value_hash is not null
+`
+
+type GetStateBucketValuesParams struct {
+	Bucket      int32
+	BlockHeight int64
+}
+
+type GetStateBucketValuesRow struct {
+	Key       string
+	ValueHash []byte
+}
+
+func (q *Queries) GetStateBucketValues(ctx context.Context, arg GetStateBucketValuesParams) ([]GetStateBucketValuesRow, error) {
+	rows, err := q.db.Query(ctx, getStateBucketValues, arg.Bucket, arg.BlockHeight)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetStateBucketValuesRow
+	for rows.Next() {
+		var i GetStateBucketValuesRow
+		if err := rows.Scan(&i.Key, &i.ValueHash); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getStateNodes = `-- name: GetStateNodes :many
+select distinct on (index) index, hash
+from core_state_nodes
+where depth = $1 and index = any($3::integer[]) and block_height <= $2
+order by index, block_height desc
+`
+
+type GetStateNodesParams struct {
+	Depth       int32
+	BlockHeight int64
+	Indexes     []int32
+}
+
+type GetStateNodesRow struct {
+	Index int32
+	Hash  []byte
+}
+
+func (q *Queries) GetStateNodes(ctx context.Context, arg GetStateNodesParams) ([]GetStateNodesRow, error) {
+	rows, err := q.db.Query(ctx, getStateNodes, arg.Depth, arg.BlockHeight, arg.Indexes)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetStateNodesRow
+	for rows.Next() {
+		var i GetStateNodesRow
+		if err := rows.Scan(&i.Index, &i.Hash); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getStateTreeRetainHeight = `-- name: GetStateTreeRetainHeight :one
+select block_height from core_state_tree_retain
+`
+
+func (q *Queries) GetStateTreeRetainHeight(ctx context.Context) (int64, error) {
+	row := q.db.QueryRow(ctx, getStateTreeRetainHeight)
+	var block_height int64
+	err := row.Scan(&block_height)
+	return block_height, err
+}
+
+const getStateValue = `-- name: GetStateValue :one
+select key, bucket, value, value_hash, block_height
+from core_state_values
+where key = $1 and block_height <= $2
+order by block_height desc
+limit 1
+`
+
+type GetStateValueParams struct {
+	Key         string
+	BlockHeight int64
+}
+
+func (q *Queries) GetStateValue(ctx context.Context, arg GetStateValueParams) (CoreStateValue, error) {
+	row := q.db.QueryRow(ctx, getStateValue, arg.Key, arg.BlockHeight)
+	var i CoreStateValue
+	err := row.Scan(
+		&i.Key,
+		&i.Bucket,
+		&i.Value,
+		&i.ValueHash,
+		&i.BlockHeight,
+	)
+	return i, err
+}
+
+const getStateValuesAtHeight = `-- name: GetStateValuesAtHeight :many
+select l.key, l.bucket, l.value_hash
+from (
+    select distinct on (key) key, bucket, value_hash
+    from core_state_values
+    where block_height <= $1
+    order by key, block_height desc
+) l
+where l.value_hash is not null
+`
+
+type GetStateValuesAtHeightRow struct {
+	Key       string
+	Bucket    int32
+	ValueHash []byte
+}
+
+func (q *Queries) GetStateValuesAtHeight(ctx context.Context, blockHeight int64) ([]GetStateValuesAtHeightRow, error) {
+	rows, err := q.db.Query(ctx, getStateValuesAtHeight, blockHeight)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetStateValuesAtHeightRow
+	for rows.Next() {
+		var i GetStateValuesAtHeightRow
+		if err := rows.Scan(&i.Key, &i.Bucket, &i.ValueHash); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getStorageProof = `-- name: GetStorageProof :one
 select id, block_height, address, cid, proof_signature, proof, prover_addresses, status
 from storage_proofs
@@ -2450,8 +3731,49 @@ func (q *Queries) GetStorageProofsForNodeInRange(ctx context.Context, arg GetSto
 	return items, nil
 }
 
+const getTransactionsInBlockRange = `-- name: GetTransactionsInBlockRange :many
+select rowid, block_id, index, tx_hash, transaction, created_at, result_code, result_log
+from core_transactions
+where block_id between $1 and $2
+order by block_id asc, index asc
+`
+
+type GetTransactionsInBlockRangeParams struct {
+	BlockID   int64
+	BlockID_2 int64
+}
+
+func (q *Queries) GetTransactionsInBlockRange(ctx context.Context, arg GetTransactionsInBlockRangeParams) ([]CoreTransaction, error) {
+	rows, err := q.db.Query(ctx, getTransactionsInBlockRange, arg.BlockID, arg.BlockID_2)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CoreTransaction
+	for rows.Next() {
+		var i CoreTransaction
+		if err := rows.Scan(
+			&i.Rowid,
+			&i.BlockID,
+			&i.Index,
+			&i.TxHash,
+			&i.Transaction,
+			&i.CreatedAt,
+			&i.ResultCode,
+			&i.ResultLog,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getTx = `-- name: GetTx :one
-select rowid, block_id, index, tx_hash, transaction, created_at
+select rowid, block_id, index, tx_hash, transaction, created_at, result_code, result_log
 from core_transactions
 where lower(tx_hash) = lower($1)
 limit 1
@@ -2467,6 +3789,8 @@ func (q *Queries) GetTx(ctx context.Context, lower string) (CoreTransaction, err
 		&i.TxHash,
 		&i.Transaction,
 		&i.CreatedAt,
+		&i.ResultCode,
+		&i.ResultLog,
 	)
 	return i, err
 }
@@ -2523,6 +3847,36 @@ func (q *Queries) HasAccessToTrackRelease(ctx context.Context, arg HasAccessToTr
 	return exists, err
 }
 
+const isCIDTakenDown = `-- name: IsCIDTakenDown :one
+select exists(select 1 from core_takedown_cids where cid = $1)
+`
+
+func (q *Queries) IsCIDTakenDown(ctx context.Context, cid string) (bool, error) {
+	row := q.db.QueryRow(ctx, isCIDTakenDown, cid)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const isManageEntityNonceUsed = `-- name: IsManageEntityNonceUsed :one
+select exists(
+    select 1 from core_manage_entity_nonces
+    where signer = $1 and nonce = $2
+)
+`
+
+type IsManageEntityNonceUsedParams struct {
+	Signer string
+	Nonce  string
+}
+
+func (q *Queries) IsManageEntityNonceUsed(ctx context.Context, arg IsManageEntityNonceUsedParams) (bool, error) {
+	row := q.db.QueryRow(ctx, isManageEntityNonceUsed, arg.Signer, arg.Nonce)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
 const totalBlocks = `-- name: TotalBlocks :one
 select count(*)
 from core_blocks
@@ -2572,6 +3926,19 @@ func (q *Queries) TotalTxResults(ctx context.Context) (int64, error) {
 	return count, err
 }
 
+const totalUnjailedValidators = `-- name: TotalUnjailedValidators :one
+select count(*)
+from core_validators
+where not jailed
+`
+
+func (q *Queries) TotalUnjailedValidators(ctx context.Context) (int64, error) {
+	row := q.db.QueryRow(ctx, totalUnjailedValidators)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const totalValidators = `-- name: TotalValidators :one
 select count(*)
 from core_validators
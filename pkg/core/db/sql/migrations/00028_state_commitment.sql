@@ -0,0 +1,5 @@
+-- +migrate Up
+alter table core_app_state add column if not exists state_digest bytea;
+
+-- +migrate Down
+alter table core_app_state drop column if exists state_digest;
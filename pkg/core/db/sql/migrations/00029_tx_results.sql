@@ -0,0 +1,7 @@
+-- +migrate Up
+alter table core_transactions add column if not exists result_code integer not null default 0;
+alter table core_transactions add column if not exists result_log text not null default '';
+
+-- +migrate Down
+alter table core_transactions drop column if exists result_log;
+alter table core_transactions drop column if exists result_code;
@@ -0,0 +1,27 @@
+-- +migrate Up
+create table if not exists core_ern_takedowns(
+    id bigserial primary key,
+    ern_address text not null unique,
+    tx_hash text not null,
+    index bigint not null,
+    sender text not null,
+    raw_message bytea not null,
+    raw_acknowledgment bytea not null,
+    block_height bigint not null
+);
+
+create index if not exists idx_core_ern_takedowns_tx_hash on core_ern_takedowns(tx_hash);
+
+-- cids of taken down sound recordings, both original and transcoded,
+-- so storage can refuse to serve them without decoding the ern
+create table if not exists core_takedown_cids(
+    cid text not null,
+    ern_address text not null,
+    block_height bigint not null,
+    primary key (cid, ern_address)
+);
+
+-- +migrate Down
+drop table if exists core_takedown_cids;
+drop index if exists idx_core_ern_takedowns_tx_hash;
+drop table if exists core_ern_takedowns;
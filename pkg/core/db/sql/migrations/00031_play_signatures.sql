@@ -0,0 +1,20 @@
+-- +migrate Up
+-- recently finalized plays, plays are only accepted within a window of block time
+-- so rows older than that window are pruned as blocks finalize. A play signature
+-- only covers the track and second, listeners of the same track in the same second
+-- through the same validator share it so the user is part of the key
+create table if not exists core_play_signatures(
+    signature text not null,
+    track_id text not null,
+    user_id text not null,
+    played_at bigint not null,
+    tx_hash text not null,
+    block_height bigint not null,
+    primary key (signature, track_id, user_id, played_at)
+);
+
+create index if not exists idx_core_play_signatures_played_at on core_play_signatures(played_at);
+
+-- +migrate Down
+drop index if exists idx_core_play_signatures_played_at;
+drop table if exists core_play_signatures;
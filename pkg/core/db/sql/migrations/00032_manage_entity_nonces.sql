@@ -0,0 +1,12 @@
+-- +migrate Up
+-- nonces consumed by finalized manage entity txs, a signer can use each nonce once
+create table if not exists core_manage_entity_nonces(
+    signer text not null,
+    nonce text not null,
+    tx_hash text not null,
+    block_height bigint not null,
+    primary key (signer, nonce)
+);
+
+-- +migrate Down
+drop table if exists core_manage_entity_nonces;
@@ -0,0 +1,14 @@
+-- +migrate Up
+-- which peers each validator could reach, carried in its vote extension and
+-- aggregated on chain by the next proposer
+create table if not exists core_peer_observations(
+    block_height bigint not null,
+    observer text not null,
+    reachable text[] not null,
+    unreachable text[] not null,
+    tx_hash text not null,
+    primary key (block_height, observer)
+);
+
+-- +migrate Down
+drop table if exists core_peer_observations;
@@ -0,0 +1,13 @@
+-- +migrate Up
+-- jailed validators stay registered with no voting power until they unjail,
+-- 00009 was meant for this but is a placeholder left behind by a revert
+alter table core_validators add column if not exists jailed boolean not null default false;
+alter table core_validators add column if not exists jailed_at_block bigint not null default 0;
+alter table core_validators add column if not exists unjailed_at_block bigint not null default 0;
+
+do $$ begin alter type validator_event add value if not exists 'jailed'; alter type validator_event add value if not exists 'unjailed'; end $$;
+
+-- +migrate Down
+alter table core_validators drop column if exists unjailed_at_block;
+alter table core_validators drop column if exists jailed_at_block;
+alter table core_validators drop column if exists jailed;
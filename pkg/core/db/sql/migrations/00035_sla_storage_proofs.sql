@@ -0,0 +1,9 @@
+-- +migrate Up
+-- storage proof challenges each validator received and failed during a rollup,
+-- zero for rollups from before they were reported
+alter table sla_node_reports add column if not exists challenges_received integer not null default 0;
+alter table sla_node_reports add column if not exists challenges_failed integer not null default 0;
+
+-- +migrate Down
+alter table sla_node_reports drop column if exists challenges_failed;
+alter table sla_node_reports drop column if exists challenges_received;
@@ -0,0 +1,21 @@
+-- +migrate Up
+-- slash proposals this node has submitted to governance, or would have in dry run,
+-- local to the node so it is left out of snapshots
+create table if not exists core_slash_proposals(
+    id bigserial primary key,
+    address text not null,
+    amount bigint not null,
+    start_time timestamp not null,
+    end_time timestamp not null,
+    attestations integer not null,
+    dry_run boolean not null,
+    tx_hash text not null default '',
+    error text not null default '',
+    created_at timestamp not null default now()
+);
+
+create index if not exists idx_core_slash_proposals_address on core_slash_proposals(lower(address), created_at desc);
+
+-- +migrate Down
+drop index if exists idx_core_slash_proposals_address;
+drop table if exists core_slash_proposals;
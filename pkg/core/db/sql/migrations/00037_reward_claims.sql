@@ -0,0 +1,20 @@
+-- +migrate Up
+-- reward claims each validator attested to, reward_address is empty for the
+-- rewards every node carries in its config
+create table if not exists core_reward_claims(
+    reward_address text not null,
+    reward_id text not null,
+    specifier text not null,
+    eth_recipient_address text not null,
+    amount bigint not null,
+    attester text not null,
+    tx_hash text not null,
+    block_height bigint not null,
+    primary key (reward_address, reward_id, specifier, attester)
+);
+
+create index if not exists idx_core_reward_claims_recipient on core_reward_claims(lower(eth_recipient_address));
+
+-- +migrate Down
+drop index if exists idx_core_reward_claims_recipient;
+drop table if exists core_reward_claims;
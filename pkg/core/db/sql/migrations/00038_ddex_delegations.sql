@@ -0,0 +1,26 @@
+-- +migrate Up
+-- every delegation and revocation a sender made, the latest row for a sender and
+-- delegate decides whether the delegate may act for the sender
+create table if not exists core_delegations(
+    tx_hash text not null,
+    index bigint not null,
+    sender text not null,
+    delegate text not null,
+    revoked boolean not null,
+    block_height bigint not null,
+    primary key (tx_hash, index)
+);
+
+create index if not exists idx_core_delegations_sender_delegate on core_delegations(lower(sender), lower(delegate), block_height);
+
+-- enrichments are looked up by the entities they're bound to
+create index if not exists idx_core_mead_resource_addresses on core_mead using gin (resource_addresses);
+create index if not exists idx_core_mead_release_addresses on core_mead using gin (release_addresses);
+create index if not exists idx_core_pie_party_addresses on core_pie using gin (party_addresses);
+
+-- +migrate Down
+drop index if exists idx_core_pie_party_addresses;
+drop index if exists idx_core_mead_release_addresses;
+drop index if exists idx_core_mead_resource_addresses;
+drop index if exists idx_core_delegations_sender_delegate;
+drop table if exists core_delegations;
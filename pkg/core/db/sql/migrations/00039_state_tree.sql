@@ -0,0 +1,36 @@
+-- +migrate Up
+-- every version of every queryable value, a removed key gets a row with no value
+create table if not exists core_state_values(
+    key text not null,
+    bucket integer not null,
+    value bytea,
+    value_hash bytea,
+    block_height bigint not null,
+    primary key (key, block_height)
+);
+
+create index if not exists idx_core_state_values_bucket on core_state_values(bucket, key, block_height);
+
+-- every version of the nodes above the buckets, depth 16 holds one node per bucket
+create table if not exists core_state_nodes(
+    depth integer not null,
+    index integer not null,
+    hash bytea not null,
+    block_height bigint not null,
+    primary key (depth, index, block_height)
+);
+
+-- the lowest height state tree versions are kept for, older ones are pruned
+create table if not exists core_state_tree_retain(
+    id boolean primary key default true check (id),
+    block_height bigint not null
+);
+
+alter table core_app_state add column if not exists state_root bytea;
+
+-- +migrate Down
+alter table core_app_state drop column if exists state_root;
+drop table if exists core_state_tree_retain;
+drop table if exists core_state_nodes;
+drop index if exists idx_core_state_values_bucket;
+drop table if exists core_state_values;
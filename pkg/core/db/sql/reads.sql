@@ -10,14 +10,18 @@ from core_transactions;
 
 -- name: GetLatestAppState :one
 select block_height,
-    app_hash
+    app_hash,
+    state_digest,
+    state_root
 from core_app_state
 order by block_height desc
 limit 1;
 
 -- name: GetAppStateAtHeight :one
 select block_height,
-    app_hash
+    app_hash,
+    state_digest,
+    state_root
 from core_app_state
 where block_height = $1
 limit 1;
@@ -76,6 +80,29 @@ from recent_rollups rr
     and nr.address = $2
 order by rr.time;
 
+-- name: GetRollupsForNodeSince :many
+with recent_rollups as (
+    select *
+    from sla_rollups
+    where block_start > $3
+    order by time desc
+    limit $1
+)
+select rr.id,
+    rr.block_start,
+    rr.block_end,
+    nr.address,
+    nr.blocks_proposed
+from recent_rollups rr
+    left join sla_node_reports nr on rr.id = nr.sla_rollup_id
+    and nr.address = $2
+order by rr.time;
+
+-- name: CountSlaRollupsSince :one
+select count(*)
+from sla_rollups
+where block_start > $1;
+
 -- name: GetRecentRollupsForAllNodes :many
 with recent_rollups as (
     select *
@@ -104,6 +131,8 @@ select
     sr.time,
     nr.address,
     nr.blocks_proposed,
+    nr.challenges_received,
+    nr.challenges_failed,
     (
         select count(distinct address)
         from sla_node_reports
@@ -243,6 +272,11 @@ where tx_type = $1;
 select count(*)
 from core_validators;
 
+-- name: TotalUnjailedValidators :one
+select count(*)
+from core_validators
+where not jailed;
+
 -- name: TxsPerHour :many
 select date_trunc('hour', created_at)::timestamp as hour,
     tx_type,
@@ -264,6 +298,18 @@ select *
 from core_blocks
 where height = $1;
 
+-- name: GetBlockByHash :one
+select *
+from core_blocks
+where hash = $1
+limit 1;
+
+-- name: GetTransactionsInBlockRange :many
+select *
+from core_transactions
+where block_id between $1 and $2
+order by block_id asc, index asc;
+
 -- name: GetStorageProofPeers :one
 select prover_addresses
 from storage_proof_peers
@@ -291,6 +337,20 @@ where block_height >= $1
     and block_height <= $2
 group by address;
 
+-- name: GetPeerObservationRollups :many
+select peer::text as address,
+    count(*) filter (
+        where peer = any(reachable)
+    ) as reachable_count,
+    count(*) filter (
+        where peer = any(unreachable)
+    ) as unreachable_count
+from core_peer_observations,
+    unnest(reachable || unreachable) as peer
+where block_height >= sqlc.arg(start_height)
+    and block_height <= sqlc.arg(end_height)
+group by peer;
+
 -- name: GetStorageProofRollupForNode :one
 select address,
     count(*) filter (
@@ -527,6 +587,30 @@ select * from core_mead where address = $1 order by block_height desc limit 1;
 -- name: GetERNReceipts :many
 select raw_acknowledgment, index from core_ern where tx_hash = $1;
 
+-- name: GetERNVersions :many
+select * from core_ern where address = $1 order by block_height asc, index asc;
+
+-- name: GetERNTakedown :one
+select * from core_ern_takedowns where ern_address = $1;
+
+-- name: GetERNTakedownForAddress :one
+select t.* from core_ern_takedowns t
+where t.ern_address = $1::text
+   or t.ern_address in (
+       select ern_address from core_releases where address = $1::text
+       union all
+       select ern_address from core_resources where address = $1::text
+       union all
+       select ern_address from core_deals where address = $1::text
+   )
+limit 1;
+
+-- name: GetERNTakedownReceipts :many
+select raw_acknowledgment, index from core_ern_takedowns where tx_hash = $1;
+
+-- name: IsCIDTakenDown :one
+select exists(select 1 from core_takedown_cids where cid = $1);
+
 -- name: GetMEADReceipts :many
 select raw_acknowledgment, index from core_mead where tx_hash = $1;
 
@@ -546,7 +630,9 @@ select
     t.index as tx_index,
     t.tx_hash,
     t.transaction,
-    t.created_at as tx_created_at
+    t.created_at as tx_created_at,
+    t.result_code,
+    t.result_log
 from core_blocks b
 left join core_transactions t on b.height = t.block_id
 where b.height = any($1::bigint[])
@@ -604,7 +690,8 @@ SELECT
         ELSE 'unknown'
     END::text as entity_type,
     COALESCE(r.entity_index, rel.entity_index, p.entity_index, d.entity_index, 0)::int as entity_index,
-    ern.raw_message
+    ern.raw_message,
+    ern.raw_acknowledgment
 FROM core_ern ern
 LEFT JOIN core_resources r ON r.address = $1::text AND r.ern_address = ern.address
 LEFT JOIN core_releases rel ON rel.address = $1::text AND rel.ern_address = ern.address
@@ -625,3 +712,135 @@ select * from core_parties where ern_address = $1 order by entity_index;
 
 -- name: GetERNDeals :many
 select * from core_deals where ern_address = $1 order by entity_index;
+
+-- name: GetAllCoreERNs :many
+select * from core_ern;
+
+-- name: GetAllCoreResources :many
+select * from core_resources;
+
+-- name: GetAllCoreReleases :many
+select * from core_releases;
+
+-- name: GetAllCoreParties :many
+select * from core_parties;
+
+-- name: GetAllCoreDeals :many
+select * from core_deals;
+
+-- name: GetAllCoreMEADs :many
+select * from core_mead;
+
+-- name: GetAllCorePIEs :many
+select * from core_pie;
+
+-- name: GetAllCoreRewards :many
+select * from core_rewards;
+
+-- name: GetAllCoreUploads :many
+select * from core_uploads;
+
+-- name: GetAllCoreERNTakedowns :many
+select * from core_ern_takedowns;
+
+-- name: GetAllCoreTakedownCIDs :many
+select * from core_takedown_cids;
+
+-- name: GetAllCorePlaySignatures :many
+select * from core_play_signatures;
+
+-- name: GetExistingPlaySignatures :many
+select signature, track_id, user_id, played_at from core_play_signatures
+where signature = any($1::text[]);
+
+-- name: GetAllCoreManageEntityNonces :many
+select * from core_manage_entity_nonces;
+
+-- name: GetAllCorePeerObservations :many
+select * from core_peer_observations;
+
+-- name: IsManageEntityNonceUsed :one
+select exists(
+    select 1 from core_manage_entity_nonces
+    where signer = $1 and nonce = $2
+);
+
+-- name: GetLatestSlashProposalForAddress :one
+select * from core_slash_proposals
+where lower(address) = lower(sqlc.arg(address))
+order by created_at desc
+limit 1;
+
+-- name: GetAllCoreRewardClaims :many
+select * from core_reward_claims;
+
+-- name: GetRewardClaimsForSpecifier :many
+select * from core_reward_claims
+where reward_address = $1 and reward_id = $2 and specifier = $3
+order by block_height, attester;
+
+-- name: GetRewardClaimsForRecipient :many
+select * from core_reward_claims
+where lower(eth_recipient_address) = lower(sqlc.arg(eth_recipient_address))
+order by block_height, reward_address, reward_id, specifier, attester;
+
+-- name: GetAllCoreDelegations :many
+select * from core_delegations;
+
+-- name: GetLatestDelegation :one
+select * from core_delegations
+where lower(sender) = lower(sqlc.arg(sender)) and lower(delegate) = lower(sqlc.arg(delegate))
+order by block_height desc, index desc
+limit 1;
+
+-- name: GetDelegationReceipts :many
+select * from core_delegations where tx_hash = $1;
+
+-- name: GetMEADsForEntity :many
+select * from core_mead
+where sqlc.arg(address)::text = any(resource_addresses) or sqlc.arg(address)::text = any(release_addresses)
+order by block_height, index;
+
+-- name: GetPIEsForEntity :many
+select * from core_pie
+where sqlc.arg(address)::text = any(party_addresses)
+order by block_height, index;
+
+-- name: GetAllTxHashes :many
+select tx_hash from core_transactions;
+
+-- name: GetStateValue :one
+select key, bucket, value, value_hash, block_height
+from core_state_values
+where key = $1 and block_height <= $2
+order by block_height desc
+limit 1;
+
+-- name: GetStateBucketValues :many
+select l.key, l.value_hash
+from (
+    select distinct on (key) key, value_hash
+    from core_state_values
+    where bucket = $1 and block_height <= $2
+    order by key, block_height desc
+) l
+where l.value_hash is not null;
+
+-- name: GetStateValuesAtHeight :many
+select l.key, l.bucket, l.value_hash
+from (
+    select distinct on (key) key, bucket, value_hash
+    from core_state_values
+    where block_height <= $1
+    order by key, block_height desc
+) l
+where l.value_hash is not null;
+
+-- name: GetStateNodes :many
+select distinct on (index) index, hash
+from core_state_nodes
+where depth = $1 and index = any(sqlc.arg(indexes)::integer[]) and block_height <= $2
+order by index, block_height desc;
+
+-- name: GetStateTreeRetainHeight :one
+select block_height from core_state_tree_retain;
@@ -1,14 +1,31 @@
 -- name: UpsertAppState :exec
-insert into core_app_state (block_height, app_hash)
-values ($1, $2);
+insert into core_app_state (block_height, app_hash, state_digest, state_root)
+values ($1, $2, $3, $4);
 
 -- name: InsertRegisteredNode :exec
 insert into core_validators(pub_key, endpoint, eth_address, comet_address, comet_pub_key, eth_block, node_type, sp_id)
 values ($1, $2, $3, $4, $5, $6, $7, $8);
 
--- name: DeleteRegisteredNode :exec
+-- name: DeleteRegisteredNode :many
 delete from core_validators
-where comet_address = $1;
+where comet_address = $1
+returning *;
+
+-- name: JailValidator :many
+update core_validators
+set jailed = true,
+    jailed_at_block = $2
+where comet_address = $1
+    and not jailed
+returning *;
+
+-- name: UnjailValidator :many
+update core_validators
+set jailed = false,
+    unjailed_at_block = $2
+where comet_address = $1
+    and jailed
+returning *;
 
 -- name: UpsertSlaRollupReport :exec
 with updated as (
@@ -26,8 +43,8 @@ delete from sla_node_reports
 where sla_rollup_id is null;
 
 -- name: CommitSlaNodeReport :exec
-insert into sla_node_reports (sla_rollup_id, address, blocks_proposed)
-values ($1, $2, $3);
+insert into sla_node_reports (sla_rollup_id, address, blocks_proposed, challenges_received, challenges_failed)
+values ($1, $2, $3, $4, $5);
 
 -- name: CommitSlaRollup :one
 insert into sla_rollups (time, tx_hash, block_start, block_end)
@@ -44,8 +61,8 @@ insert into core_blocks (height, chain_id, hash, proposer, created_at)
 values ($1, $2, $3, $4, $5);
 
 -- name: StoreTransaction :exec
-insert into core_transactions (block_id, index, tx_hash, transaction, created_at)
-values ($1, $2, $3, $4, $5);
+insert into core_transactions (block_id, index, tx_hash, transaction, created_at, result_code, result_log)
+values ($1, $2, $3, $4, $5, $6, $7);
 
 -- name: InsertStorageProofPeers :exec
 insert into storage_proof_peers (block_height, prover_addresses)
@@ -239,6 +256,22 @@ insert into core_ern (
     block_height
 ) values ($1, $2, $3, $4, $5, $6, $7, $8);
 
+-- name: InsertCoreERNTakedown :exec
+insert into core_ern_takedowns (
+    ern_address,
+    tx_hash,
+    index,
+    sender,
+    raw_message,
+    raw_acknowledgment,
+    block_height
+) values ($1, $2, $3, $4, $5, $6, $7);
+
+-- name: InsertCoreTakedownCID :execrows
+insert into core_takedown_cids (cid, ern_address, block_height)
+values ($1, $2, $3)
+on conflict do nothing;
+
 -- name: InsertCoreResource :exec
 insert into core_resources (
     address,
@@ -340,9 +373,10 @@ set name = $2,
     updated_at = now()
 where address = $1;
 
--- name: DeleteCoreReward :exec
+-- name: DeleteCoreReward :many
 delete from core_rewards
-where address = $1;
+where address = $1
+returning *;
 
 -- name: InsertFileUpload :exec
 insert into core_uploads(
@@ -356,3 +390,70 @@ insert into core_uploads(
     tx_hash,
     block_height
 ) values ($1, $2, $3, $4, $5, $6, $7, $8, $9);
+
+-- name: InsertPlaySignature :exec
+insert into core_play_signatures (signature, track_id, user_id, played_at, tx_hash, block_height)
+values ($1, $2, $3, $4, $5, $6);
+
+-- name: DeleteExpiredPlaySignatures :many
+delete from core_play_signatures
+where played_at < $1
+returning *;
+
+-- name: InsertManageEntityNonce :exec
+insert into core_manage_entity_nonces (signer, nonce, tx_hash, block_height)
+values ($1, $2, $3, $4);
+
+-- name: InsertPeerObservation :exec
+insert into core_peer_observations (block_height, observer, reachable, unreachable, tx_hash)
+values ($1, $2, $3, $4, $5)
+on conflict do nothing;
+
+-- name: InsertSlashProposal :exec
+insert into core_slash_proposals (address, amount, start_time, end_time, attestations, dry_run, tx_hash, error)
+values ($1, $2, $3, $4, $5, $6, $7, $8);
+
+-- name: InsertRewardClaim :exec
+insert into core_reward_claims (reward_address, reward_id, specifier, eth_recipient_address, amount, attester, tx_hash, block_height)
+values ($1, $2, $3, $4, $5, $6, $7, $8)
+on conflict do nothing;
+
+-- name: InsertCoreDelegation :exec
+insert into core_delegations (tx_hash, index, sender, delegate, revoked, block_height)
+values ($1, $2, $3, $4, $5, $6);
+
+-- name: InsertStateValues :exec
+insert into core_state_values (key, bucket, value, value_hash, block_height)
+select unnest(sqlc.arg(keys)::text[]),
+    unnest(sqlc.arg(buckets)::integer[]),
+    unnest(sqlc.arg(state_values)::bytea[]),
+    unnest(sqlc.arg(value_hashes)::bytea[]),
+    sqlc.arg(block_height)::bigint;
+
+-- name: InsertStateNodes :exec
+insert into core_state_nodes (depth, index, hash, block_height)
+select sqlc.arg(depth)::integer,
+    unnest(sqlc.arg(indexes)::integer[]),
+    unnest(sqlc.arg(hashes)::bytea[]),
+    sqlc.arg(block_height)::bigint;
+
+-- name: SetStateTreeRetainHeight :exec
+insert into core_state_tree_retain (block_height) values ($1)
+on conflict (id) do update set block_height = excluded.block_height;
+
+-- name: PruneStateValues :exec
+delete from core_state_values l
+where l.block_height < $1
+and exists (
+    select 1 from core_state_values newer
+    where newer.key = l.key and newer.block_height > l.block_height and newer.block_height <= $1
+);
+
+-- name: PruneStateNodes :exec
+delete from core_state_nodes n
+where n.block_height < $1
+and exists (
+    select 1 from core_state_nodes newer
+    where newer.depth = n.depth and newer.index = n.index
+    and newer.block_height > n.block_height and newer.block_height <= $1
+);
@@ -60,18 +60,26 @@ func (q *Queries) ClearUncommittedSlaNodeReports(ctx context.Context) error {
 }
 
 const commitSlaNodeReport = `-- name: CommitSlaNodeReport :exec
-insert into sla_node_reports (sla_rollup_id, address, blocks_proposed)
-values ($1, $2, $3)
+insert into sla_node_reports (sla_rollup_id, address, blocks_proposed, challenges_received, challenges_failed)
+values ($1, $2, $3, $4, $5)
 `
 
 type CommitSlaNodeReportParams struct {
-	SlaRollupID    pgtype.Int4
-	Address        string
-	BlocksProposed int32
+	SlaRollupID        pgtype.Int4
+	Address            string
+	BlocksProposed     int32
+	ChallengesReceived int32
+	ChallengesFailed   int32
 }
 
 func (q *Queries) CommitSlaNodeReport(ctx context.Context, arg CommitSlaNodeReportParams) error {
-	_, err := q.db.Exec(ctx, commitSlaNodeReport, arg.SlaRollupID, arg.Address, arg.BlocksProposed)
+	_, err := q.db.Exec(ctx, commitSlaNodeReport,
+		arg.SlaRollupID,
+		arg.Address,
+		arg.BlocksProposed,
+		arg.ChallengesReceived,
+		arg.ChallengesFailed,
+	)
 	return err
 }
 
@@ -100,24 +108,116 @@ func (q *Queries) CommitSlaRollup(ctx context.Context, arg CommitSlaRollupParams
 	return id, err
 }
 
-const deleteCoreReward = `-- name: DeleteCoreReward :exec
+const deleteCoreReward = `-- name: DeleteCoreReward :many
 delete from core_rewards
 where address = $1
+returning id, address, index, tx_hash, sender, reward_id, name, amount, claim_authorities, raw_message, block_height, created_at, updated_at
 `
 
-func (q *Queries) DeleteCoreReward(ctx context.Context, address string) error {
-	_, err := q.db.Exec(ctx, deleteCoreReward, address)
-	return err
-}
+func (q *Queries) DeleteCoreReward(ctx context.Context, address string) ([]CoreReward, error) {
+	rows, err := q.db.Query(ctx, deleteCoreReward, address)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CoreReward
+	for rows.Next() {
+		var i CoreReward
+		if err := rows.Scan(
+			&i.ID,
+			&i.Address,
+			&i.Index,
+			&i.TxHash,
+			&i.Sender,
+			&i.RewardID,
+			&i.Name,
+			&i.Amount,
+			&i.ClaimAuthorities,
+			&i.RawMessage,
+			&i.BlockHeight,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const deleteExpiredPlaySignatures = `-- name: DeleteExpiredPlaySignatures :many
+delete from core_play_signatures
+where played_at < $1
+returning signature, track_id, user_id, played_at, tx_hash, block_height
+`
 
-const deleteRegisteredNode = `-- name: DeleteRegisteredNode :exec
+func (q *Queries) DeleteExpiredPlaySignatures(ctx context.Context, playedAt int64) ([]CorePlaySignature, error) {
+	rows, err := q.db.Query(ctx, deleteExpiredPlaySignatures, playedAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CorePlaySignature
+	for rows.Next() {
+		var i CorePlaySignature
+		if err := rows.Scan(
+			&i.Signature,
+			&i.TrackID,
+			&i.UserID,
+			&i.PlayedAt,
+			&i.TxHash,
+			&i.BlockHeight,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const deleteRegisteredNode = `-- name: DeleteRegisteredNode :many
 delete from core_validators
 where comet_address = $1
+returning rowid, pub_key, endpoint, eth_address, comet_address, eth_block, node_type, sp_id, comet_pub_key, jailed, jailed_at_block, unjailed_at_block
 `
 
-func (q *Queries) DeleteRegisteredNode(ctx context.Context, cometAddress string) error {
-	_, err := q.db.Exec(ctx, deleteRegisteredNode, cometAddress)
-	return err
+func (q *Queries) DeleteRegisteredNode(ctx context.Context, cometAddress string) ([]CoreValidator, error) {
+	rows, err := q.db.Query(ctx, deleteRegisteredNode, cometAddress)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CoreValidator
+	for rows.Next() {
+		var i CoreValidator
+		if err := rows.Scan(
+			&i.Rowid,
+			&i.PubKey,
+			&i.Endpoint,
+			&i.EthAddress,
+			&i.CometAddress,
+			&i.EthBlock,
+			&i.NodeType,
+			&i.SpID,
+			&i.CometPubKey,
+			&i.Jailed,
+			&i.JailedAtBlock,
+			&i.UnjailedAtBlock,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
 const insertAccessKey = `-- name: InsertAccessKey :exec
@@ -166,6 +266,32 @@ func (q *Queries) InsertCoreDeal(ctx context.Context, arg InsertCoreDealParams)
 	return err
 }
 
+const insertCoreDelegation = `-- name: InsertCoreDelegation :exec
+insert into core_delegations (tx_hash, index, sender, delegate, revoked, block_height)
+values ($1, $2, $3, $4, $5, $6)
+`
+
+type InsertCoreDelegationParams struct {
+	TxHash      string
+	Index       int64
+	Sender      string
+	Delegate    string
+	Revoked     bool
+	BlockHeight int64
+}
+
+func (q *Queries) InsertCoreDelegation(ctx context.Context, arg InsertCoreDelegationParams) error {
+	_, err := q.db.Exec(ctx, insertCoreDelegation,
+		arg.TxHash,
+		arg.Index,
+		arg.Sender,
+		arg.Delegate,
+		arg.Revoked,
+		arg.BlockHeight,
+	)
+	return err
+}
+
 const insertCoreERN = `-- name: InsertCoreERN :exec
 insert into core_ern (
     address,
@@ -205,6 +331,41 @@ func (q *Queries) InsertCoreERN(ctx context.Context, arg InsertCoreERNParams) er
 	return err
 }
 
+const insertCoreERNTakedown = `-- name: InsertCoreERNTakedown :exec
+insert into core_ern_takedowns (
+    ern_address,
+    tx_hash,
+    index,
+    sender,
+    raw_message,
+    raw_acknowledgment,
+    block_height
+) values ($1, $2, $3, $4, $5, $6, $7)
+`
+
+type InsertCoreERNTakedownParams struct {
+	ErnAddress        string
+	TxHash            string
+	Index             int64
+	Sender            string
+	RawMessage        []byte
+	RawAcknowledgment []byte
+	BlockHeight       int64
+}
+
+func (q *Queries) InsertCoreERNTakedown(ctx context.Context, arg InsertCoreERNTakedownParams) error {
+	_, err := q.db.Exec(ctx, insertCoreERNTakedown,
+		arg.ErnAddress,
+		arg.TxHash,
+		arg.Index,
+		arg.Sender,
+		arg.RawMessage,
+		arg.RawAcknowledgment,
+		arg.BlockHeight,
+	)
+	return err
+}
+
 const insertCoreMEAD = `-- name: InsertCoreMEAD :exec
 insert into core_mead (
     address,
@@ -424,6 +585,26 @@ func (q *Queries) InsertCoreReward(ctx context.Context, arg InsertCoreRewardPara
 	return err
 }
 
+const insertCoreTakedownCID = `-- name: InsertCoreTakedownCID :execrows
+insert into core_takedown_cids (cid, ern_address, block_height)
+values ($1, $2, $3)
+on conflict do nothing
+`
+
+type InsertCoreTakedownCIDParams struct {
+	Cid         string
+	ErnAddress  string
+	BlockHeight int64
+}
+
+func (q *Queries) InsertCoreTakedownCID(ctx context.Context, arg InsertCoreTakedownCIDParams) (int64, error) {
+	result, err := q.db.Exec(ctx, insertCoreTakedownCID, arg.Cid, arg.ErnAddress, arg.BlockHeight)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const insertDecodedManageEntity = `-- name: InsertDecodedManageEntity :exec
 with duplicate_check as (
     insert into core_etl_tx_manage_entity (
@@ -829,6 +1010,28 @@ func (q *Queries) InsertFileUpload(ctx context.Context, arg InsertFileUploadPara
 	return err
 }
 
+const insertManageEntityNonce = `-- name: InsertManageEntityNonce :exec
+insert into core_manage_entity_nonces (signer, nonce, tx_hash, block_height)
+values ($1, $2, $3, $4)
+`
+
+type InsertManageEntityNonceParams struct {
+	Signer      string
+	Nonce       string
+	TxHash      string
+	BlockHeight int64
+}
+
+func (q *Queries) InsertManageEntityNonce(ctx context.Context, arg InsertManageEntityNonceParams) error {
+	_, err := q.db.Exec(ctx, insertManageEntityNonce,
+		arg.Signer,
+		arg.Nonce,
+		arg.TxHash,
+		arg.BlockHeight,
+	)
+	return err
+}
+
 const insertManagementKey = `-- name: InsertManagementKey :exec
 insert into management_keys (track_id, address) values ($1, $2)
 `
@@ -843,6 +1046,57 @@ func (q *Queries) InsertManagementKey(ctx context.Context, arg InsertManagementK
 	return err
 }
 
+const insertPeerObservation = `-- name: InsertPeerObservation :exec
+insert into core_peer_observations (block_height, observer, reachable, unreachable, tx_hash)
+values ($1, $2, $3, $4, $5)
+on conflict do nothing
+`
+
+type InsertPeerObservationParams struct {
+	BlockHeight int64
+	Observer    string
+	Reachable   []string
+	Unreachable []string
+	TxHash      string
+}
+
+func (q *Queries) InsertPeerObservation(ctx context.Context, arg InsertPeerObservationParams) error {
+	_, err := q.db.Exec(ctx, insertPeerObservation,
+		arg.BlockHeight,
+		arg.Observer,
+		arg.Reachable,
+		arg.Unreachable,
+		arg.TxHash,
+	)
+	return err
+}
+
+const insertPlaySignature = `-- name: InsertPlaySignature :exec
+insert into core_play_signatures (signature, track_id, user_id, played_at, tx_hash, block_height)
+values ($1, $2, $3, $4, $5, $6)
+`
+
+type InsertPlaySignatureParams struct {
+	Signature   string
+	TrackID     string
+	UserID      string
+	PlayedAt    int64
+	TxHash      string
+	BlockHeight int64
+}
+
+func (q *Queries) InsertPlaySignature(ctx context.Context, arg InsertPlaySignatureParams) error {
+	_, err := q.db.Exec(ctx, insertPlaySignature,
+		arg.Signature,
+		arg.TrackID,
+		arg.UserID,
+		arg.PlayedAt,
+		arg.TxHash,
+		arg.BlockHeight,
+	)
+	return err
+}
+
 const insertRegisteredNode = `-- name: InsertRegisteredNode :exec
 insert into core_validators(pub_key, endpoint, eth_address, comet_address, comet_pub_key, eth_block, node_type, sp_id)
 values ($1, $2, $3, $4, $5, $6, $7, $8)
@@ -873,6 +1127,67 @@ func (q *Queries) InsertRegisteredNode(ctx context.Context, arg InsertRegistered
 	return err
 }
 
+const insertRewardClaim = `-- name: InsertRewardClaim :exec
+insert into core_reward_claims (reward_address, reward_id, specifier, eth_recipient_address, amount, attester, tx_hash, block_height)
+values ($1, $2, $3, $4, $5, $6, $7, $8)
+on conflict do nothing
+`
+
+type InsertRewardClaimParams struct {
+	RewardAddress       string
+	RewardID            string
+	Specifier           string
+	EthRecipientAddress string
+	Amount              int64
+	Attester            string
+	TxHash              string
+	BlockHeight         int64
+}
+
+func (q *Queries) InsertRewardClaim(ctx context.Context, arg InsertRewardClaimParams) error {
+	_, err := q.db.Exec(ctx, insertRewardClaim,
+		arg.RewardAddress,
+		arg.RewardID,
+		arg.Specifier,
+		arg.EthRecipientAddress,
+		arg.Amount,
+		arg.Attester,
+		arg.TxHash,
+		arg.BlockHeight,
+	)
+	return err
+}
+
+const insertSlashProposal = `-- name: InsertSlashProposal :exec
+insert into core_slash_proposals (address, amount, start_time, end_time, attestations, dry_run, tx_hash, error)
+values ($1, $2, $3, $4, $5, $6, $7, $8)
+`
+
+type InsertSlashProposalParams struct {
+	Address      string
+	Amount       int64
+	StartTime    pgtype.Timestamp
+	EndTime      pgtype.Timestamp
+	Attestations int32
+	DryRun       bool
+	TxHash       string
+	Error        string
+}
+
+func (q *Queries) InsertSlashProposal(ctx context.Context, arg InsertSlashProposalParams) error {
+	_, err := q.db.Exec(ctx, insertSlashProposal,
+		arg.Address,
+		arg.Amount,
+		arg.StartTime,
+		arg.EndTime,
+		arg.Attestations,
+		arg.DryRun,
+		arg.TxHash,
+		arg.Error,
+	)
+	return err
+}
+
 const insertSoundRecording = `-- name: InsertSoundRecording :exec
 insert into sound_recordings (sound_recording_id, track_id, cid, encoding_details) 
 values ($1, $2, $3, $4)
@@ -895,6 +1210,59 @@ func (q *Queries) InsertSoundRecording(ctx context.Context, arg InsertSoundRecor
 	return err
 }
 
+const insertStateNodes = `-- name: InsertStateNodes :exec
+insert into core_state_nodes (depth, index, hash, block_height)
+select $1::integer,
+    unnest($2::integer[]),
+    unnest($3::bytea[]),
+    $4::bigint
+`
+
+type InsertStateNodesParams struct {
+	Depth       int32
+	Indexes     []int32
+	Hashes      [][]byte
+	BlockHeight int64
+}
+
+func (q *Queries) InsertStateNodes(ctx context.Context, arg InsertStateNodesParams) error {
+	_, err := q.db.Exec(ctx, insertStateNodes,
+		arg.Depth,
+		arg.Indexes,
+		arg.Hashes,
+		arg.BlockHeight,
+	)
+	return err
+}
+
+const insertStateValues = `-- name: InsertStateValues :exec
+insert into core_state_values (key, bucket, value, value_hash, block_height)
+select unnest($1::text[]),
+    unnest($2::integer[]),
+    unnest($3::bytea[]),
+    unnest($4::bytea[]),
+    $5::bigint
+`
+
+type InsertStateValuesParams struct {
+	Keys        []string
+	Buckets     []int32
+	StateValues [][]byte
+	ValueHashes [][]byte
+	BlockHeight int64
+}
+
+func (q *Queries) InsertStateValues(ctx context.Context, arg InsertStateValuesParams) error {
+	_, err := q.db.Exec(ctx, insertStateValues,
+		arg.Keys,
+		arg.Buckets,
+		arg.StateValues,
+		arg.ValueHashes,
+		arg.BlockHeight,
+	)
+	return err
+}
+
 const insertStorageProof = `-- name: InsertStorageProof :exec
 insert into storage_proofs (block_height, address, cid, proof_signature, prover_addresses)
 values ($1, $2, $3, $4, $5)
@@ -966,6 +1334,92 @@ func (q *Queries) InsertTxStat(ctx context.Context, arg InsertTxStatParams) erro
 	return err
 }
 
+const jailValidator = `-- name: JailValidator :many
+update core_validators
+set jailed = true,
+    jailed_at_block = $2
+where comet_address = $1
+    and not jailed
+returning rowid, pub_key, endpoint, eth_address, comet_address, eth_block, node_type, sp_id, comet_pub_key, jailed, jailed_at_block, unjailed_at_block
+`
+
+type JailValidatorParams struct {
+	CometAddress  string
+	JailedAtBlock int64
+}
+
+func (q *Queries) JailValidator(ctx context.Context, arg JailValidatorParams) ([]CoreValidator, error) {
+	rows, err := q.db.Query(ctx, jailValidator, arg.CometAddress, arg.JailedAtBlock)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CoreValidator
+	for rows.Next() {
+		var i CoreValidator
+		if err := rows.Scan(
+			&i.Rowid,
+			&i.PubKey,
+			&i.Endpoint,
+			&i.EthAddress,
+			&i.CometAddress,
+			&i.EthBlock,
+			&i.NodeType,
+			&i.SpID,
+			&i.CometPubKey,
+			&i.Jailed,
+			&i.JailedAtBlock,
+			&i.UnjailedAtBlock,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const pruneStateNodes = `-- name: PruneStateNodes :exec
+delete from core_state_nodes n
+where n.block_height < $1
+and exists (
+    select 1 from core_state_nodes newer
+    where newer.depth = n.depth and newer.index = n.index
+    and newer.block_height > n.block_height and newer.block_height <= $1
+)
+`
+
+func (q *Queries) PruneStateNodes(ctx context.Context, blockHeight int64) error {
+	_, err := q.db.Exec(ctx, pruneStateNodes, blockHeight)
+	return err
+}
+
+const pruneStateValues = `-- name: PruneStateValues :exec
+delete from core_state_values l
+where l.block_height < $1
+and exists (
+    select 1 from core_state_values newer
+    where newer.key = l.key and newer.block_height > l.block_height and newer.block_height <= $1
+)
+`
+
+func (q *Queries) PruneStateValues(ctx context.Context, blockHeight int64) error {
+	_, err := q.db.Exec(ctx, pruneStateValues, blockHeight)
+	return err
+}
+
+const setStateTreeRetainHeight = `-- name: SetStateTreeRetainHeight :exec
+insert into core_state_tree_retain (block_height) values ($1)
+on conflict (id) do update set block_height = excluded.block_height
+`
+
+func (q *Queries) SetStateTreeRetainHeight(ctx context.Context, blockHeight int64) error {
+	_, err := q.db.Exec(ctx, setStateTreeRetainHeight, blockHeight)
+	return err
+}
+
 const storeBlock = `-- name: StoreBlock :exec
 insert into core_blocks (height, chain_id, hash, proposer, created_at)
 values ($1, $2, $3, $4, $5)
@@ -991,8 +1445,8 @@ func (q *Queries) StoreBlock(ctx context.Context, arg StoreBlockParams) error {
 }
 
 const storeTransaction = `-- name: StoreTransaction :exec
-insert into core_transactions (block_id, index, tx_hash, transaction, created_at)
-values ($1, $2, $3, $4, $5)
+insert into core_transactions (block_id, index, tx_hash, transaction, created_at, result_code, result_log)
+values ($1, $2, $3, $4, $5, $6, $7)
 `
 
 type StoreTransactionParams struct {
@@ -1001,6 +1455,8 @@ type StoreTransactionParams struct {
 	TxHash      string
 	Transaction []byte
 	CreatedAt   pgtype.Timestamp
+	ResultCode  int32
+	ResultLog   string
 }
 
 func (q *Queries) StoreTransaction(ctx context.Context, arg StoreTransactionParams) error {
@@ -1010,10 +1466,59 @@ func (q *Queries) StoreTransaction(ctx context.Context, arg StoreTransactionPara
 		arg.TxHash,
 		arg.Transaction,
 		arg.CreatedAt,
+		arg.ResultCode,
+		arg.ResultLog,
 	)
 	return err
 }
 
+const unjailValidator = `-- name: UnjailValidator :many
+update core_validators
+set jailed = false,
+    unjailed_at_block = $2
+where comet_address = $1
+    and jailed
+returning rowid, pub_key, endpoint, eth_address, comet_address, eth_block, node_type, sp_id, comet_pub_key, jailed, jailed_at_block, unjailed_at_block
+`
+
+type UnjailValidatorParams struct {
+	CometAddress    string
+	UnjailedAtBlock int64
+}
+
+func (q *Queries) UnjailValidator(ctx context.Context, arg UnjailValidatorParams) ([]CoreValidator, error) {
+	rows, err := q.db.Query(ctx, unjailValidator, arg.CometAddress, arg.UnjailedAtBlock)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CoreValidator
+	for rows.Next() {
+		var i CoreValidator
+		if err := rows.Scan(
+			&i.Rowid,
+			&i.PubKey,
+			&i.Endpoint,
+			&i.EthAddress,
+			&i.CometAddress,
+			&i.EthBlock,
+			&i.NodeType,
+			&i.SpID,
+			&i.CometPubKey,
+			&i.Jailed,
+			&i.JailedAtBlock,
+			&i.UnjailedAtBlock,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updateCoreReward = `-- name: UpdateCoreReward :exec
 update core_rewards
 set name = $2,
@@ -1070,17 +1575,24 @@ func (q *Queries) UpdateStorageProof(ctx context.Context, arg UpdateStorageProof
 }
 
 const upsertAppState = `-- name: UpsertAppState :exec
-insert into core_app_state (block_height, app_hash)
-values ($1, $2)
+insert into core_app_state (block_height, app_hash, state_digest, state_root)
+values ($1, $2, $3, $4)
 `
 
 type UpsertAppStateParams struct {
 	BlockHeight int64
 	AppHash     []byte
+	StateDigest []byte
+	StateRoot   []byte
 }
 
 func (q *Queries) UpsertAppState(ctx context.Context, arg UpsertAppStateParams) error {
-	_, err := q.db.Exec(ctx, upsertAppState, arg.BlockHeight, arg.AppHash)
+	_, err := q.db.Exec(ctx, upsertAppState,
+		arg.BlockHeight,
+		arg.AppHash,
+		arg.StateDigest,
+		arg.StateRoot,
+	)
 	return err
 }
 
@@ -1089,7 +1601,7 @@ with updated as (
     update sla_node_reports 
     set blocks_proposed = blocks_proposed + 1
     where address = $1 and sla_rollup_id is null
-    returning id, address, blocks_proposed, sla_rollup_id
+    returning id, address, blocks_proposed, sla_rollup_id, challenges_received, challenges_failed
 )
 insert into sla_node_reports (address, blocks_proposed, sla_rollup_id)
 select $1, 1, null
@@ -36,6 +36,9 @@ import (
 type ABCIState struct {
 	onGoingBlock     pgx.Tx
 	finalizedTxs     []string
+	stateChanges     []stateChange
+	stateTouches     []string
+	stateErr         error
 	lastRetainHeight int64
 }
 
@@ -169,13 +172,26 @@ func (s *Server) Info(ctx context.Context, info *abcitypes.InfoRequest) (*abcity
 }
 
 func (s *Server) Query(ctx context.Context, req *abcitypes.QueryRequest) (*abcitypes.QueryResponse, error) {
-	return &abcitypes.QueryResponse{}, nil
+	return s.query(ctx, req), nil
 }
 
-func (s *Server) CheckTx(_ context.Context, check *abcitypes.CheckTxRequest) (*abcitypes.CheckTxResponse, error) {
+func (s *Server) CheckTx(ctx context.Context, check *abcitypes.CheckTxRequest) (*abcitypes.CheckTxResponse, error) {
 	// check if protobuf event
-	_, err := s.isValidSignedTransaction(check.Tx)
+	signedTx, err := s.isValidSignedTransaction(check.Tx)
 	if err == nil {
+		if signedTx.GetManageEntity() != nil {
+			if _, err := s.validateManageEntity(ctx, s.db, signedTx, s.cache.currentHeight.Load()+1); err != nil {
+				return &abcitypes.CheckTxResponse{Code: 2, Log: err.Error()}, nil
+			}
+		}
+		return &abcitypes.CheckTxResponse{Code: abcitypes.CodeTypeOK}, nil
+	}
+
+	if v2Tx, err := s.isValidV2Transaction(check.Tx); err == nil {
+		if err := s.validateV2Envelope(v2Tx, s.cache.currentHeight.Load()+1); err != nil {
+			txErr := toV2TransactionError(err)
+			return &abcitypes.CheckTxResponse{Code: uint32(txErr.Code), Log: txErr.Message, Codespace: v2TransactionCodespace}, nil
+		}
 		return &abcitypes.CheckTxResponse{Code: abcitypes.CodeTypeOK}, nil
 	}
 
@@ -189,6 +205,17 @@ func (s *Server) InitChain(_ context.Context, chain *abcitypes.InitChainRequest)
 func (s *Server) PrepareProposal(ctx context.Context, proposal *abcitypes.PrepareProposalRequest) (*abcitypes.PrepareProposalResponse, error) {
 	proposalTxs := [][]byte{}
 
+	// keep batch at 1000 even if sla rollup or vote extensions occur
+	batch := 1000
+
+	voteExtensionsTx, err := s.createVoteExtensionsTx(ctx, proposal.Height, proposal.LocalLastCommit)
+	if err != nil {
+		s.logger.Error("Failed to create vote extensions transaction", zap.Error(err))
+	} else if voteExtensionsTx != nil {
+		proposalTxs = append(proposalTxs, voteExtensionsTx)
+		batch = batch - 1
+	}
+
 	shouldProposeNewRollup := s.shouldProposeNewRollup(ctx, proposal.Height)
 	if shouldProposeNewRollup {
 		rollupTx, err := s.createRollupTx(ctx, proposal.Time, proposal.Height)
@@ -198,27 +225,32 @@ func (s *Server) PrepareProposal(ctx context.Context, proposal *abcitypes.Prepar
 			proposalTxs = append(proposalTxs, rollupTx)
 		}
 	}
-	for _, mb := range proposal.Misbehavior {
-		deregTx, err := s.createDeregisterTransaction(mb.Validator.Address)
-		if err != nil {
-			s.logger.Error("Failed to create deregistration transaction", zap.Error(err))
-		} else {
-			proposalTxs = append(proposalTxs, deregTx)
+	// once jailing is active misbehaving validators are jailed in FinalizeBlock instead
+	if !s.validatorJailingActive(proposal.Height) {
+		for _, mb := range proposal.Misbehavior {
+			deregTx, err := s.createDeregisterTransaction(mb.Validator.Address)
+			if err != nil {
+				s.logger.Error("Failed to create deregistration transaction", zap.Error(err))
+			} else {
+				proposalTxs = append(proposalTxs, deregTx)
+			}
 		}
 	}
 
-	// keep batch at 1000 even if sla rollup occurs
-	batch := 1000
 	if shouldProposeNewRollup {
 		batch = batch - 1
 	}
 
 	txMemBatch := s.mempl.GetBatch(batch, proposal.Height)
 
-	// TODO: parallelize
+	memTxs := make([][]byte, 0, len(txMemBatch))
 	for _, tx := range txMemBatch {
 		var txBytes []byte
 		var err error
+		if tx.Tx.GetVoteExtensions() != nil {
+			// only the proposer aggregates vote extensions
+			continue
+		}
 		if tx.Txv2 != nil {
 			txBytes, err = proto.Marshal(tx.Txv2)
 		} else {
@@ -228,15 +260,25 @@ func (s *Server) PrepareProposal(ctx context.Context, proposal *abcitypes.Prepar
 			s.logger.Error("tx made it into prepare but couldn't be marshalled", zap.Error(err))
 			continue
 		}
-		valid, err := s.validateBlockTx(ctx, proposal.Time, proposal.Height, proposal.Misbehavior, txBytes)
-		if err != nil {
-			s.logger.Error("tx made it into prepare but couldn't be validated", zap.Error(err))
-			continue
-		} else if !valid {
-			s.logger.Error("invalid tx made it into prepare", zap.Any("tx", tx))
-			continue
+		memTxs = append(memTxs, txBytes)
+	}
+
+	results := validateTxsConcurrently(ctx, memTxs, func(ctx context.Context, tx []byte) (bool, error) {
+		return s.validateBlockTx(ctx, proposal.Time, proposal.Height, proposal.Misbehavior, tx)
+	})
+	for i, result := range results {
+		if result.err != nil {
+			s.logger.Error("tx made it into prepare but couldn't be validated", zap.Error(result.err))
+		} else if !result.valid {
+			s.logger.Error("invalid tx made it into prepare", zap.String("tx", common.ToTxHashFromBytes(memTxs[i])))
 		}
-		proposalTxs = append(proposalTxs, txBytes)
+	}
+	proposable, deferred := proposableTxs(results)
+	for _, i := range proposable {
+		proposalTxs = append(proposalTxs, memTxs[i])
+	}
+	for _, i := range deferred {
+		s.logger.Debug("deferring conflicting tx to a later block", zap.String("tx", common.ToTxHashFromBytes(memTxs[i])), zap.String("key", results[i].key))
 	}
 
 	return &abcitypes.PrepareProposalResponse{Txs: proposalTxs}, nil
@@ -283,7 +325,7 @@ func (s *Server) FinalizeBlock(ctx context.Context, req *abcitypes.FinalizeBlock
 			finalizedTx, err := s.finalizeTransaction(ctx, req, signedTx, txhash, req.Height)
 			if err != nil {
 				s.logger.Error("error finalizing event", zap.Error(err))
-				txs[i] = &abcitypes.ExecTxResult{Code: 2}
+				txs[i] = &abcitypes.ExecTxResult{Code: 2, Log: err.Error()}
 			} else if vr := signedTx.GetValidatorRegistration(); vr != nil { // TODO: delete legacy registration after chain rollover
 				vrPubKey := ed25519.PubKey(vr.GetPubKey())
 				vrAddr := vrPubKey.Address().String()
@@ -323,6 +365,28 @@ func (s *Server) FinalizeBlock(ctx context.Context, req *abcitypes.FinalizeBlock
 					// do not halt on validator history
 					s.logger.Error("failed to append deregistration event to validator history", zap.Error(err))
 				}
+			} else if att := signedTx.GetAttestation(); att != nil && att.GetUnjailValidator() != nil {
+				unjail := att.GetUnjailValidator()
+				unjailAddr := ed25519.PubKey(unjail.GetPubKey()).Address().String()
+				// intentionally override any existing updates
+				validatorUpdatesMap[unjailAddr] = abcitypes.ValidatorUpdate{
+					Power:       int64(s.config.ValidatorVotingPower),
+					PubKeyBytes: unjail.PubKey,
+					PubKeyType:  "ed25519",
+				}
+				if node, err := s.getDb().GetRegisteredNodeByCometAddress(ctx, unjailAddr); err != nil {
+					s.logger.Error("failed to append unjail event to validator history", zap.Error(err))
+				} else if err := s.appendJailEventToValidatorHistory(ctx, node, db.ValidatorEventUnjailed, req.Time, req.Height); err != nil {
+					// do not halt on validator history
+					s.logger.Error("failed to append unjail event to validator history", zap.Error(err))
+				}
+			} else if signedTx.GetSlaRollup() != nil && s.validatorJailingActive(req.Height) {
+				vu, err := s.jailUnderperformingValidator(ctx, req.Time, req.Height)
+				if err != nil {
+					s.logger.Error("failed to jail underperforming validator", zap.Error(err))
+				} else if vu != nil {
+					validatorUpdatesMap[ed25519.PubKey(vu.PubKeyBytes).Address().String()] = *vu
+				}
 			} else if vd := signedTx.GetValidatorDeregistration(); vd != nil { // TODO: delete legacy deregistration after chain rollover
 				vdPubKey := ed25519.PubKey(vd.GetPubKey())
 				vdAddr := vdPubKey.Address().String()
@@ -340,9 +404,12 @@ func (s *Server) FinalizeBlock(ctx context.Context, req *abcitypes.FinalizeBlock
 				TxHash:      txhash,
 				Transaction: tx,
 				CreatedAt:   s.db.ToPgxTimestamp(req.Time),
+				ResultCode:  int32(txs[i].Code),
+				ResultLog:   txs[i].Log,
 			}); err != nil {
 				s.logger.Error("failed to store transaction", zap.Error(err))
 			}
+			s.stateTouch("tx", txhash)
 
 			if err := s.persistTxStat(ctx, finalizedTx, txhash, req.Height, req.Time); err != nil {
 				// don't halt consensus on this
@@ -364,7 +431,7 @@ func (s *Server) FinalizeBlock(ctx context.Context, req *abcitypes.FinalizeBlock
 				err = s.finalizeV2Transaction(ctx, req, v2Tx, txhash)
 				if err != nil {
 					s.logger.Error("failed to finalize v2 transaction", zap.String("txhash", txhash), zap.Error(err))
-					txs[i] = &abcitypes.ExecTxResult{Code: 2}
+					txs[i] = s.v2TransactionResult(err, req.Height)
 				}
 
 				if err := s.getDb().StoreTransaction(ctx, db.StoreTransactionParams{
@@ -373,9 +440,12 @@ func (s *Server) FinalizeBlock(ctx context.Context, req *abcitypes.FinalizeBlock
 					TxHash:      txhash,
 					Transaction: tx,
 					CreatedAt:   s.db.ToPgxTimestamp(req.Time),
+					ResultCode:  int32(txs[i].Code),
+					ResultLog:   txs[i].Log,
 				}); err != nil {
 					s.logger.Error("failed to store transaction", zap.String("txhash", txhash), zap.Error(err))
 				}
+				s.stateTouch("tx", txhash)
 
 				state.finalizedTxs = append(state.finalizedTxs, txhash)
 				continue
@@ -386,13 +456,32 @@ func (s *Server) FinalizeBlock(ctx context.Context, req *abcitypes.FinalizeBlock
 		}
 	}
 
+	// misbehaving validators are jailed straight from the evidence comet hands us
+	if s.validatorJailingActive(req.Height) {
+		for _, mb := range req.Misbehavior {
+			vu, err := s.jailValidator(ctx, cometbfttypes.Address(mb.Validator.Address).String(), req.Time, req.Height)
+			if err != nil {
+				s.logger.Error("failed to jail misbehaving validator", zap.Error(err))
+			} else if vu != nil {
+				validatorUpdatesMap[ed25519.PubKey(vu.PubKeyBytes).Address().String()] = *vu
+			}
+		}
+	}
+
 	s.syncPoS(ctx, req.Hash, req.Height)
 
-	nextAppHash := s.serializeAppState([]byte{}, req.GetTxs())
+	nextAppHash, stateDigest, stateRoot, err := s.finalizeAppHash(ctx, req.Height, req.GetTxs())
+	if err != nil {
+		// an app hash that doesn't commit to state would fork this node silently
+		s.logger.Error("could not compute app hash", zap.Int64("height", req.Height), zap.Error(err))
+		return nil, err
+	}
 
 	if err := s.getDb().UpsertAppState(ctx, db.UpsertAppStateParams{
 		BlockHeight: req.Height,
 		AppHash:     nextAppHash,
+		StateDigest: stateDigest,
+		StateRoot:   stateRoot,
 	}); err != nil {
 		s.logger.Error("error upserting app state", zap.Error(err))
 	}
@@ -428,6 +517,8 @@ func (s *Server) FinalizeBlock(ctx context.Context, req *abcitypes.FinalizeBlock
 		resp.ValidatorUpdates = validatorUpdates
 	}
 
+	resp.ConsensusParamUpdates = s.voteExtensionsParamUpdate(req.Height)
+
 	s.logger.Info("block finalized", zap.Int64("height", req.Height), zap.Int("txs", len(req.Txs)))
 
 	return resp, nil
@@ -455,6 +546,16 @@ func (s *Server) Commit(ctx context.Context, commit *abcitypes.CommitRequest) (*
 	if h := s.calculateLowestRetainHeight(ctx); h > 0 {
 		state.lastRetainHeight = h
 		resp.RetainHeight = h
+
+		// queries are only answered from the retained blocks on, prune the state
+		// tree versions below them off the consensus path
+		if s.stateProofActive(h) {
+			go func() {
+				if err := s.pruneStateTree(context.Background(), h); err != nil {
+					s.logger.Error("could not prune state tree", zap.Int64("retain_height", h), zap.Error(err))
+				}
+			}()
+		}
 	}
 
 	return resp, nil
@@ -491,6 +592,15 @@ func (s *Server) LoadSnapshotChunk(_ context.Context, chunk *abcitypes.LoadSnaps
 }
 
 func (s *Server) OfferSnapshot(_ context.Context, req *abcitypes.OfferSnapshotRequest) (*abcitypes.OfferSnapshotResponse, error) {
+	if err := validateOfferedSnapshot(req.Snapshot, s.config.GenesisFile.ChainID); err != nil {
+		s.logger.Warn("rejecting offered snapshot", zap.Uint64("height", req.Snapshot.GetHeight()), zap.Error(err))
+		result := abcitypes.OFFER_SNAPSHOT_RESULT_REJECT
+		if errors.Is(err, ErrUnknownSnapshotFormat) {
+			result = abcitypes.OFFER_SNAPSHOT_RESULT_REJECT_FORMAT
+		}
+		return &abcitypes.OfferSnapshotResponse{Result: result}, nil
+	}
+
 	err := s.StoreOfferedSnapshot(req.Snapshot)
 	if err != nil {
 		return &abcitypes.OfferSnapshotResponse{
@@ -524,18 +634,28 @@ func (s *Server) ApplySnapshotChunk(_ context.Context, req *abcitypes.ApplySnaps
 		}, nil
 	}
 
-	// if sender is not the same as the offered snapshot, reject
-	if !strings.EqualFold(offeredMetadata.Sender, req.Sender) {
+	height := int64(offeredSnapshot.Height)
+	totalChunks := int(offeredSnapshot.Chunks)
+	chunkIndex := int(req.Index)
+
+	if offeredSnapshot.Format == snapshotFormatLegacy {
+		// if sender is not the same as the offered snapshot, reject
+		if !strings.EqualFold(offeredMetadata.Sender, req.Sender) {
+			return &abcitypes.ApplySnapshotChunkResponse{
+				Result:        abcitypes.APPLY_SNAPSHOT_CHUNK_RESULT_RETRY,
+				RejectSenders: []string{req.Sender},
+			}, nil
+		}
+	} else if chunkIndex >= len(offeredMetadata.ChunkHashes) || chunkHash(req.Chunk) != offeredMetadata.ChunkHashes[chunkIndex] {
+		// any peer serving a chunk matching the manifest will do, fetch it again from another
+		s.logger.Warn("snapshot chunk does not match manifest", zap.Int64("height", height), zap.Int("chunkIndex", chunkIndex), zap.String("sender", req.Sender))
 		return &abcitypes.ApplySnapshotChunkResponse{
 			Result:        abcitypes.APPLY_SNAPSHOT_CHUNK_RESULT_RETRY,
+			RefetchChunks: []uint32{req.Index},
 			RejectSenders: []string{req.Sender},
 		}, nil
 	}
 
-	height := int64(offeredSnapshot.Height)
-	totalChunks := int(offeredSnapshot.Chunks)
-	chunkIndex := int(req.Index)
-
 	// Store the chunk on disk
 	err = s.StoreChunkForReconstruction(height, chunkIndex, req.Chunk)
 	if err != nil {
@@ -551,7 +671,12 @@ func (s *Server) ApplySnapshotChunk(_ context.Context, req *abcitypes.ApplySnaps
 	if s.haveAllChunks(uint64(height), totalChunks) {
 		s.logger.Info("all snapshot chunks received, beginning reassembly and restore", zap.Int64("height", height))
 
-		if err := s.ReassemblePgDump(height); err != nil {
+		dumpChunks := totalChunks
+		if offeredMetadata.BaseHeight > 0 {
+			dumpChunks = offeredMetadata.BaseChunks
+		}
+
+		if err := s.ReassemblePgDump(height, dumpChunks); err != nil {
 			s.logger.Error("failed to reassemble pg_dump", zap.Error(err))
 			return &abcitypes.ApplySnapshotChunkResponse{
 				Result: abcitypes.APPLY_SNAPSHOT_CHUNK_RESULT_RETRY,
@@ -565,6 +690,23 @@ func (s *Server) ApplySnapshotChunk(_ context.Context, req *abcitypes.ApplySnaps
 			}, nil
 		}
 
+		if offeredMetadata.BaseHeight > 0 {
+			if err := s.applyDeltaDump(context.Background(), height); err != nil {
+				s.logger.Error("failed to apply snapshot delta", zap.Error(err))
+				return &abcitypes.ApplySnapshotChunkResponse{
+					Result: abcitypes.APPLY_SNAPSHOT_CHUNK_RESULT_RETRY,
+				}, nil
+			}
+		}
+
+		if err := s.verifyRestoredState(context.Background(), height); err != nil {
+			s.logger.Error("restored snapshot failed state verification", zap.Int64("height", height), zap.Error(err))
+			return &abcitypes.ApplySnapshotChunkResponse{
+				Result:        abcitypes.APPLY_SNAPSHOT_CHUNK_RESULT_REJECT_SNAPSHOT,
+				RejectSenders: []string{req.Sender},
+			}, nil
+		}
+
 		if err := s.CleanupStateSync(); err != nil {
 			// don't need to fail the snapshot chunk if cleanup fails
 			s.logger.Warn("failed to cleanup state sync", zap.Error(err))
@@ -581,11 +723,46 @@ func (s *Server) ApplySnapshotChunk(_ context.Context, req *abcitypes.ApplySnaps
 }
 
 func (s *Server) ExtendVote(_ context.Context, extend *abcitypes.ExtendVoteRequest) (*abcitypes.ExtendVoteResponse, error) {
-	return &abcitypes.ExtendVoteResponse{}, nil
+	if !s.voteExtensionsActive(extend.Height) {
+		return &abcitypes.ExtendVoteResponse{}, nil
+	}
+
+	ext := s.buildVoteExtension(extend.Height)
+	if proto.Size(ext) == 0 {
+		return &abcitypes.ExtendVoteResponse{}, nil
+	}
+
+	extBytes, err := proto.Marshal(ext)
+	if err != nil {
+		s.logger.Error("could not marshal vote extension", zap.Error(err))
+		return &abcitypes.ExtendVoteResponse{}, nil
+	}
+	return &abcitypes.ExtendVoteResponse{VoteExtension: extBytes}, nil
 }
 
-func (s *Server) VerifyVoteExtension(_ context.Context, verify *abcitypes.VerifyVoteExtensionRequest) (*abcitypes.VerifyVoteExtensionResponse, error) {
-	return &abcitypes.VerifyVoteExtensionResponse{}, nil
+func (s *Server) VerifyVoteExtension(ctx context.Context, verify *abcitypes.VerifyVoteExtensionRequest) (*abcitypes.VerifyVoteExtensionResponse, error) {
+	accept := &abcitypes.VerifyVoteExtensionResponse{Status: abcitypes.VERIFY_VOTE_EXTENSION_STATUS_ACCEPT}
+	reject := &abcitypes.VerifyVoteExtensionResponse{Status: abcitypes.VERIFY_VOTE_EXTENSION_STATUS_REJECT}
+
+	if len(verify.VoteExtension) == 0 {
+		return accept, nil
+	}
+	validator := cometbfttypes.Address(verify.ValidatorAddress).String()
+	if len(verify.VoteExtension) > maxVoteExtensionBytes {
+		s.logger.Error("Rejecting oversized vote extension", zap.String("validator", validator), zap.Int("size", len(verify.VoteExtension)))
+		return reject, nil
+	}
+
+	var ext v1.VoteExtension
+	if err := proto.Unmarshal(verify.VoteExtension, &ext); err != nil {
+		s.logger.Error("Rejecting undecodable vote extension", zap.String("validator", validator), zap.Error(err))
+		return reject, nil
+	}
+	if err := validateVoteExtension(ctx, s.db, validator, &ext, verify.Height); err != nil {
+		s.logger.Error("Rejecting invalid vote extension", zap.String("validator", validator), zap.Error(err))
+		return reject, nil
+	}
+	return accept, nil
 }
 
 //////////////////////////////////
@@ -640,25 +817,37 @@ func (s *Server) isValidV2Transaction(tx []byte) (*v1beta1.Transaction, error) {
 		return nil, err
 	}
 
-	// check tx header info
-	header := msg.Envelope.Header
-	if header.ChainId != s.config.GenesisFile.ChainID {
-		return nil, fmt.Errorf("invalid chain id: %s", header.ChainId)
-	}
-
-	if header.Expiration < s.cache.currentHeight.Load() {
-		return nil, fmt.Errorf("transaction expired")
+	// header, expiry and signature checks happen against the height being
+	// validated, see validateV2Envelope
+	if err := checkV2Envelope(&msg); err != nil {
+		return nil, err
 	}
 
 	return &msg, nil
 }
 
 func (s *Server) validateBlockTxs(ctx context.Context, blockTime time.Time, blockHeight int64, misbehavior []abcitypes.Misbehavior, txs [][]byte) (bool, error) {
-	for _, tx := range txs {
-		valid, err := s.validateBlockTx(ctx, blockTime, blockHeight, misbehavior, tx)
-		if err != nil {
-			return false, err
-		} else if !valid {
+	if n := countVoteExtensionsTxs(txs); n > 1 {
+		s.logger.Error("Invalid block: more than one vote extensions tx", zap.Int("count", n))
+		return false, nil
+	}
+
+	results := validateTxsConcurrently(ctx, txs, func(ctx context.Context, tx []byte) (bool, error) {
+		return s.validateBlockTx(ctx, blockTime, blockHeight, misbehavior, tx)
+	})
+	for _, result := range results {
+		if result.err != nil {
+			return false, result.err
+		} else if !result.valid {
+			return false, nil
+		}
+	}
+
+	// every tx was validated against the state before the block, a second tx sharing a
+	// conflict key could be invalid once the first is applied, see proposableTxs
+	if s.conflictFreeProposalsActive(blockHeight) {
+		if _, deferred := proposableTxs(results); len(deferred) > 0 {
+			s.logger.Error("Invalid block: more than one tx per conflict key", zap.String("key", results[deferred[0]].key))
 			return false, nil
 		}
 	}
@@ -669,22 +858,39 @@ func (s *Server) validateBlockTx(ctx context.Context, blockTime time.Time, block
 	signedTx, err := s.isValidSignedTransaction(tx)
 	if err != nil {
 		// check if the tx is a v2 transaction
-		_, err := s.isValidV2Transaction(tx)
+		v2Tx, err := s.isValidV2Transaction(tx)
 		if err != nil {
 			s.logger.Error("Invalid block: unrecognized transaction type")
 			return false, nil
 		}
+		if err := s.validateV2Envelope(v2Tx, blockHeight); err != nil {
+			s.logger.Error("Invalid block: invalid v2 transaction envelope", zap.Error(err))
+			return false, nil
+		}
 		return true, nil
 	}
 
 	switch signedTx.Transaction.(type) {
 	case *v1.SignedTransaction_Plays:
+		if err := s.isValidPlayTransaction(ctx, s.db, signedTx, blockTime, blockHeight); err != nil {
+			s.logger.Error("Invalid block: invalid play tx", zap.Error(err))
+			return false, nil
+		}
+	case *v1.SignedTransaction_ManageEntity:
+		if _, err := s.validateManageEntity(ctx, s.db, signedTx, blockHeight); err != nil {
+			s.logger.Error("Invalid block: invalid manage entity tx", zap.Error(err))
+			return false, nil
+		}
 	case *v1.SignedTransaction_Attestation:
 		if err := s.isValidAttestation(ctx, signedTx, blockHeight); err != nil {
 			s.logger.Error("Invalid block: invalid attestation tx", zap.Error(err))
 			return false, nil
 		}
 	case *v1.SignedTransaction_ValidatorDeregistration:
+		if s.validatorJailingActive(blockHeight) {
+			s.logger.Error("Invalid block: misbehaving validators are jailed, not deregistered")
+			return false, nil
+		}
 		if err := s.isValidDeregisterMisbehavingNodeTx(signedTx, misbehavior); err != nil {
 			s.logger.Error("Invalid block: invalid deregister node tx", zap.Error(err))
 			return false, nil
@@ -697,12 +903,25 @@ func (s *Server) validateBlockTx(ctx context.Context, blockTime time.Time, block
 			s.logger.Error("Invalid block: invalid rollup")
 			return false, nil
 		}
+	case *v1.SignedTransaction_VoteExtensions:
+		if _, err := s.isValidVoteExtensionsTx(ctx, s.db, signedTx, blockHeight); err != nil {
+			s.logger.Error("Invalid block: invalid vote extensions tx", zap.Error(err))
+			return false, nil
+		}
 	case *v1.SignedTransaction_StorageProof:
+		if s.voteExtensionsActive(blockHeight) {
+			s.logger.Error("Invalid block: storage proof tx after vote extensions are enabled")
+			return false, nil
+		}
 		if err := s.isValidStorageProofTx(ctx, signedTx, blockHeight, true); err != nil {
 			s.logger.Error("Invalid block: invalid storage proof tx", zap.Error(err))
 			return false, nil
 		}
 	case *v1.SignedTransaction_StorageProofVerification:
+		if s.voteExtensionsActive(blockHeight) {
+			s.logger.Error("Invalid block: storage proof verification tx after vote extensions are enabled")
+			return false, nil
+		}
 		if err := s.isValidStorageProofVerificationTx(ctx, signedTx, blockHeight); err != nil {
 			s.logger.Error("Invalid block: invalid storage proof verification tx", zap.Error(err))
 			return false, nil
@@ -730,6 +949,13 @@ func (s *Server) validateV1Transaction(ctx context.Context, currentHeight int64,
 	switch signedTx.Transaction.(type) {
 	case *v1.SignedTransaction_Reward:
 		return s.isValidRewardTransaction(ctx, signedTx, currentHeight)
+	case *v1.SignedTransaction_Plays:
+		return s.isValidPlayTransaction(ctx, s.db, signedTx, time.Now(), currentHeight)
+	case *v1.SignedTransaction_ManageEntity:
+		_, err := s.validateManageEntity(ctx, s.db, signedTx, currentHeight)
+		return err
+	case *v1.SignedTransaction_VoteExtensions:
+		return ErrVoteExtensionsNotProposed
 	default:
 		// For other transaction types, no validation needed during SendTransaction
 		return nil
@@ -742,9 +968,9 @@ func (s *Server) finalizeTransaction(ctx context.Context, req *abcitypes.Finaliz
 	misbehavior := req.Misbehavior
 	switch t := msg.Transaction.(type) {
 	case *v1.SignedTransaction_Plays:
-		return s.finalizePlayTransaction(ctx, msg)
+		return s.finalizePlayTransaction(ctx, req, msg, txHash)
 	case *v1.SignedTransaction_ManageEntity:
-		return s.finalizeManageEntity(ctx, msg)
+		return s.finalizeManageEntity(ctx, msg, txHash, blockHeight)
 	case *v1.SignedTransaction_Attestation:
 		return s.finalizeAttestation(ctx, msg, req.Height)
 	case *v1.SignedTransaction_ValidatorRegistration:
@@ -757,6 +983,8 @@ func (s *Server) finalizeTransaction(ctx context.Context, req *abcitypes.Finaliz
 		return s.finalizeStorageProof(ctx, msg, blockHeight)
 	case *v1.SignedTransaction_StorageProofVerification:
 		return s.finalizeStorageProofVerification(ctx, msg, blockHeight)
+	case *v1.SignedTransaction_VoteExtensions:
+		return s.finalizeVoteExtensions(ctx, msg, txHash, blockHeight)
 	case *v1.SignedTransaction_Release:
 		return s.finalizeRelease(ctx, msg, txHash)
 	case *v1.SignedTransaction_Reward:
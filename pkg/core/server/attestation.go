@@ -33,6 +33,8 @@ func (s *Server) isValidAttestation(ctx context.Context, tx *v1.SignedTransactio
 		return s.isValidRegisterNodeAttestation(ctx, tx, signerAddrs, blockHeight)
 	case *v1.Attestation_ValidatorDeregistration:
 		return s.isValidDeregisterNodeAttestation(ctx, tx, signerAddrs, blockHeight)
+	case *v1.Attestation_UnjailValidator:
+		return s.isValidUnjailValidatorAttestation(ctx, tx, signerAddrs, blockHeight)
 	default:
 		return fmt.Errorf("unhandled attestation: %v %T", tx, t)
 	}
@@ -48,6 +50,8 @@ func (s *Server) finalizeAttestation(ctx context.Context, tx *v1.SignedTransacti
 		return tx, s.finalizeRegisterNodeAttestation(ctx, tx, blockHeight)
 	case *v1.Attestation_ValidatorDeregistration:
 		return tx, s.finalizeDeregisterValidatorAttestation(ctx, tx)
+	case *v1.Attestation_UnjailValidator:
+		return tx, s.finalizeUnjailValidatorAttestation(ctx, tx, blockHeight)
 	default:
 		return nil, fmt.Errorf("unhandled attestation: %v %T", tx, t)
 	}
@@ -59,6 +63,8 @@ func getAttestationBodyBytes(att *v1.Attestation) ([]byte, error) {
 		return proto.Marshal(att.GetValidatorRegistration())
 	case *v1.Attestation_ValidatorDeregistration:
 		return proto.Marshal(att.GetValidatorDeregistration())
+	case *v1.Attestation_UnjailValidator:
+		return proto.Marshal(att.GetUnjailValidator())
 	default:
 		return nil, fmt.Errorf("unhandled attestation: %v %T", att, t)
 	}
@@ -19,6 +19,12 @@ import (
 const (
 	validatorPurgeSLAInterval   int32 = 8
 	validatorPurgeMinValidators       = 50
+
+	// storage proof challenges are reported by the rollup covering the block this many
+	// blocks after them, by then their proofs have been revealed and the outcome is final
+	slaChallengeLag = 2 * voteExtensionProofDeadline
+	// peer observations made at a block are recorded by the block after it
+	slaPeerObservationLag = 1
 )
 
 func (s *Server) createRollupTx(ctx context.Context, ts time.Time, height int64) ([]byte, error) {
@@ -70,6 +76,18 @@ func (s *Server) createRollup(ctx context.Context, timestamp time.Time, height i
 		Reports:    make([]*v1.SlaNodeReport, 0, len(validators)),
 	}
 
+	challenges, err := s.getRollupChallenges(ctx, rollup.BlockStart, rollup.BlockEnd, height)
+	if err != nil {
+		s.logger.Error("Error retrieving storage proof outcomes", zap.Error(err))
+		return rollup, err
+	}
+
+	observations, err := s.getRollupPeerObservations(ctx, rollup.BlockStart, rollup.BlockEnd)
+	if err != nil {
+		s.logger.Error("Error retrieving peer observations", zap.Error(err))
+		return rollup, err
+	}
+
 	for _, v := range validators {
 		var proto_rep v1.SlaNodeReport
 		if r, ok := reportMap[v.CometAddress]; ok {
@@ -83,12 +101,64 @@ func (s *Server) createRollup(ctx context.Context, timestamp time.Time, height i
 				NumBlocksProposed: 0,
 			}
 		}
+		if c, ok := challenges[v.CometAddress]; ok {
+			proto_rep.ChallengesReceived = int32(c.TotalCount)
+			proto_rep.ChallengesFailed = int32(c.FailedCount)
+		}
+		if o, ok := observations[v.CometAddress]; ok {
+			proto_rep.ReachableObservations = int32(o.ReachableCount)
+			proto_rep.UnreachableObservations = int32(o.UnreachableCount)
+		}
 		rollup.Reports = append(rollup.Reports, &proto_rep)
 	}
 
 	return rollup, nil
 }
 
+func (s *Server) slaStorageProofsActive(height int64) bool {
+	activation := s.config.SlaStorageProofsHeight
+	return activation > 0 && height >= activation
+}
+
+// getRollupChallenges tallies the storage proof challenges each validator passed or
+// failed for a rollup over blocks start to end, keyed by comet address
+func (s *Server) getRollupChallenges(ctx context.Context, start, end, height int64) (map[string]db.GetStorageProofRollupsRow, error) {
+	challenges := map[string]db.GetStorageProofRollupsRow{}
+	if !s.slaStorageProofsActive(height) {
+		return challenges, nil
+	}
+
+	rows, err := s.db.GetStorageProofRollups(ctx, db.GetStorageProofRollupsParams{
+		BlockHeight:   start - slaChallengeLag,
+		BlockHeight_2: end - slaChallengeLag,
+	})
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, err
+	}
+	for _, row := range rows {
+		challenges[row.Address] = row
+	}
+	return challenges, nil
+}
+
+// getRollupPeerObservations tallies how often other validators could and couldn't reach
+// each validator during a rollup over blocks start to end, keyed by comet address. Only
+// blocks with vote extensions have observations.
+func (s *Server) getRollupPeerObservations(ctx context.Context, start, end int64) (map[string]db.GetPeerObservationRollupsRow, error) {
+	observations := map[string]db.GetPeerObservationRollupsRow{}
+	rows, err := s.db.GetPeerObservationRollups(ctx, db.GetPeerObservationRollupsParams{
+		StartHeight: start - slaPeerObservationLag,
+		EndHeight:   end - slaPeerObservationLag,
+	})
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, err
+	}
+	for _, row := range rows {
+		observations[row.Address] = row
+	}
+	return observations, nil
+}
+
 // Checks if the given sla rollup matches our local tallies
 func (s *Server) isValidRollup(ctx context.Context, timestamp time.Time, height int64, rollup *v1.SlaRollup) (bool, error) {
 	// +1 for backwards compatibility with off-by-one legacy error, delete on next chain rollover
@@ -170,9 +240,11 @@ func (s *Server) finalizeSlaRollup(ctx context.Context, event *v1.SignedTransact
 		if err = appDb.CommitSlaNodeReport(
 			ctx,
 			db.CommitSlaNodeReportParams{
-				Address:        r.Address,
-				SlaRollupID:    pgtype.Int4{Int32: id, Valid: true},
-				BlocksProposed: r.NumBlocksProposed,
+				Address:            r.Address,
+				SlaRollupID:        pgtype.Int4{Int32: id, Valid: true},
+				BlocksProposed:     r.NumBlocksProposed,
+				ChallengesReceived: r.ChallengesReceived,
+				ChallengesFailed:   r.ChallengesFailed,
 			},
 		); err != nil {
 			return nil, err
@@ -26,6 +26,8 @@ var allowedMethods = map[string]struct{}{
 	"validators":       {},
 	"consensus_params": {}, // Required for state sync to fetch consensus parameters
 	"health":           {},
+	"abci_info":        {},
+	"abci_query":       {}, // Versioned app state reads, see query.go
 }
 
 // maxRequestBodySize limits request size to 64KB - RPC requests should be tiny
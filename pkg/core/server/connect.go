@@ -20,6 +20,8 @@ import (
 	storagev1 "github.com/AudiusProject/audiusd/pkg/api/storage/v1"
 	storagev1connect "github.com/AudiusProject/audiusd/pkg/api/storage/v1/v1connect"
 	"github.com/AudiusProject/audiusd/pkg/common"
+	"github.com/AudiusProject/audiusd/pkg/core/db"
+	"github.com/AudiusProject/audiusd/pkg/ddex"
 	"github.com/AudiusProject/audiusd/pkg/mediorum/server/signature"
 	"github.com/jackc/pgx/v5"
 	"go.uber.org/zap"
@@ -56,6 +58,14 @@ func (c *CoreService) IsReady() bool {
 	return c.core != nil
 }
 
+// IsCIDTakenDown reports whether a cid belongs to an ERN that has been taken down on chain
+func (c *CoreService) IsCIDTakenDown(ctx context.Context, cid string) (bool, error) {
+	if !c.IsReady() {
+		return false, nil
+	}
+	return c.core.db.IsCIDTakenDown(ctx, cid)
+}
+
 // GetNodeInfo implements v1connect.CoreServiceHandler.
 func (c *CoreService) GetNodeInfo(ctx context.Context, req *connect.Request[v1.GetNodeInfoRequest]) (*connect.Response[v1.GetNodeInfoResponse], error) {
 	status, err := c.GetStatus(ctx, &connect.Request[v1.GetStatusRequest]{})
@@ -127,6 +137,9 @@ func (c *CoreService) ForwardTransaction(ctx context.Context, req *connect.Reque
 		if c.core.config.Environment != "dev" {
 			return nil, connect.NewError(connect.CodePermissionDenied, errors.New("received forwarded v2 tx outside of dev"))
 		}
+		if err := c.core.validateV2Envelope(req.Msg.Transactionv2, c.core.cache.currentHeight.Load()+1); err != nil {
+			return nil, v2TransactionConnectError(err)
+		}
 	} else {
 		c.core.logger.Debug("received forwarded tx", zap.Any("tx", req.Msg.Transaction))
 	}
@@ -193,19 +206,10 @@ func (c *CoreService) GetBlock(ctx context.Context, req *connect.Request[v1.GetB
 
 	txResponses := []*v1.Transaction{}
 	for _, tx := range blockTxs {
-		var transaction v1.SignedTransaction
-		err = proto.Unmarshal(tx.Transaction, &transaction)
+		res, err := c.blockTransaction(ctx, tx, block)
 		if err != nil {
 			return nil, err
 		}
-		res := &v1.Transaction{
-			Hash:        tx.TxHash,
-			BlockHash:   block.Hash,
-			ChainId:     c.core.config.GenesisFile.ChainID,
-			Height:      block.Height,
-			Timestamp:   timestamppb.New(block.CreatedAt.Time),
-			Transaction: &transaction,
-		}
 		txResponses = append(txResponses, res)
 	}
 
@@ -262,21 +266,25 @@ func (c *CoreService) GetBlocks(ctx context.Context, req *connect.Request[v1.Get
 
 		// Add transaction if it exists (pgtype.Text.Valid checks for NULL)
 		if row.TxHash.Valid && len(row.Transaction) > 0 {
-			var transaction v1.SignedTransaction
-			err = proto.Unmarshal(row.Transaction, &transaction)
+			txResponse, err := c.blockTransaction(ctx, db.CoreTransaction{
+				BlockID:     row.Height,
+				Index:       row.TxIndex.Int32,
+				TxHash:      row.TxHash.String,
+				Transaction: row.Transaction,
+				CreatedAt:   row.TxCreatedAt,
+				ResultCode:  row.ResultCode.Int32,
+				ResultLog:   row.ResultLog.String,
+			}, db.CoreBlock{
+				Height:    row.Height,
+				ChainID:   row.ChainID,
+				Hash:      row.BlockHash,
+				Proposer:  row.Proposer,
+				CreatedAt: row.BlockCreatedAt,
+			})
 			if err != nil {
 				return nil, fmt.Errorf("error unmarshaling transaction: %v", err)
 			}
 
-			txResponse := &v1.Transaction{
-				Hash:        row.TxHash.String,
-				BlockHash:   row.BlockHash,
-				ChainId:     c.core.config.GenesisFile.ChainID,
-				Height:      row.Height,
-				Timestamp:   timestamppb.New(row.BlockCreatedAt.Time),
-				Transaction: &transaction,
-			}
-
 			blockMap[row.Height].Transactions = append(blockMap[row.Height].Transactions, txResponse)
 		}
 	}
@@ -326,7 +334,11 @@ func (c *CoreService) GetDeregistrationAttestation(ctx context.Context, req *con
 		return nil, connect.NewError(connect.CodeInternal, errors.New("could not attest to node deregistration"))
 	}
 
-	shouldPurge, err := c.core.ShouldPurgeValidatorForUnderperformance(ctx, dereg.CometAddress)
+	// underperforming validators are jailed rather than deregistered once jailing is active
+	shouldPurge := false
+	if !c.core.validatorJailingActive(c.core.cache.currentHeight.Load()) {
+		shouldPurge, err = c.core.ShouldPurgeValidatorForUnderperformance(ctx, dereg.CometAddress)
+	}
 	if err != nil {
 		c.core.logger.Error("Could not attest to node eth deregistration: could not check uptime SLA history",
 			zap.String("cometAddress", dereg.CometAddress),
@@ -365,6 +377,63 @@ func (c *CoreService) GetDeregistrationAttestation(ctx context.Context, req *con
 	}), nil
 }
 
+// GetUnjailAttestation implements v1connect.CoreServiceHandler.
+func (c *CoreService) GetUnjailAttestation(ctx context.Context, req *connect.Request[v1.GetUnjailAttestationRequest]) (*connect.Response[v1.GetUnjailAttestationResponse], error) {
+	unjail := req.Msg.Unjail
+	if unjail == nil {
+		return nil, errors.New("empty unjail attestation")
+	}
+
+	if unjail.Deadline < c.core.cache.currentHeight.Load() || unjail.Deadline > c.core.cache.currentHeight.Load()+maxUnjailAttestationValidity {
+		return nil, fmt.Errorf("cannot sign unjail request with deadline %d (current height is %d)", unjail.Deadline, c.core.cache.currentHeight.Load())
+	}
+
+	node, err := c.core.db.GetRegisteredNodeByCometAddress(ctx, unjail.CometAddress)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("could not attest unjail for '%s': %v", unjail.CometAddress, err))
+	}
+	if !node.Jailed {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("node is not jailed"))
+	}
+
+	if elapsed, err := unjailCooldownElapsed(ctx, c.core.db, node); err != nil {
+		c.core.logger.Error("Could not attest to node unjail: could not check jail cooldown", zap.String("cometAddress", unjail.CometAddress), zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, errors.New("could not attest to node unjail"))
+	} else if !elapsed {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("node has not served its jail cooldown"))
+	}
+
+	ethBlock, ok := new(big.Int).SetString(node.EthBlock, 10)
+	if !ok {
+		return nil, fmt.Errorf("could not format eth block '%s' for node '%s'", node.EthBlock, node.Endpoint)
+	}
+	if registered, err := c.core.IsNodeRegisteredOnEthereum(ctx, node.Endpoint, node.EthAddress, ethBlock.Int64()); !registered || err != nil {
+		c.core.logger.Error(
+			"Could not attest to node unjail, failed to find endpoint on ethereum",
+			zap.String("cometAddress", unjail.CometAddress),
+			zap.String("endpoint", node.Endpoint),
+			zap.Error(err),
+		)
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("node is not registered on ethereum"))
+	}
+
+	unjailBytes, err := proto.Marshal(unjail)
+	if err != nil {
+		c.core.logger.Error("could not marshal unjail", zap.Error(err))
+		return nil, err
+	}
+	sig, err := common.EthSign(c.core.config.EthereumKey, unjailBytes)
+	if err != nil {
+		c.core.logger.Error("could not sign unjail", zap.Error(err))
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.GetUnjailAttestationResponse{
+		Signature: sig,
+		Unjail:    unjail,
+	}), nil
+}
+
 // GetHealth implements v1connect.CoreServiceHandler.
 func (c *CoreService) GetHealth(context.Context, *connect.Request[v1.GetHealthRequest]) (*connect.Response[v1.GetHealthResponse], error) {
 	return connect.NewResponse(&v1.GetHealthResponse{}), nil
@@ -441,45 +510,179 @@ func (c *CoreService) GetTransaction(ctx context.Context, req *connect.Request[v
 		return nil, err
 	}
 
-	// Try to unmarshal as v1 transaction first
-	var v1Transaction v1.SignedTransaction
-	err = proto.Unmarshal(tx.Transaction, &v1Transaction)
-	if err == nil {
-		// Successfully unmarshaled as v1 transaction
-		return connect.NewResponse(&v1.GetTransactionResponse{
-			Transaction: &v1.Transaction{
-				Hash:        txhash,
-				BlockHash:   block.Hash,
-				ChainId:     c.core.config.GenesisFile.ChainID,
-				Height:      block.Height,
-				Timestamp:   timestamppb.New(block.CreatedAt.Time),
-				Transaction: &v1Transaction,
-			},
-		}), nil
+	res, err := c.blockTransaction(ctx, tx, block)
+	if err != nil {
+		return nil, err
 	}
 
-	// Try to unmarshal as v2 transaction
-	var v2Transaction v1beta1.Transaction
-	err = proto.Unmarshal(tx.Transaction, &v2Transaction)
-	if err == nil {
-		// Successfully unmarshaled as v2 transaction
-		// For now, return the v2 transaction in the response - the API might need to be extended
-		// to properly handle v2 transactions, but this allows retrieval without error
-		return connect.NewResponse(&v1.GetTransactionResponse{
-			Transaction: &v1.Transaction{
-				Hash:          txhash,
-				BlockHash:     block.Hash,
-				ChainId:       c.core.config.GenesisFile.ChainID,
-				Height:        block.Height,
-				Timestamp:     timestamppb.New(block.CreatedAt.Time),
-				Transaction:   &v1Transaction,
-				Transactionv2: &v2Transaction,
-			},
-		}), nil
+	return connect.NewResponse(&v1.GetTransactionResponse{Transaction: res}), nil
+}
+
+// blockTransaction decodes a stored transaction in the same order as FinalizeBlock,
+// v1beta1 transactions carry their receipt so indexers can see what they created
+func (c *CoreService) blockTransaction(ctx context.Context, tx db.CoreTransaction, block db.CoreBlock) (*v1.Transaction, error) {
+	res := &v1.Transaction{
+		Hash:       tx.TxHash,
+		BlockHash:  block.Hash,
+		ChainId:    c.core.config.GenesisFile.ChainID,
+		Height:     block.Height,
+		Timestamp:  timestamppb.New(block.CreatedAt.Time),
+		ResultCode: uint32(tx.ResultCode),
+		ResultLog:  tx.ResultLog,
+	}
+
+	var signedTx v1.SignedTransaction
+	if err := proto.Unmarshal(tx.Transaction, &signedTx); err == nil {
+		res.Transaction = &signedTx
+		return res, nil
+	}
+
+	var v2Tx v1beta1.Transaction
+	if err := proto.Unmarshal(tx.Transaction, &v2Tx); err != nil {
+		return nil, fmt.Errorf("could not unmarshal transaction as v1 or v2: %v", err)
+	}
+	res.Transactionv2 = &v2Tx
+	res.TransactionReceipt = c.getTransactionReceipt(ctx, tx, block, &v2Tx)
+
+	return res, nil
+}
+
+// getTransactionReceipt maps the ERN, MEAD, PIE and delegation acknowledgments written by a
+// v1beta1 transaction back onto the messages that produced them
+func (c *CoreService) getTransactionReceipt(ctx context.Context, tx db.CoreTransaction, block db.CoreBlock, txv2 *v1beta1.Transaction) *v1beta1.TransactionReceipt {
+	txhash := tx.TxHash
+	header := txv2.GetEnvelope().GetHeader()
+	messages := txv2.GetEnvelope().GetMessages()
+
+	receipt := &v1beta1.TransactionReceipt{
+		EnvelopeInfo: &v1beta1.EnvelopeReceiptInfo{
+			ChainId:      c.core.config.GenesisFile.ChainID,
+			Expiration:   header.GetExpiration(),
+			Nonce:        header.GetNonce(),
+			MessageCount: int32(len(messages)),
+			From:         header.GetFrom(),
+			To:           header.GetTo(),
+		},
+		TxHash:          txhash,
+		Height:          block.Height,
+		Timestamp:       block.CreatedAt.Time.Unix(),
+		Sender:          header.GetFrom(), // matched against the envelope signature
+		Responder:       c.core.config.ProposerAddress,
+		Proposer:        block.Proposer,
+		MessageReceipts: make([]*v1beta1.MessageReceipt, len(messages)),
+	}
+	if tx.ResultCode != 0 {
+		receipt.Error = &v1beta1.TransactionError{
+			Code:    v1beta1.TransactionError_ErrorCode(tx.ResultCode),
+			Message: tx.ResultLog,
+		}
+	}
+
+	// acks are keyed by message index, ignore any that don't line up with the envelope
+	setReceipt := func(index int64, r *v1beta1.MessageReceipt) {
+		if index >= 0 && index < int64(len(receipt.MessageReceipts)) {
+			receipt.MessageReceipts[index] = r
+		}
+	}
+
+	// get all receipts by tx hash and use index to map to the correct message
+
+	// get ERNs, MEADs, and PIES by tx hash and use index to map to the correct message
+	ernReceipts, err := c.core.db.GetERNReceipts(ctx, txhash)
+	if err != nil {
+		c.core.logger.Error("error getting ERN receipts", zap.Error(err))
+	} else {
+		for _, ernReceipt := range ernReceipts {
+			ernAck := &ddexv1beta1.NewReleaseMessageAck{}
+			err = proto.Unmarshal(ernReceipt.RawAcknowledgment, ernAck)
+			if err != nil {
+				c.core.logger.Error("error unmarshalling ERN receipt", zap.Error(err))
+			}
+			setReceipt(ernReceipt.Index, &v1beta1.MessageReceipt{
+				MessageIndex: int32(ernReceipt.Index),
+				Result: &v1beta1.MessageReceipt_ErnAck{
+					ErnAck: ernAck,
+				},
+			})
+		}
+	}
+
+	takedownReceipts, err := c.core.db.GetERNTakedownReceipts(ctx, txhash)
+	if err != nil {
+		c.core.logger.Error("error getting ERN takedown receipts", zap.Error(err))
+	} else {
+		for _, takedownReceipt := range takedownReceipts {
+			ernAck := &ddexv1beta1.NewReleaseMessageAck{}
+			err = proto.Unmarshal(takedownReceipt.RawAcknowledgment, ernAck)
+			if err != nil {
+				c.core.logger.Error("error unmarshalling ERN takedown receipt", zap.Error(err))
+			}
+			setReceipt(takedownReceipt.Index, &v1beta1.MessageReceipt{
+				MessageIndex: int32(takedownReceipt.Index),
+				Result: &v1beta1.MessageReceipt_ErnAck{
+					ErnAck: ernAck,
+				},
+			})
+		}
+	}
+
+	meadReceipts, err := c.core.db.GetMEADReceipts(ctx, txhash)
+	if err != nil {
+		c.core.logger.Error("error getting MEAD receipts", zap.Error(err))
+	} else {
+		for _, meadReceipt := range meadReceipts {
+			meadAck := &ddexv1beta1.MeadMessageAck{}
+			err = proto.Unmarshal(meadReceipt.RawAcknowledgment, meadAck)
+			if err != nil {
+				c.core.logger.Error("error unmarshalling MEAD receipt", zap.Error(err))
+			}
+			setReceipt(meadReceipt.Index, &v1beta1.MessageReceipt{
+				MessageIndex: int32(meadReceipt.Index),
+				Result: &v1beta1.MessageReceipt_MeadAck{
+					MeadAck: meadAck,
+				},
+			})
+		}
+	}
+
+	pieReceipts, err := c.core.db.GetPIEReceipts(ctx, txhash)
+	if err != nil {
+		c.core.logger.Error("error getting PIE receipts", zap.Error(err))
+	} else {
+		for _, pieReceipt := range pieReceipts {
+			pieAck := &ddexv1beta1.PieMessageAck{}
+			err = proto.Unmarshal(pieReceipt.RawAcknowledgment, pieAck)
+			if err != nil {
+				c.core.logger.Error("error unmarshalling PIE receipt", zap.Error(err))
+			}
+			setReceipt(pieReceipt.Index, &v1beta1.MessageReceipt{
+				MessageIndex: int32(pieReceipt.Index),
+				Result: &v1beta1.MessageReceipt_PieAck{
+					PieAck: pieAck,
+				},
+			})
+		}
 	}
 
-	// If neither worked, return the original error
-	return nil, fmt.Errorf("could not unmarshal transaction as v1 or v2: %v", err)
+	delegationReceipts, err := c.core.db.GetDelegationReceipts(ctx, txhash)
+	if err != nil {
+		c.core.logger.Error("error getting delegation receipts", zap.Error(err))
+	} else {
+		for _, delegationReceipt := range delegationReceipts {
+			setReceipt(delegationReceipt.Index, &v1beta1.MessageReceipt{
+				MessageIndex: int32(delegationReceipt.Index),
+				Result: &v1beta1.MessageReceipt_DelegationAck{
+					DelegationAck: &v1beta1.DelegationMessageAck{
+						Sender:   delegationReceipt.Sender,
+						Delegate: delegationReceipt.Delegate,
+						Revoked:  delegationReceipt.Revoked,
+					},
+				},
+			})
+		}
+	}
+
+	return receipt
 }
 
 // Ping implements v1connect.CoreServiceHandler.
@@ -520,9 +723,9 @@ func (c *CoreService) SendTransaction(ctx context.Context, req *connect.Request[
 		}
 		txhash = common.ToTxHashFromBytes(txBytes)
 
-		err = c.core.validateV2Transaction(ctx, c.core.cache.currentHeight.Load(), req.Msg.Transactionv2)
+		err = c.core.validateV2Transaction(ctx, c.core.cache.currentHeight.Load()+1, req.Msg.Transactionv2)
 		if err != nil {
-			return nil, fmt.Errorf("transactionv2 validation failed: %v", err)
+			return nil, v2TransactionConnectError(fmt.Errorf("transactionv2 validation failed: %w", err))
 		}
 	} else {
 		tx := req.Msg.Transaction
@@ -591,80 +794,7 @@ func (c *CoreService) SendTransaction(ctx context.Context, req *connect.Request[
 		// only build receipt for v2 transactions
 		var receipt *v1beta1.TransactionReceipt
 		if req.Msg.Transactionv2 != nil {
-			receipt = &v1beta1.TransactionReceipt{
-				EnvelopeInfo: &v1beta1.EnvelopeReceiptInfo{
-					ChainId:      c.core.config.GenesisFile.ChainID,
-					Expiration:   req.Msg.Transactionv2.Envelope.Header.Expiration,
-					Nonce:        req.Msg.Transactionv2.Envelope.Header.Nonce,
-					MessageCount: int32(len(req.Msg.Transactionv2.Envelope.Messages)),
-				},
-				TxHash:          txhash,
-				Height:          block.Height,
-				Timestamp:       block.CreatedAt.Time.Unix(),
-				Sender:          "", // TODO: get sender from transaction signature
-				Responder:       c.core.config.ProposerAddress,
-				Proposer:        block.Proposer,
-				MessageReceipts: make([]*v1beta1.MessageReceipt, len(req.Msg.Transactionv2.Envelope.Messages)),
-			}
-			// get all receipts by tx hash and use index to map to the correct message
-
-			// get ERNs, MEADs, and PIES by tx hash and use index to map to the correct message
-			ernReceipts, err := c.core.db.GetERNReceipts(ctx, txhash)
-			if err != nil {
-				c.core.logger.Error("error getting ERN receipts", zap.Error(err))
-			} else {
-				for _, ernReceipt := range ernReceipts {
-					ernAck := &ddexv1beta1.NewReleaseMessageAck{}
-					err = proto.Unmarshal(ernReceipt.RawAcknowledgment, ernAck)
-					if err != nil {
-						c.core.logger.Error("error unmarshalling ERN receipt", zap.Error(err))
-					}
-					receipt.MessageReceipts[ernReceipt.Index] = &v1beta1.MessageReceipt{
-						MessageIndex: int32(ernReceipt.Index),
-						Result: &v1beta1.MessageReceipt_ErnAck{
-							ErnAck: ernAck,
-						},
-					}
-				}
-			}
-
-			meadReceipts, err := c.core.db.GetMEADReceipts(ctx, txhash)
-			if err != nil {
-				c.core.logger.Error("error getting MEAD receipts", zap.Error(err))
-			} else {
-				for _, meadReceipt := range meadReceipts {
-					meadAck := &ddexv1beta1.MeadMessageAck{}
-					err = proto.Unmarshal(meadReceipt.RawAcknowledgment, meadAck)
-					if err != nil {
-						c.core.logger.Error("error unmarshalling MEAD receipt", zap.Error(err))
-					}
-					receipt.MessageReceipts[meadReceipt.Index] = &v1beta1.MessageReceipt{
-						MessageIndex: int32(meadReceipt.Index),
-						Result: &v1beta1.MessageReceipt_MeadAck{
-							MeadAck: meadAck,
-						},
-					}
-				}
-			}
-
-			pieReceipts, err := c.core.db.GetPIEReceipts(ctx, txhash)
-			if err != nil {
-				c.core.logger.Error("error getting PIE receipts", zap.Error(err))
-			} else {
-				for _, pieReceipt := range pieReceipts {
-					pieAck := &ddexv1beta1.PieMessageAck{}
-					err = proto.Unmarshal(pieReceipt.RawAcknowledgment, pieAck)
-					if err != nil {
-						c.core.logger.Error("error unmarshalling PIE receipt", zap.Error(err))
-					}
-					receipt.MessageReceipts[pieReceipt.Index] = &v1beta1.MessageReceipt{
-						MessageIndex: int32(pieReceipt.Index),
-						Result: &v1beta1.MessageReceipt_PieAck{
-							PieAck: pieAck,
-						},
-					}
-				}
-			}
+			receipt = c.getTransactionReceipt(ctx, tx, block, req.Msg.Transactionv2)
 		}
 
 		return connect.NewResponse(&v1.SendTransactionResponse{
@@ -915,10 +1045,25 @@ func (c *CoreService) GetRewardAttestation(ctx context.Context, req *connect.Req
 		return nil, connect.NewError(connect.CodePermissionDenied, errors.New("claim authority and signer mismatch"))
 	}
 
+	claim := &v1.AttestRewardClaim{
+		RewardAddress:       reward.Address,
+		RewardId:            reward.RewardID,
+		Specifier:           req.Msg.Specifier,
+		EthRecipientAddress: req.Msg.EthRecipientAddress,
+		Amount:              req.Msg.Amount,
+	}
+	unrecorded, err := c.core.beforeRewardClaimAttestation(ctx, claim)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+
 	attestation, err := common.ProtoSign(c.core.config.EthereumKey, sigData)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, errors.New("error signing attestation"))
 	}
+	if unrecorded {
+		c.core.recordRewardClaim(claim)
+	}
 
 	res := &v1.GetRewardAttestationResponse{
 		Owner:       c.core.rewards.EthereumAddress,
@@ -928,6 +1073,20 @@ func (c *CoreService) GetRewardAttestation(ctx context.Context, req *connect.Req
 	return connect.NewResponse(res), nil
 }
 
+// GetRewardClaims implements v1connect.CoreServiceHandler.
+func (c *CoreService) GetRewardClaims(ctx context.Context, req *connect.Request[v1.GetRewardClaimsRequest]) (*connect.Response[v1.GetRewardClaimsResponse], error) {
+	if req.Msg.EthRecipientAddress == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("eth_recipient_address is required"))
+	}
+
+	rows, err := c.core.db.GetRewardClaimsForRecipient(ctx, req.Msg.EthRecipientAddress)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.GetRewardClaimsResponse{Claims: rewardClaimsFromRows(rows)}), nil
+}
+
 // GetRewards implements v1connect.CoreServiceHandler.
 func (c *CoreService) GetRewards(ctx context.Context, req *connect.Request[v1.GetRewardsRequest]) (*connect.Response[v1.GetRewardsResponse], error) {
 	claimAuthority := req.Msg.ClaimAuthority
@@ -1038,6 +1197,87 @@ func (c *CoreService) GetERN(ctx context.Context, req *connect.Request[v1.GetERN
 	}), nil
 }
 
+// GetERNXML implements v1connect.CoreServiceHandler.
+func (c *CoreService) GetERNXML(ctx context.Context, req *connect.Request[v1.GetERNXMLRequest]) (*connect.Response[v1.GetERNXMLResponse], error) {
+	if req.Msg.Address == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("address is required"))
+	}
+	if req.Msg.RecipientPartyId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("recipient_party_id is required"))
+	}
+
+	recipient := &ddexv1beta1.MessageRecipient{
+		PartyId: &ddexv1beta1.Party_PartyId{Dpid: req.Msg.RecipientPartyId},
+	}
+	if req.Msg.RecipientName != "" {
+		recipient.PartyName = &ddexv1beta1.Party_PartyName{FullName: req.Msg.RecipientName}
+	}
+
+	xml, err := c.core.renderERNXML(ctx, req.Msg.Address, recipient)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("ERN not found for address: %s", req.Msg.Address))
+		}
+		return nil, fmt.Errorf("failed to render ERN xml: %w", err)
+	}
+
+	return connect.NewResponse(&v1.GetERNXMLResponse{Xml: xml}), nil
+}
+
+// GetERNHistory implements v1connect.CoreServiceHandler.
+func (c *CoreService) GetERNHistory(ctx context.Context, req *connect.Request[v1.GetERNHistoryRequest]) (*connect.Response[v1.GetERNHistoryResponse], error) {
+	if req.Msg.Address == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("address is required"))
+	}
+
+	history, err := c.core.getERNHistory(ctx, req.Msg.Address)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("ERN not found for address: %s", req.Msg.Address))
+		}
+		return nil, fmt.Errorf("failed to get ERN history: %w", err)
+	}
+
+	res := &v1.GetERNHistoryResponse{Versions: history.versions}
+	if req.Msg.DiffFromVersion != 0 || req.Msg.DiffToVersion != 0 {
+		diff, err := history.diff(req.Msg.DiffFromVersion, req.Msg.DiffToVersion)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		res.Diff = diff
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+// ParseERNXML implements v1connect.CoreServiceHandler.
+func (c *CoreService) ParseERNXML(ctx context.Context, req *connect.Request[v1.ParseERNXMLRequest]) (*connect.Response[v1.ParseERNXMLResponse], error) {
+	if len(req.Msg.Xml) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("xml is required"))
+	}
+
+	ern, err := ddex.ParseERN(req.Msg.Xml)
+	if err != nil {
+		connectErr := connect.NewError(connect.CodeInvalidArgument, err)
+		var validationErrs ddex.ValidationErrors
+		if errors.As(err, &validationErrs) {
+			for _, validationErr := range validationErrs {
+				detail, detailErr := connect.NewErrorDetail(&v1.ERNXMLValidationError{
+					Path:    validationErr.Path,
+					Line:    int32(validationErr.Line),
+					Message: validationErr.Message,
+				})
+				if detailErr == nil {
+					connectErr.AddDetail(detail)
+				}
+			}
+		}
+		return nil, connectErr
+	}
+
+	return connect.NewResponse(&v1.ParseERNXMLResponse{Ern: ern}), nil
+}
+
 // GetMEAD implements v1connect.CoreServiceHandler.
 func (c *CoreService) GetMEAD(ctx context.Context, req *connect.Request[v1.GetMEADRequest]) (*connect.Response[v1.GetMEADResponse], error) {
 	address := req.Msg.Address
@@ -1088,6 +1328,26 @@ func (c *CoreService) GetPIE(ctx context.Context, req *connect.Request[v1.GetPIE
 	}), nil
 }
 
+// GetEnrichment implements v1connect.CoreServiceHandler.
+func (c *CoreService) GetEnrichment(ctx context.Context, req *connect.Request[v1.GetEnrichmentRequest]) (*connect.Response[v1.GetEnrichmentResponse], error) {
+	if req.Msg.Address == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("address is required"))
+	}
+
+	res, err := c.core.getEnrichment(ctx, req.Msg.Address)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("no resource, release or party at address: %s", req.Msg.Address))
+		}
+		if errors.Is(err, ErrEnrichmentEntityNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, fmt.Errorf("failed to get enrichment: %w", err)
+	}
+
+	return connect.NewResponse(res), nil
+}
+
 // GetStreamURLs implements v1connect.CoreServiceHandler.
 func (c *CoreService) GetStreamURLs(ctx context.Context, req *connect.Request[v1.GetStreamURLsRequest]) (*connect.Response[v1.GetStreamURLsResponse], error) {
 	// Check feature flag
@@ -1116,6 +1376,7 @@ func (c *CoreService) GetStreamURLs(ctx context.Context, req *connect.Request[v1
 	sigData := &v1.GetStreamURLsSignature{
 		Addresses: req.Msg.Addresses,
 		ExpiresAt: req.Msg.ExpiresAt,
+		Mode:      req.Msg.Mode,
 	}
 	sigDataBytes, err := proto.Marshal(sigData)
 	if err != nil {
@@ -1128,10 +1389,24 @@ func (c *CoreService) GetStreamURLs(ctx context.Context, req *connect.Request[v1
 		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("invalid signature: %w", err))
 	}
 
+	// Listeners are authorized by the release's deals rather than ownership
+	if req.Msg.Mode == v1.GetStreamURLsRequest_MODE_LISTENER {
+		return c.getListenerStreamURLs(ctx, req)
+	}
+
 	// Process each requested address
 	entityStreamURLs := make(map[string]*v1.GetStreamURLsResponse_EntityStreamURLs)
 
 	for _, address := range req.Msg.Addresses {
+		// Taken down ERNs and their releases, resources and deals are no longer streamable
+		takedown, err := c.core.db.GetERNTakedownForAddress(ctx, address)
+		if err == nil {
+			return nil, connect.NewError(connect.CodeFailedPrecondition,
+				fmt.Errorf("address %s was taken down by ERN %s at block %d", address, takedown.ErnAddress, takedown.BlockHeight))
+		} else if !errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("failed to query ERN takedown: %w", err)
+		}
+
 		// First try to get it as an ERN directly
 		dbErn, err := c.core.db.GetERN(ctx, address)
 
@@ -1184,7 +1459,7 @@ func (c *CoreService) GetStreamURLs(ctx context.Context, req *connect.Request[v1
 			}
 
 			// Get entity reference based on index and type
-			entityRef := c.getEntityReference(&ern, result.EntityType, int(result.EntityIndex))
+			entityRef := c.getEntityReference(&ern, result.EntityType, ernEntityIndex(result.RawAcknowledgment, result.EntityType, address, int(result.EntityIndex)))
 			streamURLs := c.getEntityStreamURLs(&ern, result.EntityType, entityRef)
 
 			if len(streamURLs) > 0 {
@@ -0,0 +1,109 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/AudiusProject/audiusd/pkg/api/core/v1beta1"
+	"github.com/AudiusProject/audiusd/pkg/core/db"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/jackc/pgx/v5"
+)
+
+var (
+	// Delegation top level errors
+	ErrDelegationMessageValidation   = errors.New("delegation message validation failed")
+	ErrDelegationMessageFinalization = errors.New("delegation message finalization failed")
+
+	// Delegation message validation errors
+	ErrDelegateInvalid           = errors.New("delegate is not an address")
+	ErrDelegateIsSender          = errors.New("delegate is the sender")
+	ErrDelegateAlreadyAuthorized = errors.New("delegate is already authorized")
+	ErrDelegateNotAuthorized     = errors.New("delegate is not authorized")
+)
+
+func (s *Server) finalizeDelegation(ctx context.Context, req *abcitypes.FinalizeBlockRequest, txhash string, tx *v1beta1.Transaction, messageIndex int64) error {
+	if len(tx.Envelope.Messages) <= int(messageIndex) {
+		return fmt.Errorf("message index out of range")
+	}
+
+	delegation := tx.Envelope.Messages[messageIndex].GetDelegation()
+	if delegation == nil {
+		return fmt.Errorf("tx: %s, message index: %d, delegation message not found", txhash, messageIndex)
+	}
+
+	// delegations weren't understood before enrichments were bound and were ignored like any
+	// other unknown message
+	if !s.enrichmentBindingActive(req.Height) {
+		return nil
+	}
+
+	sender := tx.Envelope.Header.From
+
+	qtx := s.getDb()
+	if err := s.validateDelegationMessage(ctx, qtx, sender, delegation); err != nil {
+		return errors.Join(ErrDelegationMessageValidation, err)
+	}
+
+	params := db.InsertCoreDelegationParams{
+		TxHash:      txhash,
+		Index:       messageIndex,
+		Sender:      strings.ToLower(sender),
+		Delegate:    strings.ToLower(delegation.Delegate),
+		Revoked:     delegation.Revoke,
+		BlockHeight: req.Height,
+	}
+	if err := qtx.InsertCoreDelegation(ctx, params); err != nil {
+		return errors.Join(ErrDelegationMessageFinalization, fmt.Errorf("failed to insert delegation: %w", err))
+	}
+	s.stateInsert(delegationStateRow(params))
+
+	return nil
+}
+
+// Validate a delegation message, a sender can only authorize a delegate that isn't already
+// authorized and only revoke one that is. Authorizations are read through q so a delegate
+// can't be authorized twice in one block.
+func (s *Server) validateDelegationMessage(ctx context.Context, q *db.Queries, from string, delegation *v1beta1.DelegationMessage) error {
+	if !ethcommon.IsHexAddress(delegation.GetDelegate()) {
+		return fmt.Errorf("%w: %q", ErrDelegateInvalid, delegation.GetDelegate())
+	}
+	if strings.EqualFold(delegation.Delegate, from) {
+		return ErrDelegateIsSender
+	}
+
+	authorized, err := isDelegate(ctx, q, from, delegation.Delegate)
+	if err != nil {
+		return err
+	}
+	if delegation.Revoke && !authorized {
+		return fmt.Errorf("%w: %s", ErrDelegateNotAuthorized, delegation.Delegate)
+	}
+	if !delegation.Revoke && authorized {
+		return fmt.Errorf("%w: %s", ErrDelegateAlreadyAuthorized, delegation.Delegate)
+	}
+	return nil
+}
+
+// isDelegate reports whether sender's latest delegation to delegate still stands
+func isDelegate(ctx context.Context, q *db.Queries, sender, delegate string) (bool, error) {
+	latest, err := q.GetLatestDelegation(ctx, db.GetLatestDelegationParams{Sender: sender, Delegate: delegate})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("could not get delegation from %s to %s: %w", sender, delegate, err)
+	}
+	return !latest.Revoked, nil
+}
+
+// actsFor reports whether from is owner or one of the delegates owner authorized
+func actsFor(ctx context.Context, q *db.Queries, owner, from string) (bool, error) {
+	if strings.EqualFold(owner, from) {
+		return true, nil
+	}
+	return isDelegate(ctx, q, owner, from)
+}
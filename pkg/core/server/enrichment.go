@@ -0,0 +1,244 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	v1 "github.com/AudiusProject/audiusd/pkg/api/core/v1"
+	ddexv1beta1 "github.com/AudiusProject/audiusd/pkg/api/ddex/v1beta1"
+	"github.com/AudiusProject/audiusd/pkg/common"
+	"github.com/AudiusProject/audiusd/pkg/core/db"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/proto"
+)
+
+// MEAD and PIE messages enrich entities an ERN created. Each resource, release or party
+// they describe names the entity by its chain address as an OAP proprietary id, the same
+// way exported ERNs carry them, and only the ERN sender or its delegates may enrich it.
+//
+// Enrichments of an entity merge in the order they were finalized. A field a later message
+// sets replaces the earlier value, a field it leaves unset keeps the earlier value, and
+// repeated fields collect every distinct value. Sending the same enrichment twice changes
+// nothing.
+
+var (
+	ErrEnrichmentAddressMissing = errors.New("enrichment has no OAP address for its entity")
+	ErrEnrichmentEntityNotFound = errors.New("enriched entity not found")
+	ErrEnrichmentNotAuthorized  = errors.New("enrichment sender is not the ERN sender or one of its delegates")
+)
+
+// enrichmentBindingActive reports whether enrichments at height are bound to the entities they
+// describe, before then they were stored without checks and delegations were ignored
+func (s *Server) enrichmentBindingActive(height int64) bool {
+	activation := s.config.EnrichmentBindingHeight
+	return activation > 0 && height >= activation
+}
+
+// checkEnrichmentTarget ensures address is an entity of entityType that from may enrich
+func checkEnrichmentTarget(ctx context.Context, q *db.Queries, address, entityType, from string) error {
+	entity, err := q.GetERNContainingAddress(ctx, address)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("%w: %s %s", ErrEnrichmentEntityNotFound, entityType, address)
+	}
+	if err != nil {
+		return fmt.Errorf("could not get ERN containing %s: %w", address, err)
+	}
+	if entity.EntityType != entityType {
+		return fmt.Errorf("%w: %s is a %s, not a %s", ErrEnrichmentEntityNotFound, address, entity.EntityType, entityType)
+	}
+
+	ok, err := actsFor(ctx, q, entity.Sender, from)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("%w: %s may not enrich %s of ERN %s", ErrEnrichmentNotAuthorized, from, address, entity.ErnAddress)
+	}
+	return nil
+}
+
+// appendDistinct appends the items that aren't already in list
+func appendDistinct[T proto.Message](list []T, items ...T) []T {
+	for _, item := range items {
+		if !slices.ContainsFunc(list, func(existing T) bool { return proto.Equal(existing, item) }) {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+func resourceOAPAddress(id *ddexv1beta1.Resource_ResourceId) string {
+	for _, pid := range id.GetProprietaryId() {
+		if pid.Namespace == common.OAPNamespace {
+			return pid.ProprietaryId
+		}
+	}
+	return ""
+}
+
+func releaseOAPAddress(id *ddexv1beta1.Release_ReleaseId) string {
+	for _, pid := range id.GetProprietaryId() {
+		if pid.Namespace == common.OAPNamespace {
+			return pid.Id
+		}
+	}
+	return ""
+}
+
+func partyOAPAddress(id *ddexv1beta1.Party_PartyId) string {
+	for _, pid := range id.GetProprietaryIds() {
+		if pid.Namespace == common.OAPNamespace {
+			return pid.Id
+		}
+	}
+	return ""
+}
+
+func mergeResourceInformation(merged, info *ddexv1beta1.MeadMessage_ResourceInformation) *ddexv1beta1.MeadMessage_ResourceInformation {
+	if merged == nil {
+		merged = &ddexv1beta1.MeadMessage_ResourceInformation{ResourceSummary: &ddexv1beta1.MeadMessage_ResourceSummary{}}
+	}
+	summary, next := merged.ResourceSummary, info.GetResourceSummary()
+	if next.GetResourceId() != nil {
+		summary.ResourceId = next.ResourceId
+	}
+	if next.GetDisplayTitle() != nil {
+		summary.DisplayTitle = next.DisplayTitle
+	}
+	if next.GetDisplayArtist() != nil {
+		summary.DisplayArtist = next.DisplayArtist
+	}
+	if next.GetMood() != nil {
+		summary.Mood = next.Mood
+	}
+	if next.GetBeatsPerMinute() != nil {
+		summary.BeatsPerMinute = next.BeatsPerMinute
+	}
+	if next.GetHarmony() != nil {
+		summary.Harmony = next.Harmony
+	}
+	merged.ResourceContributor = appendDistinct(merged.ResourceContributor, info.GetResourceContributor()...)
+	return merged
+}
+
+func mergeReleaseInformation(merged, info *ddexv1beta1.MeadMessage_ReleaseInformation) *ddexv1beta1.MeadMessage_ReleaseInformation {
+	if merged == nil {
+		merged = &ddexv1beta1.MeadMessage_ReleaseInformation{ReleaseSummary: &ddexv1beta1.MeadMessage_ReleaseSummary{}}
+	}
+	summary, next := merged.ReleaseSummary, info.GetReleaseSummary()
+	if next.GetReleaseId() != nil {
+		summary.ReleaseId = next.ReleaseId
+	}
+	if next.GetDisplayTitle() != nil {
+		summary.DisplayTitle = next.DisplayTitle
+	}
+	if next.GetDisplayArtist() != nil {
+		summary.DisplayArtist = next.DisplayArtist
+	}
+	if info.Mood != nil {
+		merged.Mood = info.Mood
+	}
+	if info.BeatsPerMinute != nil {
+		merged.BeatsPerMinute = info.BeatsPerMinute
+	}
+	if info.Harmony != nil {
+		merged.Harmony = info.Harmony
+	}
+	return merged
+}
+
+func mergePIEParty(merged, party *ddexv1beta1.PieMessage_Party) *ddexv1beta1.PieMessage_Party {
+	if merged == nil {
+		merged = &ddexv1beta1.PieMessage_Party{}
+	}
+	if party.PartyReference != "" {
+		merged.PartyReference = party.PartyReference
+	}
+	if party.PartyId != nil {
+		merged.PartyId = party.PartyId
+	}
+	if party.PartyType != nil {
+		merged.PartyType = party.PartyType
+	}
+	merged.PartyName = appendDistinct(merged.PartyName, party.PartyName...)
+	merged.Biographies = appendDistinct(merged.Biographies, party.Biographies...)
+	merged.Images = appendDistinct(merged.Images, party.Images...)
+	merged.SocialMediaUrls = appendDistinct(merged.SocialMediaUrls, party.SocialMediaUrls...)
+	return merged
+}
+
+// getEnrichment merges every enrichment of a resource, release or party
+func (s *Server) getEnrichment(ctx context.Context, address string) (*v1.GetEnrichmentResponse, error) {
+	entity, err := s.db.GetERNContainingAddress(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &v1.GetEnrichmentResponse{
+		ErnAddress: entity.ErnAddress,
+		EntityType: entity.EntityType,
+	}
+
+	switch entity.EntityType {
+	case "resource", "release":
+		meads, err := s.db.GetMEADsForEntity(ctx, address)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get MEADs for %s: %w", address, err)
+		}
+
+		var resource *ddexv1beta1.MeadMessage_ResourceInformation
+		var release *ddexv1beta1.MeadMessage_ReleaseInformation
+		for _, row := range meads {
+			var mead ddexv1beta1.MeadMessage
+			if err := proto.Unmarshal(row.RawMessage, &mead); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal MEAD %s: %w", row.Address, err)
+			}
+			for _, info := range mead.GetResourceInformationList().GetResourceInformation() {
+				if resourceOAPAddress(info.GetResourceSummary().GetResourceId()) == address {
+					resource = mergeResourceInformation(resource, info)
+				}
+			}
+			for _, info := range mead.GetReleaseInformationList().GetReleaseInformation() {
+				if releaseOAPAddress(info.GetReleaseSummary().GetReleaseId()) == address {
+					release = mergeReleaseInformation(release, info)
+				}
+			}
+			res.Sources = append(res.Sources, &v1.EnrichmentSource{Address: row.Address, Sender: row.Sender, TxHash: row.TxHash, BlockHeight: row.BlockHeight})
+		}
+		if resource != nil {
+			res.Enrichment = &v1.GetEnrichmentResponse_Resource{Resource: resource}
+		} else if release != nil {
+			res.Enrichment = &v1.GetEnrichmentResponse_Release{Release: release}
+		}
+
+	case "party":
+		pies, err := s.db.GetPIEsForEntity(ctx, address)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get PIEs for %s: %w", address, err)
+		}
+
+		var party *ddexv1beta1.PieMessage_Party
+		for _, row := range pies {
+			var pie ddexv1beta1.PieMessage
+			if err := proto.Unmarshal(row.RawMessage, &pie); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal PIE %s: %w", row.Address, err)
+			}
+			for _, p := range pie.GetPartyList().GetParty() {
+				if partyOAPAddress(p.GetPartyId()) == address {
+					party = mergePIEParty(party, p)
+				}
+			}
+			res.Sources = append(res.Sources, &v1.EnrichmentSource{Address: row.Address, Sender: row.Sender, TxHash: row.TxHash, BlockHeight: row.BlockHeight})
+		}
+		if party != nil {
+			res.Enrichment = &v1.GetEnrichmentResponse_Party{Party: party}
+		}
+
+	default:
+		return nil, fmt.Errorf("%w: %s is a %s which can't be enriched", ErrEnrichmentEntityNotFound, address, entity.EntityType)
+	}
+
+	return res, nil
+}
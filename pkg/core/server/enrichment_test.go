@@ -0,0 +1,124 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	corev1beta1 "github.com/AudiusProject/audiusd/pkg/api/core/v1beta1"
+	ddexv1beta1 "github.com/AudiusProject/audiusd/pkg/api/ddex/v1beta1"
+	"github.com/AudiusProject/audiusd/pkg/common"
+	"github.com/AudiusProject/audiusd/pkg/core/config"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func enrichedResource(address string, summary *ddexv1beta1.MeadMessage_ResourceSummary, contributors ...string) *ddexv1beta1.MeadMessage_ResourceInformation {
+	summary.ResourceId = &ddexv1beta1.Resource_ResourceId{ProprietaryId: []*ddexv1beta1.Resource_ProprietaryId{
+		{Namespace: common.OAPNamespace, ProprietaryId: address},
+	}}
+	info := &ddexv1beta1.MeadMessage_ResourceInformation{ResourceSummary: summary}
+	for _, name := range contributors {
+		info.ResourceContributor = append(info.ResourceContributor, &ddexv1beta1.MeadMessage_ResourceContributor{
+			PartyName: &ddexv1beta1.Party_PartyName{FullName: name},
+		})
+	}
+	return info
+}
+
+func TestMergeResourceInformation(t *testing.T) {
+	first := enrichedResource("0xaudio", &ddexv1beta1.MeadMessage_ResourceSummary{
+		Mood:           &ddexv1beta1.MeadMessage_Mood{Type: "Energetic"},
+		BeatsPerMinute: &ddexv1beta1.MeadMessage_BeatsPerMinute{Value: 128},
+	}, "Live Sound Engineers")
+	second := enrichedResource("0xaudio", &ddexv1beta1.MeadMessage_ResourceSummary{
+		BeatsPerMinute: &ddexv1beta1.MeadMessage_BeatsPerMinute{Value: 130},
+		Harmony:        &ddexv1beta1.MeadMessage_Harmony{RootChordNote: "D", RootChordQuality: "minor"},
+	}, "Live Sound Engineers", "Mastering Studio")
+
+	merged := mergeResourceInformation(nil, first)
+	merged = mergeResourceInformation(merged, second)
+
+	// later values win, unset fields keep what was there and repeated values are collected once
+	require.Equal(t, "Energetic", merged.ResourceSummary.GetMood().GetType())
+	require.Equal(t, float32(130), merged.ResourceSummary.GetBeatsPerMinute().GetValue())
+	require.Equal(t, "D", merged.ResourceSummary.GetHarmony().GetRootChordNote())
+	require.Len(t, merged.ResourceContributor, 2)
+
+	// sending the same enrichment again changes nothing
+	again := mergeResourceInformation(proto.CloneOf(merged), second)
+	require.True(t, proto.Equal(merged, again))
+}
+
+func TestMergePIEParty(t *testing.T) {
+	bio := &ddexv1beta1.PieMessage_Biography{Text: "Riders of the Electric Sky"}
+	merged := mergePIEParty(nil, &ddexv1beta1.PieMessage_Party{
+		PartyReference: "P1",
+		PartyType:      &ddexv1beta1.PieMessage_Party_PartyType{Value: "Artist"},
+		Biographies:    []*ddexv1beta1.PieMessage_Biography{bio},
+	})
+	merged = mergePIEParty(merged, &ddexv1beta1.PieMessage_Party{
+		PartyType:       &ddexv1beta1.PieMessage_Party_PartyType{Value: "Band"},
+		Biographies:     []*ddexv1beta1.PieMessage_Biography{proto.CloneOf(bio)},
+		SocialMediaUrls: []*ddexv1beta1.PieMessage_SocialMediaUrl{{Url: "https://audius.co/electricriders"}},
+	})
+
+	require.Equal(t, "P1", merged.PartyReference)
+	require.Equal(t, "Band", merged.PartyType.GetValue())
+	require.Len(t, merged.Biographies, 1)
+	require.Len(t, merged.SocialMediaUrls, 1)
+}
+
+func TestEnrichmentAddresses(t *testing.T) {
+	mead := &ddexv1beta1.MeadMessage{
+		ResourceInformationList: &ddexv1beta1.MeadMessage_ResourceInformationList{
+			ResourceInformation: []*ddexv1beta1.MeadMessage_ResourceInformation{
+				enrichedResource("0xaudio", &ddexv1beta1.MeadMessage_ResourceSummary{}),
+				enrichedResource("0xaudio", &ddexv1beta1.MeadMessage_ResourceSummary{}),
+			},
+		},
+		ReleaseInformationList: &ddexv1beta1.MeadMessage_ReleaseInformationList{
+			ReleaseInformation: []*ddexv1beta1.MeadMessage_ReleaseInformation{{
+				ReleaseSummary: &ddexv1beta1.MeadMessage_ReleaseSummary{ReleaseId: &ddexv1beta1.Release_ReleaseId{
+					ProprietaryId: []*ddexv1beta1.Release_ProprietaryId{{Namespace: common.OAPNamespace, Id: "0xrelease"}},
+				}},
+			}},
+		},
+	}
+	resources, releases, err := meadAddresses(mead)
+	require.NoError(t, err)
+	require.Equal(t, []string{"0xaudio"}, resources)
+	require.Equal(t, []string{"0xrelease"}, releases)
+
+	// an entity named only by the sender's own ids can't be bound
+	mead.ReleaseInformationList.ReleaseInformation[0].ReleaseSummary.ReleaseId = &ddexv1beta1.Release_ReleaseId{Grid: "A10301A0001234567B"}
+	_, _, err = meadAddresses(mead)
+	require.ErrorIs(t, err, ErrEnrichmentAddressMissing)
+
+	pie := &ddexv1beta1.PieMessage{PartyList: &ddexv1beta1.PieMessage_PartyList{Party: []*ddexv1beta1.PieMessage_Party{
+		{PartyId: &ddexv1beta1.Party_PartyId{ProprietaryIds: []*ddexv1beta1.Party_ProprietaryId{{Namespace: common.OAPNamespace, Id: "0xparty"}}}},
+		{PartyId: &ddexv1beta1.Party_PartyId{Dpid: "PADPIDA2024010501X"}},
+	}}}
+	_, err = pieAddresses(pie)
+	require.ErrorIs(t, err, ErrEnrichmentAddressMissing)
+
+	pie.PartyList.Party = pie.PartyList.Party[:1]
+	parties, err := pieAddresses(pie)
+	require.NoError(t, err)
+	require.Equal(t, []string{"0xparty"}, parties)
+}
+
+func TestDelegationBeforeActivation(t *testing.T) {
+	s := &Server{config: &config.Config{EnrichmentBindingHeight: 10}}
+	tx := &corev1beta1.Transaction{Envelope: &corev1beta1.Envelope{
+		Header: &corev1beta1.EnvelopeHeader{From: "0xsender"},
+		Messages: []*corev1beta1.Message{{Message: &corev1beta1.Message_Delegation{Delegation: &corev1beta1.DelegationMessage{
+			Delegate: "not an address",
+		}}}},
+	}}
+
+	// delegations before the activation height are ignored like any other unknown message,
+	// an invalid one isn't even validated
+	require.NoError(t, s.finalizeDelegation(context.Background(), &abcitypes.FinalizeBlockRequest{Height: 9}, "0x01", tx, 0))
+	require.True(t, s.enrichmentBindingActive(10))
+}
@@ -2,15 +2,20 @@ package server
 
 import (
 	"context"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 
 	corev1beta1 "github.com/AudiusProject/audiusd/pkg/api/core/v1beta1"
 	ddexv1beta1 "github.com/AudiusProject/audiusd/pkg/api/ddex/v1beta1"
 	"github.com/AudiusProject/audiusd/pkg/common"
 	"github.com/AudiusProject/audiusd/pkg/core/db"
+	"github.com/AudiusProject/audiusd/pkg/hashes"
 	abcitypes "github.com/cometbft/cometbft/abci/types"
 	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/jackc/pgx/v5"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -30,6 +35,18 @@ var (
 	ErrERNToAddressEmpty = errors.New("ERN to address is empty")
 	ErrERNAddressNotTo   = errors.New("ERN address is not the target of the message")
 	ErrERNNonceNotNext   = errors.New("ERN nonce is not the next nonce")
+
+	// Update and takedown ERN message validation errors
+	ErrERNNotFound          = errors.New("ERN not found")
+	ErrERNNotOriginalSender = errors.New("ERN sender is not the original sender")
+	ErrERNAlreadyTakenDown  = errors.New("ERN is already taken down")
+
+	// Sound recording delivery file validation errors
+	ErrERNDeliveryFileMissing = errors.New("ERN sound recording has no delivery file")
+	ErrERNUploadNotFound      = errors.New("ERN delivery file was not uploaded")
+	ErrERNUploadNotOwned      = errors.New("ERN delivery file was not uploaded by the sender or one of its delegates")
+	ErrERNHashSumMismatch     = errors.New("ERN delivery file hash sum does not match the upload")
+	ErrERNHashSumUnsupported  = errors.New("ERN delivery file hash sum algorithm can't be verified")
 )
 
 func (s *Server) finalizeERN(ctx context.Context, req *abcitypes.FinalizeBlockRequest, txhash string, tx *corev1beta1.Transaction, messageIndex int64) error {
@@ -51,7 +68,7 @@ func (s *Server) finalizeERN(ctx context.Context, req *abcitypes.FinalizeBlockRe
 
 	switch *ern.MessageHeader.MessageControlType {
 	case ddexv1beta1.MessageControlType_MESSAGE_CONTROL_TYPE_NEW_MESSAGE, ddexv1beta1.MessageControlType_MESSAGE_CONTROL_TYPE_TEST_MESSAGE:
-		if err := s.validateERNNewMessage(ctx, ern); err != nil {
+		if err := s.validateERNNewMessage(ctx, s.getDb(), sender, ern, req.Height); err != nil {
 			return errors.Join(ErrERNMessageValidation, err)
 		}
 		if err := s.finalizeERNNewMessage(ctx, req, txhash, messageIndex, ern, sender); err != nil {
@@ -60,7 +77,10 @@ func (s *Server) finalizeERN(ctx context.Context, req *abcitypes.FinalizeBlockRe
 		return nil
 
 	case ddexv1beta1.MessageControlType_MESSAGE_CONTROL_TYPE_UPDATED_MESSAGE:
-		if err := s.validateERNUpdateMessage(ctx, receiver, sender, ern); err != nil {
+		if !s.ernVersioningActive(req.Height) {
+			return nil
+		}
+		if err := s.validateERNUpdateMessage(ctx, s.getDb(), receiver, sender, ern, req.Height); err != nil {
 			return errors.Join(ErrERNMessageValidation, err)
 		}
 		if err := s.finalizeERNUpdateMessage(ctx, req, txhash, messageIndex, receiver, sender, ern); err != nil {
@@ -68,10 +88,13 @@ func (s *Server) finalizeERN(ctx context.Context, req *abcitypes.FinalizeBlockRe
 		}
 		return nil
 	case ddexv1beta1.MessageControlType_MESSAGE_CONTROL_TYPE_TAKEDOWN_MESSAGE:
-		if err := s.validateERNTakedownMessage(ctx, ern); err != nil {
+		if !s.ernTakedownsActive(req.Height) {
+			return nil
+		}
+		if err := s.validateERNTakedownMessage(ctx, s.getDb(), receiver, sender, ern); err != nil {
 			return errors.Join(ErrERNMessageValidation, err)
 		}
-		if err := s.finalizeERNTakedownMessage(ctx, req, txhash, messageIndex, ern); err != nil {
+		if err := s.finalizeERNTakedownMessage(ctx, req, txhash, messageIndex, receiver, sender, ern); err != nil {
 			return errors.Join(ErrERNMessageFinalization, err)
 		}
 		return nil
@@ -84,100 +107,124 @@ func (s *Server) finalizeERN(ctx context.Context, req *abcitypes.FinalizeBlockRe
 
 /** ERN New Message */
 
-func getERNOAPMessageSender(msg *ddexv1beta1.NewReleaseMessage) string {
-	oapAddress := ""
-
-	mh := msg.GetMessageHeader()
-	if mh == nil {
-		return oapAddress
-	}
-
-	ms := mh.GetMessageSender()
-	if ms == nil {
-		return oapAddress
-	}
-
-	pi := ms.GetPartyId()
-	if pi == nil {
-		return oapAddress
-	}
-
-	pis := pi.GetProprietaryIds()
-	if len(pis) == 0 {
-		return oapAddress
-	}
-
-	for _, pri := range pis {
-		if pri.Namespace == common.OAPNamespace {
-			if ethcommon.IsHexAddress(pri.Id) {
-				oapAddress = pri.Id
-			}
-		}
-	}
-
-	return oapAddress
+// ernUploadBindingActive reports whether ERNs at height must deliver every sound recording
+// as a verified upload of the sender or its delegates
+func (s *Server) ernUploadBindingActive(height int64) bool {
+	activation := s.config.ERNUploadBindingHeight
+	return activation > 0 && height >= activation
 }
 
-// Validate an ERN message that's expected to be a NEW_MESSAGE, expects that the transaction header is valid
-func (s *Server) validateERNNewMessage(ctx context.Context, msg *ddexv1beta1.NewReleaseMessage) error {
+// Validate an ERN message that's expected to be a NEW_MESSAGE, expects that the transaction header is valid.
+// Every sound recording must be delivered as an upload made by the sender or one of its
+// delegates, and any hash sum it declares must match that file.
+func (s *Server) validateERNNewMessage(ctx context.Context, q *db.Queries, from string, msg *ddexv1beta1.NewReleaseMessage, height int64) error {
 	// Check feature flag
 	if !s.config.ProgrammableDistributionEnabled {
 		return errors.New("programmable distribution is not enabled in this environment")
 	}
 
-	resourceList := msg.GetResourceList()
-	if resourceList == nil {
-		return nil
-	}
-
-	if len(resourceList) == 0 {
-		return nil
+	if !s.ernUploadBindingActive(height) {
+		return s.validateERNUploadsLegacy(ctx, msg)
 	}
 
-	ernSender := getERNOAPMessageSender(msg)
-
-	for _, resource := range resourceList {
+	for _, resource := range msg.GetResourceList() {
 		sr := resource.GetSoundRecording()
 		if sr == nil {
 			continue
 		}
 
-		sre := sr.GetSoundRecordingEdition()
-		if sre == nil {
-			continue
+		// in core this can be a CID (either original or transcoded)
+		f := sr.GetSoundRecordingEdition().GetTechnicalDetails().GetDeliveryFile().GetFile()
+		if f.GetUri() == "" {
+			return fmt.Errorf("%w: %s", ErrERNDeliveryFileMissing, sr.ResourceReference)
 		}
 
-		td := sre.GetTechnicalDetails()
-		if td == nil {
-			continue
+		upload, err := q.GetCoreUpload(ctx, f.Uri)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("%w: %s", ErrERNUploadNotFound, f.Uri)
+		}
+		if err != nil {
+			return fmt.Errorf("could not get upload for cid %s: %w", f.Uri, err)
 		}
 
-		df := td.GetDeliveryFile()
-		if df == nil {
-			continue
+		ok, err := actsFor(ctx, q, from, upload.UploaderAddress)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return fmt.Errorf("%w: %s was uploaded by %s", ErrERNUploadNotOwned, f.Uri, upload.UploaderAddress)
+		}
+
+		if err := checkDeliveryHashSum(f.Uri, f.GetHashSum().GetAlgorithm(), f.GetHashSum().GetHashSumValue()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func getERNOAPMessageSender(msg *ddexv1beta1.NewReleaseMessage) string {
+	oapAddress := ""
+	for _, pri := range msg.GetMessageHeader().GetMessageSender().GetPartyId().GetProprietaryIds() {
+		if pri.Namespace == common.OAPNamespace && ethcommon.IsHexAddress(pri.Id) {
+			oapAddress = pri.Id
 		}
+	}
+	return oapAddress
+}
 
-		f := df.GetFile()
+// validateERNUploadsLegacy is how ERNs were validated before uploads were bound to the
+// envelope sender, delivered files only had to be uploaded by the sender the ERN header
+// claims. Reads committed state as it always did so replayed blocks get the same results.
+func (s *Server) validateERNUploadsLegacy(ctx context.Context, msg *ddexv1beta1.NewReleaseMessage) error {
+	ernSender := getERNOAPMessageSender(msg)
+
+	for _, resource := range msg.GetResourceList() {
+		f := resource.GetSoundRecording().GetSoundRecordingEdition().GetTechnicalDetails().GetDeliveryFile().GetFile()
 		if f == nil {
 			continue
 		}
 
-		// in core this can be a CID (either original or transcoded)
-		uri := f.Uri
-		upload, err := s.db.GetCoreUpload(ctx, uri)
+		upload, err := s.db.GetCoreUpload(ctx, f.Uri)
 		if err != nil {
-			return fmt.Errorf("file doesn't exist with cid %s: %v", uri, err)
+			return fmt.Errorf("file doesn't exist with cid %s: %v", f.Uri, err)
 		}
 
-		uploader := upload.UploaderAddress
-		if uploader != ernSender {
-			return fmt.Errorf("sender %s doesn't match uploader %s for CID %s", ernSender, uploader, uri)
+		if upload.UploaderAddress != ernSender {
+			return fmt.Errorf("sender %s doesn't match uploader %s for CID %s", ernSender, upload.UploaderAddress, f.Uri)
 		}
 	}
 
 	return nil
 }
 
+// checkDeliveryHashSum ensures a declared hash sum describes the file cid names. Files are
+// addressed by their cid so an IPFS hash sum is the cid itself, a SHA-256 one is the digest
+// the cid was built from.
+func checkDeliveryHashSum(cid, algorithm, value string) error {
+	if algorithm == "" && value == "" {
+		return nil
+	}
+
+	switch strings.ToUpper(strings.ReplaceAll(algorithm, "-", "")) {
+	case "IPFS":
+		if value != cid {
+			return fmt.Errorf("%w: %s hash sum %s", ErrERNHashSumMismatch, cid, value)
+		}
+	case "SHA256":
+		digest, err := hashes.FileCIDDigest(cid)
+		if err != nil {
+			return fmt.Errorf("%w: %s: %v", ErrERNHashSumUnsupported, algorithm, err)
+		}
+		if !strings.EqualFold(strings.TrimPrefix(value, "0x"), hex.EncodeToString(digest)) {
+			return fmt.Errorf("%w: %s hash sum %s", ErrERNHashSumMismatch, cid, value)
+		}
+	default:
+		return fmt.Errorf("%w: %q", ErrERNHashSumUnsupported, algorithm)
+	}
+	return nil
+}
+
 func (s *Server) finalizeERNNewMessage(ctx context.Context, req *abcitypes.FinalizeBlockRequest, txhash string, messageIndex int64, ern *ddexv1beta1.NewReleaseMessage, sender string) error {
 	// Convert txhash to bytes for address generation
 	txhashBytes, err := common.HexToBytes(txhash)
@@ -249,7 +296,7 @@ func (s *Server) finalizeERNNewMessage(ctx context.Context, req *abcitypes.Final
 	}
 
 	qtx := s.getDb()
-	if err := qtx.InsertCoreERN(ctx, db.InsertCoreERNParams{
+	ernParams := db.InsertCoreERNParams{
 		TxHash:             txhash,
 		Index:              messageIndex,
 		Address:            ernAddress,
@@ -258,9 +305,12 @@ func (s *Server) finalizeERNNewMessage(ctx context.Context, req *abcitypes.Final
 		RawMessage:         rawMessage,
 		RawAcknowledgment:  rawAcknowledgment,
 		BlockHeight:        req.Height,
-	}); err != nil {
+	}
+	if err := qtx.InsertCoreERN(ctx, ernParams); err != nil {
 		return fmt.Errorf("failed to insert ERN: %w", err)
 	}
+	s.stateInsert(ernStateRow(ernParams))
+	s.stateTouch("ern", ernParams.Address)
 
 	// Insert normalized entity records
 	for i, partyAddress := range partyAddresses {
@@ -274,6 +324,7 @@ func (s *Server) finalizeERNNewMessage(ctx context.Context, req *abcitypes.Final
 		}); err != nil {
 			return fmt.Errorf("failed to insert party %d: %w", i, err)
 		}
+		s.stateInsert(entityStateRow("core_parties", partyAddress, ernAddress, "party", int32(i+1), txhash, req.Height))
 	}
 
 	for i, resourceAddress := range resourceAddresses {
@@ -287,6 +338,7 @@ func (s *Server) finalizeERNNewMessage(ctx context.Context, req *abcitypes.Final
 		}); err != nil {
 			return fmt.Errorf("failed to insert resource %d: %w", i, err)
 		}
+		s.stateInsert(entityStateRow("core_resources", resourceAddress, ernAddress, "resource", int32(i+1), txhash, req.Height))
 	}
 
 	for i, releaseAddress := range releaseAddresses {
@@ -300,6 +352,7 @@ func (s *Server) finalizeERNNewMessage(ctx context.Context, req *abcitypes.Final
 		}); err != nil {
 			return fmt.Errorf("failed to insert release %d: %w", i, err)
 		}
+		s.stateInsert(entityStateRow("core_releases", releaseAddress, ernAddress, "release", int32(i+1), txhash, req.Height))
 	}
 
 	for i, dealAddress := range dealAddresses {
@@ -313,6 +366,7 @@ func (s *Server) finalizeERNNewMessage(ctx context.Context, req *abcitypes.Final
 		}); err != nil {
 			return fmt.Errorf("failed to insert deal %d: %w", i, err)
 		}
+		s.stateInsert(entityStateRow("core_deals", dealAddress, ernAddress, "deal", int32(i+1), txhash, req.Height))
 	}
 
 	return nil
@@ -320,30 +374,449 @@ func (s *Server) finalizeERNNewMessage(ctx context.Context, req *abcitypes.Final
 
 /** ERN Update Message */
 
-// TODO: profile this function
-func (s *Server) validateERNUpdateMessage(ctx context.Context, to string, from string, ern *ddexv1beta1.NewReleaseMessage) error {
-	// TODO: get ERN from the DB
-	// TODO: validate to address exists in the DB and is an ERN
-	// TODO: compare initial sender and from address
-	return nil
+// ernVersioningActive reports whether updates at height are validated and stored as new
+// versions, before then they were accepted without effect
+func (s *Server) ernVersioningActive(height int64) bool {
+	activation := s.config.ERNVersioningHeight
+	return activation > 0 && height >= activation
+}
+
+// Validate an ERN message that's expected to be an UPDATED_MESSAGE, the envelope's to
+// address is the ERN being updated and only its original sender may update it. The
+// updated release is held to the same checks as a new one, an update to a release created
+// or updated earlier in the block is checked against that write since both read through q.
+func (s *Server) validateERNUpdateMessage(ctx context.Context, q *db.Queries, to string, from string, ern *ddexv1beta1.NewReleaseMessage, height int64) error {
+	if to == "" {
+		return ErrERNToAddressEmpty
+	}
+
+	versions, err := q.GetERNVersions(ctx, to)
+	if err != nil {
+		return fmt.Errorf("could not get ERN %s: %w", to, err)
+	}
+	if len(versions) == 0 {
+		return fmt.Errorf("%w: %s", ErrERNNotFound, to)
+	}
+
+	if !strings.EqualFold(versions[0].Sender, from) {
+		return fmt.Errorf("%w: %s", ErrERNNotOriginalSender, from)
+	}
+
+	if _, err := q.GetERNTakedown(ctx, to); err == nil {
+		return fmt.Errorf("%w: %s", ErrERNAlreadyTakenDown, to)
+	} else if !errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("could not get ERN takedown %s: %w", to, err)
+	}
+
+	return s.validateERNNewMessage(ctx, q, from, ern, height)
 }
 
+// finalizeERNUpdateMessage stores the update as the next version of the ERN. Entities keep
+// the address they had in the previous version by reference, entities new to this version
+// get an address from this transaction.
 func (s *Server) finalizeERNUpdateMessage(ctx context.Context, req *abcitypes.FinalizeBlockRequest, txhash string, messageIndex int64, to string, from string, ern *ddexv1beta1.NewReleaseMessage) error {
-	if err := s.validateERNUpdateMessage(ctx, to, from, ern); err != nil {
-		return errors.Join(ErrERNMessageValidation, err)
+	txhashBytes, err := common.HexToBytes(txhash)
+	if err != nil {
+		return fmt.Errorf("failed to decode txhash: %w", err)
+	}
+	chainID := s.config.GenesisFile.ChainID
+
+	qtx := s.getDb()
+	previous, err := qtx.GetERN(ctx, to)
+	if err != nil {
+		return fmt.Errorf("failed to get ERN %s: %w", to, err)
+	}
+	var previousERN ddexv1beta1.NewReleaseMessage
+	if err := proto.Unmarshal(previous.RawMessage, &previousERN); err != nil {
+		return fmt.Errorf("failed to unmarshal ERN message: %w", err)
+	}
+	var previousAck ddexv1beta1.NewReleaseMessageAck
+	if err := proto.Unmarshal(previous.RawAcknowledgment, &previousAck); err != nil {
+		return fmt.Errorf("failed to unmarshal ERN acknowledgment: %w", err)
+	}
+
+	ack := &ddexv1beta1.NewReleaseMessageAck{ErnAddress: to}
+	var created []ernEntity
+
+	partyRefs := func(msg *ddexv1beta1.NewReleaseMessage) []string {
+		refs := make([]string, len(msg.GetPartyList()))
+		for i, party := range msg.GetPartyList() {
+			refs[i] = party.PartyReference
+		}
+		return refs
+	}
+	ack.PartyAddresses, created = versionERNEntities(created, "party", partyRefs(ern), partyRefs(&previousERN), previousAck.PartyAddresses, func(ref string) string {
+		return common.CreatePartyAddress(txhashBytes, chainID, req.Height, messageIndex, ref)
+	})
+
+	resourceRefs := func(msg *ddexv1beta1.NewReleaseMessage) []string {
+		refs := make([]string, len(msg.GetResourceList()))
+		for i, resource := range msg.GetResourceList() {
+			refs[i] = resourceReference(resource)
+		}
+		return refs
+	}
+	ack.ResourceAddresses, created = versionERNEntities(created, "resource", resourceRefs(ern), resourceRefs(&previousERN), previousAck.ResourceAddresses, func(ref string) string {
+		return common.CreateResourceAddress(txhashBytes, chainID, req.Height, messageIndex, ref)
+	})
+
+	releaseRefs := func(msg *ddexv1beta1.NewReleaseMessage) []string {
+		refs := make([]string, len(msg.GetReleaseList()))
+		for i, release := range msg.GetReleaseList() {
+			refs[i] = releaseReference(release)
+		}
+		return refs
+	}
+	ack.ReleaseAddresses, created = versionERNEntities(created, "release", releaseRefs(ern), releaseRefs(&previousERN), previousAck.ReleaseAddresses, func(ref string) string {
+		return common.CreateReleaseAddress(txhashBytes, chainID, req.Height, messageIndex, ref)
+	})
+
+	// deals have no reference, an unchanged deal keeps its address
+	dealKeys := func(msg *ddexv1beta1.NewReleaseMessage) ([]string, error) {
+		keys := make([]string, len(msg.GetDealList()))
+		for i, deal := range msg.GetDealList() {
+			key, err := proto.MarshalOptions{Deterministic: true}.Marshal(deal)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal deal %d: %w", i, err)
+			}
+			keys[i] = string(key)
+		}
+		return keys, nil
+	}
+	dealKeyList, err := dealKeys(ern)
+	if err != nil {
+		return err
+	}
+	previousDealKeys, err := dealKeys(&previousERN)
+	if err != nil {
+		return err
+	}
+	ack.DealAddresses, created = versionERNEntities(created, "deal", dealKeyList, previousDealKeys, previousAck.DealAddresses, func(key string) string {
+		deal := ern.DealList[slices.Index(dealKeyList, key)]
+		return common.CreateDealAddress(txhashBytes, chainID, req.Height, messageIndex, deal.String())
+	})
+
+	rawMessage, err := proto.Marshal(ern)
+	if err != nil {
+		return fmt.Errorf("failed to marshal ERN message: %w", err)
+	}
+	rawAcknowledgment, err := proto.Marshal(ack)
+	if err != nil {
+		return fmt.Errorf("failed to marshal ERN acknowledgment: %w", err)
+	}
+
+	ernParams := db.InsertCoreERNParams{
+		TxHash:             txhash,
+		Index:              messageIndex,
+		Address:            to,
+		Sender:             from,
+		MessageControlType: int16(*ern.MessageHeader.MessageControlType),
+		RawMessage:         rawMessage,
+		RawAcknowledgment:  rawAcknowledgment,
+		BlockHeight:        req.Height,
+	}
+	if err := qtx.InsertCoreERN(ctx, ernParams); err != nil {
+		return fmt.Errorf("failed to insert ERN: %w", err)
+	}
+	s.stateInsert(ernStateRow(ernParams))
+	s.stateTouch("ern", ernParams.Address)
+
+	// new entities are numbered after every entity of the ERN so far
+	counts := map[string]int32{}
+	for _, entity := range created {
+		if _, ok := counts[entity.entityType]; !ok {
+			count, err := s.countERNEntities(ctx, qtx, entity.entityType, to)
+			if err != nil {
+				return err
+			}
+			counts[entity.entityType] = count
+		}
+		counts[entity.entityType]++
+		if err := s.insertERNEntity(ctx, qtx, entity.entityType, entity.address, to, counts[entity.entityType], txhash, req.Height); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type ernEntity struct {
+	entityType string
+	address    string
+}
+
+// versionERNEntities lists the addresses of an updated ERN's entities in order, reusing the
+// address a reference had in the previous version and creating one for each new reference
+func versionERNEntities(created []ernEntity, entityType string, refs, previousRefs, previousAddresses []string, create func(ref string) string) ([]string, []ernEntity) {
+	previous := map[string]string{}
+	for i, ref := range previousRefs {
+		if i < len(previousAddresses) {
+			previous[ref] = previousAddresses[i]
+		}
+	}
+
+	addresses := make([]string, len(refs))
+	for i, ref := range refs {
+		if address, ok := previous[ref]; ok {
+			addresses[i] = address
+			continue
+		}
+		addresses[i] = create(ref)
+		previous[ref] = addresses[i]
+		created = append(created, ernEntity{entityType: entityType, address: addresses[i]})
+	}
+	return addresses, created
+}
+
+func (s *Server) countERNEntities(ctx context.Context, q *db.Queries, entityType, ernAddress string) (int32, error) {
+	var count int
+	var err error
+	switch entityType {
+	case "party":
+		var rows []db.CoreParty
+		rows, err = q.GetERNParties(ctx, ernAddress)
+		count = len(rows)
+	case "resource":
+		var rows []db.CoreResource
+		rows, err = q.GetERNResources(ctx, ernAddress)
+		count = len(rows)
+	case "release":
+		var rows []db.CoreRelease
+		rows, err = q.GetERNReleases(ctx, ernAddress)
+		count = len(rows)
+	case "deal":
+		var rows []db.CoreDeal
+		rows, err = q.GetERNDeals(ctx, ernAddress)
+		count = len(rows)
+	default:
+		return 0, fmt.Errorf("unknown ERN entity type %s", entityType)
 	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to get ERN %s entities: %w", entityType, err)
+	}
+	return int32(count), nil
+}
+
+func (s *Server) insertERNEntity(ctx context.Context, q *db.Queries, entityType, address, ernAddress string, entityIndex int32, txhash string, height int64) error {
+	var table string
+	var err error
+	switch entityType {
+	case "party":
+		table = "core_parties"
+		err = q.InsertCoreParty(ctx, db.InsertCorePartyParams{Address: address, ErnAddress: ernAddress, EntityType: entityType, EntityIndex: entityIndex, TxHash: txhash, BlockHeight: height})
+	case "resource":
+		table = "core_resources"
+		err = q.InsertCoreResource(ctx, db.InsertCoreResourceParams{Address: address, ErnAddress: ernAddress, EntityType: entityType, EntityIndex: entityIndex, TxHash: txhash, BlockHeight: height})
+	case "release":
+		table = "core_releases"
+		err = q.InsertCoreRelease(ctx, db.InsertCoreReleaseParams{Address: address, ErnAddress: ernAddress, EntityType: entityType, EntityIndex: entityIndex, TxHash: txhash, BlockHeight: height})
+	case "deal":
+		table = "core_deals"
+		err = q.InsertCoreDeal(ctx, db.InsertCoreDealParams{Address: address, ErnAddress: ernAddress, EntityType: entityType, EntityIndex: entityIndex, TxHash: txhash, BlockHeight: height})
+	default:
+		return fmt.Errorf("unknown ERN entity type %s", entityType)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to insert %s %s: %w", entityType, address, err)
+	}
+	s.stateInsert(entityStateRow(table, address, ernAddress, entityType, entityIndex, txhash, height))
 	return nil
 }
 
+// ernEntityIndex finds the 1-indexed position of an entity in the ERN version an acknowledgment
+// belongs to, zero when that version no longer lists it. Updates can reorder entities so the
+// index an entity was first stored with only holds for the version that created it.
+func ernEntityIndex(rawAcknowledgment []byte, entityType, address string, fallback int) int {
+	var ack ddexv1beta1.NewReleaseMessageAck
+	if err := proto.Unmarshal(rawAcknowledgment, &ack); err != nil {
+		return fallback
+	}
+	var addresses []string
+	switch entityType {
+	case "party":
+		addresses = ack.PartyAddresses
+	case "resource":
+		addresses = ack.ResourceAddresses
+	case "release":
+		addresses = ack.ReleaseAddresses
+	case "deal":
+		addresses = ack.DealAddresses
+	default:
+		return fallback
+	}
+	return slices.Index(addresses, address) + 1
+}
+
+func resourceReference(resource *ddexv1beta1.Resource) string {
+	if sr := resource.GetSoundRecording(); sr != nil {
+		return sr.ResourceReference
+	}
+	if img := resource.GetImage(); img != nil {
+		return img.ResourceReference
+	}
+	return ""
+}
+
 /** ERN Takedown Message */
 
-func (s *Server) validateERNTakedownMessage(_ context.Context, _ *ddexv1beta1.NewReleaseMessage) error {
+// ernTakedownsActive reports whether takedowns at height are validated and recorded, before
+// then they were accepted without effect
+func (s *Server) ernTakedownsActive(height int64) bool {
+	activation := s.config.ERNTakedownHeight
+	return activation > 0 && height >= activation
+}
+
+// Validate an ERN message that's expected to be a TAKEDOWN_MESSAGE, the envelope's to
+// address is the ERN being taken down and only its original sender may take it down.
+// The sender is looked up through q, so a release can be taken down in the block that created it.
+func (s *Server) validateERNTakedownMessage(ctx context.Context, q *db.Queries, to string, from string, _ *ddexv1beta1.NewReleaseMessage) error {
+	if to == "" {
+		return ErrERNToAddressEmpty
+	}
+
+	versions, err := q.GetERNVersions(ctx, to)
+	if err != nil {
+		return fmt.Errorf("could not get ERN %s: %w", to, err)
+	}
+	if len(versions) == 0 {
+		return fmt.Errorf("%w: %s", ErrERNNotFound, to)
+	}
+
+	if !strings.EqualFold(versions[0].Sender, from) {
+		return fmt.Errorf("%w: %s", ErrERNNotOriginalSender, from)
+	}
+
+	if _, err := q.GetERNTakedown(ctx, to); err == nil {
+		return fmt.Errorf("%w: %s", ErrERNAlreadyTakenDown, to)
+	} else if !errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("could not get ERN takedown %s: %w", to, err)
+	}
+
 	return nil
 }
 
-func (s *Server) finalizeERNTakedownMessage(ctx context.Context, _ *abcitypes.FinalizeBlockRequest, _ string, _ int64, ern *ddexv1beta1.NewReleaseMessage) error {
-	if err := s.validateERNTakedownMessage(ctx, ern); err != nil {
-		return errors.Join(ErrERNMessageValidation, err)
+func (s *Server) finalizeERNTakedownMessage(ctx context.Context, req *abcitypes.FinalizeBlockRequest, txhash string, messageIndex int64, to string, from string, ern *ddexv1beta1.NewReleaseMessage) error {
+	qtx := s.getDb()
+
+	resources, err := qtx.GetERNResources(ctx, to)
+	if err != nil {
+		return fmt.Errorf("failed to get ERN resources: %w", err)
+	}
+	releases, err := qtx.GetERNReleases(ctx, to)
+	if err != nil {
+		return fmt.Errorf("failed to get ERN releases: %w", err)
+	}
+	deals, err := qtx.GetERNDeals(ctx, to)
+	if err != nil {
+		return fmt.Errorf("failed to get ERN deals: %w", err)
+	}
+
+	// the acknowledgment lists everything the takedown applies to
+	ack := &ddexv1beta1.NewReleaseMessageAck{
+		ErnAddress:        to,
+		ResourceAddresses: make([]string, len(resources)),
+		ReleaseAddresses:  make([]string, len(releases)),
+		DealAddresses:     make([]string, len(deals)),
+	}
+	for i, r := range resources {
+		ack.ResourceAddresses[i] = r.Address
+	}
+	for i, r := range releases {
+		ack.ReleaseAddresses[i] = r.Address
+	}
+	for i, d := range deals {
+		ack.DealAddresses[i] = d.Address
+	}
+
+	rawMessage, err := proto.Marshal(ern)
+	if err != nil {
+		return fmt.Errorf("failed to marshal ERN message: %w", err)
+	}
+
+	rawAcknowledgment, err := proto.Marshal(ack)
+	if err != nil {
+		return fmt.Errorf("failed to marshal ERN acknowledgment: %w", err)
 	}
+
+	takedownParams := db.InsertCoreERNTakedownParams{
+		ErnAddress:        to,
+		TxHash:            txhash,
+		Index:             messageIndex,
+		Sender:            from,
+		RawMessage:        rawMessage,
+		RawAcknowledgment: rawAcknowledgment,
+		BlockHeight:       req.Height,
+	}
+	if err := qtx.InsertCoreERNTakedown(ctx, takedownParams); err != nil {
+		return fmt.Errorf("failed to insert ERN takedown: %w", err)
+	}
+	s.stateInsert(ernTakedownStateRow(takedownParams))
+
+	cids, err := s.getERNTakedownCIDs(ctx, qtx, to)
+	if err != nil {
+		return err
+	}
+
+	for _, cid := range cids {
+		cidParams := db.InsertCoreTakedownCIDParams{
+			Cid:         cid,
+			ErnAddress:  to,
+			BlockHeight: req.Height,
+		}
+		inserted, err := qtx.InsertCoreTakedownCID(ctx, cidParams)
+		if err != nil {
+			return fmt.Errorf("failed to insert takedown cid %s: %w", cid, err)
+		}
+		if inserted > 0 {
+			s.stateInsert(takedownCIDStateRow(cidParams))
+		}
+	}
+
 	return nil
 }
+
+// getERNTakedownCIDs collects the sound recording CIDs across every version of an ERN,
+// along with the original or transcoded CID of the same upload, in a stable order
+func (s *Server) getERNTakedownCIDs(ctx context.Context, q *db.Queries, ernAddress string) ([]string, error) {
+	versions, err := q.GetERNVersions(ctx, ernAddress)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ERN versions: %w", err)
+	}
+
+	seen := map[string]bool{}
+	cids := []string{}
+	addCID := func(cid string) {
+		if cid == "" || seen[cid] {
+			return
+		}
+		seen[cid] = true
+		cids = append(cids, cid)
+	}
+
+	for _, version := range versions {
+		var msg ddexv1beta1.NewReleaseMessage
+		if err := proto.Unmarshal(version.RawMessage, &msg); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal ERN %s at tx %s: %w", ernAddress, version.TxHash, err)
+		}
+
+		for _, resource := range msg.GetResourceList() {
+			uri := resource.GetSoundRecording().GetSoundRecordingEdition().GetTechnicalDetails().GetDeliveryFile().GetFile().GetUri()
+			if uri == "" {
+				continue
+			}
+			addCID(uri)
+
+			upload, err := q.GetCoreUpload(ctx, uri)
+			if errors.Is(err, pgx.ErrNoRows) {
+				continue
+			}
+			if err != nil {
+				return nil, fmt.Errorf("failed to get upload for cid %s: %w", uri, err)
+			}
+			addCID(upload.Cid)
+			addCID(upload.TranscodedCid)
+		}
+	}
+
+	return cids, nil
+}
@@ -0,0 +1,176 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	v1 "github.com/AudiusProject/audiusd/pkg/api/core/v1"
+	ddexv1beta1 "github.com/AudiusProject/audiusd/pkg/api/ddex/v1beta1"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/proto"
+)
+
+var ErrERNVersionNotFound = errors.New("ERN version not found")
+
+// ernHistory is every message finalized for an ERN, its versions followed by the takedown
+// if it was taken down
+type ernHistory struct {
+	versions []*v1.ERNVersion
+	messages []*ddexv1beta1.NewReleaseMessage
+}
+
+func (s *Server) getERNHistory(ctx context.Context, ernAddress string) (*ernHistory, error) {
+	rows, err := s.db.GetERNVersions(ctx, ernAddress)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ERN versions: %w", err)
+	}
+	if len(rows) == 0 {
+		return nil, pgx.ErrNoRows
+	}
+
+	history := &ernHistory{}
+	add := func(controlType ddexv1beta1.MessageControlType, blockHeight int64, txHash string, messageIndex int64, sender string, rawMessage []byte) error {
+		var msg ddexv1beta1.NewReleaseMessage
+		if err := proto.Unmarshal(rawMessage, &msg); err != nil {
+			return fmt.Errorf("failed to unmarshal ERN %s at tx %s: %w", ernAddress, txHash, err)
+		}
+		history.versions = append(history.versions, &v1.ERNVersion{
+			Version:            uint32(len(history.versions) + 1),
+			MessageControlType: controlType,
+			BlockHeight:        blockHeight,
+			TxHash:             txHash,
+			MessageIndex:       messageIndex,
+			Sender:             sender,
+		})
+		history.messages = append(history.messages, &msg)
+		return nil
+	}
+
+	for _, row := range rows {
+		if err := add(ddexv1beta1.MessageControlType(row.MessageControlType), row.BlockHeight, row.TxHash, row.Index, row.Sender, row.RawMessage); err != nil {
+			return nil, err
+		}
+	}
+
+	// a takedown is always the last message, nothing is accepted for the ERN after it
+	takedown, err := s.db.GetERNTakedown(ctx, ernAddress)
+	if err == nil {
+		if err := add(ddexv1beta1.MessageControlType_MESSAGE_CONTROL_TYPE_TAKEDOWN_MESSAGE, takedown.BlockHeight, takedown.TxHash, takedown.Index, takedown.Sender, takedown.RawMessage); err != nil {
+			return nil, err
+		}
+	} else if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("could not get ERN takedown: %w", err)
+	}
+
+	return history, nil
+}
+
+// diff compares two versions of the release, takedowns withdraw the release rather than
+// describe it so they can't be compared
+func (h *ernHistory) diff(from, to uint32) (*v1.ERNDiff, error) {
+	for _, version := range []uint32{from, to} {
+		if version == 0 || int(version) > len(h.versions) {
+			return nil, fmt.Errorf("%w: %d", ErrERNVersionNotFound, version)
+		}
+		if h.versions[version-1].MessageControlType == ddexv1beta1.MessageControlType_MESSAGE_CONTROL_TYPE_TAKEDOWN_MESSAGE {
+			return nil, fmt.Errorf("version %d is a takedown and has no release to compare", version)
+		}
+	}
+
+	diff := diffERNs(h.messages[from-1], h.messages[to-1])
+	diff.FromVersion = from
+	diff.ToVersion = to
+	return diff, nil
+}
+
+// diffERNs lists the resources, deals and titles that differ between two versions of an ERN
+func diffERNs(from, to *ddexv1beta1.NewReleaseMessage) *v1.ERNDiff {
+	diff := &v1.ERNDiff{}
+
+	fromResources := map[string]*ddexv1beta1.Resource{}
+	for _, resource := range from.GetResourceList() {
+		fromResources[resourceReference(resource)] = resource
+	}
+	toResources := map[string]*ddexv1beta1.Resource{}
+	for _, resource := range to.GetResourceList() {
+		ref := resourceReference(resource)
+		toResources[ref] = resource
+		if previous, ok := fromResources[ref]; !ok {
+			diff.AddedResources = append(diff.AddedResources, ref)
+		} else if fromTitle, toTitle := resourceTitle(previous), resourceTitle(resource); fromTitle != toTitle {
+			diff.TitleChanges = append(diff.TitleChanges, &v1.ERNTitleChange{Reference: ref, From: fromTitle, To: toTitle})
+		}
+	}
+	for _, resource := range from.GetResourceList() {
+		if ref := resourceReference(resource); toResources[ref] == nil {
+			diff.RemovedResources = append(diff.RemovedResources, ref)
+		}
+	}
+
+	fromReleases := map[string]*ddexv1beta1.Release{}
+	for _, release := range from.GetReleaseList() {
+		fromReleases[releaseReference(release)] = release
+	}
+	for _, release := range to.GetReleaseList() {
+		ref := releaseReference(release)
+		if previous, ok := fromReleases[ref]; ok {
+			if fromTitle, toTitle := releaseTitle(previous), releaseTitle(release); fromTitle != toTitle {
+				diff.TitleChanges = append(diff.TitleChanges, &v1.ERNTitleChange{Reference: ref, From: fromTitle, To: toTitle})
+			}
+		}
+	}
+
+	// deals covering the same terms are paired up in the order they're listed
+	fromDeals := map[string][]*ddexv1beta1.Deal{}
+	for _, deal := range from.GetDealList() {
+		key := dealKey(deal)
+		fromDeals[key] = append(fromDeals[key], deal)
+	}
+	for _, deal := range to.GetDealList() {
+		key := dealKey(deal)
+		if len(fromDeals[key]) == 0 {
+			diff.DealChanges = append(diff.DealChanges, &v1.ERNDealChange{To: deal})
+			continue
+		}
+		previous := fromDeals[key][0]
+		fromDeals[key] = fromDeals[key][1:]
+		if !proto.Equal(previous, deal) {
+			diff.DealChanges = append(diff.DealChanges, &v1.ERNDealChange{From: previous, To: deal})
+		}
+	}
+	for _, deal := range from.GetDealList() {
+		key := dealKey(deal)
+		if i := slices.Index(fromDeals[key], deal); i >= 0 {
+			diff.DealChanges = append(diff.DealChanges, &v1.ERNDealChange{From: deal})
+		}
+	}
+
+	return diff
+}
+
+func dealKey(deal *ddexv1beta1.Deal) string {
+	rd := deal.GetReleaseDeal()
+	refs := slices.Clone(rd.GetDealReleaseReference())
+	slices.Sort(refs)
+	terms := rd.GetDeal().GetDealTerms()
+	return strings.Join(refs, ",") + "|" + terms.GetCommercialModelType() + "|" + terms.GetUseType()
+}
+
+func resourceTitle(resource *ddexv1beta1.Resource) string {
+	sr := resource.GetSoundRecording()
+	if sr.GetDisplayTitleText() != "" {
+		return sr.GetDisplayTitleText()
+	}
+	return sr.GetDisplayTitle().GetTitleText()
+}
+
+func releaseTitle(release *ddexv1beta1.Release) string {
+	mr := release.GetMainRelease()
+	if mr.GetDisplayTitleText() != "" {
+		return mr.GetDisplayTitleText()
+	}
+	return mr.GetDisplayTitle().GetTitleText()
+}
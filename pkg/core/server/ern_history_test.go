@@ -0,0 +1,138 @@
+package server
+
+import (
+	"testing"
+
+	v1 "github.com/AudiusProject/audiusd/pkg/api/core/v1"
+	ddexv1beta1 "github.com/AudiusProject/audiusd/pkg/api/ddex/v1beta1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func historyTestRecording(ref, title string) *ddexv1beta1.Resource {
+	return &ddexv1beta1.Resource{Resource: &ddexv1beta1.Resource_SoundRecording_{SoundRecording: &ddexv1beta1.Resource_SoundRecording{
+		ResourceReference: ref,
+		DisplayTitleText:  title,
+	}}}
+}
+
+func historyTestDeal(useType string, territories ...string) *ddexv1beta1.Deal {
+	return &ddexv1beta1.Deal{Deal: &ddexv1beta1.Deal_ReleaseDeal_{ReleaseDeal: &ddexv1beta1.Deal_ReleaseDeal{
+		DealReleaseReference: []string{"R0"},
+		Deal: &ddexv1beta1.Deal_ReleaseDeal_Deal{DealTerms: &ddexv1beta1.Deal_ReleaseDeal_Deal_DealTerms{
+			TerritoryCode:       territories,
+			CommercialModelType: "SubscriptionModel",
+			UseType:             useType,
+		}},
+	}}}
+}
+
+func TestDiffERNs(t *testing.T) {
+	from := &ddexv1beta1.NewReleaseMessage{
+		ResourceList: []*ddexv1beta1.Resource{
+			historyTestRecording("A1", "Midnight Drive"),
+			historyTestRecording("A2", "Interlude"),
+		},
+		ReleaseList: []*ddexv1beta1.Release{
+			{Release: &ddexv1beta1.Release_MainRelease{MainRelease: &ddexv1beta1.Release_Release{ReleaseReference: "R0", DisplayTitleText: "Midnight Drive"}}},
+		},
+		DealList: []*ddexv1beta1.Deal{
+			historyTestDeal("OnDemandStream", "US"),
+			historyTestDeal("PermanentDownload", "Worldwide"),
+		},
+	}
+	to := &ddexv1beta1.NewReleaseMessage{
+		ResourceList: []*ddexv1beta1.Resource{
+			historyTestRecording("A1", "Midnight Drive (Remastered)"),
+			historyTestRecording("A3", "Outro"),
+		},
+		ReleaseList: []*ddexv1beta1.Release{
+			{Release: &ddexv1beta1.Release_MainRelease{MainRelease: &ddexv1beta1.Release_Release{
+				ReleaseReference: "R0",
+				DisplayTitle:     &ddexv1beta1.Release_DisplayTitle{TitleText: "Midnight Drive (Deluxe)"},
+			}}},
+		},
+		DealList: []*ddexv1beta1.Deal{
+			historyTestDeal("OnDemandStream", "US", "CA"),
+			historyTestDeal("ConditionalDownload", "Worldwide"),
+		},
+	}
+
+	diff := diffERNs(from, to)
+	require.Equal(t, []string{"A3"}, diff.AddedResources)
+	require.Equal(t, []string{"A2"}, diff.RemovedResources)
+
+	require.Len(t, diff.TitleChanges, 2)
+	require.Equal(t, "A1", diff.TitleChanges[0].Reference)
+	require.Equal(t, "Midnight Drive", diff.TitleChanges[0].From)
+	require.Equal(t, "Midnight Drive (Remastered)", diff.TitleChanges[0].To)
+	require.Equal(t, "R0", diff.TitleChanges[1].Reference)
+	require.Equal(t, "Midnight Drive (Deluxe)", diff.TitleChanges[1].To)
+
+	// the stream deal changed territories, the download deal was swapped for another use type
+	require.Len(t, diff.DealChanges, 3)
+	require.True(t, proto.Equal(from.DealList[0], diff.DealChanges[0].From))
+	require.True(t, proto.Equal(to.DealList[0], diff.DealChanges[0].To))
+	require.Nil(t, diff.DealChanges[1].From)
+	require.True(t, proto.Equal(to.DealList[1], diff.DealChanges[1].To))
+	require.True(t, proto.Equal(from.DealList[1], diff.DealChanges[2].From))
+	require.Nil(t, diff.DealChanges[2].To)
+
+	unchanged := diffERNs(from, proto.CloneOf(from))
+	require.Empty(t, unchanged.AddedResources)
+	require.Empty(t, unchanged.RemovedResources)
+	require.Empty(t, unchanged.DealChanges)
+	require.Empty(t, unchanged.TitleChanges)
+}
+
+func TestERNHistoryDiff(t *testing.T) {
+	history := &ernHistory{
+		versions: []*v1.ERNVersion{
+			{Version: 1, MessageControlType: ddexv1beta1.MessageControlType_MESSAGE_CONTROL_TYPE_NEW_MESSAGE},
+			{Version: 2, MessageControlType: ddexv1beta1.MessageControlType_MESSAGE_CONTROL_TYPE_UPDATED_MESSAGE},
+			{Version: 3, MessageControlType: ddexv1beta1.MessageControlType_MESSAGE_CONTROL_TYPE_TAKEDOWN_MESSAGE},
+		},
+		messages: []*ddexv1beta1.NewReleaseMessage{
+			{ResourceList: []*ddexv1beta1.Resource{historyTestRecording("A1", "Midnight Drive")}},
+			{ResourceList: []*ddexv1beta1.Resource{historyTestRecording("A1", "Midnight Drive"), historyTestRecording("A2", "Outro")}},
+			{},
+		},
+	}
+
+	diff, err := history.diff(1, 2)
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), diff.FromVersion)
+	require.Equal(t, uint32(2), diff.ToVersion)
+	require.Equal(t, []string{"A2"}, diff.AddedResources)
+
+	// either direction works
+	diff, err = history.diff(2, 1)
+	require.NoError(t, err)
+	require.Equal(t, []string{"A2"}, diff.RemovedResources)
+
+	_, err = history.diff(1, 4)
+	require.ErrorIs(t, err, ErrERNVersionNotFound)
+	_, err = history.diff(0, 2)
+	require.ErrorIs(t, err, ErrERNVersionNotFound)
+	_, err = history.diff(2, 3)
+	require.ErrorContains(t, err, "takedown")
+}
+
+func TestVersionERNEntities(t *testing.T) {
+	created := 0
+	create := func(ref string) string {
+		created++
+		return "0xnew" + ref
+	}
+
+	addresses, entities := versionERNEntities(nil, "resource", []string{"A2", "A3", "A1"}, []string{"A1", "A2"}, []string{"0xa1", "0xa2"}, create)
+	require.Equal(t, []string{"0xa2", "0xnewA3", "0xa1"}, addresses)
+	require.Equal(t, []ernEntity{{entityType: "resource", address: "0xnewA3"}}, entities)
+	require.Equal(t, 1, created)
+
+	ack, err := proto.Marshal(&ddexv1beta1.NewReleaseMessageAck{ResourceAddresses: addresses})
+	require.NoError(t, err)
+	require.Equal(t, 3, ernEntityIndex(ack, "resource", "0xa1", 1))
+	require.Equal(t, 0, ernEntityIndex(ack, "resource", "0xremoved", 1))
+	require.Equal(t, 2, ernEntityIndex([]byte{0xff}, "resource", "0xa1", 2))
+}
@@ -0,0 +1,127 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	corev1beta1 "github.com/AudiusProject/audiusd/pkg/api/core/v1beta1"
+	ddexv1beta1 "github.com/AudiusProject/audiusd/pkg/api/ddex/v1beta1"
+	"github.com/AudiusProject/audiusd/pkg/core/config"
+	"github.com/AudiusProject/audiusd/pkg/core/db"
+	"github.com/AudiusProject/audiusd/pkg/hashes"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckDeliveryHashSum(t *testing.T) {
+	audio := []byte("riders of the electric sky")
+	cid, err := hashes.ComputeFileCID(bytes.NewReader(audio))
+	require.NoError(t, err)
+	digest := sha256.Sum256(audio)
+
+	require.NoError(t, checkDeliveryHashSum(cid, "", ""))
+	require.NoError(t, checkDeliveryHashSum(cid, "IPFS", cid))
+	require.NoError(t, checkDeliveryHashSum(cid, "SHA-256", hex.EncodeToString(digest[:])))
+	require.NoError(t, checkDeliveryHashSum(cid, "SHA256", "0x"+hex.EncodeToString(digest[:])))
+
+	// a hash sum of some other file doesn't bind the release to this upload
+	other := sha256.Sum256([]byte("someone else's audio"))
+	require.ErrorIs(t, checkDeliveryHashSum(cid, "IPFS", "baeaaaiqsomethingelse"), ErrERNHashSumMismatch)
+	require.ErrorIs(t, checkDeliveryHashSum(cid, "SHA-256", hex.EncodeToString(other[:])), ErrERNHashSumMismatch)
+
+	// a cid can't vouch for digests it wasn't built from
+	require.ErrorIs(t, checkDeliveryHashSum(cid, "MD5", "8b1a9953c4611296a827abf8c47804d7"), ErrERNHashSumUnsupported)
+	require.ErrorIs(t, checkDeliveryHashSum("QmZ4tDuvesekSs4qM5ZBKpXiZGun7S2CYtEZRB3DYXkjGx", "SHA-256", hex.EncodeToString(digest[:])), ErrERNHashSumUnsupported)
+}
+
+func controlledERNTx(controlType ddexv1beta1.MessageControlType) *corev1beta1.Transaction {
+	return &corev1beta1.Transaction{Envelope: &corev1beta1.Envelope{
+		Header: &corev1beta1.EnvelopeHeader{From: "0xsender", To: "0xern"},
+		Messages: []*corev1beta1.Message{{Message: &corev1beta1.Message_Ern{Ern: &ddexv1beta1.NewReleaseMessage{
+			MessageHeader: &ddexv1beta1.MessageHeader{MessageControlType: controlType.Enum()},
+		}}}},
+	}}
+}
+
+func TestERNControlMessagesBeforeActivation(t *testing.T) {
+	s := &Server{config: &config.Config{ERNTakedownHeight: 10, ERNVersioningHeight: 10}}
+
+	// takedowns and updates before their activation heights are accepted without effect as they
+	// always were, nothing is read or written so there's no database behind this server
+	for _, controlType := range []ddexv1beta1.MessageControlType{
+		ddexv1beta1.MessageControlType_MESSAGE_CONTROL_TYPE_TAKEDOWN_MESSAGE,
+		ddexv1beta1.MessageControlType_MESSAGE_CONTROL_TYPE_UPDATED_MESSAGE,
+	} {
+		require.NoError(t, s.finalizeERN(context.Background(), &abcitypes.FinalizeBlockRequest{Height: 9}, "0x01", controlledERNTx(controlType), 0))
+	}
+}
+
+func deliveredRecording(ref, cid string) *ddexv1beta1.Resource {
+	return &ddexv1beta1.Resource{Resource: &ddexv1beta1.Resource_SoundRecording_{SoundRecording: &ddexv1beta1.Resource_SoundRecording{
+		ResourceReference: ref,
+		SoundRecordingEdition: &ddexv1beta1.Resource_SoundRecording_SoundRecordingEdition{
+			TechnicalDetails: &ddexv1beta1.Resource_SoundRecording_SoundRecordingEdition_TechnicalDetails{
+				DeliveryFile: &ddexv1beta1.Resource_SoundRecording_SoundRecordingEdition_TechnicalDetails_DeliveryFile{
+					File: &ddexv1beta1.Resource_SoundRecording_SoundRecordingEdition_TechnicalDetails_DeliveryFile_File{
+						Uri:     cid,
+						HashSum: &ddexv1beta1.Resource_SoundRecording_SoundRecordingEdition_TechnicalDetails_DeliveryFile_File_HashSum{Algorithm: "IPFS", HashSumValue: cid},
+					},
+				},
+			},
+		},
+	}}}
+}
+
+func TestValidateERNUploads(t *testing.T) {
+	const (
+		sender   = "0x1111111111111111111111111111111111111111"
+		delegate = "0x2222222222222222222222222222222222222222"
+		stranger = "0x3333333333333333333333333333333333333333"
+	)
+	uploaders := map[string]string{"baeaaaiqsender": sender, "baeaaaiqdelegate": delegate, "baeaaaiqstranger": stranger}
+
+	fake := newFakeDB().
+		on("GetCoreUpload", func(args ...any) ([][]any, error) {
+			cid := args[0].(string)
+			uploader, ok := uploaders[cid]
+			if !ok {
+				return nil, nil
+			}
+			return [][]any{{int64(1), uploader, cid, "", "", "", "", "", "0x01", int64(1)}}, nil
+		}).
+		on("GetLatestDelegation", func(args ...any) ([][]any, error) {
+			if args[0] == sender && args[1] == delegate {
+				return [][]any{{"0x02", int64(0), sender, delegate, false, int64(2)}}, nil
+			}
+			return nil, nil
+		})
+	q := db.New(fake)
+	s := &Server{config: &config.Config{ProgrammableDistributionEnabled: true, ERNUploadBindingHeight: 10}}
+
+	release := func(resources ...*ddexv1beta1.Resource) *ddexv1beta1.NewReleaseMessage {
+		return &ddexv1beta1.NewReleaseMessage{ResourceList: resources}
+	}
+
+	// the sender's own uploads and those of its delegates can be released
+	require.NoError(t, s.validateERNNewMessage(context.Background(), q, sender, release(
+		deliveredRecording("A1", "baeaaaiqsender"),
+		deliveredRecording("A2", "baeaaaiqdelegate"),
+	), 10))
+
+	err := s.validateERNNewMessage(context.Background(), q, sender, release(deliveredRecording("A1", "baeaaaiqmissing")), 10)
+	require.ErrorIs(t, err, ErrERNUploadNotFound)
+
+	err = s.validateERNNewMessage(context.Background(), q, sender, release(deliveredRecording("A1", "baeaaaiqstranger")), 10)
+	require.ErrorIs(t, err, ErrERNUploadNotOwned)
+
+	// a delegate can't release the sender's audio on its own behalf, delegation only goes one way
+	err = s.validateERNNewMessage(context.Background(), q, delegate, release(deliveredRecording("A1", "baeaaaiqsender")), 10)
+	require.ErrorIs(t, err, ErrERNUploadNotOwned)
+
+	undelivered := release(&ddexv1beta1.Resource{Resource: &ddexv1beta1.Resource_SoundRecording_{SoundRecording: &ddexv1beta1.Resource_SoundRecording{ResourceReference: "A1"}}})
+	err = s.validateERNNewMessage(context.Background(), q, sender, undelivered, 10)
+	require.ErrorIs(t, err, ErrERNDeliveryFileMissing)
+}
@@ -0,0 +1,133 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	ddexv1beta1 "github.com/AudiusProject/audiusd/pkg/api/ddex/v1beta1"
+	"github.com/AudiusProject/audiusd/pkg/common"
+	"github.com/AudiusProject/audiusd/pkg/ddex"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// renderERNXML renders the latest version of an ERN for a recipient
+func (s *Server) renderERNXML(ctx context.Context, ernAddress string, recipient *ddexv1beta1.MessageRecipient) ([]byte, error) {
+	dbErn, err := s.db.GetERN(ctx, ernAddress)
+	if err != nil {
+		return nil, err
+	}
+	var ern ddexv1beta1.NewReleaseMessage
+	if err := proto.Unmarshal(dbErn.RawMessage, &ern); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal ERN message: %w", err)
+	}
+
+	// the acknowledgment lists entity addresses in the order this version lists the entities
+	var addresses ddexv1beta1.NewReleaseMessageAck
+	if err := proto.Unmarshal(dbErn.RawAcknowledgment, &addresses); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal ERN acknowledgment: %w", err)
+	}
+
+	takenDown := true
+	if _, err := s.db.GetERNTakedown(ctx, ernAddress); errors.Is(err, pgx.ErrNoRows) {
+		takenDown = false
+	} else if err != nil {
+		return nil, fmt.Errorf("could not get ERN takedown: %w", err)
+	}
+
+	header := &ddexv1beta1.MessageHeader{
+		MessageThreadId:        &ernAddress,
+		MessageId:              uuid.NewString(),
+		MessageRecipient:       []*ddexv1beta1.MessageRecipient{recipient},
+		MessageCreatedDateTime: timestamppb.New(time.Now()),
+	}
+	return ddex.MarshalERN(ernForExport(&ern, header, &addresses, takenDown))
+}
+
+// ernForExport puts a stored ERN in a new message for a recipient. Entity chain addresses
+// are added as OAP proprietary ids in place of any the sender set, and a taken down ERN
+// goes out without deals which is how ERN 4 withdraws a release.
+func ernForExport(stored *ddexv1beta1.NewReleaseMessage, header *ddexv1beta1.MessageHeader, addresses *ddexv1beta1.NewReleaseMessageAck, takenDown bool) *ddexv1beta1.NewReleaseMessage {
+	ern := proto.CloneOf(stored)
+
+	header.MessageSender = ern.GetMessageHeader().GetMessageSender()
+	header.SentOnBehalfOf = ern.GetMessageHeader().GetSentOnBehalfOf()
+	controlType := ddexv1beta1.MessageControlType_MESSAGE_CONTROL_TYPE_NEW_MESSAGE
+	if ern.GetMessageHeader().GetMessageControlType() == ddexv1beta1.MessageControlType_MESSAGE_CONTROL_TYPE_TEST_MESSAGE {
+		controlType = ddexv1beta1.MessageControlType_MESSAGE_CONTROL_TYPE_TEST_MESSAGE
+	}
+	header.MessageControlType = &controlType
+	ern.MessageHeader = header
+
+	for i, party := range ern.GetPartyList() {
+		if i < len(addresses.PartyAddresses) && addresses.PartyAddresses[i] != "" {
+			party.PartyId = withPartyAddress(party.PartyId, addresses.PartyAddresses[i])
+		}
+	}
+	for i, resource := range ern.GetResourceList() {
+		if i >= len(addresses.ResourceAddresses) || addresses.ResourceAddresses[i] == "" {
+			continue
+		}
+		if sr := resource.GetSoundRecording(); sr != nil {
+			if sr.SoundRecordingEdition == nil {
+				sr.SoundRecordingEdition = &ddexv1beta1.Resource_SoundRecording_SoundRecordingEdition{}
+			}
+			sr.SoundRecordingEdition.ResourceId = withResourceAddress(sr.SoundRecordingEdition.ResourceId, addresses.ResourceAddresses[i])
+		} else if img := resource.GetImage(); img != nil {
+			img.ResourceId = withResourceAddress(img.ResourceId, addresses.ResourceAddresses[i])
+		}
+	}
+	for i, release := range ern.GetReleaseList() {
+		if i >= len(addresses.ReleaseAddresses) || addresses.ReleaseAddresses[i] == "" {
+			continue
+		}
+		if mr := release.GetMainRelease(); mr != nil {
+			mr.ReleaseId = withReleaseAddress(mr.ReleaseId, addresses.ReleaseAddresses[i])
+		} else if tr := release.GetTrackRelease(); tr != nil {
+			tr.ReleaseId = withReleaseAddress(tr.ReleaseId, addresses.ReleaseAddresses[i])
+		}
+	}
+
+	if takenDown {
+		ern.DealList = nil
+	}
+	return ern
+}
+
+func withPartyAddress(id *ddexv1beta1.Party_PartyId, address string) *ddexv1beta1.Party_PartyId {
+	if id == nil {
+		id = &ddexv1beta1.Party_PartyId{}
+	}
+	id.ProprietaryIds = slices.DeleteFunc(id.ProprietaryIds, func(pid *ddexv1beta1.Party_ProprietaryId) bool {
+		return pid.Namespace == common.OAPNamespace
+	})
+	id.ProprietaryIds = append(id.ProprietaryIds, &ddexv1beta1.Party_ProprietaryId{Namespace: common.OAPNamespace, Id: address})
+	return id
+}
+
+func withResourceAddress(id *ddexv1beta1.Resource_ResourceId, address string) *ddexv1beta1.Resource_ResourceId {
+	if id == nil {
+		id = &ddexv1beta1.Resource_ResourceId{}
+	}
+	id.ProprietaryId = slices.DeleteFunc(id.ProprietaryId, func(pid *ddexv1beta1.Resource_ProprietaryId) bool {
+		return pid.Namespace == common.OAPNamespace
+	})
+	id.ProprietaryId = append(id.ProprietaryId, &ddexv1beta1.Resource_ProprietaryId{Namespace: common.OAPNamespace, ProprietaryId: address})
+	return id
+}
+
+func withReleaseAddress(id *ddexv1beta1.Release_ReleaseId, address string) *ddexv1beta1.Release_ReleaseId {
+	if id == nil {
+		id = &ddexv1beta1.Release_ReleaseId{}
+	}
+	id.ProprietaryId = slices.DeleteFunc(id.ProprietaryId, func(pid *ddexv1beta1.Release_ProprietaryId) bool {
+		return pid.Namespace == common.OAPNamespace
+	})
+	id.ProprietaryId = append(id.ProprietaryId, &ddexv1beta1.Release_ProprietaryId{Namespace: common.OAPNamespace, Id: address})
+	return id
+}
@@ -0,0 +1,96 @@
+package server
+
+import (
+	"testing"
+
+	ddexv1beta1 "github.com/AudiusProject/audiusd/pkg/api/ddex/v1beta1"
+	"github.com/AudiusProject/audiusd/pkg/common"
+	"github.com/AudiusProject/audiusd/pkg/ddex"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestERNForExport(t *testing.T) {
+	stored := &ddexv1beta1.NewReleaseMessage{
+		MessageHeader: &ddexv1beta1.MessageHeader{
+			MessageId: "label-message",
+			MessageSender: &ddexv1beta1.MessageSender{
+				PartyId: &ddexv1beta1.Party_PartyId{Dpid: "PADPIDA2024010501X"},
+			},
+			MessageRecipient: []*ddexv1beta1.MessageRecipient{{
+				PartyId: &ddexv1beta1.Party_PartyId{Dpid: "PADPIDA202401120D9"},
+			}},
+			MessageControlType: ddexv1beta1.MessageControlType_MESSAGE_CONTROL_TYPE_UPDATED_MESSAGE.Enum(),
+		},
+		PartyList: []*ddexv1beta1.Party{{
+			PartyReference: "P1",
+			PartyName:      &ddexv1beta1.Party_PartyName{FullName: "Marcus Stone"},
+			PartyId: &ddexv1beta1.Party_PartyId{ProprietaryIds: []*ddexv1beta1.Party_ProprietaryId{
+				{Namespace: common.OAPNamespace, Id: "0xsenderclaimed"},
+			}},
+		}},
+		ResourceList: []*ddexv1beta1.Resource{
+			{Resource: &ddexv1beta1.Resource_SoundRecording_{SoundRecording: &ddexv1beta1.Resource_SoundRecording{ResourceReference: "A1", Type: "MusicalWorkSoundRecording"}}},
+			{Resource: &ddexv1beta1.Resource_Image_{Image: &ddexv1beta1.Resource_Image{ResourceReference: "A2", Type: "FrontCoverImage"}}},
+		},
+		ReleaseList: []*ddexv1beta1.Release{
+			{Release: &ddexv1beta1.Release_MainRelease{MainRelease: &ddexv1beta1.Release_Release{ReleaseReference: "R0", ReleaseType: "Single"}}},
+		},
+		DealList: []*ddexv1beta1.Deal{{Deal: &ddexv1beta1.Deal_ReleaseDeal_{ReleaseDeal: &ddexv1beta1.Deal_ReleaseDeal{
+			DealReleaseReference: []string{"R0"},
+			Deal: &ddexv1beta1.Deal_ReleaseDeal_Deal{DealTerms: &ddexv1beta1.Deal_ReleaseDeal_Deal_DealTerms{
+				TerritoryCode:       []string{"Worldwide"},
+				CommercialModelType: "SubscriptionModel",
+				UseType:             "OnDemandStream",
+			}},
+		}}}},
+	}
+	original := proto.CloneOf(stored)
+
+	addresses := &ddexv1beta1.NewReleaseMessageAck{
+		ErnAddress:        "0xern",
+		PartyAddresses:    []string{"0xparty"},
+		ResourceAddresses: []string{"0xaudio", "0ximage"},
+		ReleaseAddresses:  []string{"0xrelease"},
+	}
+	thread := "0xern"
+	header := func() *ddexv1beta1.MessageHeader {
+		return &ddexv1beta1.MessageHeader{
+			MessageThreadId:        &thread,
+			MessageId:              "export",
+			MessageCreatedDateTime: timestamppb.Now(),
+			MessageRecipient: []*ddexv1beta1.MessageRecipient{{
+				PartyId: &ddexv1beta1.Party_PartyId{Dpid: "PADPIDA2014021301H"},
+			}},
+		}
+	}
+
+	ern := ernForExport(stored, header(), addresses, false)
+	require.True(t, proto.Equal(original, stored), "stored ERN must not change")
+
+	require.Equal(t, "export", ern.GetMessageHeader().GetMessageId())
+	require.Equal(t, "PADPIDA2024010501X", ern.GetMessageHeader().GetMessageSender().GetPartyId().GetDpid())
+	require.Equal(t, "PADPIDA2014021301H", ern.GetMessageHeader().GetMessageRecipient()[0].GetPartyId().GetDpid())
+	require.Equal(t, ddexv1beta1.MessageControlType_MESSAGE_CONTROL_TYPE_NEW_MESSAGE, ern.GetMessageHeader().GetMessageControlType())
+	require.Len(t, ern.GetDealList(), 1)
+
+	// the chain address replaces the one the sender claimed
+	partyIDs := ern.GetPartyList()[0].GetPartyId().GetProprietaryIds()
+	require.Len(t, partyIDs, 1)
+	require.Equal(t, common.OAPNamespace, partyIDs[0].GetNamespace())
+	require.Equal(t, "0xparty", partyIDs[0].GetId())
+
+	// and every address survives the trip through xml
+	data, err := ddex.MarshalERN(ern)
+	require.NoError(t, err)
+	parsed, err := ddex.ParseERN(data)
+	require.NoError(t, err)
+	require.Equal(t, "0xaudio", parsed.GetResourceList()[0].GetSoundRecording().GetSoundRecordingEdition().GetResourceId().GetProprietaryId()[0].GetProprietaryId())
+	require.Equal(t, "0ximage", parsed.GetResourceList()[1].GetImage().GetResourceId().GetProprietaryId()[0].GetProprietaryId())
+	require.Equal(t, "0xrelease", parsed.GetReleaseList()[0].GetMainRelease().GetReleaseId().GetProprietaryId()[0].GetId())
+	require.Equal(t, "0xparty", parsed.GetPartyList()[0].GetPartyId().GetProprietaryIds()[0].GetId())
+
+	takenDown := ernForExport(stored, header(), addresses, true)
+	require.Empty(t, takenDown.GetDealList())
+}
@@ -6,14 +6,21 @@ import (
 	"fmt"
 	"math/big"
 	"net/http"
+	"strings"
 
 	"github.com/AudiusProject/audiusd/pkg/core/config"
+	"github.com/AudiusProject/audiusd/pkg/core/db"
+	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/jackc/pgx/v5"
 	"github.com/labstack/echo/v4"
 )
 
+// eth_getLogs maps every tx in the range to its logs, bound the work a single call can do
+const ethMaxLogsBlockRange = 1000
+
 type EthAPI struct {
 	server *Server
 	vars   *config.SandboxVars
@@ -85,65 +92,254 @@ func (api *EthAPI) BlockNumber(ctx context.Context) (*hexutil.Uint64, error) {
 	return (*hexutil.Uint64)(&blockHeight), nil
 }
 
-func (api *EthAPI) GetBlockByNumber(ctx context.Context, blockNumber string, fullTx bool) (map[string]any, error) {
-	var height uint64
+// eth_getBlockByNumber
+func (api *EthAPI) GetBlockByNumber(ctx context.Context, blockNumber rpc.BlockNumber, fullTx bool) (map[string]any, error) {
+	dbBlock, err := api.server.db.GetBlock(ctx, api.resolveBlockNumber(blockNumber))
+	if err != nil {
+		return nil, fmt.Errorf("block not found: %v", err)
+	}
+	return api.ethBlock(ctx, dbBlock, fullTx)
+}
 
-	switch blockNumber {
-	case "latest":
-		height = uint64(api.server.cache.currentHeight.Load())
-	default:
-		n := new(big.Int)
-		if err := n.UnmarshalText([]byte(blockNumber)); err != nil {
-			return nil, fmt.Errorf("invalid block number: %s", blockNumber)
+// eth_getBlockByHash
+func (api *EthAPI) GetBlockByHash(ctx context.Context, hash ethcommon.Hash, fullTx bool) (map[string]any, error) {
+	dbBlock, err := api.server.db.GetBlockByHash(ctx, strings.TrimPrefix(hash.Hex(), "0x"))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("could not get block: %v", err)
+	}
+	return api.ethBlock(ctx, dbBlock, fullTx)
+}
+
+// eth_getTransactionByHash
+func (api *EthAPI) GetTransactionByHash(ctx context.Context, hash ethcommon.Hash) (map[string]any, error) {
+	tx, block, err := api.getTx(ctx, hash)
+	if err != nil || tx == nil {
+		return nil, err
+	}
+	return api.ethTransaction(*block, *tx), nil
+}
+
+// eth_getTransactionReceipt
+func (api *EthAPI) GetTransactionReceipt(ctx context.Context, hash ethcommon.Hash) (map[string]any, error) {
+	tx, block, err := api.getTx(ctx, hash)
+	if err != nil || tx == nil {
+		return nil, err
+	}
+
+	// log indexes count across the block so every earlier tx has to be mapped too
+	blockTxs, err := api.server.db.GetTransactionsInBlockRange(ctx, db.GetTransactionsInBlockRangeParams{BlockID: block.Height, BlockID_2: block.Height})
+	if err != nil {
+		return nil, fmt.Errorf("could not get txs for block: %v", err)
+	}
+	blockLogs, err := ethBlockLogs(api.server.config.GenesisFile.ChainID, *block, blockTxs)
+	if err != nil {
+		return nil, err
+	}
+	logs := []*ethtypes.Log{}
+	for _, log := range blockLogs {
+		if log.TxIndex == uint(tx.Index) {
+			logs = append(logs, log)
 		}
-		height = n.Uint64()
 	}
 
-	dbBlock, err := api.server.db.GetBlock(ctx, int64(height))
+	status := ethtypes.ReceiptStatusSuccessful
+	if tx.ResultCode != 0 {
+		status = ethtypes.ReceiptStatusFailed
+	}
+
+	return map[string]any{
+		"transactionHash":   ethcommon.HexToHash(tx.TxHash),
+		"transactionIndex":  hexutil.Uint64(tx.Index),
+		"blockHash":         ethcommon.HexToHash(block.Hash),
+		"blockNumber":       hexutil.Uint64(block.Height),
+		"from":              api.ethTxSender(*tx),
+		"to":                ethCoreLogAddress,
+		"cumulativeGasUsed": hexutil.Uint64(0),
+		"gasUsed":           hexutil.Uint64(0),
+		"effectiveGasPrice": (*hexutil.Big)(big.NewInt(0)),
+		"contractAddress":   nil,
+		"logs":              logs,
+		"logsBloom":         ethLogsBloom(logs),
+		"type":              hexutil.Uint64(ethtypes.LegacyTxType),
+		"status":            hexutil.Uint64(status),
+	}, nil
+}
+
+// eth_getLogs
+func (api *EthAPI) GetLogs(ctx context.Context, filter ethLogFilter) ([]*ethtypes.Log, error) {
+	var from, to int64
+	if filter.BlockHash != nil {
+		if filter.FromBlock != nil || filter.ToBlock != nil {
+			return nil, errors.New("blockHash cannot be combined with fromBlock or toBlock")
+		}
+		block, err := api.server.db.GetBlockByHash(ctx, strings.TrimPrefix(filter.BlockHash.Hex(), "0x"))
+		if err != nil {
+			return nil, fmt.Errorf("block not found: %v", err)
+		}
+		from, to = block.Height, block.Height
+	} else {
+		from, to = api.resolveBlockNumber(rpc.LatestBlockNumber), api.resolveBlockNumber(rpc.LatestBlockNumber)
+		if filter.FromBlock != nil {
+			from = api.resolveBlockNumber(*filter.FromBlock)
+		}
+		if filter.ToBlock != nil {
+			to = api.resolveBlockNumber(*filter.ToBlock)
+		}
+	}
+
+	if from > to {
+		return nil, fmt.Errorf("fromBlock %d is after toBlock %d", from, to)
+	}
+	if to-from >= ethMaxLogsBlockRange {
+		return nil, fmt.Errorf("block range is limited to %d blocks", ethMaxLogsBlockRange)
+	}
+
+	txs, err := api.server.db.GetTransactionsInBlockRange(ctx, db.GetTransactionsInBlockRangeParams{BlockID: from, BlockID_2: to})
 	if err != nil {
-		return nil, fmt.Errorf("block not found: %v", err)
+		return nil, fmt.Errorf("could not get txs: %v", err)
 	}
 
-	parentBlockHeight := 1
-	if height-1 > 0 {
-		parentBlockHeight = int(height - 1)
+	logs := []*ethtypes.Log{}
+	// txs are ordered by block so each block's txs are one contiguous run
+	for start := 0; start < len(txs); {
+		end := start
+		for end < len(txs) && txs[end].BlockID == txs[start].BlockID {
+			end++
+		}
+
+		block, err := api.server.db.GetBlock(ctx, txs[start].BlockID)
+		if err != nil {
+			return nil, fmt.Errorf("could not get block %d: %v", txs[start].BlockID, err)
+		}
+		blockLogs, err := ethBlockLogs(api.server.config.GenesisFile.ChainID, block, txs[start:end])
+		if err != nil {
+			return nil, err
+		}
+		for _, log := range blockLogs {
+			if filter.matches(log) {
+				logs = append(logs, log)
+			}
+		}
+
+		start = end
+	}
+
+	return logs, nil
+}
+
+// resolveBlockNumber maps block tags to heights, core has instant finality so
+// latest, safe, finalized and pending are all the same block
+func (api *EthAPI) resolveBlockNumber(blockNumber rpc.BlockNumber) int64 {
+	switch blockNumber {
+	case rpc.LatestBlockNumber, rpc.PendingBlockNumber, rpc.SafeBlockNumber, rpc.FinalizedBlockNumber:
+		return api.server.cache.currentHeight.Load()
+	case rpc.EarliestBlockNumber:
+		// comet blocks start at height 1
+		return 1
+	default:
+		return blockNumber.Int64()
+	}
+}
+
+// getTx returns nil without an error for unknown txs, eth clients expect null rather than an error
+func (api *EthAPI) getTx(ctx context.Context, hash ethcommon.Hash) (*db.CoreTransaction, *db.CoreBlock, error) {
+	tx, err := api.server.db.GetTx(ctx, strings.TrimPrefix(hash.Hex(), "0x"))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil, nil
+	} else if err != nil {
+		return nil, nil, fmt.Errorf("could not get tx: %v", err)
+	}
+
+	block, err := api.server.db.GetBlock(ctx, tx.BlockID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not get block for tx: %v", err)
 	}
-	parentBlock, err := api.server.db.GetBlock(ctx, int64(parentBlockHeight))
-	if !errors.Is(err, pgx.ErrNoRows) && err != nil {
-		return nil, fmt.Errorf("could not get parent block: %v", err)
+
+	return &tx, &block, nil
+}
+
+func (api *EthAPI) ethBlock(ctx context.Context, dbBlock db.CoreBlock, fullTx bool) (map[string]any, error) {
+	parentHash := ethcommon.Hash{}
+	if dbBlock.Height > 1 {
+		parentBlock, err := api.server.db.GetBlock(ctx, dbBlock.Height-1)
+		if !errors.Is(err, pgx.ErrNoRows) && err != nil {
+			return nil, fmt.Errorf("could not get parent block: %v", err)
+		}
+		parentHash = ethcommon.HexToHash(parentBlock.Hash)
 	}
 
-	blockTxs, err := api.server.db.GetBlockTransactions(ctx, dbBlock.Height)
-	if !errors.Is(err, pgx.ErrNoRows) && err != nil {
+	blockTxs, err := api.server.db.GetTransactionsInBlockRange(ctx, db.GetTransactionsInBlockRangeParams{BlockID: dbBlock.Height, BlockID_2: dbBlock.Height})
+	if err != nil {
 		return nil, fmt.Errorf("could not get txs for block: %v", err)
 	}
 
-	txs := []string{}
+	logs, err := ethBlockLogs(api.server.config.GenesisFile.ChainID, dbBlock, blockTxs)
+	if err != nil {
+		return nil, err
+	}
+
+	txs := []any{}
 	for _, tx := range blockTxs {
-		txs = append(txs, tx.TxHash)
+		if fullTx {
+			txs = append(txs, api.ethTransaction(dbBlock, tx))
+		} else {
+			txs = append(txs, ethcommon.HexToHash(tx.TxHash))
+		}
 	}
 
 	block := map[string]any{
-		"number":           hexutil.EncodeUint64(height),
-		"hash":             dbBlock.Hash,
-		"parentHash":       parentBlock.Hash,
-		"nonce":            "0x0000000000000000",
-		"sha3Uncles":       "",
-		"logsBloom":        "0x" + string(make([]byte, 256)),
-		"transactionsRoot": "0x" + string(make([]byte, 32)),
-		"stateRoot":        "0x" + string(make([]byte, 32)),
-		"receiptsRoot":     "0x" + string(make([]byte, 32)),
-		"miner":            dbBlock.Proposer,
+		"number":           hexutil.Uint64(dbBlock.Height),
+		"hash":             ethcommon.HexToHash(dbBlock.Hash),
+		"parentHash":       parentHash,
+		"nonce":            ethtypes.BlockNonce{},
+		"sha3Uncles":       ethtypes.EmptyUncleHash,
+		"logsBloom":        ethLogsBloom(logs),
+		"transactionsRoot": ethcommon.Hash{},
+		"stateRoot":        ethcommon.Hash{},
+		"receiptsRoot":     ethcommon.Hash{},
+		"miner":            ethcommon.HexToAddress(dbBlock.Proposer),
 		"difficulty":       hexutil.EncodeBig(big.NewInt(1)),
 		"totalDifficulty":  hexutil.EncodeBig(big.NewInt(1)),
 		"extraData":        "0x",
 		"size":             hexutil.Uint64(1000),
 		"gasLimit":         hexutil.Uint64(10000000),
 		"gasUsed":          hexutil.Uint64(0),
-		"timestamp":        hexutil.Uint64(1711830000),
+		"timestamp":        hexutil.Uint64(dbBlock.CreatedAt.Time.Unix()),
 		"transactions":     txs,
 		"uncles":           []string{},
 	}
 
 	return block, nil
 }
+
+func (api *EthAPI) ethTransaction(block db.CoreBlock, tx db.CoreTransaction) map[string]any {
+	return map[string]any{
+		"hash":             ethcommon.HexToHash(tx.TxHash),
+		"nonce":            hexutil.Uint64(0),
+		"blockHash":        ethcommon.HexToHash(block.Hash),
+		"blockNumber":      hexutil.Uint64(block.Height),
+		"transactionIndex": hexutil.Uint64(tx.Index),
+		"from":             api.ethTxSender(tx),
+		"to":               ethCoreLogAddress,
+		"value":            (*hexutil.Big)(big.NewInt(0)),
+		"gas":              hexutil.Uint64(0),
+		"gasPrice":         (*hexutil.Big)(big.NewInt(0)),
+		"input":            hexutil.Bytes(tx.Transaction),
+		"type":             hexutil.Uint64(ethtypes.LegacyTxType),
+		"chainId":          (*hexutil.Big)(big.NewInt(int64(api.vars.EthChainID))),
+		"v":                (*hexutil.Big)(big.NewInt(0)),
+		"r":                (*hexutil.Big)(big.NewInt(0)),
+		"s":                (*hexutil.Big)(big.NewInt(0)),
+	}
+}
+
+// ethTxSender is the same sender the mempool accounts a tx to, the zero address if there's none
+func (api *EthAPI) ethTxSender(tx db.CoreTransaction) ethcommon.Address {
+	signedTx, v2Tx, err := decodeCoreTx(tx.Transaction)
+	if err != nil {
+		return ethcommon.Address{}
+	}
+	return ethcommon.HexToAddress(mempoolTxSender(&MempoolTransaction{Tx: signedTx, Txv2: v2Tx}))
+}
@@ -0,0 +1,29 @@
+package server
+
+import (
+	"testing"
+
+	v1 "github.com/AudiusProject/audiusd/pkg/api/core/v1"
+	"github.com/AudiusProject/audiusd/pkg/common"
+	"github.com/AudiusProject/audiusd/pkg/core/db"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEthTxSender(t *testing.T) {
+	api := &EthAPI{}
+
+	validator, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	plays := &v1.TrackPlays{Plays: []*v1.TrackPlay{{UserId: "1", TrackId: "1"}}}
+	sig, err := common.ProtoSign(validator, plays)
+	require.NoError(t, err)
+
+	signed := marshalTx(t, &v1.SignedTransaction{Signature: sig, Transaction: &v1.SignedTransaction_Plays{Plays: plays}})
+	require.Equal(t, crypto.PubkeyToAddress(validator.PublicKey), api.ethTxSender(db.CoreTransaction{TxHash: common.ToTxHashFromBytes(signed), Transaction: signed}))
+
+	// txs with nobody to account them to come from the zero address
+	unsigned := marshalTx(t, &v1.SignedTransaction{Transaction: &v1.SignedTransaction_Plays{Plays: plays}})
+	require.Equal(t, ethcommon.Address{}, api.ethTxSender(db.CoreTransaction{TxHash: common.ToTxHashFromBytes(unsigned), Transaction: unsigned}))
+}
@@ -0,0 +1,240 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+
+	v1 "github.com/AudiusProject/audiusd/pkg/api/core/v1"
+	"github.com/AudiusProject/audiusd/pkg/api/core/v1beta1"
+	ddexv1beta1 "github.com/AudiusProject/audiusd/pkg/api/ddex/v1beta1"
+	"github.com/AudiusProject/audiusd/pkg/common"
+	"github.com/AudiusProject/audiusd/pkg/core/db"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// core has no contracts, every synthetic log is emitted from this one address so
+// indexers subscribe to it and tell events apart by their topics
+var ethCoreLogAddress = ethcommon.HexToAddress("0x00000000000000000000000000000000000a0d10")
+
+// the events core transactions are mapped to, indexers decode logs with this abi
+const ethCoreEventsABI = `[
+	{"type": "event", "name": "ERNCreated", "inputs": [
+		{"name": "ern", "type": "address", "indexed": true},
+		{"name": "sender", "type": "address", "indexed": true},
+		{"name": "messageId", "type": "string"}
+	]},
+	{"type": "event", "name": "RewardCreated", "inputs": [
+		{"name": "reward", "type": "address", "indexed": true},
+		{"name": "rewardId", "type": "string"},
+		{"name": "name", "type": "string"},
+		{"name": "amount", "type": "uint256"}
+	]},
+	{"type": "event", "name": "UploadRegistered", "inputs": [
+		{"name": "uploader", "type": "address", "indexed": true},
+		{"name": "cid", "type": "string"},
+		{"name": "transcodedCid", "type": "string"},
+		{"name": "uploadId", "type": "string"}
+	]},
+	{"type": "event", "name": "PlaysRecorded", "inputs": [
+		{"name": "count", "type": "uint256"},
+		{"name": "trackIds", "type": "string[]"}
+	]}
+]`
+
+var ethCoreEvents = func() abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(ethCoreEventsABI))
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}()
+
+// decodeCoreTx decodes a stored transaction in the same order as FinalizeBlock
+func decodeCoreTx(tx []byte) (*v1.SignedTransaction, *v1beta1.Transaction, error) {
+	var signedTx v1.SignedTransaction
+	if err := proto.Unmarshal(tx, &signedTx); err == nil {
+		return &signedTx, nil, nil
+	}
+
+	var v2Tx v1beta1.Transaction
+	if err := proto.Unmarshal(tx, &v2Tx); err != nil {
+		return nil, nil, fmt.Errorf("could not unmarshal transaction as v1 or v2: %v", err)
+	}
+	return nil, &v2Tx, nil
+}
+
+// ethTxLogs maps a finalized core transaction to the logs it emits. Entity addresses are
+// derived the same way finalize derives them. Failed txs emit nothing, as in the evm.
+// Block hash and log index are left for the caller who knows the rest of the block.
+func ethTxLogs(chainID string, tx db.CoreTransaction) ([]*ethtypes.Log, error) {
+	if tx.ResultCode != 0 {
+		return nil, nil
+	}
+
+	signedTx, v2Tx, err := decodeCoreTx(tx.Transaction)
+	if err != nil {
+		return nil, err
+	}
+
+	txhashBytes, err := common.HexToBytes(tx.TxHash)
+	if err != nil {
+		return nil, fmt.Errorf("invalid txhash %s: %v", tx.TxHash, err)
+	}
+
+	logs := []*ethtypes.Log{}
+	emit := func(event string, indexed []ethcommon.Hash, args ...any) error {
+		ev := ethCoreEvents.Events[event]
+		data, err := ev.Inputs.NonIndexed().Pack(args...)
+		if err != nil {
+			return fmt.Errorf("could not pack %s: %v", event, err)
+		}
+		logs = append(logs, &ethtypes.Log{
+			Address:     ethCoreLogAddress,
+			Topics:      append([]ethcommon.Hash{ev.ID}, indexed...),
+			Data:        data,
+			BlockNumber: uint64(tx.BlockID),
+			TxHash:      ethcommon.BytesToHash(txhashBytes),
+			TxIndex:     uint(tx.Index),
+		})
+		return nil
+	}
+
+	if v2Tx != nil {
+		sender := ethcommon.HexToAddress(v2Tx.GetEnvelope().GetHeader().GetFrom())
+		for i, msg := range v2Tx.GetEnvelope().GetMessages() {
+			ern := msg.GetErn()
+			switch ern.GetMessageHeader().GetMessageControlType() {
+			case ddexv1beta1.MessageControlType_MESSAGE_CONTROL_TYPE_NEW_MESSAGE, ddexv1beta1.MessageControlType_MESSAGE_CONTROL_TYPE_TEST_MESSAGE:
+			default:
+				continue
+			}
+
+			messageID := ern.GetMessageHeader().GetMessageId()
+			if messageID == "" {
+				messageID = fmt.Sprintf("%s:%d", tx.TxHash, i)
+			}
+			ernAddress := common.CreateERNAddress(txhashBytes, chainID, tx.BlockID, int64(i), messageID)
+			indexed := []ethcommon.Hash{
+				ethcommon.BytesToHash(ethcommon.HexToAddress(ernAddress).Bytes()),
+				ethcommon.BytesToHash(sender.Bytes()),
+			}
+			if err := emit("ERNCreated", indexed, messageID); err != nil {
+				return nil, err
+			}
+		}
+		return logs, nil
+	}
+
+	switch t := signedTx.Transaction.(type) {
+	case *v1.SignedTransaction_Reward:
+		create := t.Reward.GetCreate()
+		if create == nil {
+			break
+		}
+		// single reward transactions are finalized at message index 0
+		rewardAddress := common.CreateAddress(txhashBytes, chainID, tx.BlockID, 0, "")
+		indexed := []ethcommon.Hash{ethcommon.BytesToHash(ethcommon.HexToAddress(rewardAddress).Bytes())}
+		err = emit("RewardCreated", indexed, create.RewardId, create.Name, new(big.Int).SetUint64(create.Amount))
+	case *v1.SignedTransaction_FileUpload:
+		fu := t.FileUpload
+		indexed := []ethcommon.Hash{ethcommon.BytesToHash(ethcommon.HexToAddress(fu.UploaderAddress).Bytes())}
+		err = emit("UploadRegistered", indexed, fu.Cid, fu.TranscodedCid, fu.UploadId)
+	case *v1.SignedTransaction_Plays:
+		trackIDs := make([]string, len(t.Plays.Plays))
+		for i, play := range t.Plays.Plays {
+			trackIDs[i] = play.TrackId
+		}
+		err = emit("PlaysRecorded", nil, big.NewInt(int64(len(trackIDs))), trackIDs)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return logs, nil
+}
+
+// ethBlockLogs maps every transaction in a block to its logs and numbers them across the block
+func ethBlockLogs(chainID string, block db.CoreBlock, txs []db.CoreTransaction) ([]*ethtypes.Log, error) {
+	blockHash := ethcommon.HexToHash(block.Hash)
+	logs := []*ethtypes.Log{}
+	for _, tx := range txs {
+		txLogs, err := ethTxLogs(chainID, tx)
+		if err != nil {
+			return nil, fmt.Errorf("tx %s: %v", tx.TxHash, err)
+		}
+		for _, log := range txLogs {
+			log.BlockHash = blockHash
+			log.Index = uint(len(logs))
+			logs = append(logs, log)
+		}
+	}
+	return logs, nil
+}
+
+func ethLogsBloom(logs []*ethtypes.Log) ethtypes.Bloom {
+	var bloom ethtypes.Bloom
+	for _, log := range logs {
+		bloom.Add(log.Address.Bytes())
+		for _, topic := range log.Topics {
+			bloom.Add(topic.Bytes())
+		}
+	}
+	return bloom
+}
+
+// ethOneOrMany accepts either a single value or a list, as the address and topic
+// positions of an eth_getLogs filter do
+type ethOneOrMany[T any] []T
+
+func (o *ethOneOrMany[T]) UnmarshalJSON(b []byte) error {
+	b = bytes.TrimSpace(b)
+	switch {
+	case bytes.Equal(b, []byte("null")):
+		*o = nil
+	case len(b) > 0 && b[0] == '[':
+		var many []T
+		if err := json.Unmarshal(b, &many); err != nil {
+			return err
+		}
+		*o = many
+	default:
+		var one T
+		if err := json.Unmarshal(b, &one); err != nil {
+			return err
+		}
+		*o = ethOneOrMany[T]{one}
+	}
+	return nil
+}
+
+type ethLogFilter struct {
+	FromBlock *rpc.BlockNumber                `json:"fromBlock"`
+	ToBlock   *rpc.BlockNumber                `json:"toBlock"`
+	BlockHash *ethcommon.Hash                 `json:"blockHash"`
+	Address   ethOneOrMany[ethcommon.Address] `json:"address"`
+	Topics    []ethOneOrMany[ethcommon.Hash]  `json:"topics"`
+}
+
+// matches follows eth_getLogs semantics, an empty topic position matches anything
+func (f *ethLogFilter) matches(log *ethtypes.Log) bool {
+	if len(f.Address) > 0 && !slices.Contains(f.Address, log.Address) {
+		return false
+	}
+	if len(f.Topics) > len(log.Topics) {
+		return false
+	}
+	for i, want := range f.Topics {
+		if len(want) > 0 && !slices.Contains(want, log.Topics[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,110 @@
+package server
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	v1 "github.com/AudiusProject/audiusd/pkg/api/core/v1"
+	"github.com/AudiusProject/audiusd/pkg/api/core/v1beta1"
+	ddexv1beta1 "github.com/AudiusProject/audiusd/pkg/api/ddex/v1beta1"
+	"github.com/AudiusProject/audiusd/pkg/common"
+	"github.com/AudiusProject/audiusd/pkg/core/db"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEthBlockLogs(t *testing.T) {
+	const chainID = "audius-devnet"
+	newMessage := ddexv1beta1.MessageControlType_MESSAGE_CONTROL_TYPE_NEW_MESSAGE
+	updateMessage := ddexv1beta1.MessageControlType_MESSAGE_CONTROL_TYPE_UPDATED_MESSAGE
+
+	coreTx := func(index int32, resultCode int32, raw []byte) db.CoreTransaction {
+		return db.CoreTransaction{BlockID: 7, Index: index, TxHash: common.ToTxHashFromBytes(raw), Transaction: raw, ResultCode: resultCode}
+	}
+
+	// v2 txs are told apart from v1 by their binary signature failing to decode as a string
+	ern := marshalTx(t, &v1beta1.Transaction{Signature: &v1beta1.Signature{Signature: []byte{0xff, 0xfe}}, Envelope: &v1beta1.Envelope{
+		Header: &v1beta1.EnvelopeHeader{From: "0x00000000000000000000000000000000000000aa"},
+		Messages: []*v1beta1.Message{
+			{Message: &v1beta1.Message_Ern{Ern: &ddexv1beta1.NewReleaseMessage{MessageHeader: &ddexv1beta1.MessageHeader{MessageId: "m1", MessageControlType: &newMessage}}}},
+			{Message: &v1beta1.Message_Ern{Ern: &ddexv1beta1.NewReleaseMessage{MessageHeader: &ddexv1beta1.MessageHeader{MessageId: "m2", MessageControlType: &updateMessage}}}},
+		},
+	}})
+	reward := marshalTx(t, &v1.SignedTransaction{Transaction: &v1.SignedTransaction_Reward{Reward: &v1.RewardMessage{
+		Action: &v1.RewardMessage_Create{Create: &v1.CreateReward{RewardId: "r1", Name: "first", Amount: 5}},
+	}}})
+	upload := marshalTx(t, &v1.SignedTransaction{Transaction: &v1.SignedTransaction_FileUpload{FileUpload: &v1.FileUpload{
+		UploaderAddress: "0x00000000000000000000000000000000000000bb", Cid: "cid", TranscodedCid: "tcid", UploadId: "u1",
+	}}})
+	plays := marshalTx(t, &v1.SignedTransaction{Transaction: &v1.SignedTransaction_Plays{Plays: &v1.TrackPlays{
+		Plays: []*v1.TrackPlay{{TrackId: "1"}, {TrackId: "2"}},
+	}}})
+
+	txs := []db.CoreTransaction{
+		coreTx(0, 0, ern),
+		coreTx(1, 2, reward), // failed txs emit nothing
+		coreTx(2, 0, reward),
+		coreTx(3, 0, upload),
+		coreTx(4, 0, plays),
+	}
+	block := db.CoreBlock{Height: 7, Hash: "ab"}
+
+	logs, err := ethBlockLogs(chainID, block, txs)
+	require.NoError(t, err)
+	require.Len(t, logs, 4)
+
+	for i, log := range logs {
+		require.Equal(t, uint(i), log.Index)
+		require.Equal(t, ethCoreLogAddress, log.Address)
+		require.Equal(t, ethcommon.HexToHash("ab"), log.BlockHash)
+		require.Equal(t, uint64(7), log.BlockNumber)
+	}
+
+	ernHash, err := common.HexToBytes(txs[0].TxHash)
+	require.NoError(t, err)
+	ernAddress := ethcommon.HexToAddress(common.CreateERNAddress(ernHash, chainID, 7, 0, "m1"))
+	require.Equal(t, ethCoreEvents.Events["ERNCreated"].ID, logs[0].Topics[0])
+	require.Equal(t, ethcommon.BytesToHash(ernAddress.Bytes()), logs[0].Topics[1])
+	require.Equal(t, uint(0), logs[0].TxIndex)
+
+	rewardHash, err := common.HexToBytes(txs[2].TxHash)
+	require.NoError(t, err)
+	rewardAddress := ethcommon.HexToAddress(common.CreateAddress(rewardHash, chainID, 7, 0, ""))
+	require.Equal(t, ethCoreEvents.Events["RewardCreated"].ID, logs[1].Topics[0])
+	require.Equal(t, ethcommon.BytesToHash(rewardAddress.Bytes()), logs[1].Topics[1])
+	decoded, err := ethCoreEvents.Unpack("RewardCreated", logs[1].Data)
+	require.NoError(t, err)
+	require.Equal(t, []any{"r1", "first", big.NewInt(5)}, decoded)
+
+	require.Equal(t, ethCoreEvents.Events["UploadRegistered"].ID, logs[2].Topics[0])
+	decoded, err = ethCoreEvents.Unpack("UploadRegistered", logs[2].Data)
+	require.NoError(t, err)
+	require.Equal(t, []any{"cid", "tcid", "u1"}, decoded)
+
+	require.Equal(t, ethCoreEvents.Events["PlaysRecorded"].ID, logs[3].Topics[0])
+	decoded, err = ethCoreEvents.Unpack("PlaysRecorded", logs[3].Data)
+	require.NoError(t, err)
+	require.Equal(t, []any{big.NewInt(2), []string{"1", "2"}}, decoded)
+
+	matching := func(filterJSON string) int {
+		var filter ethLogFilter
+		require.NoError(t, json.Unmarshal([]byte(filterJSON), &filter))
+		n := 0
+		for _, log := range logs {
+			if filter.matches(log) {
+				n++
+			}
+		}
+		return n
+	}
+
+	require.Equal(t, 4, matching(`{}`))
+	require.Equal(t, 4, matching(`{"address": "`+ethCoreLogAddress.Hex()+`"}`))
+	require.Equal(t, 0, matching(`{"address": ["0x0000000000000000000000000000000000000001"]}`))
+	require.Equal(t, 1, matching(`{"topics": ["`+ethCoreEvents.Events["PlaysRecorded"].ID.Hex()+`"]}`))
+	require.Equal(t, 2, matching(`{"topics": [["`+ethCoreEvents.Events["ERNCreated"].ID.Hex()+`", "`+ethCoreEvents.Events["UploadRegistered"].ID.Hex()+`"]]}`))
+	require.Equal(t, 1, matching(`{"topics": [null, "`+logs[0].Topics[1].Hex()+`"]}`))
+	// plays have no indexed arguments so can't match a second topic
+	require.Equal(t, 3, matching(`{"topics": [null, null]}`))
+}
@@ -0,0 +1,147 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sync"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+var sqlcQueryName = regexp.MustCompile(`-- name: (\w+)`)
+
+// fakeQuery answers a sqlc query with the rows it returns, each row holding one value per
+// scanned column in the generated Scan order
+type fakeQuery func(args ...any) ([][]any, error)
+
+// fakeDB stands in for postgres in tests, queries are answered by sqlc query name and execs
+// are recorded and handed to the query of the same name if there is one. Queries nobody
+// answers return no rows.
+type fakeDB struct {
+	mu      sync.Mutex
+	queries map[string]fakeQuery
+	execs   []string
+}
+
+func newFakeDB() *fakeDB {
+	return &fakeDB{queries: map[string]fakeQuery{}}
+}
+
+func (f *fakeDB) on(name string, query fakeQuery) *fakeDB {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.queries[name] = query
+	return f
+}
+
+func (f *fakeDB) rows(sql string, args []any) ([][]any, error) {
+	name := ""
+	if m := sqlcQueryName.FindStringSubmatch(sql); m != nil {
+		name = m[1]
+	}
+	f.mu.Lock()
+	query, ok := f.queries[name]
+	f.mu.Unlock()
+	if !ok {
+		return nil, nil
+	}
+	return query(args...)
+}
+
+func (f *fakeDB) Exec(_ context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error) {
+	f.mu.Lock()
+	if m := sqlcQueryName.FindStringSubmatch(sql); m != nil {
+		f.execs = append(f.execs, m[1])
+	}
+	f.mu.Unlock()
+	if _, err := f.rows(sql, args); err != nil {
+		return pgconn.CommandTag{}, err
+	}
+	return pgconn.NewCommandTag("INSERT 0 1"), nil
+}
+
+func (f *fakeDB) Query(_ context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	rows, err := f.rows(sql, args)
+	if err != nil {
+		return nil, err
+	}
+	return &fakeRows{rows: rows, index: -1}, nil
+}
+
+func (f *fakeDB) QueryRow(_ context.Context, sql string, args ...interface{}) pgx.Row {
+	rows, err := f.rows(sql, args)
+	if err != nil {
+		return fakeRow{err: err}
+	}
+	if len(rows) == 0 {
+		return fakeRow{err: pgx.ErrNoRows}
+	}
+	return fakeRow{values: rows[0]}
+}
+
+func scanFake(values []any, dest []any) error {
+	if len(values) != len(dest) {
+		return fmt.Errorf("fake row has %d values, scanning %d", len(values), len(dest))
+	}
+	for i, v := range values {
+		target := reflect.ValueOf(dest[i]).Elem()
+		if v == nil {
+			target.SetZero()
+			continue
+		}
+		target.Set(reflect.ValueOf(v).Convert(target.Type()))
+	}
+	return nil
+}
+
+type fakeRow struct {
+	values []any
+	err    error
+}
+
+func (r fakeRow) Scan(dest ...any) error {
+	if r.err != nil {
+		return r.err
+	}
+	return scanFake(r.values, dest)
+}
+
+type fakeRows struct {
+	rows  [][]any
+	index int
+}
+
+func (r *fakeRows) Close()                                       {}
+func (r *fakeRows) Err() error                                   { return nil }
+func (r *fakeRows) CommandTag() pgconn.CommandTag                { return pgconn.NewCommandTag("SELECT") }
+func (r *fakeRows) FieldDescriptions() []pgconn.FieldDescription { return nil }
+func (r *fakeRows) Values() ([]any, error)                       { return r.rows[r.index], nil }
+func (r *fakeRows) RawValues() [][]byte                          { return nil }
+func (r *fakeRows) Conn() *pgx.Conn                              { return nil }
+func (r *fakeRows) Scan(dest ...any) error                       { return scanFake(r.rows[r.index], dest) }
+
+func (r *fakeRows) Next() bool {
+	r.index++
+	return r.index < len(r.rows)
+}
+
+// fakeTx runs the in progress block's queries against the same fakeDB, see Server.getDb
+type fakeTx struct {
+	pgx.Tx
+	db *fakeDB
+}
+
+func (t fakeTx) Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error) {
+	return t.db.Exec(ctx, sql, args...)
+}
+
+func (t fakeTx) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	return t.db.Query(ctx, sql, args...)
+}
+
+func (t fakeTx) QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row {
+	return t.db.QueryRow(ctx, sql, args...)
+}
@@ -132,7 +132,7 @@ func (s *Server) finalizeFileUpload(ctx context.Context, tx *v1.SignedTransactio
 
 	qtx := s.getDb()
 
-	err := qtx.InsertFileUpload(ctx, db.InsertFileUploadParams{
+	uploadParams := db.InsertFileUploadParams{
 		UploaderAddress:    fu.UploaderAddress,
 		Cid:                fu.Cid,
 		TranscodedCid:      fu.TranscodedCid,
@@ -142,10 +142,14 @@ func (s *Server) finalizeFileUpload(ctx context.Context, tx *v1.SignedTransactio
 		ValidatorSignature: fu.ValidatorSignature,
 		TxHash:             txHash,
 		BlockHeight:        blockHeight,
-	})
+	}
+	err := qtx.InsertFileUpload(ctx, uploadParams)
 	if err != nil {
 		return nil, fmt.Errorf("could not store file upload tx: %v", err)
 	}
+	s.stateInsert(uploadStateRow(uploadParams))
+	s.stateTouch("upload", uploadParams.Cid)
+	s.stateTouch("upload", uploadParams.TranscodedCid)
 
 	return nil, nil
 }
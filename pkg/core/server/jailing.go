@@ -0,0 +1,340 @@
+package server
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"connectrpc.com/connect"
+	v1 "github.com/AudiusProject/audiusd/pkg/api/core/v1"
+	"github.com/AudiusProject/audiusd/pkg/common"
+	"github.com/AudiusProject/audiusd/pkg/core/db"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+// Jailing replaces deregistration for validators that stop proposing or misbehave.
+// A jailed validator keeps its registration but has no voting power, once it has sat
+// out validatorJailCooldownRollups sla rollups it can send an UnjailValidator
+// attestation to get its power back without registering again.
+
+const (
+	// sla rollups a jailed validator sits out before it may unjail
+	validatorJailCooldownRollups int64 = 4
+
+	maxUnjailAttestationValidity = 24 * 60 * 60 // Unjail attestations are only valid for approx 24 hours
+)
+
+func (s *Server) validatorJailingActive(height int64) bool {
+	activation := s.config.ValidatorJailingHeight
+	return activation > 0 && height >= activation
+}
+
+// jailValidator takes the voting power of a registered validator, returns nil if
+// the validator is not registered or already jailed
+func (s *Server) jailValidator(ctx context.Context, cometAddress string, timestamp time.Time, height int64) (*abcitypes.ValidatorUpdate, error) {
+	qtx := s.getDb()
+	jailed, err := qtx.JailValidator(ctx, db.JailValidatorParams{
+		CometAddress:  cometAddress,
+		JailedAtBlock: height,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not jail validator %s: %v", cometAddress, err)
+	}
+	if len(jailed) == 0 {
+		return nil, nil
+	}
+	node := jailed[0]
+
+	pubKey, err := base64.StdEncoding.DecodeString(node.CometPubKey)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode public key '%s' as base64 encoded string: %v", node.CometPubKey, err)
+	}
+	s.stateInsert(validatorJailStateRow(node))
+
+	if err := s.appendJailEventToValidatorHistory(ctx, node, db.ValidatorEventJailed, timestamp, height); err != nil {
+		// do not halt on validator history
+		s.logger.Error("failed to append jail event to validator history", zap.Error(err))
+	}
+	s.logger.Info("jailed validator", zap.String("address", node.CometAddress), zap.String("endpoint", node.Endpoint), zap.Int64("height", height))
+
+	return &abcitypes.ValidatorUpdate{
+		Power:       int64(0),
+		PubKeyBytes: pubKey,
+		PubKeyType:  "ed25519",
+	}, nil
+}
+
+// jailUnderperformingValidator runs after an sla rollup is finalized and jails the first
+// validator, by comet address, that proposed nothing over the last rollups
+func (s *Server) jailUnderperformingValidator(ctx context.Context, timestamp time.Time, height int64) (*abcitypes.ValidatorUpdate, error) {
+	qtx := s.getDb()
+	unjailed, err := qtx.TotalUnjailedValidators(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get total validators: %v", err)
+	}
+
+	// killswitch to avoid jailing too many validators
+	if unjailed <= validatorPurgeMinValidators {
+		return nil, nil
+	}
+
+	validators, err := qtx.GetAllRegisteredNodesSorted(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get validators: %v", err)
+	}
+	for _, validator := range validators {
+		if validator.Jailed {
+			continue
+		}
+		rollups, err := qtx.GetRollupsForNodeSince(ctx, db.GetRollupsForNodeSinceParams{
+			Limit:      validatorPurgeSLAInterval,
+			Address:    validator.CometAddress,
+			BlockStart: validator.UnjailedAtBlock,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("could not get recent rollups for node %s: %v", validator.CometAddress, err)
+		}
+		if isUnderperforming(rollups) {
+			// one per rollup, the next underperformer waits for the next rollup
+			return s.jailValidator(ctx, validator.CometAddress, timestamp, height)
+		}
+	}
+	return nil, nil
+}
+
+// isUnderperforming reports whether a validator was in every one of a full interval of
+// rollups, counted from when it was last unjailed, and proposed no blocks in any of them
+func isUnderperforming(rollups []db.GetRollupsForNodeSinceRow) bool {
+	if len(rollups) < int(validatorPurgeSLAInterval) {
+		return false
+	}
+	for _, rollup := range rollups {
+		if !rollup.Address.Valid || rollup.BlocksProposed.Int32 > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func unjailCooldownElapsed(ctx context.Context, q *db.Queries, node db.CoreValidator) (bool, error) {
+	rollups, err := q.CountSlaRollupsSince(ctx, node.JailedAtBlock)
+	if err != nil {
+		return false, fmt.Errorf("could not count rollups since validator %s was jailed: %v", node.CometAddress, err)
+	}
+	return rollups >= validatorJailCooldownRollups, nil
+}
+
+func (s *Server) isValidUnjailValidatorAttestation(ctx context.Context, tx *v1.SignedTransaction, signers []string, blockHeight int64) error {
+	unjail := tx.GetAttestation().GetUnjailValidator()
+	if unjail == nil {
+		return fmt.Errorf("unknown attestation fell into isValidUnjailValidatorAttestation: %v", tx)
+	}
+
+	addr := unjail.GetCometAddress()
+	if len(unjail.GetPubKey()) != ed25519.PubKeySize {
+		return fmt.Errorf("incorrect pubkey size %d, must by %d", len(unjail.GetPubKey()), ed25519.PubKeySize)
+	}
+	pubKey := ed25519.PubKey(unjail.GetPubKey())
+	if pubKey.Address().String() != addr {
+		return fmt.Errorf("address does not match public key: %s %s", pubKey.Address(), addr)
+	}
+
+	// validate age of request
+	if unjail.Deadline < blockHeight || unjail.Deadline > blockHeight+maxUnjailAttestationValidity {
+		return fmt.Errorf("unjail request for '%s' with deadline %d is too new/old (current height is %d)", addr, unjail.Deadline, blockHeight)
+	}
+
+	node, err := s.db.GetRegisteredNodeByCometAddress(ctx, addr)
+	if err != nil {
+		return fmt.Errorf("could not find validator '%s' to unjail: %v", addr, err)
+	}
+	if !node.Jailed {
+		return fmt.Errorf("validator '%s' is not jailed", addr)
+	}
+	if base64.StdEncoding.EncodeToString(pubKey.Bytes()) != node.CometPubKey {
+		return fmt.Errorf("public key does not match registered validator '%s'", addr)
+	}
+
+	// only the validator itself may ask to be unjailed
+	attBytes, err := proto.Marshal(tx.GetAttestation())
+	if err != nil {
+		return fmt.Errorf("could not marshal unjail tx: %v", err)
+	}
+	_, address, err := common.EthRecover(tx.GetSignature(), attBytes)
+	if err != nil {
+		return fmt.Errorf("could not recover msg sig: %v", err)
+	}
+	if address != node.EthAddress {
+		return fmt.Errorf("signature address '%s' does not match validator '%s'", address, node.EthAddress)
+	}
+
+	elapsed, err := unjailCooldownElapsed(ctx, s.db, node)
+	if err != nil {
+		return err
+	} else if !elapsed {
+		return fmt.Errorf("validator '%s' jailed at %d has not served its cooldown", addr, node.JailedAtBlock)
+	}
+
+	// validate signers
+	enough, err := s.attestationHasEnoughSigners(ctx, signers, pubKey.Bytes(), s.config.AttRegistrationRSize, s.config.AttRegistrationMin, node.EthAddress)
+	if err != nil {
+		return fmt.Errorf("error checking attestors against validators: %v", err)
+	} else if !enough {
+		return fmt.Errorf("not enough attestations provided to unjail validator '%s'", addr)
+	}
+
+	return nil
+}
+
+func (s *Server) finalizeUnjailValidatorAttestation(ctx context.Context, tx *v1.SignedTransaction, blockHeight int64) error {
+	unjail := tx.GetAttestation().GetUnjailValidator()
+	if unjail == nil {
+		return fmt.Errorf("unknown attestation fell into finalizeUnjailValidatorAttestation: %v", tx)
+	}
+	qtx := s.getDb()
+	unjailed, err := qtx.UnjailValidator(ctx, db.UnjailValidatorParams{
+		CometAddress:    unjail.GetCometAddress(),
+		UnjailedAtBlock: blockHeight,
+	})
+	if err != nil {
+		return fmt.Errorf("error unjailing validator: %v", err)
+	}
+	for _, node := range unjailed {
+		s.stateDelete(validatorJailStateRow(node))
+	}
+	return nil
+}
+
+func (s *Server) appendJailEventToValidatorHistory(ctx context.Context, node db.CoreValidator, event db.ValidatorEventType, timestamp time.Time, block int64) error {
+	spId64, err := strconv.ParseInt(node.SpID, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	qtx := s.getDb()
+	return qtx.AppendValidatorHistory(ctx, db.AppendValidatorHistoryParams{
+		Endpoint:     node.Endpoint,
+		EthAddress:   node.EthAddress,
+		CometAddress: node.CometAddress,
+		SpID:         spId64,
+		ServiceType:  node.NodeType,
+		EventType:    event,
+		EventTime:    qtx.ToPgxTimestamp(timestamp),
+		EventBlock:   block,
+	})
+}
+
+// startValidatorUnjailer watches for this node being jailed and unjails it as soon
+// as its cooldown has passed, e.g. after being down for an upgrade
+func (s *Server) startValidatorUnjailer(ctx context.Context) error {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if !s.validatorJailingActive(s.cache.currentHeight.Load()) || s.cache.catchingUp.Load() {
+				continue
+			}
+			node, err := s.db.GetRegisteredNodeByCometAddress(ctx, s.config.ProposerAddress)
+			if errors.Is(err, pgx.ErrNoRows) {
+				continue
+			} else if err != nil {
+				s.logger.Error("could not check if validator is jailed", zap.Error(err))
+				continue
+			}
+			if !node.Jailed {
+				continue
+			}
+			if elapsed, err := unjailCooldownElapsed(ctx, s.db, node); err != nil {
+				s.logger.Error("could not check jail cooldown", zap.Error(err))
+				continue
+			} else if !elapsed {
+				continue
+			}
+			if err := s.unjailSelf(ctx, node); err != nil {
+				s.logger.Error("could not unjail validator", zap.Error(err))
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (s *Server) unjailSelf(ctx context.Context, node db.CoreValidator) error {
+	addrs, err := s.db.GetAllEthAddressesOfRegisteredNodes(ctx)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("failed to get currently registered nodes: %v", err)
+	}
+
+	// a jailed validator can't attest for itself
+	filteredAddrs := make([]string, 0, len(addrs))
+	for _, addr := range addrs {
+		if addr != node.EthAddress {
+			filteredAddrs = append(filteredAddrs, addr)
+		}
+	}
+
+	pubKey := s.config.CometKey.PubKey().Bytes()
+	rendezvous := common.GetAttestorRendezvous(filteredAddrs, pubKey, s.config.AttRegistrationRSize)
+	attestations := make([]string, 0, s.config.AttRegistrationRSize)
+	unjail := &v1.UnjailValidator{
+		CometAddress: node.CometAddress,
+		PubKey:       pubKey,
+		Deadline:     s.cache.currentHeight.Load() + 120,
+	}
+
+	peers := s.connectRPCPeers.ToMap()
+	for addr := range rendezvous {
+		peer, ok := peers[addr]
+		if !ok {
+			continue
+		}
+		resp, err := peer.GetUnjailAttestation(ctx, connect.NewRequest(&v1.GetUnjailAttestationRequest{
+			Unjail: proto.Clone(unjail).(*v1.UnjailValidator),
+		}))
+		if err != nil {
+			s.logger.Error("failed to get unjail attestation from peer", zap.String("peer_address", addr), zap.Error(err))
+			continue
+		}
+		attestations = append(attestations, resp.Msg.Signature)
+	}
+
+	unjailAtt := &v1.Attestation{
+		Signatures: attestations,
+		Body:       &v1.Attestation_UnjailValidator{UnjailValidator: unjail},
+	}
+
+	txBytes, err := proto.Marshal(unjailAtt)
+	if err != nil {
+		return fmt.Errorf("failure to marshal unjail tx: %v", err)
+	}
+
+	sig, err := common.EthSign(s.config.EthereumKey, txBytes)
+	if err != nil {
+		return fmt.Errorf("could not sign unjail tx: %v", err)
+	}
+
+	tx := &v1.SignedTransaction{
+		Signature: sig,
+		RequestId: uuid.NewString(),
+		Transaction: &v1.SignedTransaction_Attestation{
+			Attestation: unjailAtt,
+		},
+	}
+
+	txhash, err := s.self.SendTransaction(ctx, connect.NewRequest(&v1.SendTransactionRequest{Transaction: tx}))
+	if err != nil {
+		return fmt.Errorf("send unjail tx failed: %v", err)
+	}
+
+	s.logger.Info("unjailed validator", zap.String("endpoint", s.config.NodeEndpoint), zap.String("receipt", txhash.Msg.Transaction.Hash))
+	return nil
+}
@@ -0,0 +1,64 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/AudiusProject/audiusd/pkg/api/core/v1"
+	"github.com/AudiusProject/audiusd/pkg/core/db"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsUnderperforming(t *testing.T) {
+	rollups := func(proposed ...int32) []db.GetRollupsForNodeSinceRow {
+		rows := make([]db.GetRollupsForNodeSinceRow, len(proposed))
+		for i, p := range proposed {
+			rows[i] = db.GetRollupsForNodeSinceRow{
+				Address:        pgtype.Text{String: "ABCD", Valid: p >= 0},
+				BlocksProposed: pgtype.Int4{Int32: max(p, 0), Valid: p >= 0},
+			}
+		}
+		return rows
+	}
+
+	require.True(t, isUnderperforming(rollups(0, 0, 0, 0, 0, 0, 0, 0)))
+	require.False(t, isUnderperforming(rollups(0, 0, 0, 0, 0, 0, 0, 1)))
+	// not enough rollups since it was last unjailed
+	require.False(t, isUnderperforming(rollups(0, 0, 0)))
+	// registered partway through the interval
+	require.False(t, isUnderperforming(rollups(-1, -1, 0, 0, 0, 0, 0, 0)))
+}
+
+func TestUnjailValidatorAttestationRejects(t *testing.T) {
+	s := &Server{}
+	pubKey := ed25519.GenPrivKey().PubKey()
+	unjailTx := func(unjail *v1.UnjailValidator) *v1.SignedTransaction {
+		return &v1.SignedTransaction{Transaction: &v1.SignedTransaction_Attestation{Attestation: &v1.Attestation{
+			Body: &v1.Attestation_UnjailValidator{UnjailValidator: unjail},
+		}}}
+	}
+
+	// every rejection here happens before the validator is looked up
+	require.Error(t, s.isValidUnjailValidatorAttestation(context.Background(), unjailTx(&v1.UnjailValidator{
+		CometAddress: pubKey.Address().String(),
+		PubKey:       []byte{1, 2, 3},
+		Deadline:     20,
+	}), nil, 10))
+	require.Error(t, s.isValidUnjailValidatorAttestation(context.Background(), unjailTx(&v1.UnjailValidator{
+		CometAddress: "ABCD",
+		PubKey:       pubKey.Bytes(),
+		Deadline:     20,
+	}), nil, 10))
+	require.Error(t, s.isValidUnjailValidatorAttestation(context.Background(), unjailTx(&v1.UnjailValidator{
+		CometAddress: pubKey.Address().String(),
+		PubKey:       pubKey.Bytes(),
+		Deadline:     5,
+	}), nil, 10))
+	require.Error(t, s.isValidUnjailValidatorAttestation(context.Background(), unjailTx(&v1.UnjailValidator{
+		CometAddress: pubKey.Address().String(),
+		PubKey:       pubKey.Bytes(),
+		Deadline:     10 + maxUnjailAttestationValidity + 1,
+	}), nil, 10))
+}
@@ -4,26 +4,82 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	v1 "github.com/AudiusProject/audiusd/pkg/api/core/v1"
+	"github.com/AudiusProject/audiusd/pkg/core/db"
 	"google.golang.org/protobuf/proto"
 )
 
-func (s *Server) validateManageEntity(_ context.Context, stx *v1.SignedTransaction) (proto.Message, error) {
+var (
+	ErrManageEntityInvalidSignature = errors.New("manage entity signature could not be recovered")
+	ErrManageEntitySignerMismatch   = errors.New("manage entity signer does not match its signature")
+	ErrManageEntityNonceUsed        = errors.New("manage entity nonce was already used by its signer")
+)
+
+func (s *Server) manageEntityValidationActive(height int64) bool {
+	activation := s.config.ManageEntityValidationHeight
+	return activation > 0 && height >= activation
+}
+
+// validateManageEntity recovers the EIP-712 signer of a manage entity, checks it matches the
+// signer the tx claims and that the signer hasn't used the nonce before
+func (s *Server) validateManageEntity(ctx context.Context, q *db.Queries, stx *v1.SignedTransaction, blockHeight int64) (proto.Message, error) {
 	manageEntity := stx.GetManageEntity()
 	if manageEntity == nil {
 		return nil, errors.New("not manage entity")
 	}
+
+	if !s.manageEntityValidationActive(blockHeight) {
+		return manageEntity, nil
+	}
+
+	signer, _, err := RecoverPubkeyFromCoreTx(s.config, manageEntity)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrManageEntityInvalidSignature, err)
+	}
+	if !strings.EqualFold(signer, manageEntity.Signer) {
+		return nil, fmt.Errorf("%w: signed by %s, signer %s", ErrManageEntitySignerMismatch, signer, manageEntity.Signer)
+	}
+
+	used, err := q.IsManageEntityNonceUsed(ctx, db.IsManageEntityNonceUsedParams{
+		Signer: strings.ToLower(signer),
+		Nonce:  strings.ToLower(manageEntity.Nonce),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not check manage entity nonce: %v", err)
+	}
+	if used {
+		return nil, fmt.Errorf("%w: %s", ErrManageEntityNonceUsed, manageEntity.Nonce)
+	}
+
 	return manageEntity, nil
 }
 
-func (s *Server) finalizeManageEntity(ctx context.Context, stx *v1.SignedTransaction) (proto.Message, error) {
-	tx, err := s.validateManageEntity(ctx, stx)
+func (s *Server) finalizeManageEntity(ctx context.Context, stx *v1.SignedTransaction, txhash string, blockHeight int64) (proto.Message, error) {
+	// reads the in progress block so a replay within the same block is caught too
+	qtx := s.getDb()
+	tx, err := s.validateManageEntity(ctx, qtx, stx, blockHeight)
 	if err != nil {
-		return nil, fmt.Errorf("invalid manage entity: %v", err)
+		return nil, fmt.Errorf("invalid manage entity: %w", err)
 	}
 
 	manageEntity := tx.(*v1.ManageEntityLegacy)
 
+	if !s.manageEntityValidationActive(blockHeight) {
+		return manageEntity, nil
+	}
+
+	params := db.InsertManageEntityNonceParams{
+		Signer:      strings.ToLower(manageEntity.Signer),
+		Nonce:       strings.ToLower(manageEntity.Nonce),
+		TxHash:      txhash,
+		BlockHeight: blockHeight,
+	}
+	if err := qtx.InsertManageEntityNonce(ctx, params); err != nil {
+		return nil, fmt.Errorf("could not record manage entity nonce: %v", err)
+	}
+	s.stateInsert(manageEntityNonceStateRow(params))
+
 	return manageEntity, nil
 }
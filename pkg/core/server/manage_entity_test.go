@@ -0,0 +1,123 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v1 "github.com/AudiusProject/audiusd/pkg/api/core/v1"
+	"github.com/AudiusProject/audiusd/pkg/core/config"
+	"github.com/AudiusProject/audiusd/pkg/core/db"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestValidateManageEntityRejects(t *testing.T) {
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	cfg := &config.Config{
+		AcdcEntityManagerAddress:     config.DevAcdcAddress,
+		AcdcChainID:                  config.DevAcdcChainID,
+		ManageEntityValidationHeight: 10,
+	}
+	s := &Server{config: cfg}
+
+	signed := func(signer string) *v1.SignedTransaction {
+		em := &v1.ManageEntityLegacy{
+			UserId:     1,
+			EntityType: "Track",
+			EntityId:   2,
+			Action:     "Create",
+			Metadata:   "{}",
+			Nonce:      "0x0000000000000000000000000000000000000000000000000000000000000001",
+		}
+		require.NoError(t, SignManageEntity(cfg, em, privateKey))
+		em.Signer = signer
+		return &v1.SignedTransaction{Transaction: &v1.SignedTransaction_ManageEntity{ManageEntity: em}}
+	}
+
+	// before the activation height the signer is taken as is
+	_, err = s.validateManageEntity(context.Background(), nil, signed("0x123"), 9)
+	require.NoError(t, err)
+
+	_, err = s.validateManageEntity(context.Background(), nil, signed("0x123"), 10)
+	require.ErrorIs(t, err, ErrManageEntitySignerMismatch)
+
+	forged := signed(crypto.PubkeyToAddress(privateKey.PublicKey).Hex())
+	forged.GetManageEntity().Metadata = `{"forged":true}`
+	_, err = s.validateManageEntity(context.Background(), nil, forged, 10)
+	require.ErrorIs(t, err, ErrManageEntitySignerMismatch)
+
+	garbage := signed("0x123")
+	garbage.GetManageEntity().Signature = "0x1234"
+	_, err = s.validateManageEntity(context.Background(), nil, garbage, 10)
+	require.ErrorIs(t, err, ErrManageEntityInvalidSignature)
+}
+
+func TestManageEntityNonceReplay(t *testing.T) {
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	cfg := &config.Config{
+		AcdcEntityManagerAddress:     config.DevAcdcAddress,
+		AcdcChainID:                  config.DevAcdcChainID,
+		ManageEntityValidationHeight: 10,
+	}
+
+	used := map[string]bool{}
+	fake := newFakeDB().
+		on("InsertManageEntityNonce", func(args ...any) ([][]any, error) {
+			used[args[0].(string)+":"+args[1].(string)] = true
+			return nil, nil
+		}).
+		on("IsManageEntityNonceUsed", func(args ...any) ([][]any, error) {
+			return [][]any{{used[args[0].(string)+":"+args[1].(string)]}}, nil
+		})
+
+	s := &Server{
+		config:    cfg,
+		logger:    zap.NewNop(),
+		db:        db.New(fake),
+		cache:     &Cache{},
+		abciState: &ABCIState{onGoingBlock: fakeTx{db: fake}},
+	}
+	s.cache.currentHeight.Store(10)
+
+	em := &v1.ManageEntityLegacy{
+		UserId:     1,
+		EntityType: "Track",
+		EntityId:   2,
+		Action:     "Create",
+		Metadata:   "{}",
+		Nonce:      "0x0000000000000000000000000000000000000000000000000000000000000001",
+		Signer:     crypto.PubkeyToAddress(privateKey.PublicKey).Hex(),
+	}
+	require.NoError(t, SignManageEntity(cfg, em, privateKey))
+	stx := &v1.SignedTransaction{Transaction: &v1.SignedTransaction_ManageEntity{ManageEntity: em}}
+	raw, err := proto.Marshal(stx)
+	require.NoError(t, err)
+
+	check, err := s.CheckTx(context.Background(), &abcitypes.CheckTxRequest{Tx: raw})
+	require.NoError(t, err)
+	require.Equal(t, abcitypes.CodeTypeOK, check.Code)
+
+	_, err = s.finalizeManageEntity(context.Background(), stx, "0xtx", 10)
+	require.NoError(t, err)
+
+	// once finalized the same signer and nonce can't get into the mempool or a block again
+	check, err = s.CheckTx(context.Background(), &abcitypes.CheckTxRequest{Tx: raw})
+	require.NoError(t, err)
+	require.NotEqual(t, abcitypes.CodeTypeOK, check.Code)
+	require.Contains(t, check.Log, ErrManageEntityNonceUsed.Error())
+
+	valid, err := s.validateBlockTx(context.Background(), time.Now(), 11, nil, raw)
+	require.NoError(t, err)
+	require.False(t, valid)
+
+	_, err = s.finalizeManageEntity(context.Background(), stx, "0xreplay", 11)
+	require.ErrorIs(t, err, ErrManageEntityNonceUsed)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 
 	"github.com/AudiusProject/audiusd/pkg/api/core/v1beta1"
 	ddexv1beta1 "github.com/AudiusProject/audiusd/pkg/api/ddex/v1beta1"
@@ -45,8 +46,10 @@ func (s *Server) finalizeMEAD(ctx context.Context, req *abcitypes.FinalizeBlockR
 	sender := tx.Envelope.Header.From
 
 	// MEAD has no control type, always create a new MEAD
-	if err := s.validateMEADNewMessage(ctx, mead); err != nil {
-		return errors.Join(ErrMEADMessageValidation, err)
+	if s.enrichmentBindingActive(req.Height) {
+		if err := s.validateMEADNewMessage(ctx, s.getDb(), sender, mead); err != nil {
+			return errors.Join(ErrMEADMessageValidation, err)
+		}
 	}
 	if err := s.finalizeMEADNewMessage(ctx, req, txhash, messageIndex, mead, sender); err != nil {
 		return errors.Join(ErrMEADMessageFinalization, err)
@@ -56,9 +59,50 @@ func (s *Server) finalizeMEAD(ctx context.Context, req *abcitypes.FinalizeBlockR
 
 /** MEAD New Message */
 
-// Validate a MEAD message that's expected to be a NEW_MESSAGE, expects that the transaction header is valid
-func (s *Server) validateMEADNewMessage(_ context.Context, mead *ddexv1beta1.MeadMessage) error {
-	// TODO: add validation for conflicts and duplicates
+// meadAddresses lists the distinct resource and release addresses a MEAD message enriches
+func meadAddresses(mead *ddexv1beta1.MeadMessage) (resources []string, releases []string, err error) {
+	for i, info := range mead.GetResourceInformationList().GetResourceInformation() {
+		address := resourceOAPAddress(info.GetResourceSummary().GetResourceId())
+		if address == "" {
+			return nil, nil, fmt.Errorf("%w: resource information %d", ErrEnrichmentAddressMissing, i)
+		}
+		if !slices.Contains(resources, address) {
+			resources = append(resources, address)
+		}
+	}
+	for i, info := range mead.GetReleaseInformationList().GetReleaseInformation() {
+		address := releaseOAPAddress(info.GetReleaseSummary().GetReleaseId())
+		if address == "" {
+			return nil, nil, fmt.Errorf("%w: release information %d", ErrEnrichmentAddressMissing, i)
+		}
+		if !slices.Contains(releases, address) {
+			releases = append(releases, address)
+		}
+	}
+	return resources, releases, nil
+}
+
+// Validate a MEAD message, every resource and release it describes must exist and be one
+// the sender may enrich, see enrichment.go.
+func (s *Server) validateMEADNewMessage(ctx context.Context, q *db.Queries, from string, mead *ddexv1beta1.MeadMessage) error {
+	resources, releases, err := meadAddresses(mead)
+	if err != nil {
+		return err
+	}
+	if len(resources) == 0 && len(releases) == 0 {
+		return ErrMEADResourceAndReleaseAddressesEmpty
+	}
+
+	for _, address := range resources {
+		if err := checkEnrichmentTarget(ctx, q, address, "resource", from); err != nil {
+			return err
+		}
+	}
+	for _, address := range releases {
+		if err := checkEnrichmentTarget(ctx, q, address, "release", from); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -70,6 +114,15 @@ func (s *Server) finalizeMEADNewMessage(ctx context.Context, req *abcitypes.Fina
 	// the MEAD address is the location of the message on the chain
 	meadAddress := common.CreateAddress(txhashBytes, s.config.GenesisFile.ChainID, req.Height, messageIndex, "")
 
+	// enrichments from before binding was enforced aren't bound to any entity
+	resourceAddresses, releaseAddresses := []string{}, []string{}
+	if s.enrichmentBindingActive(req.Height) {
+		resourceAddresses, releaseAddresses, err = meadAddresses(mead)
+		if err != nil {
+			return err
+		}
+	}
+
 	rawMessage, err := proto.Marshal(mead)
 	if err != nil {
 		return fmt.Errorf("failed to marshal MEAD message: %w", err)
@@ -86,17 +139,21 @@ func (s *Server) finalizeMEADNewMessage(ctx context.Context, req *abcitypes.Fina
 	}
 
 	qtx := s.getDb()
-	if err := qtx.InsertCoreMEAD(ctx, db.InsertCoreMEADParams{
+	meadParams := db.InsertCoreMEADParams{
 		TxHash:            txhash,
 		Index:             messageIndex,
 		Address:           meadAddress,
 		Sender:            sender,
+		ResourceAddresses: resourceAddresses,
+		ReleaseAddresses:  releaseAddresses,
 		RawMessage:        rawMessage,
 		RawAcknowledgment: rawAcknowledgment,
 		BlockHeight:       req.Height,
-	}); err != nil {
+	}
+	if err := qtx.InsertCoreMEAD(ctx, meadParams); err != nil {
 		return fmt.Errorf("failed to insert MEAD: %w", err)
 	}
+	s.stateInsert(meadStateRow(meadParams))
 
 	return nil
 }
@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -15,6 +16,7 @@ import (
 	v1 "github.com/AudiusProject/audiusd/pkg/api/core/v1"
 	corev1connect "github.com/AudiusProject/audiusd/pkg/api/core/v1/v1connect"
 	"github.com/AudiusProject/audiusd/pkg/api/core/v1beta1"
+	"github.com/AudiusProject/audiusd/pkg/common"
 	"github.com/AudiusProject/audiusd/pkg/core/config"
 	"github.com/AudiusProject/audiusd/pkg/core/db"
 	"github.com/labstack/echo/v4"
@@ -24,20 +26,44 @@ import (
 )
 
 var (
-	ErrFullMempool = errors.New("mempool full")
+	ErrFullMempool        = errors.New("mempool full")
+	ErrMempoolSenderLimit = errors.New("mempool sender limit reached")
+)
+
+// transactions are proposed from the highest priority class down, within a class in the order they arrived
+type mempoolPriority int
+
+const (
+	mempoolPriorityLow mempoolPriority = iota
+	mempoolPriorityNormal
+	mempoolPriorityHigh
+	numMempoolPriorities
 )
 
 type Mempool struct {
 	logger *zap.Logger
 	config *config.Config
 
-	deque *list.List
-	txMap map[string]*list.Element
-	mutex sync.Mutex
+	// one queue per priority class, indexed by mempoolPriority
+	deques       [numMempoolPriorities]*list.List
+	txMap        map[string]*list.Element
+	senderCounts map[string]int
+	mutex        sync.Mutex
 
 	db *db.Queries
 
-	maxMempoolTransactions int
+	maxMempoolTransactions   int
+	maxTransactionsPerSender int
+
+	stats MempoolStats
+}
+
+// counters of transactions that left the mempool without making it into a block
+type MempoolStats struct {
+	Evicted             int64
+	RejectedFull        int64
+	RejectedSenderLimit int64
+	Expired             int64
 }
 
 // signed tx with mempool related metadata
@@ -46,17 +72,26 @@ type MempoolTransaction struct {
 	Deadline int64
 	Tx       *v1.SignedTransaction
 	Txv2     *v1beta1.Transaction
+
+	key      string
+	sender   string
+	priority mempoolPriority
 }
 
 func NewMempool(logger *zap.Logger, config *config.Config, db *db.Queries, maxTransactions int) *Mempool {
-	return &Mempool{
-		logger:                 logger.With(zap.String("service", "mempool")),
-		config:                 config,
-		deque:                  list.New(),
-		txMap:                  make(map[string]*list.Element),
-		db:                     db,
-		maxMempoolTransactions: maxTransactions,
+	m := &Mempool{
+		logger:                   logger.With(zap.String("service", "mempool")),
+		config:                   config,
+		txMap:                    make(map[string]*list.Element),
+		senderCounts:             make(map[string]int),
+		db:                       db,
+		maxMempoolTransactions:   maxTransactions,
+		maxTransactionsPerSender: config.MempoolMaxTxsPerSender,
+	}
+	for i := range m.deques {
+		m.deques[i] = list.New()
 	}
+	return m
 }
 
 // gathers a batch of transactions skipping those that have expired
@@ -67,18 +102,20 @@ func (m *Mempool) GetBatch(batchSize int, currentBlock int64) []*MempoolTransact
 	batch := []*MempoolTransaction{}
 	count := 0
 
-	for e := m.deque.Front(); e != nil && count < batchSize; e = e.Next() {
-		tx, ok := e.Value.(*MempoolTransaction)
-		if !ok {
-			continue
-		}
+	for priority := numMempoolPriorities - 1; priority >= mempoolPriorityLow; priority-- {
+		for e := m.deques[priority].Front(); e != nil && count < batchSize; e = e.Next() {
+			tx, ok := e.Value.(*MempoolTransaction)
+			if !ok {
+				continue
+			}
 
-		if tx.Deadline <= currentBlock {
-			continue
-		}
+			if tx.Deadline <= currentBlock {
+				continue
+			}
 
-		batch = append(batch, tx)
-		count++
+			batch = append(batch, tx)
+			count++
+		}
 	}
 
 	return batch
@@ -90,17 +127,14 @@ func (m *Mempool) GetAll() []*MempoolTransaction {
 
 	batch := []*MempoolTransaction{}
 
-	for {
-		e := m.deque.Front()
-		if e.Next() != nil {
+	for priority := numMempoolPriorities - 1; priority >= mempoolPriorityLow; priority-- {
+		for e := m.deques[priority].Front(); e != nil; e = e.Next() {
 			tx, ok := e.Value.(*MempoolTransaction)
 			if !ok {
 				continue
 			}
 			batch = append(batch, tx)
-			continue
 		}
-		break
 	}
 
 	return batch
@@ -112,8 +146,7 @@ func (m *Mempool) RemoveBatch(ids []string) {
 
 	for _, id := range ids {
 		if element, exists := m.txMap[id]; exists {
-			m.deque.Remove(element)
-			delete(m.txMap, id)
+			m.remove(element)
 			m.logger.Info("removed from mempools", zap.String("tx", id))
 		}
 	}
@@ -123,52 +156,184 @@ func (m *Mempool) RemoveExpiredTransactions(blockNum int64) {
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
 
-	for id, element := range m.txMap {
-		mptx, ok := element.Value.(*MempoolTransaction)
-		if !ok {
-			continue
-		}
-		deadline := mptx.Deadline
-		if deadline <= blockNum {
-			m.deque.Remove(element)
-			delete(m.txMap, id)
-		}
-	}
+	m.removeExpired(blockNum)
 }
 
 func (m *Mempool) MempoolSize() (int, int) {
-	return len(m.txMap), m.deque.Len()
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	dequeLen := 0
+	for _, deque := range m.deques {
+		dequeLen += deque.Len()
+	}
+	return len(m.txMap), dequeLen
 }
 
-func (s *Server) addMempoolTransaction(key string, tx *MempoolTransaction, broadcast bool) error {
-	// TODO: check db if tx already exists
+// Stats returns the drop counters and the number of transactions queued per priority class
+func (m *Mempool) Stats() (MempoolStats, [numMempoolPriorities]int) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
 
-	// broadcast to peers before adding to our own mempool
-	if broadcast {
-		go s.broadcastMempoolTransaction(key, tx)
+	var counts [numMempoolPriorities]int
+	for i, deque := range m.deques {
+		counts[i] = deque.Len()
 	}
+	return m.stats, counts
+}
+
+// add queues a transaction. When the mempool is full expired transactions are dropped first,
+// then the transaction closest to its deadline in the lowest class at or below the new one's
+// priority makes room. Within the same class only a transaction with an earlier deadline is evicted.
+func (m *Mempool) add(key string, tx *MempoolTransaction, currentHeight int64) error {
+	// signature recovery is slow, keep it out of the lock
+	tx.key = key
+	tx.priority = mempoolTxPriority(tx)
+	tx.sender = mempoolTxSender(tx)
 
-	m := s.mempl
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
 
+	if _, exists := m.txMap[key]; exists {
+		m.logger.Warn("duplicate tx tried to add to mempool", zap.String("tx", key))
+		return nil
+	}
+
+	// validator and reward traffic is never held back by a sender's quota
+	if tx.sender != "" && tx.priority < mempoolPriorityHigh && m.maxTransactionsPerSender > 0 {
+		if m.senderCounts[tx.sender] >= m.maxTransactionsPerSender {
+			m.stats.RejectedSenderLimit++
+			return ErrMempoolSenderLimit
+		}
+	}
+
 	if len(m.txMap) >= m.maxMempoolTransactions {
-		return ErrFullMempool
+		m.removeExpired(currentHeight)
 	}
 
-	_, exists := m.txMap[key]
-	if exists {
-		m.logger.Warn("duplicate tx tried to add to mempool", zap.String("tx", key))
-		return nil
+	if len(m.txMap) >= m.maxMempoolTransactions {
+		victim := m.evictionCandidate(tx)
+		if victim == nil {
+			m.stats.RejectedFull++
+			return ErrFullMempool
+		}
+		evicted := victim.Value.(*MempoolTransaction)
+		m.remove(victim)
+		m.stats.Evicted++
+		m.logger.Info("evicted from mempool", zap.String("tx", evicted.key), zap.String("for", key))
 	}
 
-	element := m.deque.PushBack(tx)
-	m.txMap[key] = element
+	m.txMap[key] = m.deques[tx.priority].PushBack(tx)
+	if tx.sender != "" {
+		m.senderCounts[tx.sender]++
+	}
 
 	m.logger.Info("added to mempool", zap.String("tx", key))
 	return nil
 }
 
+// evictionCandidate picks the transaction to drop so tx fits, nil if tx should be rejected instead
+func (m *Mempool) evictionCandidate(tx *MempoolTransaction) *list.Element {
+	for priority := mempoolPriorityLow; priority <= tx.priority; priority++ {
+		var victim *list.Element
+		for e := m.deques[priority].Front(); e != nil; e = e.Next() {
+			if victim == nil || e.Value.(*MempoolTransaction).Deadline < victim.Value.(*MempoolTransaction).Deadline {
+				victim = e
+			}
+		}
+		if victim == nil {
+			continue
+		}
+		if priority == tx.priority && victim.Value.(*MempoolTransaction).Deadline >= tx.Deadline {
+			return nil
+		}
+		return victim
+	}
+	return nil
+}
+
+func (m *Mempool) removeExpired(blockNum int64) {
+	for _, element := range m.txMap {
+		mptx, ok := element.Value.(*MempoolTransaction)
+		if !ok {
+			continue
+		}
+		if mptx.Deadline <= blockNum {
+			m.remove(element)
+			m.stats.Expired++
+		}
+	}
+}
+
+// remove drops an element from its queue, the tx map and its sender's count, callers hold the lock
+func (m *Mempool) remove(element *list.Element) {
+	tx := element.Value.(*MempoolTransaction)
+	m.deques[tx.priority].Remove(element)
+	delete(m.txMap, tx.key)
+	if tx.sender != "" {
+		m.senderCounts[tx.sender]--
+		if m.senderCounts[tx.sender] <= 0 {
+			delete(m.senderCounts, tx.sender)
+		}
+	}
+}
+
+// mempoolTxPriority ranks validator, storage proof and reward traffic above entity
+// and ddex writes, and those above plays
+func mempoolTxPriority(tx *MempoolTransaction) mempoolPriority {
+	if tx.Tx == nil {
+		return mempoolPriorityNormal
+	}
+	switch tx.Tx.Transaction.(type) {
+	case *v1.SignedTransaction_Attestation,
+		*v1.SignedTransaction_ValidatorRegistration,
+		*v1.SignedTransaction_ValidatorDeregistration,
+		*v1.SignedTransaction_SlaRollup,
+		*v1.SignedTransaction_StorageProof,
+		*v1.SignedTransaction_StorageProofVerification,
+		*v1.SignedTransaction_Reward:
+		return mempoolPriorityHigh
+	case *v1.SignedTransaction_Plays:
+		return mempoolPriorityLow
+	default:
+		return mempoolPriorityNormal
+	}
+}
+
+// mempoolTxSender is the address a transaction counts against for per sender quotas,
+// empty when it can't be determined. Envelopes count against their recovered signer, the
+// header's from is whatever the submitter claims and unsigned envelopes are only admitted
+// before envelope signatures are required.
+func mempoolTxSender(tx *MempoolTransaction) string {
+	if tx.Txv2 != nil {
+		if signer, err := common.RecoverEnvelopeSigner(tx.Txv2.GetSignature(), tx.Txv2.GetEnvelope()); err == nil {
+			return strings.ToLower(signer)
+		}
+		return ""
+	}
+	switch t := tx.Tx.GetTransaction().(type) {
+	case *v1.SignedTransaction_Plays:
+		// plays are signed over the inner message by the submitting validator
+		if sender, err := common.ProtoRecover(t.Plays, tx.Tx.Signature); err == nil {
+			return strings.ToLower(sender)
+		}
+	case *v1.SignedTransaction_ManageEntity:
+		return strings.ToLower(t.ManageEntity.GetSigner())
+	}
+	return ""
+}
+
+func (s *Server) addMempoolTransaction(key string, tx *MempoolTransaction, broadcast bool) error {
+	// TODO: check db if tx already exists
+
+	// broadcast to peers before adding to our own mempool
+	if broadcast {
+		go s.broadcastMempoolTransaction(key, tx)
+	}
+
+	return s.mempl.add(key, tx, s.cache.currentHeight.Load())
+}
+
 func (s *Server) broadcastMempoolTransaction(key string, tx *MempoolTransaction) {
 	// only broadcast certain types of txs, don't broadcast these ones
 	if tx.Tx != nil {
@@ -250,18 +415,22 @@ func (s *Server) startMempoolCache(ctx context.Context) error {
 				mempl := s.mempl
 				mempl.mutex.Lock()
 
-				memplCount := mempl.deque.Len()
-				txCount := int64(memplCount)
+				txCount := int64(len(mempl.txMap))
 				maxTxCount := int64(mempl.maxMempoolTransactions)
+				stats := mempl.stats
 
 				// Calculate total size of transactions in mempool
 				var totalSize int64
-				for e := mempl.deque.Front(); e != nil; e = e.Next() {
-					if tx, ok := e.Value.(*MempoolTransaction); ok {
-						if tx.Tx != nil {
-							totalSize += int64(proto.Size(tx.Tx))
-						} else if tx.Txv2 != nil {
-							totalSize += int64(proto.Size(tx.Txv2))
+				var priorityCounts [numMempoolPriorities]int64
+				for priority, deque := range mempl.deques {
+					priorityCounts[priority] = int64(deque.Len())
+					for e := deque.Front(); e != nil; e = e.Next() {
+						if tx, ok := e.Value.(*MempoolTransaction); ok {
+							if tx.Tx != nil {
+								totalSize += int64(proto.Size(tx.Tx))
+							} else if tx.Txv2 != nil {
+								totalSize += int64(proto.Size(tx.Txv2))
+							}
 						}
 					}
 				}
@@ -274,10 +443,17 @@ func (s *Server) startMempoolCache(ctx context.Context) error {
 
 				upsertCache(s.cache.mempoolInfo, MempoolInfoKey, func(mempoolInfo *v1.GetStatusResponse_MempoolInfo) *v1.GetStatusResponse_MempoolInfo {
 					return &v1.GetStatusResponse_MempoolInfo{
-						TxCount:    txCount,
-						MaxTxCount: maxTxCount,
-						TxSize:     totalSize,
-						MaxTxSize:  maxTxSize,
+						TxCount:                    txCount,
+						MaxTxCount:                 maxTxCount,
+						TxSize:                     totalSize,
+						MaxTxSize:                  maxTxSize,
+						HighPriorityTxCount:        priorityCounts[mempoolPriorityHigh],
+						NormalPriorityTxCount:      priorityCounts[mempoolPriorityNormal],
+						LowPriorityTxCount:         priorityCounts[mempoolPriorityLow],
+						EvictedTxCount:             stats.Evicted,
+						RejectedFullTxCount:        stats.RejectedFull,
+						RejectedSenderLimitTxCount: stats.RejectedSenderLimit,
+						ExpiredTxCount:             stats.Expired,
 					}
 				})
 			}(s)
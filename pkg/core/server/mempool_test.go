@@ -0,0 +1,132 @@
+package server
+
+import (
+	"crypto/ecdsa"
+	"strings"
+	"testing"
+
+	v1 "github.com/AudiusProject/audiusd/pkg/api/core/v1"
+	"github.com/AudiusProject/audiusd/pkg/api/core/v1beta1"
+	"github.com/AudiusProject/audiusd/pkg/common"
+	"github.com/AudiusProject/audiusd/pkg/core/config"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestMempoolPriorityAndEviction(t *testing.T) {
+	m := NewMempool(zap.NewNop(), &config.Config{MempoolMaxTxsPerSender: 2}, nil, 3)
+
+	play := func(deadline int64) *MempoolTransaction {
+		return &MempoolTransaction{Deadline: deadline, Tx: &v1.SignedTransaction{
+			Transaction: &v1.SignedTransaction_Plays{Plays: &v1.TrackPlays{}},
+		}}
+	}
+	entity := func(signer string, deadline int64) *MempoolTransaction {
+		return &MempoolTransaction{Deadline: deadline, Tx: &v1.SignedTransaction{
+			Transaction: &v1.SignedTransaction_ManageEntity{ManageEntity: &v1.ManageEntityLegacy{Signer: signer}},
+		}}
+	}
+	ern := func(from string, deadline int64) *MempoolTransaction {
+		return &MempoolTransaction{Deadline: deadline, Txv2: &v1beta1.Transaction{
+			Envelope: &v1beta1.Envelope{Header: &v1beta1.EnvelopeHeader{From: from}},
+		}}
+	}
+	reward := func(deadline int64) *MempoolTransaction {
+		return &MempoolTransaction{Deadline: deadline, Tx: &v1.SignedTransaction{
+			Transaction: &v1.SignedTransaction_Reward{Reward: &v1.RewardMessage{}},
+		}}
+	}
+	keys := func(txs []*MempoolTransaction) []string {
+		out := []string{}
+		for _, tx := range txs {
+			out = append(out, tx.key)
+		}
+		return out
+	}
+
+	require.NoError(t, m.add("play1", play(20), 1))
+	require.NoError(t, m.add("play2", play(10), 1))
+	require.NoError(t, m.add("me1", entity("0xAbC", 10), 1))
+
+	// higher priority txs are proposed first regardless of arrival order
+	require.Equal(t, []string{"me1", "play1", "play2"}, keys(m.GetBatch(10, 1)))
+
+	// a full mempool evicts the play closest to its deadline
+	require.NoError(t, m.add("reward1", reward(10), 1))
+	require.Equal(t, []string{"reward1", "me1", "play1"}, keys(m.GetAll()))
+
+	// the same sender counts against one quota regardless of case
+	require.NoError(t, m.add("me2", entity("0xabc", 10), 1))
+	require.ErrorIs(t, m.add("me3", entity("0xABC", 10), 1), ErrMempoolSenderLimit)
+
+	// nothing lower to evict and no earlier deadline within the class
+	require.ErrorIs(t, m.add("ern1", ern("0xdef", 5), 1), ErrFullMempool)
+	require.NoError(t, m.add("ern2", ern("0xdef", 20), 1))
+	require.Equal(t, []string{"reward1", "me2", "ern2"}, keys(m.GetAll()))
+
+	// expired txs are dropped before anything is evicted
+	require.NoError(t, m.add("reward2", reward(30), 15))
+
+	stats, counts := m.Stats()
+	require.Equal(t, MempoolStats{Evicted: 3, RejectedFull: 1, RejectedSenderLimit: 1, Expired: 2}, stats)
+	require.Equal(t, [numMempoolPriorities]int{0, 1, 1}, counts)
+
+	m.RemoveBatch([]string{"ern2", "reward2"})
+	size, dequeLen := m.MempoolSize()
+	require.Zero(t, size)
+	require.Zero(t, dequeLen)
+	require.Empty(t, m.senderCounts)
+}
+
+func TestMempoolSignedPlaysSenderQuota(t *testing.T) {
+	m := NewMempool(zap.NewNop(), &config.Config{MempoolMaxTxsPerSender: 1}, nil, 10)
+
+	validator, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	other, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	signedPlays := func(key *ecdsa.PrivateKey, trackID string) *MempoolTransaction {
+		plays := &v1.TrackPlays{Plays: []*v1.TrackPlay{{UserId: "1", TrackId: trackID}}}
+		sig, err := common.ProtoSign(key, plays)
+		require.NoError(t, err)
+		return &MempoolTransaction{Deadline: 10, Tx: &v1.SignedTransaction{
+			Signature:   sig,
+			Transaction: &v1.SignedTransaction_Plays{Plays: plays},
+		}}
+	}
+
+	first := signedPlays(validator, "1")
+	require.NoError(t, m.add("play1", first, 1))
+	require.Equal(t, strings.ToLower(crypto.PubkeyToAddress(validator.PublicKey).Hex()), first.sender)
+
+	// the signing validator's quota is used up, another validator's isn't
+	require.ErrorIs(t, m.add("play2", signedPlays(validator, "2"), 1), ErrMempoolSenderLimit)
+	require.NoError(t, m.add("play3", signedPlays(other, "2"), 1))
+}
+
+func TestMempoolSignedEnvelopeSenderQuota(t *testing.T) {
+	m := NewMempool(zap.NewNop(), &config.Config{MempoolMaxTxsPerSender: 1}, nil, 10)
+
+	sender, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	spammer, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	signedERN := func(key *ecdsa.PrivateKey, from string, expiration int64) *MempoolTransaction {
+		envelope := &v1beta1.Envelope{Header: &v1beta1.EnvelopeHeader{From: from, Expiration: expiration}}
+		sig, err := common.SignEnvelope(key, v1beta1.Signature_SIGNATURE_TYPE_EIP712, envelope)
+		require.NoError(t, err)
+		return &MempoolTransaction{Deadline: 10, Txv2: &v1beta1.Transaction{Envelope: envelope, Signature: sig}}
+	}
+
+	first := signedERN(spammer, "0x1", 1)
+	require.NoError(t, m.add("ern1", first, 1))
+	require.Equal(t, strings.ToLower(crypto.PubkeyToAddress(spammer.PublicKey).Hex()), first.sender)
+
+	// claiming another from doesn't buy the signer another quota, nor use up that address's
+	senderAddress := common.PrivKeyToAddress(sender)
+	require.ErrorIs(t, m.add("ern2", signedERN(spammer, senderAddress, 2), 1), ErrMempoolSenderLimit)
+	require.NoError(t, m.add("ern3", signedERN(sender, senderAddress, 3), 1))
+}
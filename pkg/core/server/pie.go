@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 
 	"github.com/AudiusProject/audiusd/pkg/api/core/v1beta1"
 	ddexv1beta1 "github.com/AudiusProject/audiusd/pkg/api/ddex/v1beta1"
@@ -25,10 +26,11 @@ var (
 	ErrPIENonceNotOne       = errors.New("PIE nonce is not one")
 
 	// Update PIE message validation errors
-	ErrPIEAddressEmpty   = errors.New("PIE address is empty")
-	ErrPIEToAddressEmpty = errors.New("PIE to address is empty")
-	ErrPIEAddressNotTo   = errors.New("PIE address is not the target of the message")
-	ErrPIENonceNotNext   = errors.New("PIE nonce is not the next nonce")
+	ErrPIEAddressEmpty        = errors.New("PIE address is empty")
+	ErrPIEToAddressEmpty      = errors.New("PIE to address is empty")
+	ErrPIEAddressNotTo        = errors.New("PIE address is not the target of the message")
+	ErrPIENonceNotNext        = errors.New("PIE nonce is not the next nonce")
+	ErrPIEPartyAddressesEmpty = errors.New("PIE party addresses are empty")
 )
 
 func (s *Server) finalizePIE(ctx context.Context, req *abcitypes.FinalizeBlockRequest, txhash string, tx *v1beta1.Transaction, messageIndex int64) error {
@@ -44,8 +46,10 @@ func (s *Server) finalizePIE(ctx context.Context, req *abcitypes.FinalizeBlockRe
 	sender := tx.Envelope.Header.From
 
 	// PIE has no control type, always create a new PIE
-	if err := s.validatePIENewMessage(ctx, pie); err != nil {
-		return errors.Join(ErrPIEMessageValidation, err)
+	if s.enrichmentBindingActive(req.Height) {
+		if err := s.validatePIENewMessage(ctx, s.getDb(), sender, pie); err != nil {
+			return errors.Join(ErrPIEMessageValidation, err)
+		}
 	}
 	if err := s.finalizePIENewMessage(ctx, req, txhash, messageIndex, pie, sender); err != nil {
 		return errors.Join(ErrPIEMessageFinalization, err)
@@ -55,9 +59,37 @@ func (s *Server) finalizePIE(ctx context.Context, req *abcitypes.FinalizeBlockRe
 
 /** PIE New Message */
 
-// Validate a PIE message that's expected to be a NEW_MESSAGE, expects that the transaction header is valid
-func (s *Server) validatePIENewMessage(_ context.Context, pie *ddexv1beta1.PieMessage) error {
-	// TODO: add validation for conflicts and duplicates
+// pieAddresses lists the distinct party addresses a PIE message enriches
+func pieAddresses(pie *ddexv1beta1.PieMessage) ([]string, error) {
+	var parties []string
+	for i, party := range pie.GetPartyList().GetParty() {
+		address := partyOAPAddress(party.GetPartyId())
+		if address == "" {
+			return nil, fmt.Errorf("%w: party %d", ErrEnrichmentAddressMissing, i)
+		}
+		if !slices.Contains(parties, address) {
+			parties = append(parties, address)
+		}
+	}
+	return parties, nil
+}
+
+// Validate a PIE message, every party it describes must exist and be one the sender may
+// enrich, see enrichment.go.
+func (s *Server) validatePIENewMessage(ctx context.Context, q *db.Queries, from string, pie *ddexv1beta1.PieMessage) error {
+	parties, err := pieAddresses(pie)
+	if err != nil {
+		return err
+	}
+	if len(parties) == 0 {
+		return ErrPIEPartyAddressesEmpty
+	}
+
+	for _, address := range parties {
+		if err := checkEnrichmentTarget(ctx, q, address, "party", from); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -69,6 +101,15 @@ func (s *Server) finalizePIENewMessage(ctx context.Context, req *abcitypes.Final
 	// the PIE address is the location of the message on the chain
 	pieAddress := common.CreateAddress(txhashBytes, s.config.GenesisFile.ChainID, req.Height, messageIndex, "")
 
+	// enrichments from before binding was enforced aren't bound to any entity
+	partyAddresses := []string{}
+	if s.enrichmentBindingActive(req.Height) {
+		partyAddresses, err = pieAddresses(pie)
+		if err != nil {
+			return err
+		}
+	}
+
 	rawMessage, err := proto.Marshal(pie)
 	if err != nil {
 		return fmt.Errorf("failed to marshal PIE message: %w", err)
@@ -85,17 +126,20 @@ func (s *Server) finalizePIENewMessage(ctx context.Context, req *abcitypes.Final
 	}
 
 	qtx := s.getDb()
-	if err := qtx.InsertCorePIE(ctx, db.InsertCorePIEParams{
+	pieParams := db.InsertCorePIEParams{
 		TxHash:            txhash,
 		Index:             messageIndex,
 		Address:           pieAddress,
 		Sender:            sender,
+		PartyAddresses:    partyAddresses,
 		RawMessage:        rawMessage,
 		RawAcknowledgment: rawAcknowledgment,
 		BlockHeight:       req.Height,
-	}); err != nil {
+	}
+	if err := qtx.InsertCorePIE(ctx, pieParams); err != nil {
 		return fmt.Errorf("failed to insert PIE: %w", err)
 	}
+	s.stateInsert(pieStateRow(pieParams))
 
 	return nil
 }
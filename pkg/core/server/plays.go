@@ -4,16 +4,136 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	v1 "github.com/AudiusProject/audiusd/pkg/api/core/v1"
+	storagev1 "github.com/AudiusProject/audiusd/pkg/api/storage/v1"
+	"github.com/AudiusProject/audiusd/pkg/common"
+	"github.com/AudiusProject/audiusd/pkg/core/db"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"github.com/jackc/pgx/v5"
 )
 
-func (s *Server) isValidPlayTransaction(_ context.Context, _ *v1.SignedTransaction) error {
+const (
+	// how long before block time a play may have happened, older plays are
+	// rejected so their signatures only need to be remembered this long. Plays
+	// queue up on storage validators while the chain is halted, the window is
+	// wide enough that they're still accepted once blocks resume
+	playTimestampWindow = 24 * time.Hour
+	// how far after block time a play may be stamped to allow for clock drift
+	playTimestampSkew = 5 * time.Minute
+)
+
+var (
+	ErrPlaysEmpty              = errors.New("play tx has no plays")
+	ErrPlaysSignerInvalid      = errors.New("play tx signer could not be recovered")
+	ErrPlaysSignerNotStorage   = errors.New("play tx signer is not a registered storage validator")
+	ErrPlaySignatureInvalid    = errors.New("play signature does not recover to the tx signer")
+	ErrPlayTimestampOutOfRange = errors.New("play timestamp is outside the accepted window")
+	ErrPlayDuplicate           = errors.New("play was already recorded")
+)
+
+// playKey identifies a recorded play. A play signature only covers the track and second,
+// so listeners of the same track in the same second through the same validator share one.
+type playKey struct {
+	signature string
+	trackID   string
+	userID    string
+	playedAt  int64
+}
+
+func (s *Server) playValidationActive(height int64) bool {
+	activation := s.config.PlayValidationHeight
+	return activation > 0 && height >= activation
+}
+
+// isValidPlayTransaction checks that a play tx was signed by a registered storage validator,
+// every play in it was signed by that validator, happened close to block time and hasn't
+// been recorded before
+func (s *Server) isValidPlayTransaction(ctx context.Context, q *db.Queries, stx *v1.SignedTransaction, blockTime time.Time, blockHeight int64) error {
+	if !s.playValidationActive(blockHeight) {
+		return nil
+	}
+
+	plays := stx.GetPlays()
+	if len(plays.GetPlays()) == 0 {
+		return ErrPlaysEmpty
+	}
+
+	signer, err := common.ProtoRecover(plays, stx.Signature)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrPlaysSignerInvalid, err)
+	}
+
+	earliest := blockTime.Add(-playTimestampWindow)
+	latest := blockTime.Add(playTimestampSkew)
+	signatures := make([]string, 0, len(plays.Plays))
+	seen := make(map[playKey]struct{}, len(plays.Plays))
+
+	for i, play := range plays.Plays {
+		playedAt := play.GetTimestamp().AsTime()
+		if play.GetTimestamp() == nil || playedAt.Before(earliest) || playedAt.After(latest) {
+			return fmt.Errorf("%w: play %d at %s, block at %s", ErrPlayTimestampOutOfRange, i, playedAt, blockTime)
+		}
+
+		_, playSigner, err := common.RecoverPlaySignature(play.Signature, &storagev1.StreamTrackSignatureData{
+			TrackId:   play.TrackId,
+			Timestamp: playedAt.Unix(),
+		})
+		if err != nil {
+			return fmt.Errorf("%w: play %d: %v", ErrPlaySignatureInvalid, i, err)
+		}
+		if !strings.EqualFold(playSigner, signer) {
+			return fmt.Errorf("%w: play %d signed by %s", ErrPlaySignatureInvalid, i, playSigner)
+		}
+
+		key := playKey{signature: play.Signature, trackID: play.TrackId, userID: play.UserId, playedAt: playedAt.Unix()}
+		if _, ok := seen[key]; ok {
+			return fmt.Errorf("%w: play %d repeats a play in the same tx", ErrPlayDuplicate, i)
+		}
+		seen[key] = struct{}{}
+		signatures = append(signatures, play.Signature)
+	}
+
+	// stateless checks above run first so junk txs never reach the db
+	node, err := q.GetRegisteredNodeByEthAddress(ctx, signer)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("%w: %s", ErrPlaysSignerNotStorage, signer)
+	} else if err != nil {
+		return fmt.Errorf("could not get node %s: %v", signer, err)
+	}
+	if !isStorageNodeType(node.NodeType) {
+		return fmt.Errorf("%w: %s is a %s", ErrPlaysSignerNotStorage, signer, node.NodeType)
+	}
+
+	existing, err := q.GetExistingPlaySignatures(ctx, signatures)
+	if err != nil {
+		return fmt.Errorf("could not check play signatures: %v", err)
+	}
+	for _, p := range existing {
+		if _, ok := seen[playKey{signature: p.Signature, trackID: p.TrackID, userID: p.UserID, playedAt: p.PlayedAt}]; ok {
+			return fmt.Errorf("%w: %s by user %s", ErrPlayDuplicate, p.Signature, p.UserID)
+		}
+	}
+
 	return nil
 }
 
-func (s *Server) finalizePlayTransaction(ctx context.Context, stx *v1.SignedTransaction) (*v1.TrackPlays, error) {
-	if err := s.isValidPlayTransaction(ctx, stx); err != nil {
+// storage validators are the node types that serve content and so can witness plays
+func isStorageNodeType(nodeType string) bool {
+	switch nodeType {
+	case "content-node", "validator":
+		return true
+	default:
+		return false
+	}
+}
+
+func (s *Server) finalizePlayTransaction(ctx context.Context, req *abcitypes.FinalizeBlockRequest, stx *v1.SignedTransaction, txhash string) (*v1.TrackPlays, error) {
+	// reads the in progress block so duplicates within the same block are caught too
+	qtx := s.getDb()
+	if err := s.isValidPlayTransaction(ctx, qtx, stx, req.Time, req.Height); err != nil {
 		return nil, fmt.Errorf("invalid play tx: %v", err)
 	}
 
@@ -22,5 +142,32 @@ func (s *Server) finalizePlayTransaction(ctx context.Context, stx *v1.SignedTran
 		return nil, errors.New("invalid play tx")
 	}
 
+	if !s.playValidationActive(req.Height) {
+		return tx, nil
+	}
+
+	expired, err := qtx.DeleteExpiredPlaySignatures(ctx, req.Time.Add(-playTimestampWindow).Unix())
+	if err != nil {
+		return nil, fmt.Errorf("could not prune play signatures: %v", err)
+	}
+	for _, p := range expired {
+		s.stateDelete(playSignatureStateRow(db.InsertPlaySignatureParams(p)))
+	}
+
+	for _, play := range tx.Plays {
+		params := db.InsertPlaySignatureParams{
+			Signature:   play.Signature,
+			TrackID:     play.TrackId,
+			UserID:      play.UserId,
+			PlayedAt:    play.Timestamp.AsTime().Unix(),
+			TxHash:      txhash,
+			BlockHeight: req.Height,
+		}
+		if err := qtx.InsertPlaySignature(ctx, params); err != nil {
+			return nil, fmt.Errorf("could not record play signature: %v", err)
+		}
+		s.stateInsert(playSignatureStateRow(params))
+	}
+
 	return tx, nil
 }
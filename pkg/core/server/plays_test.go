@@ -0,0 +1,100 @@
+package server
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"testing"
+	"time"
+
+	v1 "github.com/AudiusProject/audiusd/pkg/api/core/v1"
+	storagev1 "github.com/AudiusProject/audiusd/pkg/api/storage/v1"
+	"github.com/AudiusProject/audiusd/pkg/common"
+	"github.com/AudiusProject/audiusd/pkg/core/config"
+	"github.com/AudiusProject/audiusd/pkg/core/db"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestIsValidPlayTransactionRejects(t *testing.T) {
+	validator, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	other, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	blockTime := time.Unix(1_700_000_000, 0)
+	s := &Server{config: &config.Config{PlayValidationHeight: 10}}
+
+	play := func(signer *ecdsa.PrivateKey, trackID string, playedAt time.Time) *v1.TrackPlay {
+		return signedPlay(t, signer, "1", trackID, playedAt)
+	}
+	playTx := func(plays ...*v1.TrackPlay) *v1.SignedTransaction {
+		return signedPlayTx(t, validator, plays...)
+	}
+
+	// plays before the activation height are accepted as they always were
+	require.NoError(t, s.isValidPlayTransaction(context.Background(), nil, &v1.SignedTransaction{}, blockTime, 9))
+
+	dup := play(validator, "1", blockTime)
+	tests := []struct {
+		name string
+		tx   *v1.SignedTransaction
+		err  error
+	}{
+		{name: "no plays", tx: playTx(), err: ErrPlaysEmpty},
+		{name: "unsigned tx", tx: &v1.SignedTransaction{Transaction: playTx(dup).Transaction}, err: ErrPlaysSignerInvalid},
+		{name: "too old", tx: playTx(play(validator, "1", blockTime.Add(-25*time.Hour))), err: ErrPlayTimestampOutOfRange},
+		{name: "in the future", tx: playTx(play(validator, "1", blockTime.Add(time.Hour))), err: ErrPlayTimestampOutOfRange},
+		{name: "signed by someone else", tx: playTx(play(other, "1", blockTime)), err: ErrPlaySignatureInvalid},
+		{name: "signature for another track", tx: playTx(&v1.TrackPlay{TrackId: "2", Timestamp: dup.Timestamp, Signature: dup.Signature}), err: ErrPlaySignatureInvalid},
+		{name: "duplicate in tx", tx: playTx(dup, dup), err: ErrPlayDuplicate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.ErrorIs(t, s.isValidPlayTransaction(context.Background(), nil, tt.tx, blockTime, 10), tt.err)
+		})
+	}
+}
+
+func signedPlay(t *testing.T, signer *ecdsa.PrivateKey, userID, trackID string, playedAt time.Time) *v1.TrackPlay {
+	sig, _, err := common.GeneratePlaySignature(signer, &storagev1.StreamTrackSignatureData{TrackId: trackID, Timestamp: playedAt.Unix()})
+	require.NoError(t, err)
+	return &v1.TrackPlay{UserId: userID, TrackId: trackID, Timestamp: timestamppb.New(playedAt), Signature: sig}
+}
+
+func signedPlayTx(t *testing.T, validator *ecdsa.PrivateKey, plays ...*v1.TrackPlay) *v1.SignedTransaction {
+	tp := &v1.TrackPlays{Plays: plays}
+	sig, err := common.ProtoSign(validator, tp)
+	require.NoError(t, err)
+	return &v1.SignedTransaction{Signature: sig, Transaction: &v1.SignedTransaction_Plays{Plays: tp}}
+}
+
+func TestIsValidPlayTransactionSameSecondPlays(t *testing.T) {
+	validator, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	address := crypto.PubkeyToAddress(validator.PublicKey).Hex()
+
+	blockTime := time.Unix(1_700_000_000, 0)
+	s := &Server{config: &config.Config{PlayValidationHeight: 10}}
+	recorded := signedPlay(t, validator, "1", "1", blockTime)
+
+	fake := newFakeDB().
+		on("GetRegisteredNodeByEthAddress", func(args ...any) ([][]any, error) {
+			return [][]any{{int32(1), "", "", address, "", "", "validator", "", "", false, int64(0), int64(0)}}, nil
+		}).
+		on("GetExistingPlaySignatures", func(args ...any) ([][]any, error) {
+			return [][]any{{recorded.Signature, recorded.TrackId, recorded.UserId, blockTime.Unix()}}, nil
+		})
+	q := db.New(fake)
+
+	// other listeners of the track in the same second share the signature but are distinct plays
+	tx := signedPlayTx(t, validator, signedPlay(t, validator, "2", "1", blockTime), signedPlay(t, validator, "3", "1", blockTime))
+	require.NoError(t, s.isValidPlayTransaction(context.Background(), q, tx, blockTime, 10))
+
+	tx = signedPlayTx(t, validator, signedPlay(t, validator, "2", "1", blockTime), recorded)
+	require.ErrorIs(t, s.isValidPlayTransaction(context.Background(), q, tx, blockTime, 10), ErrPlayDuplicate)
+
+	tx = signedPlayTx(t, validator, signedPlay(t, validator, "2", "1", blockTime), signedPlay(t, validator, "2", "1", blockTime))
+	require.ErrorIs(t, s.isValidPlayTransaction(context.Background(), q, tx, blockTime, 10), ErrPlayDuplicate)
+}
@@ -77,8 +77,12 @@ func (s *Server) sendPoSChallengeToStorage(blockHash []byte, blockHeight int64)
 			s.logger.Error("Could not update existing PoS challenge", zap.ByteString("hash", blockHash), zap.Error(err))
 		}
 
-		// submit proof tx if we are part of the challenge
-		if len(response.Proof) > 0 {
+		// submit proof if we are part of the challenge, in our vote extensions once they're enabled
+		if len(response.Proof) > 0 && s.voteExtensionsActive(blockHeight) {
+			if err := s.queueStorageProof(blockHeight, response.CID, proverAddresses, response.Proof); err != nil {
+				s.logger.Error("Could not queue storage proof for vote extension", zap.ByteString("hash", blockHash), zap.Error(err))
+			}
+		} else if len(response.Proof) > 0 {
 			err := s.submitStorageProofTx(blockHeight, blockHash, response.CID, proverAddresses, response.Proof)
 			if err != nil {
 				s.logger.Error("Could not submit storage proof tx", zap.ByteString("hash", blockHash), zap.Error(err))
@@ -204,24 +208,11 @@ func (s *Server) isValidStorageProofTx(ctx context.Context, tx *v1.SignedTransac
 
 	// validate height
 	height := sp.GetHeight()
-	if height == 0 {
-		return fmt.Errorf("invalid height '%d' for storage proof", height)
-	}
 	if currentBlockHeight-height > posChallengeDeadline {
 		return fmt.Errorf("proof submitted at height '%d' for challenge at height '%d' which is past the deadline", currentBlockHeight, height)
 	}
-
-	// validate height corresponds to triggered challenge
-	block, err := s.db.GetBlock(ctx, height)
-	if err != nil {
-		return fmt.Errorf("failed to get block at height %d: %v", height, err)
-	}
-	blockHashBytes, err := hex.DecodeString(block.Hash)
-	if err != nil {
-		return fmt.Errorf("failed to decode blockhash at height %d: %v", height, err)
-	}
-	if !blockShouldTriggerNewPoSChallenge(blockHashBytes) {
-		return fmt.Errorf("block at height %d with hash '%s' should not trigger a storage proof", height, block.Hash)
+	if err := isStorageProofChallenge(ctx, s.db, height); err != nil {
+		return err
 	}
 
 	// validate proof comes from a replica peer
@@ -243,15 +234,19 @@ func (s *Server) isValidStorageProofVerificationTx(ctx context.Context, tx *v1.S
 
 	// validate height
 	height := spv.GetHeight()
-	if height == 0 {
-		return fmt.Errorf("invalid height '%d' for storage proof", height)
-	}
 	if currentBlockHeight-height <= posChallengeDeadline {
 		return fmt.Errorf("proof submitted at height '%d' for challenge at height '%d' which is before the deadline", currentBlockHeight, height)
 	}
+	return isStorageProofChallenge(ctx, s.db, height)
+}
 
-	// validate height corresponds to triggered challenge
-	block, err := s.db.GetBlock(ctx, height)
+// isStorageProofChallenge checks that the block at height triggered a storage proof challenge
+func isStorageProofChallenge(ctx context.Context, q *db.Queries, height int64) error {
+	if height <= 0 {
+		return fmt.Errorf("invalid height '%d' for storage proof", height)
+	}
+
+	block, err := q.GetBlock(ctx, height)
 	if err != nil {
 		return fmt.Errorf("failed to get block at height %d: %v", height, err)
 	}
@@ -272,12 +267,18 @@ func (s *Server) finalizeStorageProof(ctx context.Context, tx *v1.SignedTransact
 	}
 
 	sp := tx.GetStorageProof()
-	qtx := s.getDb()
+	if err := s.recordStorageProof(ctx, s.getDb(), sp); err != nil {
+		return nil, err
+	}
 
+	return sp, nil
+}
+
+func (s *Server) recordStorageProof(ctx context.Context, qtx *db.Queries, sp *v1.StorageProof) error {
 	// ignore duplicates
 	if _, err := qtx.GetStorageProof(ctx, db.GetStorageProofParams{BlockHeight: sp.Height, Address: sp.Address}); !errors.Is(err, pgx.ErrNoRows) {
 		s.logger.Error("Storage proof already exists, skipping.", zap.String("address", sp.Address), zap.Int64("height", sp.Height))
-		return sp, nil
+		return nil
 	}
 
 	proofSigStr := base64.StdEncoding.EncodeToString(sp.ProofSignature)
@@ -292,10 +293,10 @@ func (s *Server) finalizeStorageProof(ctx context.Context, tx *v1.SignedTransact
 			ProverAddresses: sp.ProverAddresses,
 		},
 	); err != nil {
-		return nil, fmt.Errorf("could not persist storage proof in db: %v", err)
+		return fmt.Errorf("could not persist storage proof in db: %v", err)
 	}
 
-	return sp, nil
+	return nil
 }
 
 func (s *Server) finalizeStorageProofVerification(ctx context.Context, tx *v1.SignedTransaction, currentBlockHeight int64) (*v1.StorageProofVerification, error) {
@@ -304,15 +305,23 @@ func (s *Server) finalizeStorageProofVerification(ctx context.Context, tx *v1.Si
 	}
 
 	spv := tx.GetStorageProofVerification()
-	qtx := s.getDb()
+	if err := s.resolveStorageProofChallenge(ctx, s.getDb(), spv); err != nil {
+		return nil, err
+	}
+
+	return spv, nil
+}
 
+// resolveStorageProofChallenge checks the revealed proof against every signature submitted for
+// the challenge and, once a majority agree, marks each prover as passed or failed
+func (s *Server) resolveStorageProofChallenge(ctx context.Context, qtx *db.Queries, spv *v1.StorageProofVerification) error {
 	proofs, err := qtx.GetStorageProofs(ctx, spv.Height)
 	if err != nil {
-		return nil, fmt.Errorf("could not fetch storage proofs: %v", err)
+		return fmt.Errorf("could not fetch storage proofs: %v", err)
 	}
 	if len(proofs) == 0 || proofs[0].Status != db.ProofStatusUnresolved {
 		// challenge already resolved, no-op
-		return spv, nil
+		return nil
 	}
 
 	consensusNodes := make([]string, 0, len(proofs))
@@ -323,16 +332,16 @@ func (s *Server) finalizeStorageProofVerification(ctx context.Context, tx *v1.Si
 	for _, p := range proofs {
 		node, err := qtx.GetRegisteredNodeByCometAddress(ctx, p.Address)
 		if err != nil {
-			return nil, fmt.Errorf("could not fetch node with address %s: %v", p.Address, err)
+			return fmt.Errorf("could not fetch node with address %s: %v", p.Address, err)
 		}
 
 		sigBytes, err := base64.StdEncoding.DecodeString(p.ProofSignature.String)
 		if err != nil {
-			return nil, fmt.Errorf("could not decode proof signature node at address %s: %v", node.CometAddress, err)
+			return fmt.Errorf("could not decode proof signature node at address %s: %v", node.CometAddress, err)
 		}
 		pubKeyBytes, err := base64.StdEncoding.DecodeString(node.CometPubKey)
 		if err != nil {
-			return nil, fmt.Errorf("could not decode public key for node at address %s: %v", node.CometAddress, err)
+			return fmt.Errorf("could not decode public key for node at address %s: %v", node.CometAddress, err)
 		}
 		pubKey := ed25519.PubKey(pubKeyBytes)
 		if pubKey.VerifySignature(spv.Proof, sigBytes) {
@@ -364,7 +373,7 @@ func (s *Server) finalizeStorageProofVerification(ctx context.Context, tx *v1.Si
 					},
 				)
 				if err != nil {
-					return nil, fmt.Errorf("could not update storage proof for prover %s at height %d: %v", p.Address, spv.Height, err)
+					return fmt.Errorf("could not update storage proof for prover %s at height %d: %v", p.Address, spv.Height, err)
 				}
 			} else {
 				// Mark remaining provers as failed
@@ -378,7 +387,7 @@ func (s *Server) finalizeStorageProofVerification(ctx context.Context, tx *v1.Si
 					},
 				)
 				if err != nil {
-					return nil, fmt.Errorf("could not update storage proof for prover %s at height %d: %v", p.Address, spv.Height, err)
+					return fmt.Errorf("could not update storage proof for prover %s at height %d: %v", p.Address, spv.Height, err)
 				}
 			}
 			// This peer has now been handled. Remove it from consensusPeers in preparation for the
@@ -399,5 +408,5 @@ func (s *Server) finalizeStorageProofVerification(ctx context.Context, tx *v1.Si
 		}
 	}
 
-	return spv, nil
+	return nil
 }
@@ -0,0 +1,291 @@
+package server
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	v1 "github.com/AudiusProject/audiusd/pkg/api/core/v1"
+	"github.com/AudiusProject/audiusd/pkg/api/core/v1beta1"
+	ddexv1beta1 "github.com/AudiusProject/audiusd/pkg/api/ddex/v1beta1"
+	"github.com/AudiusProject/audiusd/pkg/core/db"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const queryCodespace = "core"
+
+// abci query response codes, zero is reserved for ok
+const (
+	QueryCodeUnknownPath uint32 = iota + 1
+	QueryCodeInvalidRequest
+	QueryCodeNotFound
+	QueryCodeHeightUnavailable
+	QueryCodeInternal
+	QueryCodeProofUnavailable
+)
+
+var (
+	ErrQueryUnknownPath       = errors.New("unknown query path")
+	ErrQueryMissingKey        = errors.New("query path is missing a key")
+	ErrQueryHeightUnavailable = errors.New("state is not available at the requested height")
+	ErrQueryProofUnavailable  = errors.New("no state tree to prove the query against at the requested height")
+)
+
+// queryHandler reads the value stored under key from a consistent view of app state
+type queryHandler func(ctx context.Context, q *db.Queries, key string) (proto.Message, error)
+
+func (s *Server) queryRoutes() map[string]queryHandler {
+	return map[string]queryHandler{
+		"ern":       s.queryERN,
+		"reward":    s.queryReward,
+		"validator": s.queryValidator,
+		"upload":    s.queryUpload,
+		"tx":        s.queryTx,
+	}
+}
+
+// parseQueryPath splits an abci query path of the form /{route}/{key}
+func parseQueryPath(path string) (string, string, error) {
+	trimmed := strings.Trim(path, "/")
+	route, key, found := strings.Cut(trimmed, "/")
+	if route == "" {
+		return "", "", ErrQueryUnknownPath
+	}
+	if !found || key == "" || strings.Contains(key, "/") {
+		return route, "", ErrQueryMissingKey
+	}
+	return route, key, nil
+}
+
+func (s *Server) query(ctx context.Context, req *abcitypes.QueryRequest) *abcitypes.QueryResponse {
+	route, key, err := parseQueryPath(req.Path)
+	if errors.Is(err, ErrQueryUnknownPath) {
+		return queryError(QueryCodeUnknownPath, err, 0)
+	} else if err != nil {
+		return queryError(QueryCodeInvalidRequest, err, 0)
+	}
+
+	if _, ok := s.queryRoutes()[route]; !ok {
+		return queryError(QueryCodeUnknownPath, fmt.Errorf("%w: %s", ErrQueryUnknownPath, route), 0)
+	}
+
+	// read the app state and the value in one snapshot so the returned
+	// height always matches the state the value was read from
+	dbTx, err := s.pool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.RepeatableRead,
+		AccessMode: pgx.ReadOnly,
+	})
+	if err != nil {
+		return queryError(QueryCodeInternal, fmt.Errorf("could not open query snapshot: %v", err), 0)
+	}
+	defer dbTx.Rollback(context.Background())
+
+	return s.queryState(ctx, s.db.WithTx(dbTx), req, route, key)
+}
+
+// queryState answers a query from the state tree at the requested height, or
+// from the latest app state before the state tree is active
+func (s *Server) queryState(ctx context.Context, q *db.Queries, req *abcitypes.QueryRequest, route, key string) *abcitypes.QueryResponse {
+	appState, err := q.GetLatestAppState(ctx)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return queryError(QueryCodeInternal, fmt.Errorf("could not read app state: %v", err), 0)
+	}
+	latest := appState.BlockHeight
+
+	height := req.Height
+	if height == 0 {
+		height = latest
+	}
+	if height < 0 || height > latest {
+		return queryError(QueryCodeHeightUnavailable, fmt.Errorf("%w: requested %d, latest %d", ErrQueryHeightUnavailable, height, latest), latest)
+	}
+
+	if !s.stateProofActive(height) {
+		return s.queryLatestState(ctx, q, req, route, key, height, latest)
+	}
+
+	retain, err := q.GetStateTreeRetainHeight(ctx)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return queryError(QueryCodeInternal, fmt.Errorf("could not read state tree retain height: %v", err), height)
+	}
+	if height < retain {
+		return queryError(QueryCodeHeightUnavailable, fmt.Errorf("%w: requested %d, pruned below %d", ErrQueryHeightUnavailable, height, retain), height)
+	}
+
+	stateKey := queryStateKey(route, key)
+	value, err := q.GetStateValue(ctx, db.GetStateValueParams{Key: stateKey, BlockHeight: height})
+	if errors.Is(err, pgx.ErrNoRows) || (err == nil && value.ValueHash == nil) {
+		return queryError(QueryCodeNotFound, fmt.Errorf("%s not found: %s", route, key), height)
+	} else if err != nil {
+		return queryError(QueryCodeInternal, fmt.Errorf("could not read state value: %v", err), height)
+	}
+
+	res := &abcitypes.QueryResponse{
+		Code:      abcitypes.CodeTypeOK,
+		Key:       []byte(stateKey),
+		Value:     value.Value,
+		Height:    height,
+		Codespace: queryCodespace,
+	}
+
+	if req.Prove {
+		res.ProofOps, err = stateProof(ctx, q, stateKey, value.Bucket, height)
+		if err != nil {
+			return queryError(QueryCodeInternal, fmt.Errorf("could not prove %s: %v", stateKey, err), height)
+		}
+	}
+
+	return res
+}
+
+// queryLatestState reads a value straight from app state, which only holds the
+// latest height and isn't committed to anything a value could be proven against
+func (s *Server) queryLatestState(ctx context.Context, q *db.Queries, req *abcitypes.QueryRequest, route, key string, height, latest int64) *abcitypes.QueryResponse {
+	if req.Prove {
+		return queryError(QueryCodeProofUnavailable, fmt.Errorf("%w: %d", ErrQueryProofUnavailable, height), height)
+	}
+	if height != latest {
+		return queryError(QueryCodeHeightUnavailable, fmt.Errorf("%w: requested %d, only the latest height %d is kept", ErrQueryHeightUnavailable, height, latest), latest)
+	}
+
+	value, err := s.queryRoutes()[route](ctx, q, key)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return queryError(QueryCodeNotFound, fmt.Errorf("%s not found: %s", route, key), height)
+	} else if err != nil {
+		return queryError(QueryCodeInternal, err, height)
+	}
+
+	valueBytes, err := proto.Marshal(value)
+	if err != nil {
+		return queryError(QueryCodeInternal, fmt.Errorf("could not marshal %s: %v", route, err), height)
+	}
+
+	return &abcitypes.QueryResponse{
+		Code:      abcitypes.CodeTypeOK,
+		Key:       []byte(req.Path),
+		Value:     valueBytes,
+		Height:    height,
+		Codespace: queryCodespace,
+	}
+}
+
+func queryError(code uint32, err error, height int64) *abcitypes.QueryResponse {
+	return &abcitypes.QueryResponse{
+		Code:      code,
+		Log:       err.Error(),
+		Height:    height,
+		Codespace: queryCodespace,
+	}
+}
+
+func (s *Server) queryERN(ctx context.Context, q *db.Queries, address string) (proto.Message, error) {
+	dbErn, err := q.GetERN(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+
+	var ern ddexv1beta1.NewReleaseMessage
+	if err := proto.Unmarshal(dbErn.RawMessage, &ern); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal ERN message: %w", err)
+	}
+
+	return &v1.GetERNResponse{Ern: &ern}, nil
+}
+
+func (s *Server) queryReward(ctx context.Context, q *db.Queries, address string) (proto.Message, error) {
+	reward, err := q.GetReward(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.GetRewardResponse{
+		Address:          reward.Address,
+		RewardId:         reward.RewardID,
+		Name:             reward.Name,
+		Amount:           uint64(reward.Amount),
+		ClaimAuthorities: reward.ClaimAuthorities,
+		Sender:           reward.Sender,
+		BlockHeight:      reward.BlockHeight,
+	}, nil
+}
+
+func (s *Server) queryValidator(ctx context.Context, q *db.Queries, cometAddress string) (proto.Message, error) {
+	node, err := q.GetRegisteredNodeByCometAddress(ctx, cometAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ethBlock, err := strconv.ParseInt(node.EthBlock, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid eth block '%s' for validator %s: %v", node.EthBlock, cometAddress, err)
+	}
+
+	pubKey, err := base64.StdEncoding.DecodeString(node.CometPubKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid comet pubkey for validator %s: %v", cometAddress, err)
+	}
+
+	return &v1.ValidatorRegistration{
+		DelegateWallet: node.EthAddress,
+		Endpoint:       node.Endpoint,
+		NodeType:       node.NodeType,
+		SpId:           node.SpID,
+		EthBlock:       ethBlock,
+		CometAddress:   node.CometAddress,
+		PubKey:         pubKey,
+	}, nil
+}
+
+func (s *Server) queryUpload(ctx context.Context, q *db.Queries, cid string) (proto.Message, error) {
+	upload, err := q.GetCoreUpload(ctx, cid)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.GetUploadByCIDResponse{
+		Exists:          true,
+		UploaderAddress: upload.UploaderAddress,
+		OriginalCid:     upload.Cid,
+		TranscodedCid:   upload.TranscodedCid,
+	}, nil
+}
+
+func (s *Server) queryTx(ctx context.Context, q *db.Queries, txhash string) (proto.Message, error) {
+	tx, err := q.GetTx(ctx, txhash)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := q.GetBlock(ctx, tx.BlockID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &v1.Transaction{
+		Hash:      tx.TxHash,
+		BlockHash: block.Hash,
+		ChainId:   s.config.GenesisFile.ChainID,
+		Height:    block.Height,
+		Timestamp: timestamppb.New(block.CreatedAt.Time),
+	}
+
+	// decode in the same order as GetTransaction and FinalizeBlock
+	var signedTx v1.SignedTransaction
+	if err := proto.Unmarshal(tx.Transaction, &signedTx); err == nil {
+		res.Transaction = &signedTx
+	} else {
+		var v2Tx v1beta1.Transaction
+		if err := proto.Unmarshal(tx.Transaction, &v2Tx); err != nil {
+			return nil, fmt.Errorf("could not unmarshal transaction as v1 or v2: %v", err)
+		}
+		res.Transactionv2 = &v2Tx
+	}
+
+	return &v1.GetTransactionResponse{Transaction: res}, nil
+}
@@ -0,0 +1,62 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseQueryPath(t *testing.T) {
+	tests := []struct {
+		name          string
+		path          string
+		expectedRoute string
+		expectedKey   string
+		expectedErr   error
+	}{
+		{
+			name:          "ern address",
+			path:          "/ern/0xabc",
+			expectedRoute: "ern",
+			expectedKey:   "0xabc",
+		},
+		{
+			name:          "no leading slash",
+			path:          "tx/ABCDEF",
+			expectedRoute: "tx",
+			expectedKey:   "ABCDEF",
+		},
+		{
+			name:          "trailing slash",
+			path:          "/upload/baeaaaiqsecid/",
+			expectedRoute: "upload",
+			expectedKey:   "baeaaaiqsecid",
+		},
+		{
+			name:        "empty path",
+			path:        "",
+			expectedErr: ErrQueryUnknownPath,
+		},
+		{
+			name:          "route without key",
+			path:          "/validator",
+			expectedRoute: "validator",
+			expectedErr:   ErrQueryMissingKey,
+		},
+		{
+			name:          "nested key",
+			path:          "/reward/a/b",
+			expectedRoute: "reward",
+			expectedErr:   ErrQueryMissingKey,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			route, key, err := parseQueryPath(tt.path)
+			require.ErrorIs(t, err, tt.expectedErr)
+			require.Equal(t, tt.expectedRoute, route)
+			require.Equal(t, tt.expectedKey, key)
+		})
+	}
+}
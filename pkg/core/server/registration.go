@@ -101,7 +101,7 @@ func (s *Server) finalizeRegisterNodeAttestation(ctx context.Context, tx *v1.Sig
 
 	// Do not reinsert duplicate registrations
 	if _, err = qtx.GetRegisteredNodeByEthAddress(ctx, vr.GetDelegateWallet()); errors.Is(err, pgx.ErrNoRows) {
-		err = qtx.InsertRegisteredNode(ctx, db.InsertRegisteredNodeParams{
+		nodeParams := db.InsertRegisteredNodeParams{
 			PubKey:       serializedPubKey,
 			EthAddress:   vr.GetDelegateWallet(),
 			Endpoint:     vr.GetEndpoint(),
@@ -110,10 +110,13 @@ func (s *Server) finalizeRegisterNodeAttestation(ctx context.Context, tx *v1.Sig
 			EthBlock:     strconv.FormatInt(vr.GetEthBlock(), 10),
 			NodeType:     vr.GetNodeType(),
 			SpID:         vr.GetSpId(),
-		})
+		}
+		err = qtx.InsertRegisteredNode(ctx, nodeParams)
 		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
 			return fmt.Errorf("error inserting registered node: %v", err)
 		}
+		s.stateInsert(validatorStateRow(nodeParams))
+		s.stateTouch("validator", nodeParams.CometAddress)
 	}
 	return nil
 }
@@ -168,10 +171,17 @@ func (s *Server) finalizeDeregisterValidatorAttestation(ctx context.Context, tx
 		return fmt.Errorf("unknown attestation fell into isValidDeregisterNodeAttestation: %v", tx)
 	}
 	qtx := s.getDb()
-	err := qtx.DeleteRegisteredNode(ctx, dereg.GetCometAddress())
+	deleted, err := qtx.DeleteRegisteredNode(ctx, dereg.GetCometAddress())
 	if err != nil {
 		return fmt.Errorf("error deleting registered node: %v", err)
 	}
+	for _, node := range deleted {
+		s.stateDelete(validatorStateRow(validatorRowParams(node)))
+		if node.Jailed {
+			s.stateDelete(validatorJailStateRow(node))
+		}
+		s.stateTouch("validator", node.CometAddress)
+	}
 
 	return nil
 }
@@ -219,10 +229,17 @@ func (s *Server) finalizeDeregisterMisbehavingNode(ctx context.Context, tx *v1.S
 
 	vd := tx.GetValidatorDeregistration()
 	qtx := s.getDb()
-	err := qtx.DeleteRegisteredNode(ctx, vd.GetCometAddress())
+	deleted, err := qtx.DeleteRegisteredNode(ctx, vd.GetCometAddress())
 	if err != nil {
 		return nil, fmt.Errorf("error deleting registered node: %v", err)
 	}
+	for _, node := range deleted {
+		s.stateDelete(validatorStateRow(validatorRowParams(node)))
+		if node.Jailed {
+			s.stateDelete(validatorJailStateRow(node))
+		}
+		s.stateTouch("validator", node.CometAddress)
+	}
 
 	return vd, nil
 }
@@ -34,7 +34,7 @@ func (s *Server) finalizeLegacyRegisterNode(ctx context.Context, tx *v1.SignedTr
 
 	// Do not reinsert duplicate registrations
 	if _, err = qtx.GetRegisteredNodeByEthAddress(ctx, address); errors.Is(err, pgx.ErrNoRows) {
-		err = qtx.InsertRegisteredNode(ctx, db.InsertRegisteredNodeParams{
+		nodeParams := db.InsertRegisteredNodeParams{
 			PubKey:       serializedPubKey,
 			EthAddress:   address,
 			Endpoint:     registerNode.GetEndpoint(),
@@ -43,10 +43,13 @@ func (s *Server) finalizeLegacyRegisterNode(ctx context.Context, tx *v1.SignedTr
 			EthBlock:     registerNode.GetEthBlock(),
 			NodeType:     registerNode.GetNodeType(),
 			SpID:         registerNode.GetSpId(),
-		})
+		}
+		err = qtx.InsertRegisteredNode(ctx, nodeParams)
 		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
 			return nil, fmt.Errorf("error inserting registered node: %v", err)
 		}
+		s.stateInsert(validatorStateRow(nodeParams))
+		s.stateTouch("validator", nodeParams.CometAddress)
 	}
 
 	return vr, nil
@@ -100,6 +100,10 @@ ethstatus:
 				s.RunningProcess(ProcessStateRegistryBridge)
 				s.lc.AddManagedRoutine("eth contract event listener", s.listenForEthContractEvents)
 				s.lc.AddManagedRoutine("validator warden", s.startValidatorWarden)
+				s.lc.AddManagedRoutine("validator unjailer", s.startValidatorUnjailer)
+				if s.config.SlashProposals == config.SlashProposalsDryRun || s.config.SlashProposals == config.SlashProposalsSubmit {
+					s.lc.AddManagedRoutine("slash proposer", s.startSlashProposer)
+				}
 				return nil
 			}
 		case <-timeout:
@@ -168,6 +172,11 @@ func (s *Server) startValidatorWarden(ctx context.Context) error {
 				}
 			}
 
+			// down validators are jailed on chain once jailing is active
+			if s.validatorJailingActive(s.cache.currentHeight.Load()) {
+				continue
+			}
+
 			// deregister any down validators
 			for _, validator := range allValidators {
 				if shouldPurge, err := s.ShouldPurgeValidatorForUnderperformance(ctx, validator.CometAddress); err == nil && shouldPurge {
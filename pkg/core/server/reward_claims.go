@@ -0,0 +1,289 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"connectrpc.com/connect"
+	corev1 "github.com/AudiusProject/audiusd/pkg/api/core/v1"
+	"github.com/AudiusProject/audiusd/pkg/common"
+	"github.com/AudiusProject/audiusd/pkg/core/db"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+// blocks a validator has to get the record of a claim it attested to on chain
+const rewardClaimDeadlineBlocks = 100
+
+var (
+	ErrRewardClaimExceedsAmount   = errors.New("reward claims exceed reward amount")
+	ErrRewardClaimAlreadyAttested = errors.New("specifier already attested to a different claim")
+	ErrRewardClaimUnknownReward   = errors.New("reward claim is not for a reward on chain")
+)
+
+func (s *Server) rewardClaimLedgerActive(height int64) bool {
+	activation := s.config.RewardClaimLedgerHeight
+	return activation > 0 && height >= activation
+}
+
+// checkRewardClaim reports whether attester already attested to this exact claim. A validator
+// may attest to a claim other validators attested to, but the distinct claims for a specifier
+// can't add up to more than the reward amount and a validator attests to one claim per specifier.
+func checkRewardClaim(claims []db.CoreRewardClaim, attester, recipient string, amount, rewardAmount uint64) (bool, error) {
+	if amount > rewardAmount {
+		return false, fmt.Errorf("%w: claim of %d for reward of %d", ErrRewardClaimExceedsAmount, amount, rewardAmount)
+	}
+
+	sameClaim := func(c db.CoreRewardClaim) bool {
+		return strings.EqualFold(c.EthRecipientAddress, recipient) && uint64(c.Amount) == amount
+	}
+
+	seen := map[string]bool{}
+	total := uint64(0)
+	known := false
+	for _, c := range claims {
+		if strings.EqualFold(c.Attester, attester) {
+			if sameClaim(c) {
+				return true, nil
+			}
+			return false, fmt.Errorf("%w: %s attested to %d for %s", ErrRewardClaimAlreadyAttested, c.Attester, c.Amount, c.EthRecipientAddress)
+		}
+		key := fmt.Sprintf("%s:%d", strings.ToLower(c.EthRecipientAddress), c.Amount)
+		if !seen[key] {
+			seen[key] = true
+			total += uint64(c.Amount)
+		}
+		known = known || sameClaim(c)
+	}
+
+	if !known && total+amount > rewardAmount {
+		return false, fmt.Errorf("%w: %d already attested for reward of %d", ErrRewardClaimExceedsAmount, total, rewardAmount)
+	}
+	return false, nil
+}
+
+// rewardClaimAmount is the amount of the reward a claim is for. It's only ever read from
+// chain so every validator checks a claim against the same amount.
+func rewardClaimAmount(ctx context.Context, q *db.Queries, rewardAddress string) (uint64, error) {
+	if rewardAddress == "" {
+		return 0, fmt.Errorf("%w: no reward address", ErrRewardClaimUnknownReward)
+	}
+	reward, err := q.GetReward(ctx, rewardAddress)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, fmt.Errorf("%w: %s", ErrRewardClaimUnknownReward, rewardAddress)
+	} else if err != nil {
+		return 0, fmt.Errorf("could not get reward %s: %w", rewardAddress, err)
+	}
+	return uint64(reward.Amount), nil
+}
+
+// rewardClaimLedger reads the amount of a claim's reward and the claims recorded for its specifier
+func (s *Server) rewardClaimLedger(ctx context.Context, q *db.Queries, attest *corev1.AttestRewardClaim) (uint64, []db.CoreRewardClaim, error) {
+	rewardAmount, err := rewardClaimAmount(ctx, q, attest.RewardAddress)
+	if err != nil {
+		return 0, nil, err
+	}
+	claims, err := q.GetRewardClaimsForSpecifier(ctx, db.GetRewardClaimsForSpecifierParams{
+		RewardAddress: attest.RewardAddress,
+		RewardID:      attest.RewardId,
+		Specifier:     attest.Specifier,
+	})
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return 0, nil, fmt.Errorf("could not get reward claims: %w", err)
+	}
+	return rewardAmount, claims, nil
+}
+
+// checkRewardClaimLedger checks a claim against the claims recorded for its specifier,
+// reporting whether attester already recorded it
+func (s *Server) checkRewardClaimLedger(ctx context.Context, q *db.Queries, attest *corev1.AttestRewardClaim, attester string) (bool, error) {
+	rewardAmount, claims, err := s.rewardClaimLedger(ctx, q, attest)
+	if err != nil {
+		return false, err
+	}
+	return checkRewardClaim(claims, attester, attest.EthRecipientAddress, attest.Amount, rewardAmount)
+}
+
+// pendingRewardClaims are the claims this node attested to that aren't on chain yet, keyed
+// by reward and specifier. The lock is held from checking the ledger until a new claim is
+// pending so concurrent requests for a specifier can't both pass the check.
+type pendingRewardClaims struct {
+	mu     sync.Mutex
+	claims map[string]db.CoreRewardClaim
+}
+
+func newPendingRewardClaims() *pendingRewardClaims {
+	return &pendingRewardClaims{claims: map[string]db.CoreRewardClaim{}}
+}
+
+func pendingRewardClaimKey(attest *corev1.AttestRewardClaim) string {
+	return attest.RewardAddress + ":" + attest.RewardId + ":" + attest.Specifier
+}
+
+// beforeRewardClaimAttestation runs before this node signs a reward claim, it reports
+// whether the attestation still has to be recorded. Claims this node attested to count
+// as soon as they're pending, the ledger only has them once they're finalized.
+func (s *Server) beforeRewardClaimAttestation(ctx context.Context, attest *corev1.AttestRewardClaim) (bool, error) {
+	if !s.rewardClaimLedgerActive(s.cache.currentHeight.Load()) {
+		return false, nil
+	}
+
+	pending := s.pendingRewardClaims
+	pending.mu.Lock()
+	defer pending.mu.Unlock()
+
+	rewardAmount, claims, err := s.rewardClaimLedger(ctx, s.db, attest)
+	if err != nil {
+		return false, err
+	}
+
+	key := pendingRewardClaimKey(attest)
+	if claim, ok := pending.claims[key]; ok {
+		onChain := false
+		for _, c := range claims {
+			onChain = onChain || strings.EqualFold(c.Attester, claim.Attester)
+		}
+		if onChain {
+			delete(pending.claims, key)
+		} else {
+			claims = append(claims, claim)
+		}
+	}
+
+	recorded, err := checkRewardClaim(claims, s.config.WalletAddress, attest.EthRecipientAddress, attest.Amount, rewardAmount)
+	if err != nil || recorded {
+		return false, err
+	}
+
+	pending.claims[key] = db.CoreRewardClaim{
+		RewardAddress:       attest.RewardAddress,
+		RewardID:            attest.RewardId,
+		Specifier:           attest.Specifier,
+		EthRecipientAddress: attest.EthRecipientAddress,
+		Amount:              int64(attest.Amount),
+		Attester:            s.config.WalletAddress,
+	}
+	return true, nil
+}
+
+// recordRewardClaim puts the claim this node attested to on chain, other validators
+// check it before attesting to the same specifier. The claim stays pending until it's
+// on chain, even if this fails, so this node never attests to a conflicting one.
+func (s *Server) recordRewardClaim(claim *corev1.AttestRewardClaim) {
+	// the caller may still be reading its claim
+	attest := proto.Clone(claim).(*corev1.AttestRewardClaim)
+	go func() {
+		attest.DeadlineBlockHeight = s.cache.currentHeight.Load() + rewardClaimDeadlineBlocks
+		sig, err := common.SignAttestRewardClaim(s.config.EthereumKey, attest)
+		if err != nil {
+			s.logger.Error("could not sign reward claim", zap.Error(err))
+			return
+		}
+		attest.Signature = sig
+
+		tx := &corev1.SignedTransaction{
+			RequestId: uuid.NewString(),
+			Transaction: &corev1.SignedTransaction_Reward{
+				Reward: &corev1.RewardMessage{
+					Action: &corev1.RewardMessage_Attest{Attest: attest},
+				},
+			},
+		}
+		txhash, err := s.self.SendTransaction(context.Background(), connect.NewRequest(&corev1.SendTransactionRequest{Transaction: tx}))
+		if err != nil {
+			s.logger.Error("send reward claim tx failed", zap.String("specifier", attest.Specifier), zap.Error(err))
+			return
+		}
+		s.logger.Info("recorded reward claim", zap.String("specifier", attest.Specifier), zap.String("receipt", txhash.Msg.Transaction.Hash))
+	}()
+}
+
+func (s *Server) validateAttestRewardClaim(ctx context.Context, attest *corev1.AttestRewardClaim, blockHeight int64) error {
+	if !s.rewardClaimLedgerActive(blockHeight) {
+		return fmt.Errorf("%w: reward claims aren't recorded yet", ErrRewardMessageValidation)
+	}
+	signer, err := s.validateAttestRewardClaimSigner(ctx, s.db, attest, blockHeight)
+	if err != nil {
+		return err
+	}
+	if _, err := s.checkRewardClaimLedger(ctx, s.db, attest, signer); err != nil {
+		return fmt.Errorf("%w: %w", ErrRewardMessageValidation, err)
+	}
+	return nil
+}
+
+func (s *Server) validateAttestRewardClaimSigner(ctx context.Context, q *db.Queries, attest *corev1.AttestRewardClaim, blockHeight int64) (string, error) {
+	if attest.Specifier == "" || attest.EthRecipientAddress == "" || attest.Amount == 0 {
+		return "", fmt.Errorf("%w: incomplete reward claim", ErrRewardMessageValidation)
+	}
+	signatureData := common.CreateDeterministicAttestRewardClaimData(attest)
+	signer, err := s.validateRewardSignature(blockHeight, attest.Signature, attest.DeadlineBlockHeight, signatureData)
+	if err != nil {
+		return "", fmt.Errorf("attest reward claim validation failed: %w", err)
+	}
+	if _, err := q.GetRegisteredNodeByEthAddress(ctx, signer); err != nil {
+		return "", fmt.Errorf("%w: %s is not a registered validator", ErrRewardUnauthorized, signer)
+	}
+	return signer, nil
+}
+
+func (s *Server) finalizeAttestRewardClaim(ctx context.Context, req *abcitypes.FinalizeBlockRequest, txhash string, attest *corev1.AttestRewardClaim) error {
+	qtx := s.getDb()
+	signer, err := s.validateAttestRewardClaimSigner(ctx, qtx, attest, req.Height)
+	if err != nil {
+		return err
+	}
+	// another claim in this block may have used up the reward
+	recorded, err := s.checkRewardClaimLedger(ctx, qtx, attest, signer)
+	if err != nil {
+		return err
+	} else if recorded {
+		return nil
+	}
+
+	claim := db.InsertRewardClaimParams{
+		RewardAddress:       attest.RewardAddress,
+		RewardID:            attest.RewardId,
+		Specifier:           attest.Specifier,
+		EthRecipientAddress: attest.EthRecipientAddress,
+		Amount:              int64(attest.Amount),
+		Attester:            signer,
+		TxHash:              txhash,
+		BlockHeight:         req.Height,
+	}
+	if err := qtx.InsertRewardClaim(ctx, claim); err != nil {
+		return fmt.Errorf("failed to insert reward claim: %w", err)
+	}
+	s.stateInsert(rewardClaimStateRow(claim))
+	return nil
+}
+
+// groups a recipient's recorded attestations by the claim they attest to
+func rewardClaimsFromRows(rows []db.CoreRewardClaim) []*corev1.RewardClaim {
+	claims := []*corev1.RewardClaim{}
+	index := map[string]*corev1.RewardClaim{}
+	for _, row := range rows {
+		key := fmt.Sprintf("%s:%s:%s:%s:%d", row.RewardAddress, row.RewardID, row.Specifier, strings.ToLower(row.EthRecipientAddress), row.Amount)
+		claim, ok := index[key]
+		if !ok {
+			claim = &corev1.RewardClaim{
+				RewardAddress:       row.RewardAddress,
+				RewardId:            row.RewardID,
+				Specifier:           row.Specifier,
+				EthRecipientAddress: row.EthRecipientAddress,
+				Amount:              uint64(row.Amount),
+				BlockHeight:         row.BlockHeight,
+			}
+			index[key] = claim
+			claims = append(claims, claim)
+		}
+		claim.Attesters = append(claim.Attesters, row.Attester)
+	}
+	return claims
+}
@@ -0,0 +1,131 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	corev1 "github.com/AudiusProject/audiusd/pkg/api/core/v1"
+	"github.com/AudiusProject/audiusd/pkg/core/config"
+	"github.com/AudiusProject/audiusd/pkg/core/db"
+	"github.com/AudiusProject/audiusd/pkg/rewards"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckRewardClaim(t *testing.T) {
+	claim := func(attester, recipient string, amount int64) db.CoreRewardClaim {
+		return db.CoreRewardClaim{Attester: attester, EthRecipientAddress: recipient, Amount: amount}
+	}
+
+	recorded, err := checkRewardClaim(nil, "0xA", "0xR1", 5, 5)
+	require.NoError(t, err)
+	require.False(t, recorded)
+
+	_, err = checkRewardClaim(nil, "0xA", "0xR1", 6, 5)
+	require.ErrorIs(t, err, ErrRewardClaimExceedsAmount)
+
+	claims := []db.CoreRewardClaim{claim("0xA", "0xR1", 5)}
+
+	// other validators attest to the same claim for their quorum
+	recorded, err = checkRewardClaim(claims, "0xB", "0xr1", 5, 5)
+	require.NoError(t, err)
+	require.False(t, recorded)
+
+	// asking the same validator again is answered without recording twice
+	recorded, err = checkRewardClaim(claims, "0xa", "0xR1", 5, 5)
+	require.NoError(t, err)
+	require.True(t, recorded)
+
+	// the specifier was already paid out in full
+	_, err = checkRewardClaim(claims, "0xB", "0xR2", 5, 5)
+	require.ErrorIs(t, err, ErrRewardClaimExceedsAmount)
+
+	// one claim per specifier from each validator
+	_, err = checkRewardClaim(claims, "0xA", "0xR2", 5, 10)
+	require.ErrorIs(t, err, ErrRewardClaimAlreadyAttested)
+
+	// split rewards may add up to the amount
+	claims = append(claims, claim("0xB", "0xR2", 3))
+	_, err = checkRewardClaim(claims, "0xC", "0xR3", 2, 10)
+	require.NoError(t, err)
+	_, err = checkRewardClaim(claims, "0xC", "0xR3", 3, 10)
+	require.ErrorIs(t, err, ErrRewardClaimExceedsAmount)
+}
+
+func TestBeforeRewardClaimAttestationConcurrent(t *testing.T) {
+	var ledger []db.CoreRewardClaim
+	fake := newFakeDB().on("GetReward", func(args ...any) ([][]any, error) {
+		if args[0] != "0xreward" {
+			return nil, nil
+		}
+		return [][]any{{int64(1), "0xreward", int64(0), "tx", "0xsender", "r", "reward", int64(5), []string{"0xauth"}, []byte(nil), int64(1), nil, nil}}, nil
+	}).on("GetRewardClaimsForSpecifier", func(args ...any) ([][]any, error) {
+		// widen the window between reading the ledger and signing
+		time.Sleep(10 * time.Millisecond)
+		rows := [][]any{}
+		for _, c := range ledger {
+			rows = append(rows, []any{c.RewardAddress, c.RewardID, c.Specifier, c.EthRecipientAddress, c.Amount, c.Attester, c.TxHash, c.BlockHeight})
+		}
+		return rows, nil
+	})
+	s := &Server{
+		config:              &config.Config{RewardClaimLedgerHeight: 1, WalletAddress: "0xNode"},
+		db:                  db.New(fake),
+		cache:               &Cache{},
+		rewards:             &rewards.RewardAttester{Rewards: []rewards.Reward{{RewardId: "r", Amount: 50}}}, // never read for the amount
+		pendingRewardClaims: newPendingRewardClaims(),
+	}
+	s.cache.currentHeight.Store(1)
+
+	claim := func(recipient string) *corev1.AttestRewardClaim {
+		return &corev1.AttestRewardClaim{RewardAddress: "0xreward", RewardId: "r", Specifier: "s", EthRecipientAddress: recipient, Amount: 5}
+	}
+	concurrently := func(claims ...*corev1.AttestRewardClaim) ([]bool, []error) {
+		unrecorded := make([]bool, len(claims))
+		errs := make([]error, len(claims))
+		var wg sync.WaitGroup
+		for i, c := range claims {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				unrecorded[i], errs[i] = s.beforeRewardClaimAttestation(context.Background(), c)
+			}()
+		}
+		wg.Wait()
+		return unrecorded, errs
+	}
+
+	// only one of two different claims for the specifier gets attested
+	unrecorded, errs := concurrently(claim("0xR1"), claim("0xR2"))
+	require.ElementsMatch(t, []bool{true, false}, unrecorded)
+	require.Len(t, errs, 2)
+	if errs[0] == nil {
+		require.ErrorIs(t, errs[1], ErrRewardClaimAlreadyAttested)
+	} else {
+		require.ErrorIs(t, errs[0], ErrRewardClaimAlreadyAttested)
+		require.NoError(t, errs[1])
+	}
+	attested := claim("0xR1")
+	if unrecorded[1] {
+		attested = claim("0xR2")
+	}
+
+	// asking for the pending claim again attests without recording it twice
+	unrecorded, errs = concurrently(attested, attested)
+	require.Equal(t, []bool{false, false}, unrecorded)
+	require.Equal(t, []error{nil, nil}, errs)
+
+	// once the claim is on chain the ledger takes over from the pending claim
+	ledger = []db.CoreRewardClaim{{RewardAddress: "0xreward", RewardID: "r", Specifier: "s", EthRecipientAddress: attested.EthRecipientAddress, Amount: 5, Attester: "0xnode"}}
+	unrecorded, errs = concurrently(attested)
+	require.Equal(t, []bool{false}, unrecorded)
+	require.Equal(t, []error{nil}, errs)
+	require.Empty(t, s.pendingRewardClaims.claims)
+
+	// claims for rewards that aren't on chain are refused
+	_, errs = concurrently(&corev1.AttestRewardClaim{RewardId: "r", Specifier: "s2", EthRecipientAddress: "0xR1", Amount: 5})
+	require.ErrorIs(t, errs[0], ErrRewardClaimUnknownReward)
+	_, errs = concurrently(&corev1.AttestRewardClaim{RewardAddress: "0xother", RewardId: "r", Specifier: "s2", EthRecipientAddress: "0xR1", Amount: 5})
+	require.ErrorIs(t, errs[0], ErrRewardClaimUnknownReward)
+}
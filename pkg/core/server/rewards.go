@@ -70,6 +70,8 @@ func (s *Server) getRewardAttestation(c echo.Context) error {
 		return c.JSON(http.StatusUnauthorized, err.Error())
 	}
 
+	// rewards from the node config aren't on chain, their claims can't be checked against
+	// the reward claim ledger, see GetRewardAttestation
 	_, attestation, err := s.rewards.Attest(claim)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, err.Error())
@@ -101,6 +103,8 @@ func (s *Server) isValidRewardTransaction(ctx context.Context, signedTx *corev1.
 		return s.validateCreateReward(ctx, action.Create, blockHeight)
 	case *corev1.RewardMessage_Delete:
 		return s.validateDeleteReward(ctx, action.Delete, blockHeight)
+	case *corev1.RewardMessage_Attest:
+		return s.validateAttestRewardClaim(ctx, action.Attest, blockHeight)
 	default:
 		return fmt.Errorf("%w: unsupported reward action type", ErrRewardMessageValidation)
 	}
@@ -190,6 +194,12 @@ func (s *Server) finalizeRewards(ctx context.Context, req *abcitypes.FinalizeBlo
 		}
 		return nil
 
+	case *corev1.RewardMessage_Attest:
+		if err := s.finalizeAttestRewardClaim(ctx, req, txhash, action.Attest); err != nil {
+			return errors.Join(ErrRewardMessageFinalization, err)
+		}
+		return nil
+
 	default:
 		return fmt.Errorf("tx: %s, message index: %d, unsupported reward action type", txhash, messageIndex)
 	}
@@ -223,7 +233,7 @@ func (s *Server) finalizeCreateReward(ctx context.Context, req *abcitypes.Finali
 	}
 
 	qtx := s.getDb()
-	if err := qtx.InsertCoreReward(ctx, db.InsertCoreRewardParams{
+	rewardParams := db.InsertCoreRewardParams{
 		TxHash:           txhash,
 		Index:            messageIndex,
 		Address:          rewardAddress,
@@ -234,9 +244,12 @@ func (s *Server) finalizeCreateReward(ctx context.Context, req *abcitypes.Finali
 		ClaimAuthorities: claimAuthorities,
 		RawMessage:       rawMessage,
 		BlockHeight:      req.Height,
-	}); err != nil {
+	}
+	if err := qtx.InsertCoreReward(ctx, rewardParams); err != nil {
 		return fmt.Errorf("failed to insert reward: %w", err)
 	}
+	s.stateInsert(rewardStateRow(rewardParams))
+	s.stateTouch("reward", rewardParams.Address)
 
 	return nil
 }
@@ -268,9 +281,14 @@ func (s *Server) finalizeDeleteReward(ctx context.Context, req *abcitypes.Finali
 	}
 
 	qtx := s.getDb()
-	if err := qtx.DeleteCoreReward(ctx, deleteReward.Address); err != nil {
+	deleted, err := qtx.DeleteCoreReward(ctx, deleteReward.Address)
+	if err != nil {
 		return fmt.Errorf("failed to delete reward: %w", err)
 	}
+	for _, reward := range deleted {
+		s.stateDelete(rewardStateRow(rewardRowParams(reward)))
+	}
+	s.stateTouch("reward", deleteReward.Address)
 
 	return nil
 }
@@ -8,6 +8,7 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"github.com/AudiusProject/audiusd/pkg/core/config"
 	"github.com/AudiusProject/audiusd/pkg/rewards"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/labstack/echo/v4"
@@ -42,7 +43,7 @@ func TestGetRewardAttestation(t *testing.T) {
 	// Setup
 	e := echo.New()
 	attester := rewards.NewRewardAttester(privKey, TestRewards)
-	s := &Server{rewards: attester}
+	s := &Server{rewards: attester, config: &config.Config{}, cache: &Cache{}}
 
 	if err != nil {
 		log.Fatalf("couldn't create server for test: %s", err)
@@ -33,7 +33,7 @@ type Server struct {
 	config         *config.Config
 	cometbftConfig *cconfig.Config
 	logger         *zap.Logger
-	self           corev1connect.CoreServiceClient
+	self           corev1connect.CoreServiceHandler
 	eth            *eth.EthService
 
 	httpServer         *echo.Echo
@@ -50,6 +50,7 @@ type Server struct {
 	cometRPCPeers    *safemap.SafeMap[EthAddress, *CometBFTRPC]
 	cometListenAddrs *safemap.SafeMap[CometBFTAddress, CometBFTListener]
 	peerStatus       *safemap.SafeMap[EthAddress, *v1.GetStatusResponse_PeerInfo_Peer]
+	pendingProofs    *safemap.SafeMap[int64, *pendingStorageProof]
 
 	txPubsub       *TransactionHashPubsub
 	blockNumPubsub *BlockNumPubsub
@@ -57,7 +58,8 @@ type Server struct {
 	cache     *Cache
 	abciState *ABCIState
 
-	rewards *rewards.RewardAttester
+	rewards             *rewards.RewardAttester
+	pendingRewardClaims *pendingRewardClaims
 
 	awaitHttpServerReady chan struct{}
 	awaitRpcReady        chan struct{}
@@ -96,6 +98,7 @@ func NewServer(lc *lifecycle.Lifecycle, config *config.Config, cconfig *cconfig.
 		cometRPCPeers:    safemap.New[EthAddress, *CometBFTRPC](),
 		cometListenAddrs: safemap.New[CometBFTAddress, CometBFTListener](),
 		peerStatus:       safemap.New[EthAddress, *v1.GetStatusResponse_PeerInfo_Peer](),
+		pendingProofs:    safemap.New[int64, *pendingStorageProof](),
 		txPubsub:         txPubsub,
 		blockNumPubsub:   blockNumPubsub,
 		cache:            NewCache(config),
@@ -104,7 +107,8 @@ func NewServer(lc *lifecycle.Lifecycle, config *config.Config, cconfig *cconfig.
 		httpServer: httpServer,
 		grpcServer: grpcServer,
 
-		rewards: rewards.NewRewardAttester(config.EthereumKey, config.Rewards),
+		rewards:             rewards.NewRewardAttester(config.EthereumKey, config.Rewards),
+		pendingRewardClaims: newPendingRewardClaims(),
 
 		awaitHttpServerReady: make(chan struct{}),
 		awaitRpcReady:        make(chan struct{}),
@@ -133,7 +137,7 @@ func (s *Server) Start() error {
 	return fmt.Errorf("core stopped or shut down")
 }
 
-func (s *Server) setSelf(self corev1connect.CoreServiceClient) {
+func (s *Server) setSelf(self corev1connect.CoreServiceHandler) {
 	s.self = self
 }
 
@@ -24,24 +24,46 @@ func (s *Server) getSlashAttestation(ctx context.Context, slash *v1.SlashRecomme
 	if slash == nil {
 		return signature, errors.New("nil slash recommendation")
 	}
-	serviceProviderAddress := slash.Address
+
+	calculatedRecommendation, _, err := s.calculateSlashRecommendationForServiceProvider(ctx, slash.Address, slash.Start.AsTime(), slash.End.AsTime())
+	if err != nil {
+		return signature, err
+	}
+	if slash.Amount != calculatedRecommendation {
+		s.logger.Info("slash amounts do not match", zap.Int64("requested_slash", slash.Amount), zap.Int64("calculated_slash", calculatedRecommendation))
+		return signature, errors.New("slash amounts do not match")
+	}
+
+	signature, err = SignSlashRecommendation(s.config.EthereumKey, slash)
+	if err != nil {
+		s.logger.Error("could not sign slash recommendation", zap.Error(err))
+		return signature, err
+	}
+	return signature, nil
+}
+
+// calculates the slash recommendation for all of a service provider's endpoints from this node's
+// sla rollups, returns the amount and the number of missed slas it was based on
+func (s *Server) calculateSlashRecommendationForServiceProvider(ctx context.Context, serviceProviderAddress string, start, end time.Time) (int64, int, error) {
 	endpointsResp, err := s.eth.GetRegisteredEndpointsForServiceProvider(
 		ctx,
 		connect.NewRequest(&ethv1.GetRegisteredEndpointsForServiceProviderRequest{Owner: serviceProviderAddress}),
 	)
 	if err != nil {
 		s.logger.Error("Failed to get service provider endpoints", zap.String("address", serviceProviderAddress), zap.Error(err))
-		return signature, err
+		return 0, 0, err
 	}
 	endpoints := endpointsResp.Msg.Endpoints
 	totalMissedSlas, totalSlas := 0, 0
+	challengesReceived, challengesFailed := 0, 0
+	lastRollupHeight := int64(0)
 	for _, ep := range endpoints {
 		// Get the comet address for each endpoint, if possible
 		var cometAddress string
 		validator, err := s.db.GetNodeByEndpoint(ctx, ep.Endpoint)
 		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
 			s.logger.Error("Failed to get cometbft validator for endpoint", zap.String("endpoint", ep.Endpoint), zap.Error(err))
-			return signature, err
+			return 0, 0, err
 		} else if errors.Is(err, pgx.ErrNoRows) {
 			// Endpoint is registered on eth but not on comet.
 			// Attempt to get comet address from validator history
@@ -54,7 +76,7 @@ func (s *Server) getSlashAttestation(ctx context.Context, slash *v1.SlashRecomme
 			)
 			if err != nil && !errors.Is(err, pgx.ErrNoRows) {
 				s.logger.Error("Failed to get validator history for endpoint", zap.String("endpoint", ep.Endpoint), zap.Error(err))
-				return signature, err
+				return 0, 0, err
 			} else if err == nil {
 				cometAddress = history.CometAddress
 			}
@@ -67,34 +89,27 @@ func (s *Server) getSlashAttestation(ctx context.Context, slash *v1.SlashRecomme
 			ctx,
 			db.GetRollupReportsForNodeInTimeRangeParams{
 				Address: cometAddress,
-				Time:    s.db.ToPgxTimestamp(slash.Start.AsTime()),
-				Time_2:  s.db.ToPgxTimestamp(slash.End.AsTime()),
+				Time:    s.db.ToPgxTimestamp(start),
+				Time_2:  s.db.ToPgxTimestamp(end),
 			},
 		)
 		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
-			s.logger.Error("Failed to get rollups from db for node", zap.String("address", cometAddress), zap.Time("start_time", slash.Start.AsTime()), zap.Time("end_time", slash.End.AsTime()), zap.Error(err))
-			return signature, err
+			s.logger.Error("Failed to get rollups from db for node", zap.String("address", cometAddress), zap.Time("start_time", start), zap.Time("end_time", end), zap.Error(err))
+			return 0, 0, err
 		}
 		for _, rollup := range slaRollups {
 			totalSlas += 1
 			if rollup.BlocksProposed.Int32 == 0 {
 				totalMissedSlas += 1
 			}
+			challengesReceived += int(rollup.ChallengesReceived.Int32)
+			challengesFailed += int(rollup.ChallengesFailed.Int32)
+			lastRollupHeight = max(lastRollupHeight, rollup.BlockEnd)
 		}
 	}
 
-	calculatedRecommendation := CalculateSlashRecommendation(slash.Start.AsTime(), slash.End.AsTime(), len(endpoints), totalSlas, totalMissedSlas)
-	if slash.Amount != calculatedRecommendation {
-		s.logger.Info("slash amounts do not match", zap.Int64("requested_slash", slash.Amount), zap.Int64("calculated_slash", calculatedRecommendation))
-		return signature, errors.New("slash amounts do not match")
-	}
-
-	signature, err = SignSlashRecommendation(s.config.EthereumKey, slash)
-	if err != nil {
-		s.logger.Error("could not sign slash recommendation", zap.Error(err))
-		return signature, err
-	}
-	return signature, nil
+	storageProofs := s.slaStorageProofsActive(lastRollupHeight)
+	return CalculateSlashRecommendation(start, end, len(endpoints), totalSlas, totalMissedSlas, challengesReceived, challengesFailed, storageProofs), totalMissedSlas, nil
 }
 
 func SignSlashRecommendation(ethKey *ecdsa.PrivateKey, slash *corev1.SlashRecommendation) (string, error) {
@@ -121,11 +136,23 @@ func SignSlashRecommendation(ethKey *ecdsa.PrivateKey, slash *corev1.SlashRecomm
 //
 //	200k AUDIO is the minimum stake per endpoint.
 //	We therefore recommend slashing 200k AUDIO for a full year of zero sla performance
-//	from a single endpoint.
-//	$AUDIO to slash = $200k * number of endpoints * (days in selected interval / 365) * (zeroed SLAs / total SLAs)
-func CalculateSlashRecommendation(startTime, endTime time.Time, totalEndpoints, totalSlas, missedSlas int) int64 {
+//	from a single endpoint. Once the period's rollups report storage proofs, failing
+//	every storage proof challenge counts the same as missing every SLA, whichever rate
+//	is worse is used so a node that is down isn't penalized twice for it.
+//	$AUDIO to slash = $200k * number of endpoints * (days in selected interval / 365) * max(zeroed SLAs / total SLAs, failed challenges / received challenges)
+//
+// storageProofs is whether the last rollup of the period is at or after SlaStorageProofsHeight,
+// before that validators recommend from zeroed SLAs alone and so must every attester.
+func CalculateSlashRecommendation(startTime, endTime time.Time, totalEndpoints, totalSlas, missedSlas, challengesReceived, challengesFailed int, storageProofs bool) int64 {
 	periodDays := int64(endTime.Sub(startTime).Hours() / 24)
-	return int64(200000.0 * float64(totalEndpoints) * (float64(periodDays) / 365.0) * (float64(missedSlas) / float64(totalSlas)))
+	var missedRate, failedRate float64
+	if totalSlas > 0 {
+		missedRate = float64(missedSlas) / float64(totalSlas)
+	}
+	if storageProofs && challengesReceived > 0 {
+		failedRate = float64(challengesFailed) / float64(challengesReceived)
+	}
+	return int64(200000.0 * float64(totalEndpoints) * (float64(periodDays) / 365.0) * max(missedRate, failedRate))
 }
 
 func (s *Server) gatherSlashAttestations(ctx context.Context, slash *v1.SlashRecommendation) (map[string]string, error) {
@@ -0,0 +1,391 @@
+package server
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	v1 "github.com/AudiusProject/audiusd/pkg/api/core/v1"
+	ethv1 "github.com/AudiusProject/audiusd/pkg/api/eth/v1"
+	"github.com/AudiusProject/audiusd/pkg/common"
+	"github.com/AudiusProject/audiusd/pkg/core/config"
+	"github.com/AudiusProject/audiusd/pkg/core/db"
+	"github.com/AudiusProject/audiusd/pkg/eth"
+	"github.com/AudiusProject/audiusd/pkg/eth/contracts"
+	"github.com/AudiusProject/audiusd/pkg/eth/contracts/gen"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	slashProposerInterval = time.Hour
+	// how long each validator has to propose a slash against a service provider before
+	// the turn passes to the next one, see slashProposerFor
+	slashProposalTurn = 6 * time.Hour
+	// same window the console adjudicate page defaults to
+	slashProposalPeriod = 30 * 24 * time.Hour
+	// slashes at or below this are left to unearned rewards, matches the console
+	slashProposalThreshold = int64(10000)
+	// how long a submitted proposal keeps this node from proposing against the same
+	// service provider again, covers the time it takes governance to pick it up
+	slashProposalResubmitCooldown = slashProposalPeriod
+
+	slashFunctionSignature = "slash(uint256,address)"
+)
+
+// submits slash proposals to the governance contract, in dry run the
+// transaction is signed but never sent
+type slashProposalSubmitter struct {
+	governance *gen.Governance
+	key        *ecdsa.PrivateKey
+	chainID    *big.Int
+	dryRun     bool
+}
+
+func newSlashProposalSubmitter(backend bind.ContractBackend, governanceAddress ethcommon.Address, key *ecdsa.PrivateKey, chainID *big.Int, dryRun bool) (*slashProposalSubmitter, error) {
+	if key == nil {
+		return nil, errors.New("empty eth key")
+	}
+	governance, err := gen.NewGovernance(governanceAddress, backend)
+	if err != nil {
+		return nil, fmt.Errorf("could not bind governance contract: %v", err)
+	}
+	return &slashProposalSubmitter{
+		governance: governance,
+		key:        key,
+		chainID:    chainID,
+		dryRun:     dryRun,
+	}, nil
+}
+
+// returns the hash of the submitProposal transaction
+func (sp *slashProposalSubmitter) submit(ctx context.Context, slash *v1.SlashRecommendation, description string) (string, error) {
+	delegateManagerABI, err := gen.DelegateManagerMetaData.GetAbi()
+	if err != nil {
+		return "", fmt.Errorf("failed to get delegate manager abi: %v", err)
+	}
+	slashMethod, ok := delegateManagerABI.Methods["slash"]
+	if !ok {
+		return "", errors.New("could not retrieve slash method from DelegateManager abi")
+	}
+	callData, err := slashMethod.Inputs.Pack(contracts.AudioToWei(big.NewInt(slash.Amount)), ethcommon.HexToAddress(slash.Address))
+	if err != nil {
+		return "", fmt.Errorf("failed to pack slash call data: %v", err)
+	}
+
+	opts, err := bind.NewKeyedTransactorWithChainID(sp.key, sp.chainID)
+	if err != nil {
+		return "", fmt.Errorf("failed to create keyed transactor: %v", err)
+	}
+	opts.Context = ctx
+	opts.NoSend = sp.dryRun
+
+	tx, err := sp.governance.SubmitProposal(
+		opts,
+		contracts.DelegateManagerKey,
+		big.NewInt(0),
+		slashFunctionSignature,
+		callData,
+		fmt.Sprintf("Slash %s", slash.Address),
+		description,
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to submit slash proposal: %v", err)
+	}
+	return tx.Hash().Hex(), nil
+}
+
+// hasInProgressProposal asks governance itself whether a slash against address is being
+// voted on, the eth service only refreshes its copy of in progress proposals periodically
+func (sp *slashProposalSubmitter) hasInProgressProposal(ctx context.Context, address string) (bool, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	proposalIds, err := sp.governance.GetInProgressProposals(opts)
+	if err != nil {
+		return false, fmt.Errorf("could not get in progress proposals: %v", err)
+	}
+	for _, id := range proposalIds {
+		proposal, err := sp.governance.GetProposalById(opts, id)
+		if err != nil {
+			return false, fmt.Errorf("could not get proposal %s: %v", id, err)
+		}
+		if proposal.TargetContractRegistryKey != contracts.DelegateManagerKey || !strings.HasPrefix(proposal.FunctionSignature, "slash(") {
+			continue
+		}
+		slashAddr, _, err := eth.DecodeSlashProposalArguments(ethcommon.Bytes2Hex(proposal.CallData))
+		if err != nil {
+			continue
+		}
+		if strings.EqualFold(slashAddr.Hex(), address) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (s *Server) dialSlashProposalSubmitter(ctx context.Context) (*slashProposalSubmitter, func(), error) {
+	rpc, err := ethclient.DialContext(ctx, s.config.EthRPCUrl)
+	if err != nil {
+		return nil, nil, fmt.Errorf("eth client dial err: %v", err)
+	}
+	c, err := contracts.NewAudiusContracts(rpc, s.config.EthRegistryAddress)
+	if err != nil {
+		rpc.Close()
+		return nil, nil, fmt.Errorf("failed to initialize eth contracts: %v", err)
+	}
+	if _, err := c.GetGovernanceContract(); err != nil {
+		rpc.Close()
+		return nil, nil, fmt.Errorf("failed to get governance contract: %v", err)
+	}
+	chainID, err := rpc.ChainID(ctx)
+	if err != nil {
+		rpc.Close()
+		return nil, nil, fmt.Errorf("failed to get chain id: %v", err)
+	}
+	submitter, err := newSlashProposalSubmitter(rpc, *c.GovernanceAddress, s.config.EthereumKey, chainID, s.config.SlashProposals != config.SlashProposalsSubmit)
+	if err != nil {
+		rpc.Close()
+		return nil, nil, err
+	}
+	return submitter, rpc.Close, nil
+}
+
+func (s *Server) startSlashProposer(ctx context.Context) error {
+	ticker := time.NewTicker(slashProposerInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if s.cache.catchingUp.Load() {
+				continue
+			}
+			if err := s.proposeSlashes(ctx); err != nil {
+				s.logger.Error("could not propose slashes", zap.Error(err))
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// slashProposerFor picks the validator whose turn it is to propose slashing owner. Active
+// validators that owner doesn't operate are ranked by rendezvous hash of its address so
+// every node picks the same one, and every slashProposalTurn the turn passes to the next
+// in rank in case the current one is down or misses it.
+func slashProposerFor(owner string, validators []db.CoreValidator, operators map[string]string, now time.Time) string {
+	candidates := []string{}
+	for _, v := range validators {
+		if v.Jailed || strings.EqualFold(operators[strings.ToLower(v.EthAddress)], owner) {
+			continue
+		}
+		candidates = append(candidates, strings.ToLower(v.EthAddress))
+	}
+	if len(candidates) == 0 {
+		return ""
+	}
+	ranked := common.GetRendezvousRanking(candidates, []byte(strings.ToLower(owner)))
+	turn := now.Unix() / int64(slashProposalTurn/time.Second)
+	return ranked[turn%int64(len(ranked))]
+}
+
+// proposes a slash against every delinquent service provider whose turn this node has,
+// that a quorum of validators attests to and that governance isn't already voting on
+func (s *Server) proposeSlashes(ctx context.Context) error {
+	endpointsResp, err := s.eth.GetRegisteredEndpoints(ctx, connect.NewRequest(&ethv1.GetRegisteredEndpointsRequest{}))
+	if err != nil {
+		return fmt.Errorf("failed to get registered endpoints: %v", err)
+	}
+	// delegate wallet to the service provider operating it
+	operators := map[string]string{}
+	owners := []string{}
+	for _, ep := range endpointsResp.Msg.Endpoints {
+		operators[strings.ToLower(ep.DelegateWallet)] = ep.Owner
+		if !slices.Contains(owners, ep.Owner) {
+			owners = append(owners, ep.Owner)
+		}
+	}
+	slices.Sort(owners)
+
+	validators, err := s.db.GetAllRegisteredNodes(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get registered nodes: %v", err)
+	}
+
+	now := time.Now().UTC()
+	end := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	start := end.Add(-slashProposalPeriod)
+
+	var submitter *slashProposalSubmitter
+	for _, owner := range owners {
+		// validators are never candidates for slashing their own operator
+		if !strings.EqualFold(slashProposerFor(owner, validators, operators, now), s.config.WalletAddress) {
+			continue
+		}
+
+		amount, missedSlas, err := s.calculateSlashRecommendationForServiceProvider(ctx, owner, start, end)
+		if err != nil {
+			s.logger.Error("could not calculate slash recommendation", zap.String("address", owner), zap.Error(err))
+			continue
+		}
+		if amount <= slashProposalThreshold {
+			continue
+		}
+
+		if pending, err := s.hasPendingSlashProposal(ctx, owner); err != nil {
+			s.logger.Error("could not check for pending slash proposal", zap.String("address", owner), zap.Error(err))
+			continue
+		} else if pending {
+			continue
+		}
+
+		slash := &v1.SlashRecommendation{
+			Address:    owner,
+			Start:      timestamppb.New(start),
+			End:        timestamppb.New(end),
+			MissedSLAs: int32(missedSlas),
+			Amount:     amount,
+		}
+		attestations, err := s.gatherSlashAttestations(ctx, slash)
+		if err != nil {
+			s.logger.Error("could not gather slash attestations", zap.String("address", owner), zap.Error(err))
+			continue
+		}
+
+		if len(attestations) < s.config.AttRegistrationMin {
+			err = fmt.Errorf("only %d of %d required attestations", len(attestations), s.config.AttRegistrationMin)
+			s.recordSlashProposal(ctx, slash, len(attestations), "", err)
+			continue
+		}
+
+		if submitter == nil {
+			var closeRpc func()
+			submitter, closeRpc, err = s.dialSlashProposalSubmitter(ctx)
+			if err != nil {
+				return err
+			}
+			defer closeRpc()
+		}
+		// the previous submitter may have proposed after the eth service last refreshed
+		if pending, err := submitter.hasInProgressProposal(ctx, owner); err != nil {
+			s.logger.Error("could not check governance for slash proposal", zap.String("address", owner), zap.Error(err))
+			continue
+		} else if pending {
+			continue
+		}
+
+		signature, err := SignSlashRecommendation(s.config.EthereumKey, slash)
+		if err != nil {
+			return err
+		}
+		txHash, err := submitter.submit(ctx, slash, s.slashProposalDescription(slash, signature, attestations))
+		s.recordSlashProposal(ctx, slash, len(attestations), txHash, err)
+	}
+	return nil
+}
+
+// a service provider has a pending proposal if governance is already voting on one, or if
+// this node submitted one recently that may not have been indexed yet
+func (s *Server) hasPendingSlashProposal(ctx context.Context, address string) (bool, error) {
+	_, err := s.eth.GetActiveSlashProposalForAddress(ctx, connect.NewRequest(&ethv1.GetActiveSlashProposalForAddressRequest{Address: address}))
+	if err == nil {
+		return true, nil
+	}
+	var connectErr *connect.Error
+	if !errors.As(err, &connectErr) || connectErr.Code() != connect.CodeNotFound {
+		return false, err
+	}
+
+	latest, err := s.db.GetLatestSlashProposalForAddress(ctx, address)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, err
+	}
+	submitted := !latest.DryRun && latest.TxHash != "" && latest.Error == ""
+	return submitted && time.Since(latest.CreatedAt.Time) < slashProposalResubmitCooldown, nil
+}
+
+func (s *Server) recordSlashProposal(ctx context.Context, slash *v1.SlashRecommendation, attestations int, txHash string, submitErr error) {
+	logger := s.logger.With(zap.String("address", slash.Address), zap.Int64("amount", slash.Amount), zap.Int("attestations", attestations))
+	var errMsg string
+	if submitErr != nil {
+		errMsg = submitErr.Error()
+		logger.Error("could not submit slash proposal", zap.Error(submitErr))
+	} else {
+		logger.Info("submitted slash proposal", zap.String("tx", txHash), zap.Bool("dry_run", s.config.SlashProposals != config.SlashProposalsSubmit))
+	}
+	if err := s.db.InsertSlashProposal(ctx, db.InsertSlashProposalParams{
+		Address:      slash.Address,
+		Amount:       slash.Amount,
+		StartTime:    s.db.ToPgxTimestamp(slash.Start.AsTime()),
+		EndTime:      s.db.ToPgxTimestamp(slash.End.AsTime()),
+		Attestations: int32(attestations),
+		DryRun:       s.config.SlashProposals != config.SlashProposalsSubmit,
+		TxHash:       txHash,
+		Error:        errMsg,
+	}); err != nil {
+		logger.Error("could not record slash proposal", zap.Error(err))
+	}
+}
+
+// same layout as the proposal the console adjudicate page drafts
+func (s *Server) slashProposalDescription(slash *v1.SlashRecommendation, signature string, attestations map[string]string) string {
+	adjudicateURL := func(endpoint string) string {
+		v := url.Values{}
+		v.Set("start", slash.Start.AsTime().Format("2006-01-02"))
+		v.Set("end", slash.End.AsTime().Format("2006-01-02"))
+		return fmt.Sprintf("%s/console/adjudicate/%s?%s", endpoint, slash.Address, v.Encode())
+	}
+
+	endpoints := make([]string, 0, len(attestations))
+	for endpoint := range attestations {
+		endpoints = append(endpoints, endpoint)
+	}
+	slices.Sort(endpoints)
+	proofs := make([]string, len(endpoints))
+	for i, endpoint := range endpoints {
+		proofs[i] = fmt.Sprintf("* %s,[%s](%s)", attestations[endpoint], endpoint, adjudicateURL(endpoint))
+	}
+
+	return fmt.Sprintf(`We recommend slashing %d $AUDIO from %s for consistently failing to meet SLA.
+
+Proof:
+
+`+"```"+`
+{
+  start: %s,
+  end: %s,
+  missedSLAs: %d,
+  Amount: %d
+}
+`+"```"+`
+
+Signature: %s
+Produced by [%s](%s)
+
+Additional Proofs
+
+%s`,
+		slash.Amount,
+		slash.Address,
+		slash.Start.AsTime().Format("2006-01-02"),
+		slash.End.AsTime().Format("2006-01-02"),
+		slash.MissedSLAs,
+		slash.Amount,
+		signature,
+		s.config.NodeEndpoint,
+		adjudicateURL(s.config.NodeEndpoint),
+		strings.Join(proofs, "\n"),
+	)
+}
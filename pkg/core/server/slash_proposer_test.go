@@ -0,0 +1,115 @@
+package server
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	v1 "github.com/AudiusProject/audiusd/pkg/api/core/v1"
+	"github.com/AudiusProject/audiusd/pkg/core/db"
+	"github.com/AudiusProject/audiusd/pkg/eth"
+	"github.com/AudiusProject/audiusd/pkg/eth/contracts"
+	"github.com/AudiusProject/audiusd/pkg/eth/contracts/gen"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestSlashProposalSubmitter(t *testing.T) {
+	ctx := context.Background()
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	proposer := crypto.PubkeyToAddress(key.PublicKey)
+	// any code will do, the submitter only needs the call to go through
+	governanceAddress := ethcommon.HexToAddress("0x00000000000000000000000000000000000000aa")
+
+	backend := simulated.NewBackend(types.GenesisAlloc{
+		proposer:          {Balance: new(big.Int).Mul(big.NewInt(1e18), big.NewInt(100))},
+		governanceAddress: {Code: []byte{0x00}},
+	})
+	defer backend.Close()
+	client := backend.Client()
+	chainID, err := client.ChainID(ctx)
+	require.NoError(t, err)
+
+	end := time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)
+	slash := &v1.SlashRecommendation{
+		Address: "0x1D3aA5e83B5D3F0A1BcbAB9dCB6c7E83b5fe5D1B",
+		Start:   timestamppb.New(end.Add(-slashProposalPeriod)),
+		End:     timestamppb.New(end),
+		Amount:  15000,
+	}
+
+	// dry run builds the transaction but never sends it
+	dryRun, err := newSlashProposalSubmitter(client, governanceAddress, key, chainID, true)
+	require.NoError(t, err)
+	txHash, err := dryRun.submit(ctx, slash, "dry run")
+	require.NoError(t, err)
+	require.NotEmpty(t, txHash)
+	backend.Commit()
+	nonce, err := client.NonceAt(ctx, proposer, nil)
+	require.NoError(t, err)
+	require.Zero(t, nonce)
+
+	submitter, err := newSlashProposalSubmitter(client, governanceAddress, key, chainID, false)
+	require.NoError(t, err)
+	txHash, err = submitter.submit(ctx, slash, "submit")
+	require.NoError(t, err)
+	backend.Commit()
+
+	tx, pending, err := client.TransactionByHash(ctx, ethcommon.HexToHash(txHash))
+	require.NoError(t, err)
+	require.False(t, pending)
+	require.Equal(t, governanceAddress, *tx.To())
+
+	governanceABI, err := gen.GovernanceMetaData.GetAbi()
+	require.NoError(t, err)
+	method, err := governanceABI.MethodById(tx.Data()[:4])
+	require.NoError(t, err)
+	require.Equal(t, "submitProposal", method.Name)
+	args, err := method.Inputs.Unpack(tx.Data()[4:])
+	require.NoError(t, err)
+	require.Equal(t, contracts.DelegateManagerKey, args[0])
+	require.Equal(t, slashFunctionSignature, args[2])
+	require.Equal(t, "submit", args[5])
+
+	slashAddr, slashAmount, err := eth.DecodeSlashProposalArguments(ethcommon.Bytes2Hex(args[3].([]byte)))
+	require.NoError(t, err)
+	require.Equal(t, ethcommon.HexToAddress(slash.Address), slashAddr)
+	require.Equal(t, slash.Amount, contracts.WeiToAudio(slashAmount).Int64())
+}
+
+func TestSlashProposerFor(t *testing.T) {
+	validators := []db.CoreValidator{
+		{EthAddress: "0xA1"},
+		{EthAddress: "0xa2"},
+		{EthAddress: "0xa3"},
+		{EthAddress: "0xa4", Jailed: true},
+		{EthAddress: "0xa5"},
+	}
+	// 0xa5 is operated by the service provider being slashed
+	operators := map[string]string{"0xa5": "0xSP", "0xa1": "0xOther"}
+	now := time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)
+
+	proposer := slashProposerFor("0xSP", validators, operators, now)
+	require.Contains(t, []string{"0xa1", "0xa2", "0xa3"}, proposer)
+
+	// every node agrees on the proposer however it lists the validators
+	reversed := []db.CoreValidator{validators[4], validators[3], validators[2], validators[1], validators[0]}
+	require.Equal(t, proposer, slashProposerFor("0xsp", reversed, operators, now))
+	require.Equal(t, proposer, slashProposerFor("0xSP", validators, operators, now.Add(slashProposalTurn-time.Second)))
+
+	// a missed turn passes to the next candidate, each gets one before it comes back around
+	turns := map[string]int{}
+	for i := range 3 {
+		turns[slashProposerFor("0xSP", validators, operators, now.Add(time.Duration(i)*slashProposalTurn))]++
+	}
+	require.Equal(t, map[string]int{"0xa1": 1, "0xa2": 1, "0xa3": 1}, turns)
+	require.Equal(t, proposer, slashProposerFor("0xSP", validators, operators, now.Add(3*slashProposalTurn)))
+
+	require.Empty(t, slashProposerFor("0xSP", validators[3:], operators, now))
+}
@@ -0,0 +1,28 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalculateSlashRecommendation(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, 365)
+
+	require.Equal(t, int64(0), CalculateSlashRecommendation(start, end, 1, 10, 0, 0, 0, true))
+	require.Equal(t, int64(200000), CalculateSlashRecommendation(start, end, 1, 10, 10, 0, 0, true))
+	require.Equal(t, int64(100000), CalculateSlashRecommendation(start, end, 2, 10, 0, 8, 2, true))
+
+	// failing every challenge is as bad as missing every sla
+	require.Equal(t, CalculateSlashRecommendation(start, end, 1, 10, 10, 0, 0, true), CalculateSlashRecommendation(start, end, 1, 10, 0, 4, 4, true))
+	// a node that was down is not penalized twice
+	require.Equal(t, int64(100000), CalculateSlashRecommendation(start, end, 1, 10, 5, 10, 5, true))
+	// no rollups in the period
+	require.Equal(t, int64(0), CalculateSlashRecommendation(start, end, 1, 0, 0, 0, 0, true))
+
+	// before rollups report storage proofs only zeroed slas count
+	require.Equal(t, int64(0), CalculateSlashRecommendation(start, end, 1, 10, 0, 4, 4, false))
+	require.Equal(t, int64(100000), CalculateSlashRecommendation(start, end, 1, 10, 5, 10, 10, false))
+}
@@ -0,0 +1,348 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/AudiusProject/audiusd/pkg/core/config"
+	"github.com/AudiusProject/audiusd/pkg/core/db"
+	v1 "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmtstate "github.com/cometbft/cometbft/api/cometbft/state/v1"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	cconfig "github.com/cometbft/cometbft/config"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/store"
+	"github.com/cometbft/cometbft/types"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+	"gocloud.dev/blob"
+	_ "gocloud.dev/blob/azureblob"
+	"gocloud.dev/blob/fileblob"
+	_ "gocloud.dev/blob/gcsblob"
+	_ "gocloud.dev/blob/s3blob"
+)
+
+// cometStateFileName holds the cometbft state and commit at the height of an exported
+// snapshot, what state sync would otherwise get from the light client
+const cometStateFileName = "cometbft.json"
+
+type cometSnapshotState struct {
+	State  []byte `json:"state"`
+	Commit []byte `json:"commit"`
+}
+
+// SnapshotArchive exports and imports snapshots to and from a directory or bucket while
+// the node is stopped, so operators can seed nodes without state sync
+type SnapshotArchive struct {
+	config      *config.Config
+	cometConfig *cconfig.Config
+	logger      *zap.Logger
+	server      *Server
+}
+
+func NewSnapshotArchive(config *config.Config, cometConfig *cconfig.Config, logger *zap.Logger, pool *pgxpool.Pool) *SnapshotArchive {
+	logger = logger.With(zap.String("service", "snapshot_archive"))
+	return &SnapshotArchive{
+		config:      config,
+		cometConfig: cometConfig,
+		logger:      logger,
+		server: &Server{
+			config:         config,
+			cometbftConfig: cometConfig,
+			logger:         logger,
+			pool:           pool,
+			db:             db.New(pool),
+		},
+	}
+}
+
+// openSnapshotBucket opens a gocloud.dev/blob url, or a local directory when location has no scheme
+func openSnapshotBucket(ctx context.Context, location string) (*blob.Bucket, error) {
+	if !strings.Contains(location, "://") {
+		return fileblob.OpenBucket(location, &fileblob.Options{CreateDir: true})
+	}
+	return blob.OpenBucket(ctx, location)
+}
+
+func (a *SnapshotArchive) openCometStores() (sm.Store, *store.BlockStore, error) {
+	stateDB, err := cconfig.DefaultDBProvider(&cconfig.DBContext{ID: "state", Config: a.cometConfig})
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not open state db, is the node stopped? %v", err)
+	}
+	blockStoreDB, err := cconfig.DefaultDBProvider(&cconfig.DBContext{ID: "blockstore", Config: a.cometConfig})
+	if err != nil {
+		stateDB.Close()
+		return nil, nil, fmt.Errorf("could not open blockstore db, is the node stopped? %v", err)
+	}
+
+	stateStore := sm.NewStore(stateDB, sm.StoreOptions{
+		DiscardABCIResponses: a.cometConfig.Storage.DiscardABCIResponses,
+		DBKeyLayout:          a.cometConfig.Storage.ExperimentalKeyLayout,
+	})
+	blockStore := store.NewBlockStore(blockStoreDB, store.WithDBKeyLayout(a.cometConfig.Storage.ExperimentalKeyLayout))
+	return stateStore, blockStore, nil
+}
+
+// Export writes a full snapshot of the node's current height to dest
+func (a *SnapshotArchive) Export(ctx context.Context, dest string) error {
+	stateStore, blockStore, err := a.openCometStores()
+	if err != nil {
+		return err
+	}
+	defer stateStore.Close()
+	defer blockStore.Close()
+
+	state, err := stateStore.Load()
+	if err != nil {
+		return fmt.Errorf("could not load cometbft state: %v", err)
+	}
+	height := state.LastBlockHeight
+	if height == 0 {
+		return errors.New("node has no blocks to export")
+	}
+
+	commit := blockStore.LoadSeenCommit(height)
+	if commit == nil {
+		return fmt.Errorf("no commit for height %d", height)
+	}
+
+	appState, err := a.server.db.GetAppStateAtHeight(ctx, height)
+	if err != nil {
+		return fmt.Errorf("could not read app state at %d: %v", height, err)
+	}
+	if !bytes.Equal(appState.AppHash, state.AppHash) {
+		return fmt.Errorf("database app hash at %d does not match cometbft, is the node stopped?", height)
+	}
+
+	workDir, err := os.MkdirTemp(a.config.RootDir, "snapshot_export_")
+	if err != nil {
+		return fmt.Errorf("could not create export directory: %v", err)
+	}
+	defer os.RemoveAll(workDir)
+
+	a.logger.Info("Creating pg_dump", zap.Int64("height", height))
+	if err := a.server.createPgDump(a.logger, workDir); err != nil {
+		return fmt.Errorf("error creating pg_dump: %v", err)
+	}
+	chunkCount, err := a.server.chunkPgDump(a.logger, workDir, getPgDumpPath(workDir))
+	if err != nil {
+		return fmt.Errorf("error chunking pg_dump: %v", err)
+	}
+	if err := a.server.deletePgDump(a.logger, getPgDumpPath(workDir)); err != nil {
+		return fmt.Errorf("error deleting pg_dump: %v", err)
+	}
+	chunkHashes, err := hashChunks(workDir, chunkCount)
+	if err != nil {
+		return fmt.Errorf("error hashing chunks: %v", err)
+	}
+
+	metadata, err := json.Marshal(Metadata{
+		Sender:      a.config.ProposerAddress,
+		ChainID:     a.config.GenesisFile.ChainID,
+		ChunkHashes: chunkHashes,
+	})
+	if err != nil {
+		return fmt.Errorf("error marshalling metadata: %v", err)
+	}
+	snapshot, err := json.Marshal(v1.Snapshot{
+		Height:   uint64(height),
+		Format:   snapshotFormatManifest,
+		Chunks:   uint32(chunkCount),
+		Hash:     commit.BlockID.Hash,
+		Metadata: metadata,
+	})
+	if err != nil {
+		return fmt.Errorf("error marshalling snapshot metadata: %v", err)
+	}
+
+	stateProto, err := state.ToProto()
+	if err != nil {
+		return fmt.Errorf("could not encode cometbft state: %v", err)
+	}
+	stateBytes, err := stateProto.Marshal()
+	if err != nil {
+		return fmt.Errorf("could not encode cometbft state: %v", err)
+	}
+	commitBytes, err := commit.ToProto().Marshal()
+	if err != nil {
+		return fmt.Errorf("could not encode commit: %v", err)
+	}
+	cometState, err := json.Marshal(cometSnapshotState{State: stateBytes, Commit: commitBytes})
+	if err != nil {
+		return fmt.Errorf("could not encode cometbft state: %v", err)
+	}
+
+	bucket, err := openSnapshotBucket(ctx, dest)
+	if err != nil {
+		return fmt.Errorf("could not open %s: %v", dest, err)
+	}
+	defer bucket.Close()
+
+	// metadata goes last so a partial export is never mistaken for a complete one
+	for i := range chunkCount {
+		chunk, err := os.ReadFile(getChunkPath(workDir, i))
+		if err != nil {
+			return err
+		}
+		if err := bucket.WriteAll(ctx, path.Base(getChunkPath("", i)), chunk, nil); err != nil {
+			return fmt.Errorf("could not write chunk %d: %v", i, err)
+		}
+		a.logger.Info("Exported chunk", zap.Int("chunk", i), zap.Int("chunks", chunkCount))
+	}
+	if err := bucket.WriteAll(ctx, cometStateFileName, cometState, nil); err != nil {
+		return fmt.Errorf("could not write cometbft state: %v", err)
+	}
+	if err := bucket.WriteAll(ctx, metadataFileName, snapshot, nil); err != nil {
+		return fmt.Errorf("could not write metadata: %v", err)
+	}
+
+	a.logger.Info("Snapshot exported", zap.Int64("height", height), zap.String("dest", dest))
+	return nil
+}
+
+// Import restores the database and bootstraps cometbft from a snapshot at src, the node
+// then starts at the snapshot height. cometbft's stores must be empty.
+func (a *SnapshotArchive) Import(ctx context.Context, src string) error {
+	bucket, err := openSnapshotBucket(ctx, src)
+	if err != nil {
+		return fmt.Errorf("could not open %s: %v", src, err)
+	}
+	defer bucket.Close()
+
+	snapshotBytes, err := bucket.ReadAll(ctx, metadataFileName)
+	if err != nil {
+		return fmt.Errorf("could not read metadata: %v", err)
+	}
+	var snapshot v1.Snapshot
+	if err := json.Unmarshal(snapshotBytes, &snapshot); err != nil {
+		return fmt.Errorf("invalid metadata: %v", err)
+	}
+	if err := validateOfferedSnapshot(&snapshot, a.config.GenesisFile.ChainID); err != nil {
+		return err
+	}
+	metadata := &Metadata{}
+	if err := json.Unmarshal(snapshot.Metadata, metadata); err != nil {
+		return fmt.Errorf("invalid metadata: %v", err)
+	}
+	height := int64(snapshot.Height)
+
+	state, commit, err := readCometSnapshotState(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	if state.ChainID != a.config.GenesisFile.ChainID || state.LastBlockHeight != height || commit.Height != height {
+		return fmt.Errorf("cometbft state is for %s at %d, snapshot is at %d", state.ChainID, state.LastBlockHeight, height)
+	}
+	if !bytes.Equal(commit.BlockID.Hash, snapshot.Hash) {
+		return errors.New("commit is not for the snapshot block")
+	}
+
+	stateStore, blockStore, err := a.openCometStores()
+	if err != nil {
+		return err
+	}
+	defer stateStore.Close()
+	defer blockStore.Close()
+
+	// refuse before touching the database rather than after
+	existing, err := stateStore.Load()
+	if err != nil {
+		return fmt.Errorf("could not load cometbft state: %v", err)
+	}
+	if !blockStore.IsEmpty() || !existing.IsEmpty() {
+		return errors.New("cometbft state is not empty, remove the node's data directory first")
+	}
+
+	for i := range int(snapshot.Chunks) {
+		chunk, err := bucket.ReadAll(ctx, path.Base(getChunkPath("", i)))
+		if err != nil {
+			return fmt.Errorf("could not read chunk %d: %v", i, err)
+		}
+		if snapshot.Format == snapshotFormatManifest && chunkHash(chunk) != metadata.ChunkHashes[i] {
+			return fmt.Errorf("chunk %d does not match the manifest", i)
+		}
+		if err := a.server.StoreChunkForReconstruction(height, i, chunk); err != nil {
+			return err
+		}
+	}
+	defer a.server.CleanupStateSync()
+
+	dumpChunks := int(snapshot.Chunks)
+	if metadata.BaseHeight > 0 {
+		dumpChunks = metadata.BaseChunks
+	}
+	a.logger.Info("Restoring database", zap.Int64("height", height))
+	if err := a.server.ReassemblePgDump(height, dumpChunks); err != nil {
+		return fmt.Errorf("could not reassemble pg_dump: %v", err)
+	}
+	if err := a.server.RestoreDatabase(height); err != nil {
+		return err
+	}
+	if metadata.BaseHeight > 0 {
+		if err := a.server.applyDeltaDump(ctx, height); err != nil {
+			return fmt.Errorf("could not apply delta: %v", err)
+		}
+	}
+	if err := a.server.verifyRestoredState(ctx, height); err != nil {
+		return err
+	}
+	appState, err := a.server.db.GetAppStateAtHeight(ctx, height)
+	if err != nil {
+		return fmt.Errorf("could not read restored app state at %d: %v", height, err)
+	}
+	if !bytes.Equal(appState.AppHash, state.AppHash) {
+		return fmt.Errorf("restored app hash at %d does not match cometbft state", height)
+	}
+
+	// what comet does after an online state sync, see node.BootstrapState
+	a.logger.Info("Bootstrapping cometbft", zap.Int64("height", height))
+	if err := stateStore.Bootstrap(*state); err != nil {
+		return fmt.Errorf("could not bootstrap cometbft state: %v", err)
+	}
+	if err := blockStore.SaveSeenCommit(height, commit); err != nil {
+		return fmt.Errorf("could not save commit: %v", err)
+	}
+	if err := stateStore.SetOfflineStateSyncHeight(height); err != nil {
+		return fmt.Errorf("could not set state sync height: %v", err)
+	}
+
+	a.logger.Info("Snapshot imported", zap.Int64("height", height), zap.String("src", src))
+	return nil
+}
+
+func readCometSnapshotState(ctx context.Context, bucket *blob.Bucket) (*sm.State, *types.Commit, error) {
+	b, err := bucket.ReadAll(ctx, cometStateFileName)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not read cometbft state: %v", err)
+	}
+	var cometState cometSnapshotState
+	if err := json.Unmarshal(b, &cometState); err != nil {
+		return nil, nil, fmt.Errorf("invalid cometbft state: %v", err)
+	}
+
+	var stateProto cmtstate.State
+	if err := stateProto.Unmarshal(cometState.State); err != nil {
+		return nil, nil, fmt.Errorf("invalid cometbft state: %v", err)
+	}
+	state, err := sm.FromProto(&stateProto)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid cometbft state: %v", err)
+	}
+
+	var commitProto cmtproto.Commit
+	if err := commitProto.Unmarshal(cometState.Commit); err != nil {
+		return nil, nil, fmt.Errorf("invalid commit: %v", err)
+	}
+	commit, err := types.CommitFromProto(&commitProto)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid commit: %v", err)
+	}
+
+	return state, commit, nil
+}
@@ -0,0 +1,50 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCometSnapshotStateRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	pubKey := ed25519.GenPrivKey().PubKey()
+	state, err := sm.MakeGenesisState(&types.GenesisDoc{
+		ChainID:         "audius-devnet",
+		InitialHeight:   1,
+		ConsensusParams: types.DefaultConsensusParams(),
+		Validators:      []types.GenesisValidator{{Address: pubKey.Address(), PubKey: pubKey, Power: 10}},
+	})
+	require.NoError(t, err)
+	state.LastBlockHeight = 42
+	state.AppHash = []byte("apphash")
+	state.LastValidators = state.Validators.Copy()
+	commit := &types.Commit{Height: 42, BlockID: types.BlockID{Hash: make([]byte, 32)}, Signatures: []types.CommitSig{types.NewCommitSigAbsent()}}
+
+	stateProto, err := state.ToProto()
+	require.NoError(t, err)
+	stateBytes, err := stateProto.Marshal()
+	require.NoError(t, err)
+	commitBytes, err := commit.ToProto().Marshal()
+	require.NoError(t, err)
+	b, err := json.Marshal(cometSnapshotState{State: stateBytes, Commit: commitBytes})
+	require.NoError(t, err)
+
+	// a location without a scheme is a local directory
+	bucket, err := openSnapshotBucket(ctx, t.TempDir())
+	require.NoError(t, err)
+	defer bucket.Close()
+	require.NoError(t, bucket.WriteAll(ctx, cometStateFileName, b, nil))
+
+	restored, restoredCommit, err := readCometSnapshotState(ctx, bucket)
+	require.NoError(t, err)
+	require.Equal(t, int64(42), restored.LastBlockHeight)
+	require.Equal(t, state.AppHash, restored.AppHash)
+	require.Equal(t, state.Validators.Hash(), restored.Validators.Hash())
+	require.Equal(t, int64(42), restoredCommit.Height)
+}
@@ -0,0 +1,562 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/AudiusProject/audiusd/pkg/core/db"
+	"github.com/jackc/pgx/v5"
+	"golang.org/x/crypto/sha3"
+)
+
+// The app hash commits to the rows FinalizeBlock writes rather than to the raw
+// bytes of the block's txs. Each committed row is expanded into a vector of
+// lanes and summed into a lattice hash (LtHash), which is order independent
+// and supports removal. That lets every block update the digest with only the
+// rows it inserted or deleted, while a full table scan yields the same digest,
+// which is how restored snapshots are verified before the node rejoins. A sum
+// of rows can't prove any single one of them, queries are proven against the
+// state tree instead, see state_tree.go.
+
+const stateDigestLanes = 1024
+
+var (
+	ErrStateDigestInvalid  = errors.New("invalid state digest")
+	ErrStateDigestMismatch = errors.New("state digest does not match app state")
+	ErrStateRowUnsupported = errors.New("unsupported state column type")
+)
+
+// stateDigest is the lattice hash of every committed row
+type stateDigest [stateDigestLanes]uint16
+
+type stateChange struct {
+	row    []byte
+	remove bool
+}
+
+func (d *stateDigest) add(row []byte) {
+	lanes := expandStateRow(row)
+	for i := range d {
+		d[i] += lanes[i]
+	}
+}
+
+func (d *stateDigest) remove(row []byte) {
+	lanes := expandStateRow(row)
+	for i := range d {
+		d[i] -= lanes[i]
+	}
+}
+
+func (d *stateDigest) apply(changes []stateChange) {
+	for _, c := range changes {
+		if c.remove {
+			d.remove(c.row)
+		} else {
+			d.add(c.row)
+		}
+	}
+}
+
+func (d *stateDigest) Bytes() []byte {
+	b := make([]byte, stateDigestLanes*2)
+	for i, lane := range d {
+		binary.LittleEndian.PutUint16(b[i*2:], lane)
+	}
+	return b
+}
+
+// AppHash is the 32 byte commitment handed to comet
+func (d *stateDigest) AppHash() []byte {
+	h := sha256.Sum256(d.Bytes())
+	return h[:]
+}
+
+func stateDigestFromBytes(b []byte) (*stateDigest, error) {
+	if len(b) != stateDigestLanes*2 {
+		return nil, fmt.Errorf("%w: expected %d bytes, got %d", ErrStateDigestInvalid, stateDigestLanes*2, len(b))
+	}
+	var d stateDigest
+	for i := range d {
+		d[i] = binary.LittleEndian.Uint16(b[i*2:])
+	}
+	return &d, nil
+}
+
+func expandStateRow(row []byte) *stateDigest {
+	buf := make([]byte, stateDigestLanes*2)
+	shake := sha3.NewShake256()
+	shake.Write(row)
+	shake.Read(buf)
+
+	var lanes stateDigest
+	for i := range lanes {
+		lanes[i] = binary.LittleEndian.Uint16(buf[i*2:])
+	}
+	return &lanes
+}
+
+// encodeStateRow canonically encodes a row as its table name followed by its
+// consensus columns, serial ids and wall clock timestamps are left out since
+// they differ between nodes. A column of any other type is an error that fails
+// the block on every node alike instead of panicking halfway through it.
+func encodeStateRow(table string, columns ...any) ([]byte, error) {
+	var buf bytes.Buffer
+	writeBytes := func(b []byte) {
+		buf.Write(binary.AppendUvarint(nil, uint64(len(b))))
+		buf.Write(b)
+	}
+
+	writeBytes([]byte(table))
+	for _, col := range columns {
+		switch v := col.(type) {
+		case string:
+			writeBytes([]byte(v))
+		case []byte:
+			writeBytes(v)
+		case bool:
+			if v {
+				buf.WriteByte(1)
+			} else {
+				buf.WriteByte(0)
+			}
+		case int16:
+			buf.Write(binary.BigEndian.AppendUint64(nil, uint64(v)))
+		case int32:
+			buf.Write(binary.BigEndian.AppendUint64(nil, uint64(v)))
+		case int64:
+			buf.Write(binary.BigEndian.AppendUint64(nil, uint64(v)))
+		case []string:
+			buf.Write(binary.AppendUvarint(nil, uint64(len(v))))
+			for _, s := range v {
+				writeBytes([]byte(s))
+			}
+		default:
+			return nil, fmt.Errorf("%w %T in %s", ErrStateRowUnsupported, col, table)
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+func ernStateRow(p db.InsertCoreERNParams) ([]byte, error) {
+	return encodeStateRow("core_ern", p.Address, p.TxHash, p.Index, p.Sender, p.MessageControlType, p.RawMessage, p.RawAcknowledgment, p.BlockHeight)
+}
+
+// entityStateRow encodes rows of core_resources, core_releases, core_parties and core_deals
+func entityStateRow(table, address, ernAddress, entityType string, entityIndex int32, txHash string, blockHeight int64) ([]byte, error) {
+	return encodeStateRow(table, address, ernAddress, entityType, entityIndex, txHash, blockHeight)
+}
+
+func ernTakedownStateRow(p db.InsertCoreERNTakedownParams) ([]byte, error) {
+	return encodeStateRow("core_ern_takedowns", p.ErnAddress, p.TxHash, p.Index, p.Sender, p.RawMessage, p.RawAcknowledgment, p.BlockHeight)
+}
+
+func takedownCIDStateRow(p db.InsertCoreTakedownCIDParams) ([]byte, error) {
+	return encodeStateRow("core_takedown_cids", p.Cid, p.ErnAddress, p.BlockHeight)
+}
+
+func meadStateRow(p db.InsertCoreMEADParams) ([]byte, error) {
+	return encodeStateRow("core_mead", p.Address, p.TxHash, p.Index, p.Sender, p.ResourceAddresses, p.ReleaseAddresses, p.RawMessage, p.RawAcknowledgment, p.BlockHeight)
+}
+
+func pieStateRow(p db.InsertCorePIEParams) ([]byte, error) {
+	return encodeStateRow("core_pie", p.Address, p.TxHash, p.Index, p.Sender, p.PartyAddresses, p.RawMessage, p.RawAcknowledgment, p.BlockHeight)
+}
+
+func delegationStateRow(p db.InsertCoreDelegationParams) ([]byte, error) {
+	return encodeStateRow("core_delegations", p.TxHash, p.Index, p.Sender, p.Delegate, p.Revoked, p.BlockHeight)
+}
+
+func rewardStateRow(p db.InsertCoreRewardParams) ([]byte, error) {
+	return encodeStateRow("core_rewards", p.Address, p.TxHash, p.Index, p.Sender, p.RewardID, p.Name, p.Amount, p.ClaimAuthorities, p.RawMessage, p.BlockHeight)
+}
+
+func rewardClaimStateRow(p db.InsertRewardClaimParams) ([]byte, error) {
+	return encodeStateRow("core_reward_claims", p.RewardAddress, p.RewardID, p.Specifier, p.EthRecipientAddress, p.Amount, p.Attester, p.TxHash, p.BlockHeight)
+}
+
+func uploadStateRow(p db.InsertFileUploadParams) ([]byte, error) {
+	return encodeStateRow("core_uploads", p.UploaderAddress, p.Cid, p.TranscodedCid, p.Upid, p.UploadSignature, p.ValidatorAddress, p.ValidatorSignature, p.TxHash, p.BlockHeight)
+}
+
+func playSignatureStateRow(p db.InsertPlaySignatureParams) ([]byte, error) {
+	return encodeStateRow("core_play_signatures", p.Signature, p.TrackID, p.UserID, p.PlayedAt, p.TxHash, p.BlockHeight)
+}
+
+func manageEntityNonceStateRow(p db.InsertManageEntityNonceParams) ([]byte, error) {
+	return encodeStateRow("core_manage_entity_nonces", p.Signer, p.Nonce, p.TxHash, p.BlockHeight)
+}
+
+func peerObservationStateRow(p db.InsertPeerObservationParams) ([]byte, error) {
+	return encodeStateRow("core_peer_observations", p.BlockHeight, p.Observer, p.Reachable, p.Unreachable, p.TxHash)
+}
+
+func validatorStateRow(p db.InsertRegisteredNodeParams) ([]byte, error) {
+	return encodeStateRow("core_validators", p.PubKey, p.Endpoint, p.EthAddress, p.CometAddress, p.CometPubKey, p.EthBlock, p.NodeType, p.SpID)
+}
+
+// validatorJailStateRow is committed for as long as a validator is jailed
+func validatorJailStateRow(v db.CoreValidator) ([]byte, error) {
+	return encodeStateRow("core_validator_jails", v.CometAddress, v.JailedAtBlock)
+}
+
+func rewardRowParams(r db.CoreReward) db.InsertCoreRewardParams {
+	return db.InsertCoreRewardParams{
+		Address:          r.Address,
+		TxHash:           r.TxHash,
+		Index:            r.Index,
+		Sender:           r.Sender,
+		RewardID:         r.RewardID,
+		Name:             r.Name,
+		Amount:           r.Amount,
+		ClaimAuthorities: r.ClaimAuthorities,
+		RawMessage:       r.RawMessage,
+		BlockHeight:      r.BlockHeight,
+	}
+}
+
+func validatorRowParams(v db.CoreValidator) db.InsertRegisteredNodeParams {
+	return db.InsertRegisteredNodeParams{
+		PubKey:       v.PubKey,
+		Endpoint:     v.Endpoint,
+		EthAddress:   v.EthAddress,
+		CometAddress: v.CometAddress,
+		CometPubKey:  v.CometPubKey,
+		EthBlock:     v.EthBlock,
+		NodeType:     v.NodeType,
+		SpID:         v.SpID,
+	}
+}
+
+// stateInsert records a row written by the in progress block, a row that
+// couldn't be encoded fails the block in finalizeAppHash
+func (s *Server) stateInsert(row []byte, err error) {
+	s.recordStateChange(stateChange{row: row}, err)
+}
+
+// stateDelete records a row removed by the in progress block
+func (s *Server) stateDelete(row []byte, err error) {
+	s.recordStateChange(stateChange{row: row, remove: true}, err)
+}
+
+func (s *Server) recordStateChange(change stateChange, err error) {
+	if err != nil {
+		if s.abciState.stateErr == nil {
+			s.abciState.stateErr = err
+		}
+		return
+	}
+	s.abciState.stateChanges = append(s.abciState.stateChanges, change)
+}
+
+func (s *Server) stateCommitmentActive(height int64) bool {
+	activation := s.config.StateCommitmentHeight
+	return activation > 0 && height >= activation
+}
+
+// finalizeAppHash consumes the row changes and touched query keys of the in
+// progress block and returns the app hash for height along with the state
+// digest and state tree root to persist, the digest is nil while the legacy app
+// hash is in effect and the root is nil until the state tree is active
+func (s *Server) finalizeAppHash(ctx context.Context, height int64, txs [][]byte) ([]byte, []byte, []byte, error) {
+	changes := s.abciState.stateChanges
+	touched := s.abciState.stateTouches
+	stateErr := s.abciState.stateErr
+	s.abciState.stateChanges = nil
+	s.abciState.stateTouches = nil
+	s.abciState.stateErr = nil
+
+	if !s.stateCommitmentActive(height) {
+		return s.serializeAppState([]byte{}, txs), nil, nil, nil
+	}
+	if stateErr != nil {
+		return nil, nil, nil, fmt.Errorf("could not encode state row: %w", stateErr)
+	}
+
+	var digest *stateDigest
+	var prevRoot []byte
+	prev, err := s.getDb().GetAppStateAtHeight(ctx, height-1)
+	switch {
+	case err != nil && !errors.Is(err, pgx.ErrNoRows):
+		return nil, nil, nil, fmt.Errorf("could not read app state at %d: %v", height-1, err)
+	case err == nil && prev.StateDigest != nil:
+		digest, err = stateDigestFromBytes(prev.StateDigest)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("app state at %d: %w", height-1, err)
+		}
+		digest.apply(changes)
+		prevRoot = prev.StateRoot
+	default:
+		// activation height, the digest starts from everything already
+		// committed including the rows written by this block
+		digest, err = computeStateDigest(ctx, s.getDb())
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	if !s.stateProofActive(height) {
+		return digest.AppHash(), digest.Bytes(), nil, nil
+	}
+
+	root, err := s.finalizeStateTree(ctx, s.getDb(), height, touched, prevRoot)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return stateAppHash(digest, root), digest.Bytes(), root, nil
+}
+
+// computeStateDigest rebuilds the digest from every committed row
+func computeStateDigest(ctx context.Context, q *db.Queries) (*stateDigest, error) {
+	var d stateDigest
+	var rowErr error
+	add := func(row []byte, err error) {
+		if err != nil {
+			if rowErr == nil {
+				rowErr = err
+			}
+			return
+		}
+		d.add(row)
+	}
+
+	erns, err := q.GetAllCoreERNs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not read erns: %v", err)
+	}
+	for _, r := range erns {
+		add(ernStateRow(db.InsertCoreERNParams{
+			Address:            r.Address,
+			TxHash:             r.TxHash,
+			Index:              r.Index,
+			Sender:             r.Sender,
+			MessageControlType: r.MessageControlType,
+			RawMessage:         r.RawMessage,
+			RawAcknowledgment:  r.RawAcknowledgment,
+			BlockHeight:        r.BlockHeight,
+		}))
+	}
+
+	resources, err := q.GetAllCoreResources(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not read resources: %v", err)
+	}
+	for _, r := range resources {
+		add(entityStateRow("core_resources", r.Address, r.ErnAddress, r.EntityType, r.EntityIndex, r.TxHash, r.BlockHeight))
+	}
+
+	releases, err := q.GetAllCoreReleases(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not read releases: %v", err)
+	}
+	for _, r := range releases {
+		add(entityStateRow("core_releases", r.Address, r.ErnAddress, r.EntityType, r.EntityIndex, r.TxHash, r.BlockHeight))
+	}
+
+	parties, err := q.GetAllCoreParties(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not read parties: %v", err)
+	}
+	for _, r := range parties {
+		add(entityStateRow("core_parties", r.Address, r.ErnAddress, r.EntityType, r.EntityIndex, r.TxHash, r.BlockHeight))
+	}
+
+	deals, err := q.GetAllCoreDeals(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not read deals: %v", err)
+	}
+	for _, r := range deals {
+		add(entityStateRow("core_deals", r.Address, r.ErnAddress, r.EntityType, r.EntityIndex, r.TxHash, r.BlockHeight))
+	}
+
+	takedowns, err := q.GetAllCoreERNTakedowns(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not read ern takedowns: %v", err)
+	}
+	for _, r := range takedowns {
+		add(ernTakedownStateRow(db.InsertCoreERNTakedownParams{
+			ErnAddress:        r.ErnAddress,
+			TxHash:            r.TxHash,
+			Index:             r.Index,
+			Sender:            r.Sender,
+			RawMessage:        r.RawMessage,
+			RawAcknowledgment: r.RawAcknowledgment,
+			BlockHeight:       r.BlockHeight,
+		}))
+	}
+
+	takedownCIDs, err := q.GetAllCoreTakedownCIDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not read takedown cids: %v", err)
+	}
+	for _, r := range takedownCIDs {
+		add(takedownCIDStateRow(db.InsertCoreTakedownCIDParams{
+			Cid:         r.Cid,
+			ErnAddress:  r.ErnAddress,
+			BlockHeight: r.BlockHeight,
+		}))
+	}
+
+	meads, err := q.GetAllCoreMEADs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not read meads: %v", err)
+	}
+	for _, r := range meads {
+		add(meadStateRow(db.InsertCoreMEADParams{
+			Address:           r.Address,
+			TxHash:            r.TxHash,
+			Index:             r.Index,
+			Sender:            r.Sender,
+			ResourceAddresses: r.ResourceAddresses,
+			ReleaseAddresses:  r.ReleaseAddresses,
+			RawMessage:        r.RawMessage,
+			RawAcknowledgment: r.RawAcknowledgment,
+			BlockHeight:       r.BlockHeight,
+		}))
+	}
+
+	pies, err := q.GetAllCorePIEs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not read pies: %v", err)
+	}
+	for _, r := range pies {
+		add(pieStateRow(db.InsertCorePIEParams{
+			Address:           r.Address,
+			TxHash:            r.TxHash,
+			Index:             r.Index,
+			Sender:            r.Sender,
+			PartyAddresses:    r.PartyAddresses,
+			RawMessage:        r.RawMessage,
+			RawAcknowledgment: r.RawAcknowledgment,
+			BlockHeight:       r.BlockHeight,
+		}))
+	}
+
+	delegations, err := q.GetAllCoreDelegations(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not read delegations: %v", err)
+	}
+	for _, r := range delegations {
+		add(delegationStateRow(db.InsertCoreDelegationParams(r)))
+	}
+
+	rewards, err := q.GetAllCoreRewards(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not read rewards: %v", err)
+	}
+	for _, r := range rewards {
+		add(rewardStateRow(rewardRowParams(r)))
+	}
+
+	rewardClaims, err := q.GetAllCoreRewardClaims(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not read reward claims: %v", err)
+	}
+	for _, c := range rewardClaims {
+		add(rewardClaimStateRow(db.InsertRewardClaimParams(c)))
+	}
+
+	uploads, err := q.GetAllCoreUploads(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not read uploads: %v", err)
+	}
+	for _, r := range uploads {
+		add(uploadStateRow(db.InsertFileUploadParams{
+			UploaderAddress:    r.UploaderAddress,
+			Cid:                r.Cid,
+			TranscodedCid:      r.TranscodedCid,
+			Upid:               r.Upid,
+			UploadSignature:    r.UploadSignature,
+			ValidatorAddress:   r.ValidatorAddress,
+			ValidatorSignature: r.ValidatorSignature,
+			TxHash:             r.TxHash,
+			BlockHeight:        r.BlockHeight,
+		}))
+	}
+
+	playSignatures, err := q.GetAllCorePlaySignatures(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not read play signatures: %v", err)
+	}
+	for _, p := range playSignatures {
+		add(playSignatureStateRow(db.InsertPlaySignatureParams(p)))
+	}
+
+	nonces, err := q.GetAllCoreManageEntityNonces(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not read manage entity nonces: %v", err)
+	}
+	for _, n := range nonces {
+		add(manageEntityNonceStateRow(db.InsertManageEntityNonceParams(n)))
+	}
+
+	peerObservations, err := q.GetAllCorePeerObservations(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not read peer observations: %v", err)
+	}
+	for _, p := range peerObservations {
+		add(peerObservationStateRow(db.InsertPeerObservationParams(p)))
+	}
+
+	validators, err := q.GetAllRegisteredNodes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not read validators: %v", err)
+	}
+	for _, v := range validators {
+		add(validatorStateRow(validatorRowParams(v)))
+		if v.Jailed {
+			add(validatorJailStateRow(v))
+		}
+	}
+
+	if rowErr != nil {
+		return nil, fmt.Errorf("could not encode state rows: %w", rowErr)
+	}
+	return &d, nil
+}
+
+// verifyRestoredState recomputes the state digest of a restored snapshot and
+// checks it against the app hash recorded at the snapshot height, comet then
+// checks that app hash against the light client verified header
+func (s *Server) verifyRestoredState(ctx context.Context, height int64) error {
+	if !s.stateCommitmentActive(height) {
+		return nil
+	}
+
+	appState, err := s.db.GetAppStateAtHeight(ctx, height)
+	if err != nil {
+		return fmt.Errorf("could not read restored app state at %d: %v", height, err)
+	}
+
+	digest, err := computeStateDigest(ctx, s.db)
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(digest.Bytes(), appState.StateDigest) {
+		return fmt.Errorf("%w: recomputed digest differs from the one recorded at %d", ErrStateDigestMismatch, height)
+	}
+
+	appHash := digest.AppHash()
+	if s.stateProofActive(height) {
+		root, err := computeStateTreeRoot(ctx, s.db, height)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(root, appState.StateRoot) {
+			return fmt.Errorf("%w: recomputed state tree root differs from the one recorded at %d", ErrStateDigestMismatch, height)
+		}
+		appHash = stateAppHash(digest, root)
+	}
+
+	if !bytes.Equal(appHash, appState.AppHash) {
+		return fmt.Errorf("%w: recomputed app hash differs from the one recorded at %d", ErrStateDigestMismatch, height)
+	}
+
+	return nil
+}
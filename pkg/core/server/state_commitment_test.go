@@ -0,0 +1,93 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/AudiusProject/audiusd/pkg/core/config"
+	"github.com/AudiusProject/audiusd/pkg/core/db"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStateDigest(t *testing.T) {
+	must := func(row []byte, err error) []byte {
+		require.NoError(t, err)
+		return row
+	}
+
+	rowA := must(rewardStateRow(db.InsertCoreRewardParams{
+		Address:          "0xreward",
+		TxHash:           "abc",
+		Sender:           "0xsender",
+		RewardID:         "r",
+		Name:             "reward",
+		Amount:           5,
+		ClaimAuthorities: []string{"0xauth"},
+		BlockHeight:      10,
+	}))
+	rowB := must(entityStateRow("core_releases", "0xrelease", "0xern", "release", 1, "def", 11))
+	rowC := must(entityStateRow("core_deals", "0xdeal", "0xern", "deal", 1, "def", 11))
+
+	t.Run("order independent", func(t *testing.T) {
+		var d1, d2 stateDigest
+		d1.add(rowA)
+		d1.add(rowB)
+		d1.add(rowC)
+		d2.add(rowC)
+		d2.add(rowA)
+		d2.add(rowB)
+		require.Equal(t, d1.AppHash(), d2.AppHash())
+	})
+
+	t.Run("incremental matches recompute", func(t *testing.T) {
+		var full stateDigest
+		full.add(rowA)
+		full.add(rowC)
+
+		var incremental stateDigest
+		incremental.add(rowA)
+		incremental.apply([]stateChange{
+			{row: rowB},
+			{row: rowC},
+			{row: rowB, remove: true},
+		})
+		require.Equal(t, full.Bytes(), incremental.Bytes())
+	})
+
+	t.Run("round trips through bytes", func(t *testing.T) {
+		var d stateDigest
+		d.add(rowA)
+		restored, err := stateDigestFromBytes(d.Bytes())
+		require.NoError(t, err)
+		require.Equal(t, d, *restored)
+
+		_, err = stateDigestFromBytes([]byte{1, 2, 3})
+		require.ErrorIs(t, err, ErrStateDigestInvalid)
+	})
+
+	t.Run("columns are length prefixed", func(t *testing.T) {
+		require.NotEqual(t,
+			must(encodeStateRow("t", "ab", "c")),
+			must(encodeStateRow("t", "a", "bc")),
+		)
+		require.Equal(t,
+			must(encodeStateRow("t", []string(nil), []byte(nil))),
+			must(encodeStateRow("t", []string{}, []byte{})),
+		)
+	})
+
+	t.Run("unsupported columns fail the block", func(t *testing.T) {
+		_, err := encodeStateRow("t", "a", 1.5)
+		require.ErrorIs(t, err, ErrStateRowUnsupported)
+
+		s := &Server{config: &config.Config{StateCommitmentHeight: 1}, abciState: NewABCIState(0)}
+		s.stateInsert(rowA, nil)
+		s.stateInsert(encodeStateRow("t", 1.5))
+		_, _, _, err = s.finalizeAppHash(context.Background(), 2, nil)
+		require.ErrorIs(t, err, ErrStateRowUnsupported)
+
+		// the failed block's changes don't leak into the next one
+		require.Nil(t, s.abciState.stateErr)
+		require.Empty(t, s.abciState.stateChanges)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"compress/gzip"
 	"context"
+	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
@@ -12,6 +13,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"slices"
 	"sort"
 	"strings"
 
@@ -48,14 +50,69 @@ var (
 	// This is the binary format dump file created by pg_dump and used for database restoration.
 	pgDumpFileName = "data.dump"
 
+	// deltaFileName is the name of the file holding the tables of a delta snapshot.
+	// It is loaded over the pg_dump of the full snapshot the delta is based on.
+	deltaFileName = "data.delta"
+
 	// tmpReconstructionDir is the name of the temporary directory used during snapshot reconstruction.
 	// This directory is used to store chunks and metadata while reconstructing a snapshot.
 	tmpReconstructionDir = "tmp_reconstruction"
 )
 
+const (
+	// snapshotFormatLegacy snapshots are trusted chunk by chunk from the sender that offered them
+	snapshotFormatLegacy uint32 = 1
+	// snapshotFormatManifest snapshots carry the hash of every chunk in their metadata
+	snapshotFormatManifest uint32 = 2
+)
+
+// tables included in snapshots, parents before the tables referencing them since deltas
+// are loaded in this order
+var snapshotTables = []string{
+	"access_keys",
+	"core_app_state",
+	"core_blocks",
+	"core_db_migrations",
+	"core_deals",
+	"core_delegations",
+	"core_ern",
+	"core_ern_takedowns",
+	"core_manage_entity_nonces",
+	"core_mead",
+	"core_parties",
+	"core_peer_observations",
+	"core_pie",
+	"core_play_signatures",
+	"core_releases",
+	"core_resources",
+	"core_reward_claims",
+	"core_rewards",
+	"core_state_nodes",
+	"core_state_tree_retain",
+	"core_state_values",
+	"core_takedown_cids",
+	"core_transactions",
+	"core_tx_stats",
+	"core_uploads",
+	"core_validators",
+	"management_keys",
+	"sla_rollups",
+	"sla_node_reports",
+	"sound_recordings",
+	"storage_proof_peers",
+	"storage_proofs",
+	"track_releases",
+}
+
 type Metadata struct {
 	Sender  string `json:"sender"`
 	ChainID string `json:"chain_id"`
+	// hex sha256 of each gzipped chunk, in order
+	ChunkHashes []string `json:"chunk_hashes,omitempty"`
+	// delta snapshots serve the chunks of the full snapshot at BaseHeight first, the
+	// rest of their chunks are the delta to load over it
+	BaseHeight int64 `json:"base_height,omitempty"`
+	BaseChunks int   `json:"base_chunks,omitempty"`
 }
 
 // Helper functions for common filepath patterns
@@ -79,6 +136,14 @@ func getPgDumpPath(baseDir string) string {
 	return filepath.Join(baseDir, pgDumpFileName)
 }
 
+func getDeltaPath(baseDir string) string {
+	return filepath.Join(baseDir, deltaFileName)
+}
+
+func getReconstructionDir(rootDir string) string {
+	return filepath.Join(rootDir, tmpReconstructionDir)
+}
+
 func (s *Server) startStateSync(ctx context.Context) error {
 	s.StartProcess(ProcessStateStateSync)
 
@@ -183,39 +248,78 @@ func (s *Server) createSnapshot(logger *zap.Logger, height int64) error {
 		return fmt.Errorf("error creating latest snapshot directory: %v", err)
 	}
 
-	logger.Info("Creating pg_dump", zap.Int64("height", blockHeight))
-
-	if err := s.createPgDump(logger, latestSnapshotDir); err != nil {
-		return fmt.Errorf("error creating pg_dump: %v", err)
+	metadata := Metadata{
+		Sender:  s.config.ProposerAddress,
+		ChainID: s.config.GenesisFile.ChainID,
 	}
 
-	logger.Info("Chunking pg_dump", zap.Int64("height", blockHeight))
-
-	chunkCount, err := s.chunkPgDump(logger, latestSnapshotDir)
+	base, err := s.snapshotBase(snapshotDir, blockHeight)
 	if err != nil {
-		return fmt.Errorf("error chunking pg_dump: %v", err)
+		return err
 	}
 
-	logger.Info("Deleting pg_dump", zap.Int64("height", blockHeight))
+	var chunkCount int
+	if base == nil {
+		logger.Info("Creating pg_dump", zap.Int64("height", blockHeight))
+
+		if err := s.createPgDump(logger, latestSnapshotDir); err != nil {
+			return fmt.Errorf("error creating pg_dump: %v", err)
+		}
+
+		logger.Info("Chunking pg_dump", zap.Int64("height", blockHeight))
+
+		chunkCount, err = s.chunkPgDump(logger, latestSnapshotDir, getPgDumpPath(latestSnapshotDir))
+		if err != nil {
+			return fmt.Errorf("error chunking pg_dump: %v", err)
+		}
+
+		logger.Info("Deleting pg_dump", zap.Int64("height", blockHeight))
+
+		if err := s.deletePgDump(logger, getPgDumpPath(latestSnapshotDir)); err != nil {
+			return fmt.Errorf("error deleting pg_dump: %v", err)
+		}
+	} else {
+		baseMetadata := &Metadata{}
+		if err := json.Unmarshal(base.Metadata, baseMetadata); err != nil {
+			return fmt.Errorf("error unmarshalling base snapshot metadata: %v", err)
+		}
+		metadata.BaseHeight = int64(base.Height)
+		metadata.BaseChunks = int(base.Chunks)
+		metadata.ChunkHashes = baseMetadata.ChunkHashes
+
+		logger.Info("Creating delta", zap.Int64("height", blockHeight), zap.Int64("base", metadata.BaseHeight))
 
-	if err := s.deletePgDump(logger, latestSnapshotDir); err != nil {
-		return fmt.Errorf("error deleting pg_dump: %v", err)
+		if err := s.createDeltaDump(context.Background(), logger, latestSnapshotDir, metadata.BaseHeight, blockHeight); err != nil {
+			return fmt.Errorf("error creating delta: %v", err)
+		}
+
+		chunkCount, err = s.chunkPgDump(logger, latestSnapshotDir, getDeltaPath(latestSnapshotDir))
+		if err != nil {
+			return fmt.Errorf("error chunking delta: %v", err)
+		}
+
+		if err := s.deletePgDump(logger, getDeltaPath(latestSnapshotDir)); err != nil {
+			return fmt.Errorf("error deleting delta: %v", err)
+		}
 	}
 
+	chunkHashes, err := hashChunks(latestSnapshotDir, chunkCount)
+	if err != nil {
+		return fmt.Errorf("error hashing chunks: %v", err)
+	}
+	metadata.ChunkHashes = append(metadata.ChunkHashes, chunkHashes...)
+
 	logger.Info("Writing snapshot metadata", zap.Int64("height", blockHeight))
 
-	b, err := json.Marshal(Metadata{
-		Sender:  s.config.ProposerAddress,
-		ChainID: s.config.GenesisFile.ChainID,
-	})
+	b, err := json.Marshal(metadata)
 	if err != nil {
 		return fmt.Errorf("error marshalling metadata: %v", err)
 	}
 
 	snapshotMetadata := v1.Snapshot{
 		Height:   uint64(blockHeight),
-		Format:   1,
-		Chunks:   uint32(chunkCount),
+		Format:   snapshotFormatManifest,
+		Chunks:   uint32(metadata.BaseChunks + chunkCount),
 		Hash:     blockHash,
 		Metadata: b,
 	}
@@ -240,27 +344,9 @@ func (s *Server) createPgDump(logger *zap.Logger, latestSnapshotDir string) erro
 	pgString := s.config.PSQLConn
 	dumpPath := getPgDumpPath(latestSnapshotDir)
 
-	// You can customize this slice with the tables you want to dump
-	tables := []string{
-		"access_keys",
-		"core_app_state",
-		"core_blocks",
-		"core_db_migrations",
-		"core_transactions",
-		"core_tx_stats",
-		"core_validators",
-		"management_keys",
-		"sla_node_reports",
-		"sla_rollups",
-		"sound_recordings",
-		"storage_proof_peers",
-		"storage_proofs",
-		"track_releases",
-	}
-
 	// Start building the args
 	args := []string{"--dbname=" + pgString, "-Fc"}
-	for _, table := range tables {
+	for _, table := range snapshotTables {
 		args = append(args, "-t", table)
 	}
 	args = append(args, "-f", dumpPath)
@@ -278,10 +364,9 @@ func (s *Server) createPgDump(logger *zap.Logger, latestSnapshotDir string) erro
 	return nil
 }
 
-// chunkPgDump splits the pg_dump into 16MB gzip-compressed chunks and returns the number of chunks created
-func (s *Server) chunkPgDump(logger *zap.Logger, latestSnapshotDir string) (int, error) {
+// chunkPgDump splits a pg_dump or delta into 12MB gzip-compressed chunks and returns the number of chunks created
+func (s *Server) chunkPgDump(logger *zap.Logger, latestSnapshotDir string, dumpPath string) (int, error) {
 	const chunkSize = 12 * 1024 * 1024 // 12MB
-	dumpPath := getPgDumpPath(latestSnapshotDir)
 
 	dumpFile, err := os.Open(dumpPath)
 	if err != nil {
@@ -328,8 +413,7 @@ func (s *Server) chunkPgDump(logger *zap.Logger, latestSnapshotDir string) (int,
 	return chunkIndex, nil
 }
 
-func (s *Server) deletePgDump(logger *zap.Logger, latestSnapshotDir string) error {
-	dumpPath := getPgDumpPath(latestSnapshotDir)
+func (s *Server) deletePgDump(logger *zap.Logger, dumpPath string) error {
 	if err := os.Remove(dumpPath); err != nil {
 		return fmt.Errorf("error deleting pg_dump: %w", err)
 	}
@@ -337,6 +421,50 @@ func (s *Server) deletePgDump(logger *zap.Logger, latestSnapshotDir string) erro
 	return nil
 }
 
+// hashChunks returns the hex sha256 of each of the first count chunks in a snapshot directory
+func hashChunks(snapshotDir string, count int) ([]string, error) {
+	hashes := make([]string, 0, count)
+	for i := range count {
+		chunk, err := os.ReadFile(getChunkPath(snapshotDir, i))
+		if err != nil {
+			return nil, err
+		}
+		hashes = append(hashes, chunkHash(chunk))
+	}
+	return hashes, nil
+}
+
+func chunkHash(chunk []byte) string {
+	sum := sha256.Sum256(chunk)
+	return hex.EncodeToString(sum[:])
+}
+
+// snapshotBase returns the full snapshot a new snapshot at height should be a delta of,
+// nil when it's time for a new full snapshot
+func (s *Server) snapshotBase(snapshotDir string, height int64) (*v1.Snapshot, error) {
+	snapshots, err := s.readSnapshots(snapshotDir)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, snapshot := range slices.Backward(snapshots) {
+		if snapshot.Format != snapshotFormatManifest || isDeltaSnapshot(&snapshot) {
+			continue
+		}
+		deltas := (height - int64(snapshot.Height)) / s.config.StateSync.BlockInterval
+		if deltas >= int64(s.config.StateSync.FullSnapshotInterval) {
+			return nil, nil
+		}
+		return &snapshot, nil
+	}
+	return nil, nil
+}
+
+func isDeltaSnapshot(snapshot *v1.Snapshot) bool {
+	metadata := &Metadata{}
+	return json.Unmarshal(snapshot.Metadata, metadata) == nil && metadata.BaseHeight > 0
+}
+
 // Prunes snapshots by deleting the oldest ones while retaining the most recent ones
 // based on the configured retention count
 func (s *Server) pruneSnapshots(logger *zap.Logger) error {
@@ -352,10 +480,30 @@ func (s *Server) pruneSnapshots(logger *zap.Logger) error {
 		return files[i].Name() < files[j].Name()
 	})
 
+	// full snapshots that retained deltas are based on are kept along with them
+	bases := map[string]bool{}
+	for i := max(len(files)-keep, 0); i < len(files); i++ {
+		metadataBytes, err := os.ReadFile(getMetadataPath(filepath.Join(snapshotDir, files[i].Name())))
+		if err != nil {
+			continue
+		}
+		var meta v1.Snapshot
+		metadata := &Metadata{}
+		if json.Unmarshal(metadataBytes, &meta) != nil || json.Unmarshal(meta.Metadata, metadata) != nil {
+			continue
+		}
+		if metadata.BaseHeight > 0 {
+			bases[filepath.Base(getHeightDir(snapshotDir, metadata.BaseHeight))] = true
+		}
+	}
+
 	for i := range files {
 		if i >= len(files)-keep {
 			break
 		}
+		if bases[files[i].Name()] {
+			continue
+		}
 
 		os.RemoveAll(filepath.Join(snapshotDir, files[i].Name()))
 		logger.Info("Deleted snapshot", zap.String("path", filepath.Join(snapshotDir, files[i].Name())))
@@ -369,8 +517,11 @@ func (s *Server) getStoredSnapshots() ([]v1.Snapshot, error) {
 		return []v1.Snapshot{}, nil
 	}
 
-	snapshotDir := getSnapshotDir(s.config.RootDir, s.config.GenesisFile.ChainID)
+	return s.readSnapshots(getSnapshotDir(s.config.RootDir, s.config.GenesisFile.ChainID))
+}
 
+// readSnapshots reads the metadata of every snapshot in a directory, ascending by height
+func (s *Server) readSnapshots(snapshotDir string) ([]v1.Snapshot, error) {
 	dirs, err := os.ReadDir(snapshotDir)
 	if err != nil {
 		return nil, fmt.Errorf("error reading snapshot directory: %w", err)
@@ -435,6 +586,15 @@ func (s *Server) GetChunkByHeight(height int64, chunk int) ([]byte, error) {
 		return nil, fmt.Errorf("error unmarshalling metadata: %v", err)
 	}
 
+	// the first chunks of a delta are those of its base
+	metadata := &Metadata{}
+	if err := json.Unmarshal(meta.Metadata, metadata); err == nil && metadata.BaseHeight > 0 {
+		if chunk < metadata.BaseChunks {
+			return s.GetChunkByHeight(metadata.BaseHeight, chunk)
+		}
+		chunk -= metadata.BaseChunks
+	}
+
 	// Read the chunk file
 	chunkPath := getChunkPath(latestSnapshotDir, chunk)
 
@@ -446,6 +606,40 @@ func (s *Server) GetChunkByHeight(height int64, chunk int) ([]byte, error) {
 	return chunkData, nil
 }
 
+var ErrUnknownSnapshotFormat = errors.New("unknown snapshot format")
+
+// validateOfferedSnapshot checks a snapshot's metadata is consistent before any of its chunks are fetched
+func validateOfferedSnapshot(snapshot *v1.Snapshot, chainID string) error {
+	if snapshot == nil {
+		return errors.New("no snapshot offered")
+	}
+	if snapshot.Format != snapshotFormatLegacy && snapshot.Format != snapshotFormatManifest {
+		return fmt.Errorf("%w: %d", ErrUnknownSnapshotFormat, snapshot.Format)
+	}
+
+	metadata := &Metadata{}
+	if err := json.Unmarshal(snapshot.Metadata, metadata); err != nil {
+		return fmt.Errorf("invalid snapshot metadata: %v", err)
+	}
+	if metadata.ChainID != chainID {
+		return fmt.Errorf("snapshot is for chain %s", metadata.ChainID)
+	}
+	if snapshot.Format == snapshotFormatLegacy {
+		return nil
+	}
+
+	if len(metadata.ChunkHashes) != int(snapshot.Chunks) {
+		return fmt.Errorf("manifest has %d chunk hashes for %d chunks", len(metadata.ChunkHashes), snapshot.Chunks)
+	}
+	if metadata.BaseHeight < 0 || metadata.BaseHeight >= int64(snapshot.Height) {
+		return fmt.Errorf("delta base %d is not below snapshot height %d", metadata.BaseHeight, snapshot.Height)
+	}
+	if metadata.BaseHeight > 0 && (metadata.BaseChunks <= 0 || metadata.BaseChunks >= int(snapshot.Chunks)) {
+		return fmt.Errorf("delta has %d base chunks of %d", metadata.BaseChunks, snapshot.Chunks)
+	}
+	return nil
+}
+
 func (s *Server) StoreOfferedSnapshot(snapshot *v1.Snapshot) error {
 	snapshotDir := filepath.Join(s.config.RootDir, tmpReconstructionDir)
 	if err := os.MkdirAll(snapshotDir, 0755); err != nil {
@@ -535,9 +729,10 @@ func (s *Server) haveAllChunks(height uint64, total int) bool {
 	return len(chunks) == total
 }
 
-// ReassemblePgDump reconstructs and decompresses a binary pg_dump file from multiple gzipped chunks
-func (s *Server) ReassemblePgDump(height int64) error {
-	tmpDir := filepath.Join(s.config.RootDir, tmpReconstructionDir)
+// ReassemblePgDump reconstructs and decompresses a binary pg_dump file from multiple gzipped chunks.
+// Chunks from dumpChunks on are the delta of a delta snapshot and go to the delta file instead.
+func (s *Server) ReassemblePgDump(height int64, dumpChunks int) error {
+	tmpDir := getReconstructionDir(s.config.RootDir)
 	heightDir := getHeightDir(tmpDir, height)
 
 	// Create the output pg_dump file in binary format
@@ -547,6 +742,7 @@ func (s *Server) ReassemblePgDump(height int64) error {
 		return fmt.Errorf("failed to create output file: %v", err)
 	}
 	defer outputFile.Close()
+	output := io.Writer(outputFile)
 
 	// Read all chunk files in order
 	files, err := os.ReadDir(heightDir)
@@ -559,11 +755,22 @@ func (s *Server) ReassemblePgDump(height int64) error {
 		return files[i].Name() < files[j].Name()
 	})
 
+	chunkIndex := 0
 	for _, file := range files {
 		if !strings.HasSuffix(file.Name(), ".gz") {
 			continue
 		}
 
+		if chunkIndex == dumpChunks {
+			deltaFile, err := os.Create(getDeltaPath(heightDir))
+			if err != nil {
+				return fmt.Errorf("failed to create delta file: %v", err)
+			}
+			defer deltaFile.Close()
+			output = deltaFile
+		}
+		chunkIndex++
+
 		chunkPath := filepath.Join(heightDir, file.Name())
 		chunkData, err := os.ReadFile(chunkPath)
 		if err != nil {
@@ -576,7 +783,7 @@ func (s *Server) ReassemblePgDump(height int64) error {
 			return fmt.Errorf("failed to create gzip reader: %v", err)
 		}
 
-		if _, err := io.Copy(outputFile, gzReader); err != nil {
+		if _, err := io.Copy(output, gzReader); err != nil {
 			gzReader.Close()
 			return fmt.Errorf("failed to write decompressed data: %v", err)
 		}
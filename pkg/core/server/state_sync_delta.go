@@ -0,0 +1,200 @@
+package server
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// snapshotRangedTables are only ever appended to at the height in their height column,
+// delta snapshots carry just the rows added since their base. Every other table is
+// small and mutable so deltas carry all of it. Superseded state tree rows are also
+// pruned, rows the sender pruned after the base stay in the restored tables which
+// is harmless since they're never read at or above the retain height.
+var snapshotRangedTables = map[string]string{
+	"core_app_state":    "block_height",
+	"core_blocks":       "height",
+	"core_state_nodes":  "block_height",
+	"core_state_values": "block_height",
+	"core_transactions": "block_id",
+	"core_tx_stats":     "block_height",
+}
+
+// deltaSection precedes the COPY text of one table in a delta file
+type deltaSection struct {
+	Table string `json:"table"`
+	// rows above this height replace the ones in the base, zero replaces the whole table
+	FromHeight int64 `json:"from_height,omitempty"`
+	Size       int64 `json:"size"`
+
+	offset int64
+}
+
+// createDeltaDump writes every table of the snapshot to a delta file as of one consistent
+// read, ranged tables only from the rows above baseHeight up to height
+func (s *Server) createDeltaDump(ctx context.Context, logger *zap.Logger, latestSnapshotDir string, baseHeight, height int64) error {
+	deltaPath := getDeltaPath(latestSnapshotDir)
+	out, err := os.Create(deltaPath)
+	if err != nil {
+		return fmt.Errorf("failed to create delta: %w", err)
+	}
+	defer out.Close()
+
+	tx, err := s.pool.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return fmt.Errorf("could not begin delta read: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	for _, table := range snapshotTables {
+		section := deltaSection{Table: table}
+		query := fmt.Sprintf("copy %s to stdout", table)
+		if column, ok := snapshotRangedTables[table]; ok {
+			section.FromHeight = baseHeight
+			query = fmt.Sprintf("copy (select * from %s where %s > %d and %s <= %d) to stdout", table, column, baseHeight, column, height)
+		}
+
+		// sections are length prefixed so the copy is staged to learn its size
+		staged, err := os.CreateTemp(latestSnapshotDir, "section_*")
+		if err != nil {
+			return fmt.Errorf("failed to stage %s: %w", table, err)
+		}
+		tag, err := tx.Conn().PgConn().CopyTo(ctx, staged, query)
+		if err != nil {
+			staged.Close()
+			os.Remove(staged.Name())
+			return fmt.Errorf("failed to copy %s: %w", table, err)
+		}
+		section.Size, err = staged.Seek(0, io.SeekCurrent)
+		if err == nil {
+			err = writeDeltaSection(out, section, staged)
+		}
+		staged.Close()
+		os.Remove(staged.Name())
+		if err != nil {
+			return fmt.Errorf("failed to write %s: %w", table, err)
+		}
+
+		logger.Info("Wrote delta section", zap.String("table", table), zap.Int64("rows", tag.RowsAffected()), zap.Int64("size", section.Size))
+	}
+
+	return nil
+}
+
+func writeDeltaSection(w io.Writer, section deltaSection, data io.ReadSeeker) error {
+	header, err := json.Marshal(section)
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(append(header, '\n')); err != nil {
+		return err
+	}
+	if _, err := data.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	_, err = io.CopyN(w, data, section.Size)
+	return err
+}
+
+// readDeltaSections reads the section headers of a delta file and where each one's data starts
+func readDeltaSections(f io.ReadSeeker) ([]deltaSection, error) {
+	sections := []deltaSection{}
+	var offset int64
+	for {
+		if _, err := f.Seek(offset, io.SeekStart); err != nil {
+			return nil, err
+		}
+		line, err := bufio.NewReader(f).ReadBytes('\n')
+		if err == io.EOF && len(line) == 0 {
+			return sections, nil
+		} else if err != nil {
+			return nil, fmt.Errorf("truncated delta section header: %w", err)
+		}
+
+		var section deltaSection
+		if err := json.Unmarshal(line, &section); err != nil {
+			return nil, fmt.Errorf("invalid delta section header: %w", err)
+		}
+		if !slices.Contains(snapshotTables, section.Table) {
+			return nil, fmt.Errorf("delta section for unknown table %s", section.Table)
+		}
+		section.offset = offset + int64(len(line))
+		sections = append(sections, section)
+		offset = section.offset + section.Size
+	}
+}
+
+// applyDeltaDump loads a delta over the base snapshot restored before it. Tables are
+// cleared children first and loaded parents first so foreign keys hold throughout.
+func (s *Server) applyDeltaDump(ctx context.Context, height int64) error {
+	heightDir := getHeightDir(getReconstructionDir(s.config.RootDir), height)
+	f, err := os.Open(getDeltaPath(heightDir))
+	if err != nil {
+		return fmt.Errorf("failed to open delta: %w", err)
+	}
+	defer f.Close()
+
+	sections, err := readDeltaSections(f)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("could not begin delta restore: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	for _, section := range slices.Backward(sections) {
+		query := fmt.Sprintf("delete from %s", section.Table)
+		if section.FromHeight > 0 {
+			query = fmt.Sprintf("%s where %s > %d", query, snapshotRangedTables[section.Table], section.FromHeight)
+		}
+		if _, err := tx.Exec(ctx, query); err != nil {
+			return fmt.Errorf("failed to clear %s: %w", section.Table, err)
+		}
+	}
+
+	for _, section := range sections {
+		data := io.NewSectionReader(f, section.offset, section.Size)
+		if _, err := tx.Conn().PgConn().CopyFrom(ctx, data, fmt.Sprintf("copy %s from stdin", section.Table)); err != nil {
+			return fmt.Errorf("failed to load %s: %w", section.Table, err)
+		}
+		if err := resetSerialSequences(ctx, tx, section.Table); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+// resetSerialSequences moves the sequences behind a table's serial columns past the rows
+// copied into it, pg_restore does this for full snapshots
+func resetSerialSequences(ctx context.Context, tx pgx.Tx, table string) error {
+	rows, err := tx.Query(ctx, `
+		select attname from pg_attribute
+		where attrelid = $1::regclass and attnum > 0 and not attisdropped
+		  and pg_get_serial_sequence($1, attname) is not null`, table)
+	if err != nil {
+		return fmt.Errorf("could not find sequences of %s: %w", table, err)
+	}
+	columns, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return fmt.Errorf("could not find sequences of %s: %w", table, err)
+	}
+
+	for _, column := range columns {
+		query := fmt.Sprintf("select setval(pg_get_serial_sequence('%s', '%s'), coalesce(max(%s), 0) + 1, false) from %s", table, column, column, table)
+		if _, err := tx.Exec(ctx, query); err != nil {
+			return fmt.Errorf("could not reset sequence of %s.%s: %w", table, column, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/AudiusProject/audiusd/pkg/core/config"
+	v1 "github.com/cometbft/cometbft/api/cometbft/abci/v1"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestValidateOfferedSnapshot(t *testing.T) {
+	const chainID = "audius-devnet"
+	snapshot := func(format uint32, chunks uint32, metadata Metadata) *v1.Snapshot {
+		b, err := json.Marshal(metadata)
+		require.NoError(t, err)
+		return &v1.Snapshot{Height: 200, Format: format, Chunks: chunks, Metadata: b}
+	}
+
+	require.NoError(t, validateOfferedSnapshot(snapshot(snapshotFormatLegacy, 3, Metadata{ChainID: chainID}), chainID))
+	require.NoError(t, validateOfferedSnapshot(snapshot(snapshotFormatManifest, 2, Metadata{ChainID: chainID, ChunkHashes: []string{"a", "b"}}), chainID))
+	require.NoError(t, validateOfferedSnapshot(snapshot(snapshotFormatManifest, 3, Metadata{ChainID: chainID, ChunkHashes: []string{"a", "b", "c"}, BaseHeight: 100, BaseChunks: 2}), chainID))
+
+	require.ErrorIs(t, validateOfferedSnapshot(snapshot(3, 1, Metadata{ChainID: chainID}), chainID), ErrUnknownSnapshotFormat)
+	require.Error(t, validateOfferedSnapshot(snapshot(snapshotFormatLegacy, 1, Metadata{ChainID: "other"}), chainID))
+	require.Error(t, validateOfferedSnapshot(snapshot(snapshotFormatManifest, 3, Metadata{ChainID: chainID, ChunkHashes: []string{"a", "b"}}), chainID))
+	require.Error(t, validateOfferedSnapshot(snapshot(snapshotFormatManifest, 2, Metadata{ChainID: chainID, ChunkHashes: []string{"a", "b"}, BaseHeight: 200, BaseChunks: 1}), chainID))
+	require.Error(t, validateOfferedSnapshot(snapshot(snapshotFormatManifest, 2, Metadata{ChainID: chainID, ChunkHashes: []string{"a", "b"}, BaseHeight: 100, BaseChunks: 2}), chainID))
+}
+
+func TestDeltaSections(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, writeDeltaSection(&buf, deltaSection{Table: "core_validators", Size: 4}, strings.NewReader("a\tb\n")))
+	require.NoError(t, writeDeltaSection(&buf, deltaSection{Table: "core_blocks", FromHeight: 100, Size: 0}, strings.NewReader("")))
+	require.NoError(t, writeDeltaSection(&buf, deltaSection{Table: "core_transactions", FromHeight: 100, Size: 2}, strings.NewReader("c\n")))
+
+	sections, err := readDeltaSections(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	require.Len(t, sections, 3)
+	require.Equal(t, "core_blocks", sections[1].Table)
+	require.Equal(t, int64(100), sections[1].FromHeight)
+	require.Equal(t, "a\tb\n", string(buf.Bytes()[sections[0].offset:sections[0].offset+sections[0].Size]))
+	require.Equal(t, "c\n", string(buf.Bytes()[sections[2].offset:sections[2].offset+sections[2].Size]))
+
+	var bad bytes.Buffer
+	require.NoError(t, writeDeltaSection(&bad, deltaSection{Table: "pg_authid", Size: 0}, strings.NewReader("")))
+	_, err = readDeltaSections(bytes.NewReader(bad.Bytes()))
+	require.Error(t, err)
+}
+
+func TestDeltaSnapshotChunks(t *testing.T) {
+	root := t.TempDir()
+	genesis := &cmttypes.GenesisDoc{ChainID: "audius-devnet"}
+	s := &Server{config: &config.Config{
+		RootDir:     root,
+		GenesisFile: genesis,
+		StateSync:   &config.StateSyncConfig{ServeSnapshots: true, BlockInterval: 100, Keep: 1, FullSnapshotInterval: 3},
+	}}
+	snapshotDir := getSnapshotDir(root, genesis.ChainID)
+
+	writeSnapshot := func(height int64, chunks []string, metadata Metadata) {
+		dir := getHeightDir(snapshotDir, height)
+		require.NoError(t, os.MkdirAll(dir, 0755))
+		for i, chunk := range chunks {
+			require.NoError(t, os.WriteFile(getChunkPath(dir, i), []byte(chunk), 0644))
+		}
+		hashes, err := hashChunks(dir, len(chunks))
+		require.NoError(t, err)
+		metadata.ChunkHashes = append(metadata.ChunkHashes, hashes...)
+		b, err := json.Marshal(metadata)
+		require.NoError(t, err)
+		snapshotBytes, err := json.Marshal(v1.Snapshot{Height: uint64(height), Format: snapshotFormatManifest, Chunks: uint32(metadata.BaseChunks + len(chunks)), Metadata: b})
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(getMetadataPath(dir), snapshotBytes, 0644))
+	}
+
+	require.NoError(t, os.MkdirAll(snapshotDir, 0755))
+	base, err := s.snapshotBase(snapshotDir, 100)
+	require.NoError(t, err)
+	require.Nil(t, base)
+
+	writeSnapshot(100, []string{"full0", "full1"}, Metadata{})
+	base, err = s.snapshotBase(snapshotDir, 300)
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), base.Height)
+	base, err = s.snapshotBase(snapshotDir, 400)
+	require.NoError(t, err)
+	require.Nil(t, base)
+
+	writeSnapshot(200, []string{"delta0"}, Metadata{BaseHeight: 100, BaseChunks: 2, ChunkHashes: []string{chunkHash([]byte("full0")), chunkHash([]byte("full1"))}})
+
+	// deltas serve the chunks of their base first
+	for i, want := range []string{"full0", "full1", "delta0"} {
+		chunk, err := s.GetChunkByHeight(200, i)
+		require.NoError(t, err)
+		require.Equal(t, want, string(chunk))
+	}
+
+	// the base of a retained delta outlives the retention count
+	require.NoError(t, s.pruneSnapshots(zap.NewNop()))
+	snapshots, err := s.getStoredSnapshots()
+	require.NoError(t, err)
+	require.Len(t, snapshots, 2)
+}
@@ -0,0 +1,515 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/AudiusProject/audiusd/pkg/core/db"
+	cmtcrypto "github.com/cometbft/cometbft/api/cometbft/crypto/v1"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/proto"
+)
+
+// The state tree commits to the value every query route answers with so abci
+// queries can be proven, which the lattice digest can't do for a single row.
+// Each key is a leaf of one of stateTreeBuckets buckets picked by the hash of
+// the key, a bucket is a simple merkle tree of its leaves sorted by key and the
+// bucket roots are the leaves of a fixed depth tree above them. Both levels use
+// comet's simple merkle encoding so a proof is a chain of ValueOps that comet's
+// default proof runtime verifies as is, the last one binding the tree root next
+// to the state digest in the app hash. Values and nodes are kept per height
+// that wrote them so queries are answered and proven at any unpruned height.
+
+const (
+	stateTreeDepth   = 16
+	stateTreeBuckets = 1 << stateTreeDepth
+
+	// values written at activation are inserted in batches of this size
+	stateTreeInsertBatch = 1000
+)
+
+var (
+	// keys of the two leaves the app hash is the simple merkle root of
+	stateDigestKey = []byte("digest")
+	stateTreeKey   = []byte("tree")
+
+	ErrStateTreeNodeMissing = errors.New("state tree node missing")
+)
+
+type stateLeaf struct {
+	key       string
+	valueHash []byte
+}
+
+func (s *Server) stateProofActive(height int64) bool {
+	activation := s.config.StateProofHeight
+	return activation > 0 && height >= activation && s.stateCommitmentActive(height)
+}
+
+// stateTouch records a query key whose value the in progress block may have changed
+func (s *Server) stateTouch(route, key string) {
+	if key == "" {
+		return
+	}
+	s.abciState.stateTouches = append(s.abciState.stateTouches, queryStateKey(route, key))
+}
+
+// queryStateKey is the key a query route's value is stored under in the state tree,
+// tx hashes are upper case hex as common.ToTxHashFromBytes writes them
+func queryStateKey(route, key string) string {
+	if route == "tx" {
+		key = strings.ToUpper(key)
+	}
+	return "/" + route + "/" + key
+}
+
+func stateBucket(key string) int32 {
+	h := sha256.Sum256([]byte(key))
+	return int32(binary.BigEndian.Uint16(h[:2]))
+}
+
+func stateBucketKey(bucket int32) []byte {
+	return binary.BigEndian.AppendUint16(nil, uint16(bucket))
+}
+
+// stateKVLeaf encodes a key and the hash of its value the way comet's ValueOp does
+func stateKVLeaf(key, valueHash []byte) []byte {
+	b := binary.AppendUvarint(nil, uint64(len(key)))
+	b = append(b, key...)
+	b = binary.AppendUvarint(b, uint64(len(valueHash)))
+	return append(b, valueHash...)
+}
+
+func stateValueHash(value []byte) []byte {
+	h := sha256.Sum256(value)
+	return h[:]
+}
+
+// stateInnerHash matches the inner nodes of comet's simple merkle tree
+func stateInnerHash(left, right []byte) []byte {
+	h := sha256.New()
+	h.Write([]byte{1})
+	h.Write(left)
+	h.Write(right)
+	return h.Sum(nil)
+}
+
+// stateBucketItems returns the leaves of a bucket in tree order, sorted by the
+// bytes of their keys so the order doesn't depend on the database collation
+func stateBucketItems(leaves []stateLeaf) ([]string, [][]byte) {
+	sort.Slice(leaves, func(i, j int) bool { return leaves[i].key < leaves[j].key })
+	keys := make([]string, len(leaves))
+	items := make([][]byte, len(leaves))
+	for i, l := range leaves {
+		keys[i] = l.key
+		items[i] = stateKVLeaf([]byte(l.key), l.valueHash)
+	}
+	return keys, items
+}
+
+// stateBucketNode is the depth stateTreeDepth node of a bucket, the leaf hash of
+// the bucket key and the bucket's root
+func stateBucketNode(bucket int32, leaves []stateLeaf) []byte {
+	_, items := stateBucketItems(leaves)
+	root := merkle.HashFromByteSlices(items)
+	return merkle.HashFromByteSlices([][]byte{stateKVLeaf(stateBucketKey(bucket), stateValueHash(root))})
+}
+
+// stateAppHashItems are the leaves of the app hash once the state tree is active
+func stateAppHashItems(digest *stateDigest, root []byte) [][]byte {
+	return [][]byte{
+		stateKVLeaf(stateDigestKey, stateValueHash(digest.Bytes())),
+		stateKVLeaf(stateTreeKey, stateValueHash(root)),
+	}
+}
+
+func stateAppHash(digest *stateDigest, root []byte) []byte {
+	return merkle.HashFromByteSlices(stateAppHashItems(digest, root))
+}
+
+// stateTreeParents hashes the nodes of one depth into their parents, siblings
+// holds the nodes of that depth that aren't in nodes
+func stateTreeParents(nodes, siblings map[int32][]byte) (map[int32][]byte, error) {
+	parents := make(map[int32][]byte, len(nodes))
+	for index := range nodes {
+		parent := index / 2
+		if _, ok := parents[parent]; ok {
+			continue
+		}
+		left, right := nodes[parent*2], nodes[parent*2+1]
+		if left == nil {
+			left = siblings[parent*2]
+		}
+		if right == nil {
+			right = siblings[parent*2+1]
+		}
+		if left == nil || right == nil {
+			return nil, fmt.Errorf("%w: children of %d", ErrStateTreeNodeMissing, parent)
+		}
+		parents[parent] = stateInnerHash(left, right)
+	}
+	return parents, nil
+}
+
+// stateValue reads the current value of a state tree key through its query
+// route, nil when the key has no value
+func (s *Server) stateValue(ctx context.Context, q *db.Queries, key string) ([]byte, error) {
+	route, routeKey, err := parseQueryPath(key)
+	if err != nil {
+		return nil, err
+	}
+	handler, ok := s.queryRoutes()[route]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrQueryUnknownPath, route)
+	}
+
+	value, err := handler(ctx, q, routeKey)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("could not read %s: %v", key, err)
+	}
+
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal %s: %v", key, err)
+	}
+	return b, nil
+}
+
+// finalizeStateTree writes the values of the keys the in progress block touched
+// and the nodes above them and returns the new tree root, the whole tree is
+// built from app state when there's no previous root
+func (s *Server) finalizeStateTree(ctx context.Context, q *db.Queries, height int64, touched []string, prevRoot []byte) ([]byte, error) {
+	if prevRoot == nil {
+		return s.buildStateTree(ctx, q, height)
+	}
+
+	slices.Sort(touched)
+	touched = slices.Compact(touched)
+
+	var params db.InsertStateValuesParams
+	dirty := map[int32]bool{}
+	for _, key := range touched {
+		value, err := s.stateValue(ctx, q, key)
+		if err != nil {
+			return nil, err
+		}
+
+		prev, err := q.GetStateValue(ctx, db.GetStateValueParams{Key: key, BlockHeight: height - 1})
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("could not read state value %s: %v", key, err)
+		}
+
+		var valueHash []byte
+		if value != nil {
+			valueHash = stateValueHash(value)
+		}
+		if bytes.Equal(prev.ValueHash, valueHash) {
+			continue
+		}
+
+		bucket := stateBucket(key)
+		params.Keys = append(params.Keys, key)
+		params.Buckets = append(params.Buckets, bucket)
+		params.StateValues = append(params.StateValues, value)
+		params.ValueHashes = append(params.ValueHashes, valueHash)
+		dirty[bucket] = true
+	}
+
+	if len(params.Keys) == 0 {
+		return prevRoot, nil
+	}
+
+	params.BlockHeight = height
+	if err := q.InsertStateValues(ctx, params); err != nil {
+		return nil, fmt.Errorf("could not insert state values: %v", err)
+	}
+
+	buckets := make(map[int32][]byte, len(dirty))
+	for bucket := range dirty {
+		rows, err := q.GetStateBucketValues(ctx, db.GetStateBucketValuesParams{Bucket: bucket, BlockHeight: height})
+		if err != nil {
+			return nil, fmt.Errorf("could not read state bucket %d: %v", bucket, err)
+		}
+		leaves := make([]stateLeaf, len(rows))
+		for i, r := range rows {
+			leaves[i] = stateLeaf{key: r.Key, valueHash: r.ValueHash}
+		}
+		buckets[bucket] = stateBucketNode(bucket, leaves)
+	}
+
+	return s.writeStateNodes(ctx, q, height, buckets)
+}
+
+// buildStateTree writes every queryable value and every node of the tree, it
+// runs once at the activation height
+func (s *Server) buildStateTree(ctx context.Context, q *db.Queries, height int64) ([]byte, error) {
+	keys, err := s.stateKeys(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	leaves := make(map[int32][]stateLeaf)
+	var params db.InsertStateValuesParams
+	for _, key := range keys {
+		value, err := s.stateValue(ctx, q, key)
+		if err != nil {
+			return nil, err
+		}
+		if value == nil {
+			continue
+		}
+
+		bucket := stateBucket(key)
+		valueHash := stateValueHash(value)
+		leaves[bucket] = append(leaves[bucket], stateLeaf{key: key, valueHash: valueHash})
+
+		params.Keys = append(params.Keys, key)
+		params.Buckets = append(params.Buckets, bucket)
+		params.StateValues = append(params.StateValues, value)
+		params.ValueHashes = append(params.ValueHashes, valueHash)
+		if len(params.Keys) == stateTreeInsertBatch {
+			params.BlockHeight = height
+			if err := q.InsertStateValues(ctx, params); err != nil {
+				return nil, fmt.Errorf("could not insert state values: %v", err)
+			}
+			params = db.InsertStateValuesParams{}
+		}
+	}
+	if len(params.Keys) > 0 {
+		params.BlockHeight = height
+		if err := q.InsertStateValues(ctx, params); err != nil {
+			return nil, fmt.Errorf("could not insert state values: %v", err)
+		}
+	}
+
+	buckets := make(map[int32][]byte, stateTreeBuckets)
+	for bucket := int32(0); bucket < stateTreeBuckets; bucket++ {
+		buckets[bucket] = stateBucketNode(bucket, leaves[bucket])
+	}
+
+	return s.writeStateNodes(ctx, q, height, buckets)
+}
+
+// stateKeys lists the key of every value the query routes can answer with
+func (s *Server) stateKeys(ctx context.Context, q *db.Queries) ([]string, error) {
+	var keys []string
+	appendKey := func(route, key string) {
+		if key != "" {
+			keys = append(keys, queryStateKey(route, key))
+		}
+	}
+
+	erns, err := q.GetAllCoreERNs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not read erns: %v", err)
+	}
+	for _, r := range erns {
+		appendKey("ern", r.Address)
+	}
+
+	rewards, err := q.GetAllCoreRewards(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not read rewards: %v", err)
+	}
+	for _, r := range rewards {
+		appendKey("reward", r.Address)
+	}
+
+	validators, err := q.GetAllRegisteredNodes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not read validators: %v", err)
+	}
+	for _, v := range validators {
+		appendKey("validator", v.CometAddress)
+	}
+
+	uploads, err := q.GetAllCoreUploads(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not read uploads: %v", err)
+	}
+	for _, u := range uploads {
+		appendKey("upload", u.Cid)
+		appendKey("upload", u.TranscodedCid)
+	}
+
+	txHashes, err := q.GetAllTxHashes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not read tx hashes: %v", err)
+	}
+	for _, h := range txHashes {
+		appendKey("tx", h)
+	}
+
+	slices.Sort(keys)
+	return slices.Compact(keys), nil
+}
+
+// writeStateNodes writes the given bucket nodes and every node above them up to
+// the root, which it returns
+func (s *Server) writeStateNodes(ctx context.Context, q *db.Queries, height int64, nodes map[int32][]byte) ([]byte, error) {
+	for depth := int32(stateTreeDepth); ; depth-- {
+		params := db.InsertStateNodesParams{Depth: depth, BlockHeight: height}
+		for index := range nodes {
+			params.Indexes = append(params.Indexes, index)
+		}
+		slices.Sort(params.Indexes)
+		for _, index := range params.Indexes {
+			params.Hashes = append(params.Hashes, nodes[index])
+		}
+		if err := q.InsertStateNodes(ctx, params); err != nil {
+			return nil, fmt.Errorf("could not insert state nodes at depth %d: %v", depth, err)
+		}
+
+		if depth == 0 {
+			return nodes[0], nil
+		}
+
+		var missing []int32
+		for index := range nodes {
+			if _, ok := nodes[index^1]; !ok {
+				missing = append(missing, index^1)
+			}
+		}
+		siblings, err := stateNodes(ctx, q, depth, missing, height)
+		if err != nil {
+			return nil, err
+		}
+
+		nodes, err = stateTreeParents(nodes, siblings)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// stateNodes reads the nodes at depth as of height
+func stateNodes(ctx context.Context, q *db.Queries, depth int32, indexes []int32, height int64) (map[int32][]byte, error) {
+	nodes := make(map[int32][]byte, len(indexes))
+	if len(indexes) == 0 {
+		return nodes, nil
+	}
+
+	rows, err := q.GetStateNodes(ctx, db.GetStateNodesParams{Depth: depth, Indexes: indexes, BlockHeight: height})
+	if err != nil {
+		return nil, fmt.Errorf("could not read state nodes at depth %d: %v", depth, err)
+	}
+	for _, r := range rows {
+		nodes[r.Index] = r.Hash
+	}
+	for _, index := range indexes {
+		if nodes[index] == nil {
+			return nil, fmt.Errorf("%w: %d at depth %d", ErrStateTreeNodeMissing, index, depth)
+		}
+	}
+	return nodes, nil
+}
+
+// stateProof proves the value of key at height against the app hash recorded at
+// that height, which the header of the next block carries. It verifies with
+// comet's default proof runtime under the key path /tree/x:{bucket}/{key}.
+func stateProof(ctx context.Context, q *db.Queries, key string, bucket int32, height int64) (*cmtcrypto.ProofOps, error) {
+	rows, err := q.GetStateBucketValues(ctx, db.GetStateBucketValuesParams{Bucket: bucket, BlockHeight: height})
+	if err != nil {
+		return nil, fmt.Errorf("could not read state bucket %d: %v", bucket, err)
+	}
+	leaves := make([]stateLeaf, len(rows))
+	for i, r := range rows {
+		leaves[i] = stateLeaf{key: r.Key, valueHash: r.ValueHash}
+	}
+	keys, items := stateBucketItems(leaves)
+	index := slices.Index(keys, key)
+	if index < 0 {
+		return nil, fmt.Errorf("%s is not in state bucket %d", key, bucket)
+	}
+	_, valueProofs := merkle.ProofsFromByteSlices(items)
+
+	bucketProof := &merkle.Proof{Total: stateTreeBuckets, Index: int64(bucket)}
+	node := bucket
+	for depth := int32(stateTreeDepth); depth > 0; depth-- {
+		indexes := []int32{node ^ 1}
+		if depth == stateTreeDepth {
+			indexes = append(indexes, node)
+		}
+		nodes, err := stateNodes(ctx, q, depth, indexes, height)
+		if err != nil {
+			return nil, err
+		}
+		if depth == stateTreeDepth {
+			bucketProof.LeafHash = nodes[node]
+		}
+		bucketProof.Aunts = append(bucketProof.Aunts, nodes[node^1])
+		node /= 2
+	}
+
+	appState, err := q.GetAppStateAtHeight(ctx, height)
+	if err != nil {
+		return nil, fmt.Errorf("could not read app state at %d: %v", height, err)
+	}
+	digest, err := stateDigestFromBytes(appState.StateDigest)
+	if err != nil {
+		return nil, fmt.Errorf("app state at %d: %w", height, err)
+	}
+	_, appHashProofs := merkle.ProofsFromByteSlices(stateAppHashItems(digest, appState.StateRoot))
+
+	return &cmtcrypto.ProofOps{Ops: []cmtcrypto.ProofOp{
+		merkle.NewValueOp([]byte(key), valueProofs[index]).ProofOp(),
+		merkle.NewValueOp(stateBucketKey(bucket), bucketProof).ProofOp(),
+		merkle.NewValueOp(stateTreeKey, appHashProofs[1]).ProofOp(),
+	}}, nil
+}
+
+// computeStateTreeRoot rebuilds the tree root at height from the stored values
+func computeStateTreeRoot(ctx context.Context, q *db.Queries, height int64) ([]byte, error) {
+	rows, err := q.GetStateValuesAtHeight(ctx, height)
+	if err != nil {
+		return nil, fmt.Errorf("could not read state values at %d: %v", height, err)
+	}
+	leaves := make(map[int32][]stateLeaf)
+	for _, r := range rows {
+		leaves[r.Bucket] = append(leaves[r.Bucket], stateLeaf{key: r.Key, valueHash: r.ValueHash})
+	}
+
+	nodes := make(map[int32][]byte, stateTreeBuckets)
+	for bucket := int32(0); bucket < stateTreeBuckets; bucket++ {
+		nodes[bucket] = stateBucketNode(bucket, leaves[bucket])
+	}
+	for depth := stateTreeDepth; depth > 0; depth-- {
+		nodes, err = stateTreeParents(nodes, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return nodes[0], nil
+}
+
+// pruneStateTree drops the values and nodes superseded at or below height,
+// queries below it are refused from then on
+func (s *Server) pruneStateTree(ctx context.Context, height int64) error {
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("could not begin state tree prune: %v", err)
+	}
+	defer tx.Rollback(ctx)
+
+	q := s.db.WithTx(tx)
+	if err := q.SetStateTreeRetainHeight(ctx, height); err != nil {
+		return fmt.Errorf("could not set state tree retain height: %v", err)
+	}
+	if err := q.PruneStateValues(ctx, height); err != nil {
+		return fmt.Errorf("could not prune state values: %v", err)
+	}
+	if err := q.PruneStateNodes(ctx, height); err != nil {
+		return fmt.Errorf("could not prune state nodes: %v", err)
+	}
+	return tx.Commit(ctx)
+}
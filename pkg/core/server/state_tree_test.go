@@ -0,0 +1,327 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	v1 "github.com/AudiusProject/audiusd/pkg/api/core/v1"
+	"github.com/AudiusProject/audiusd/pkg/core/config"
+	"github.com/AudiusProject/audiusd/pkg/core/db"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	"github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+type fakeStateVersion struct {
+	height int64
+	bucket int32
+	value  []byte
+	hash   []byte
+}
+
+// fakeStateTree keeps the state tree tables and app state of a fakeDB in memory
+type fakeStateTree struct {
+	mu        sync.Mutex
+	values    map[string][]fakeStateVersion
+	nodes     map[[2]int32][]fakeStateVersion
+	appStates map[int64][]any
+	retain    int64
+}
+
+func newFakeStateTree(fake *fakeDB) *fakeStateTree {
+	t := &fakeStateTree{
+		values:    map[string][]fakeStateVersion{},
+		nodes:     map[[2]int32][]fakeStateVersion{},
+		appStates: map[int64][]any{},
+	}
+
+	// the latest version of each entry at or below height
+	at := func(versions []fakeStateVersion, height int64) (fakeStateVersion, bool) {
+		var latest fakeStateVersion
+		found := false
+		for _, v := range versions {
+			if v.height <= height && (!found || v.height > latest.height) {
+				latest, found = v, true
+			}
+		}
+		return latest, found
+	}
+
+	fake.on("InsertStateValues", func(args ...any) ([][]any, error) {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		keys, buckets, values, hashes := args[0].([]string), args[1].([]int32), args[2].([][]byte), args[3].([][]byte)
+		for i, key := range keys {
+			v := fakeStateVersion{height: args[4].(int64), bucket: buckets[i]}
+			if hashes[i] != nil {
+				v.value, v.hash = values[i], hashes[i]
+			}
+			t.values[key] = append(t.values[key], v)
+		}
+		return nil, nil
+	})
+	fake.on("GetStateValue", func(args ...any) ([][]any, error) {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		v, ok := at(t.values[args[0].(string)], args[1].(int64))
+		if !ok {
+			return nil, nil
+		}
+		return [][]any{{args[0].(string), v.bucket, v.value, v.hash, v.height}}, nil
+	})
+	fake.on("GetStateBucketValues", func(args ...any) ([][]any, error) {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		var rows [][]any
+		for key, versions := range t.values {
+			if v, ok := at(versions, args[1].(int64)); ok && v.bucket == args[0].(int32) && v.hash != nil {
+				rows = append(rows, []any{key, v.hash})
+			}
+		}
+		return rows, nil
+	})
+	fake.on("GetStateValuesAtHeight", func(args ...any) ([][]any, error) {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		var rows [][]any
+		for key, versions := range t.values {
+			if v, ok := at(versions, args[0].(int64)); ok && v.hash != nil {
+				rows = append(rows, []any{key, v.bucket, v.hash})
+			}
+		}
+		return rows, nil
+	})
+	fake.on("InsertStateNodes", func(args ...any) ([][]any, error) {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		depth, indexes, hashes := args[0].(int32), args[1].([]int32), args[2].([][]byte)
+		for i, index := range indexes {
+			node := [2]int32{depth, index}
+			t.nodes[node] = append(t.nodes[node], fakeStateVersion{height: args[3].(int64), hash: hashes[i]})
+		}
+		return nil, nil
+	})
+	fake.on("GetStateNodes", func(args ...any) ([][]any, error) {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		var rows [][]any
+		for _, index := range args[2].([]int32) {
+			if v, ok := at(t.nodes[[2]int32{args[0].(int32), index}], args[1].(int64)); ok {
+				rows = append(rows, []any{index, v.hash})
+			}
+		}
+		return rows, nil
+	})
+	fake.on("GetStateTreeRetainHeight", func(args ...any) ([][]any, error) {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		if t.retain == 0 {
+			return nil, nil
+		}
+		return [][]any{{t.retain}}, nil
+	})
+	fake.on("GetAppStateAtHeight", func(args ...any) ([][]any, error) {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		if row, ok := t.appStates[args[0].(int64)]; ok {
+			return [][]any{row}, nil
+		}
+		return nil, nil
+	})
+	fake.on("GetLatestAppState", func(args ...any) ([][]any, error) {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		var latest int64
+		for h := range t.appStates {
+			latest = max(latest, h)
+		}
+		if latest == 0 {
+			return nil, nil
+		}
+		return [][]any{t.appStates[latest]}, nil
+	})
+
+	return t
+}
+
+// commit records the app state of height as FinalizeBlock would and returns its app hash
+func (t *fakeStateTree) commit(height int64, digest *stateDigest, root []byte) []byte {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	appHash := stateAppHash(digest, root)
+	t.appStates[height] = []any{height, appHash, digest.Bytes(), root}
+	return appHash
+}
+
+func stateKeyPath(key string) string {
+	return merkle.KeyPath{}.
+		AppendKey(stateTreeKey, merkle.KeyEncodingURL).
+		AppendKey(stateBucketKey(stateBucket(key)), merkle.KeyEncodingHex).
+		AppendKey([]byte(key), merkle.KeyEncodingURL).
+		String()
+}
+
+func TestStateTreeQueries(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeDB()
+	tree := newFakeStateTree(fake)
+
+	rewards := map[string]int64{}
+	var rewardsMu sync.Mutex
+	rewardRow := func(address string, amount int64) []any {
+		return []any{int64(1), address, int64(0), "tx", "0xsender", "r-" + address, "reward", amount, []string{"0xauth"}, []byte(nil), int64(1), nil, nil}
+	}
+	fake.on("GetAllCoreRewards", func(args ...any) ([][]any, error) {
+		rewardsMu.Lock()
+		defer rewardsMu.Unlock()
+		var rows [][]any
+		for address, amount := range rewards {
+			rows = append(rows, rewardRow(address, amount))
+		}
+		return rows, nil
+	})
+	fake.on("GetReward", func(args ...any) ([][]any, error) {
+		rewardsMu.Lock()
+		defer rewardsMu.Unlock()
+		amount, ok := rewards[args[0].(string)]
+		if !ok {
+			return nil, nil
+		}
+		return [][]any{rewardRow(args[0].(string), amount)}, nil
+	})
+	setRewards := func(r map[string]int64) {
+		rewardsMu.Lock()
+		defer rewardsMu.Unlock()
+		rewards = r
+	}
+
+	s := &Server{
+		config: &config.Config{
+			StateCommitmentHeight: 1,
+			StateProofHeight:      1,
+			GenesisFile:           &types.GenesisDoc{ChainID: "audius-devnet"},
+		},
+		db:        db.New(fake),
+		abciState: &ABCIState{onGoingBlock: fakeTx{db: fake}},
+	}
+	q := s.getDb()
+
+	var digest stateDigest
+	digest.add([]byte("row"))
+
+	// the whole tree is built at activation, later blocks only write what they touched
+	setRewards(map[string]int64{"0xa": 5})
+	root1, err := s.finalizeStateTree(ctx, q, 1, nil, nil)
+	require.NoError(t, err)
+	appHash1 := tree.commit(1, &digest, root1)
+
+	setRewards(map[string]int64{"0xa": 7, "0xb": 3})
+	root2, err := s.finalizeStateTree(ctx, q, 2, []string{queryStateKey("reward", "0xa"), queryStateKey("reward", "0xb")}, root1)
+	require.NoError(t, err)
+	appHash2 := tree.commit(2, &digest, root2)
+
+	setRewards(map[string]int64{"0xb": 3})
+	root3, err := s.finalizeStateTree(ctx, q, 3, []string{queryStateKey("reward", "0xa")}, root2)
+	require.NoError(t, err)
+	tree.commit(3, &digest, root3)
+
+	t.Run("incremental roots match a rebuild", func(t *testing.T) {
+		for height, root := range map[int64][]byte{1: root1, 2: root2, 3: root3} {
+			rebuilt, err := computeStateTreeRoot(ctx, q, height)
+			require.NoError(t, err)
+			require.Equal(t, root, rebuilt, "height %d", height)
+		}
+		require.NotEqual(t, root1, root2)
+		require.NotEqual(t, root2, root3)
+	})
+
+	t.Run("untouched block keeps the root", func(t *testing.T) {
+		root4, err := s.finalizeStateTree(ctx, q, 4, []string{queryStateKey("reward", "0xb")}, root3)
+		require.NoError(t, err)
+		require.Equal(t, root3, root4)
+	})
+
+	query := func(key string, height int64) *abcitypes.QueryResponse {
+		route, routeKey, err := parseQueryPath(key)
+		require.NoError(t, err)
+		return s.queryState(ctx, q, &abcitypes.QueryRequest{Path: key, Height: height, Prove: true}, route, routeKey)
+	}
+
+	t.Run("values are proven at each height", func(t *testing.T) {
+		tests := []struct {
+			key     string
+			height  int64
+			appHash []byte
+			amount  uint64
+		}{
+			{key: "/reward/0xa", height: 1, appHash: appHash1, amount: 5},
+			{key: "/reward/0xa", height: 2, appHash: appHash2, amount: 7},
+			{key: "/reward/0xb", height: 2, appHash: appHash2, amount: 3},
+			{key: "/reward/0xb", height: 0, appHash: tree.appStates[3][1].([]byte), amount: 3},
+		}
+		for _, tt := range tests {
+			res := query(tt.key, tt.height)
+			require.Equal(t, abcitypes.CodeTypeOK, res.Code, res.Log)
+			require.Equal(t, []byte(tt.key), res.Key)
+
+			var reward v1.GetRewardResponse
+			require.NoError(t, proto.Unmarshal(res.Value, &reward))
+			require.Equal(t, tt.amount, reward.Amount)
+
+			require.NoError(t, merkle.DefaultProofRuntime().VerifyValue(res.ProofOps, tt.appHash, stateKeyPath(tt.key), res.Value))
+		}
+	})
+
+	t.Run("proof doesn't verify against another height", func(t *testing.T) {
+		res := query("/reward/0xa", 2)
+		require.Equal(t, abcitypes.CodeTypeOK, res.Code, res.Log)
+		require.Error(t, merkle.DefaultProofRuntime().VerifyValue(res.ProofOps, appHash1, stateKeyPath("/reward/0xa"), res.Value))
+	})
+
+	t.Run("removed and missing keys", func(t *testing.T) {
+		require.Equal(t, QueryCodeNotFound, query("/reward/0xa", 3).Code)
+		require.Equal(t, QueryCodeNotFound, query("/reward/0xb", 1).Code)
+	})
+
+	t.Run("unavailable heights", func(t *testing.T) {
+		require.Equal(t, QueryCodeHeightUnavailable, query("/reward/0xb", 4).Code)
+
+		tree.mu.Lock()
+		tree.retain = 2
+		tree.mu.Unlock()
+		res := query("/reward/0xa", 1)
+		require.Equal(t, QueryCodeHeightUnavailable, res.Code)
+		require.Contains(t, res.Log, "pruned below 2")
+		require.Equal(t, abcitypes.CodeTypeOK, query("/reward/0xa", 2).Code)
+	})
+}
+
+func TestQueryBeforeStateTree(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeDB()
+	tree := newFakeStateTree(fake)
+
+	var digest stateDigest
+	tree.commit(5, &digest, nil)
+	tree.commit(6, &digest, nil)
+
+	s := &Server{config: &config.Config{StateCommitmentHeight: 1}}
+	q := db.New(fake)
+
+	// there's nothing to prove against and only the latest state is kept, both are refused
+	res := s.queryState(ctx, q, &abcitypes.QueryRequest{Path: "/ern/0xabc", Prove: true}, "ern", "0xabc")
+	require.Equal(t, QueryCodeProofUnavailable, res.Code)
+	require.Contains(t, res.Log, ErrQueryProofUnavailable.Error())
+	require.Empty(t, res.Value)
+
+	res = s.queryState(ctx, q, &abcitypes.QueryRequest{Path: "/ern/0xabc", Height: 5}, "ern", "0xabc")
+	require.Equal(t, QueryCodeHeightUnavailable, res.Code)
+	require.Equal(t, int64(6), res.Height)
+
+	res = s.queryState(ctx, q, &abcitypes.QueryRequest{Path: "/ern/0xabc"}, "ern", "0xabc")
+	require.Equal(t, QueryCodeNotFound, res.Code)
+	require.Equal(t, int64(6), res.Height)
+}
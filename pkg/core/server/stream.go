@@ -0,0 +1,266 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	v1 "github.com/AudiusProject/audiusd/pkg/api/core/v1"
+	"github.com/AudiusProject/audiusd/pkg/api/core/v1beta1"
+)
+
+const (
+	// heights read per GetBlocks call while a stream catches up
+	streamBatchSize = 100
+	// wakes a stream up in case a block number notification was dropped
+	streamPollInterval = 2 * time.Second
+)
+
+var (
+	signedTransactionOneof = (&v1.SignedTransaction{}).ProtoReflect().Descriptor().Oneofs().ByName("transaction")
+	messageOneof           = (&v1beta1.Message{}).ProtoReflect().Descriptor().Oneofs().ByName("message")
+)
+
+// StreamBlocks implements v1connect.CoreServiceHandler.
+func (c *CoreService) StreamBlocks(ctx context.Context, req *connect.Request[v1.StreamBlocksRequest], stream *connect.ServerStream[v1.StreamBlocksResponse]) error {
+	filter := newStreamFilter(req.Msg.Filter)
+	return c.followBlocks(ctx, req.Msg.StartHeight, func(block *v1.Block, currentHeight int64) error {
+		block.Transactions = filter.apply(block.Transactions)
+		return stream.Send(&v1.StreamBlocksResponse{Block: block, CurrentHeight: currentHeight})
+	})
+}
+
+// StreamTransactions implements v1connect.CoreServiceHandler.
+func (c *CoreService) StreamTransactions(ctx context.Context, req *connect.Request[v1.StreamTransactionsRequest], stream *connect.ServerStream[v1.StreamTransactionsResponse]) error {
+	filter := newStreamFilter(req.Msg.Filter)
+	return c.followBlocks(ctx, req.Msg.StartHeight, func(block *v1.Block, currentHeight int64) error {
+		for _, tx := range filter.apply(block.Transactions) {
+			if err := stream.Send(&v1.StreamTransactionsResponse{Transaction: tx, CurrentHeight: currentHeight}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// followBlocks calls send with every committed block from startHeight on, in order.
+// Blocks already in postgres are read in batches, after that every commit wakes the
+// stream up so there's no gap between the backfill and live blocks.
+func (c *CoreService) followBlocks(ctx context.Context, startHeight int64, send func(block *v1.Block, currentHeight int64) error) error {
+	if startHeight < 0 {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("start height must not be negative"))
+	}
+
+	// subscribe before reading the current height so no commit is missed in between
+	blockNumCh := c.core.blockNumPubsub.Subscribe(BlockNumPubsubTopic)
+	defer c.core.blockNumPubsub.Unsubscribe(BlockNumPubsubTopic, blockNumCh)
+
+	next := startHeight
+	if next == 0 {
+		next = c.core.cache.currentHeight.Load() + 1
+	}
+
+	ticker := time.NewTicker(streamPollInterval)
+	defer ticker.Stop()
+
+	for {
+		currentHeight := c.core.cache.currentHeight.Load()
+		for next <= currentHeight {
+			end := min(currentHeight, next+streamBatchSize-1)
+			blocks, err := c.getBlockRange(ctx, next, end)
+			if err != nil {
+				return err
+			}
+			for _, block := range blocks {
+				if err := send(block, currentHeight); err != nil {
+					return err
+				}
+			}
+			next = end + 1
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-blockNumCh:
+		case <-ticker.C:
+		}
+	}
+}
+
+// getBlockRange reads the blocks from through to in height order, heights missing
+// from the batch query go through GetBlock and its rpc fallback
+func (c *CoreService) getBlockRange(ctx context.Context, from, to int64) ([]*v1.Block, error) {
+	heights := make([]int64, 0, to-from+1)
+	for h := from; h <= to; h++ {
+		heights = append(heights, h)
+	}
+
+	res, err := c.GetBlocks(ctx, connect.NewRequest(&v1.GetBlocksRequest{Height: heights}))
+	if err != nil {
+		return nil, err
+	}
+
+	blocks := make([]*v1.Block, 0, len(heights))
+	for _, h := range heights {
+		block, ok := res.Msg.Blocks[h]
+		if !ok {
+			blockRes, err := c.GetBlock(ctx, connect.NewRequest(&v1.GetBlockRequest{Height: h}))
+			if err != nil {
+				return nil, fmt.Errorf("could not get block %d: %v", h, err)
+			}
+			block = blockRes.Msg.Block
+		}
+		blocks = append(blocks, block)
+	}
+
+	return blocks, nil
+}
+
+type streamFilter struct {
+	txTypes   map[string]struct{}
+	addresses map[string]struct{}
+}
+
+func newStreamFilter(f *v1.StreamFilter) *streamFilter {
+	filter := &streamFilter{
+		txTypes:   make(map[string]struct{}),
+		addresses: make(map[string]struct{}),
+	}
+	for _, txType := range f.GetTxTypes() {
+		filter.txTypes[txType] = struct{}{}
+	}
+	for _, address := range f.GetAddresses() {
+		filter.addresses[strings.ToLower(address)] = struct{}{}
+	}
+	return filter
+}
+
+func (f *streamFilter) matches(tx *v1.Transaction) bool {
+	if len(f.txTypes) > 0 && !f.matchesAny(f.txTypes, transactionTypes(tx), false) {
+		return false
+	}
+	if len(f.addresses) > 0 && !f.matchesAny(f.addresses, transactionAddresses(tx), true) {
+		return false
+	}
+	return true
+}
+
+func (f *streamFilter) matchesAny(set map[string]struct{}, values []string, fold bool) bool {
+	for _, value := range values {
+		if fold {
+			value = strings.ToLower(value)
+		}
+		if _, ok := set[value]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *streamFilter) apply(txs []*v1.Transaction) []*v1.Transaction {
+	if len(f.txTypes) == 0 && len(f.addresses) == 0 {
+		return txs
+	}
+	matched := []*v1.Transaction{}
+	for _, tx := range txs {
+		if f.matches(tx) {
+			matched = append(matched, tx)
+		}
+	}
+	return matched
+}
+
+// transactionTypes names a transaction after its oneof field, v1beta1 envelopes
+// after the oneof field of each of their messages
+func transactionTypes(tx *v1.Transaction) []string {
+	var txTypes []string
+	if signedTx := tx.GetTransaction(); signedTx != nil {
+		if fd := signedTx.ProtoReflect().WhichOneof(signedTransactionOneof); fd != nil {
+			txTypes = append(txTypes, string(fd.Name()))
+		}
+	}
+	for _, msg := range tx.GetTransactionv2().GetEnvelope().GetMessages() {
+		if fd := msg.ProtoReflect().WhichOneof(messageOneof); fd != nil {
+			txTypes = append(txTypes, string(fd.Name()))
+		}
+	}
+	return txTypes
+}
+
+// transactionAddresses lists the addresses a transaction was signed by, targets or created
+func transactionAddresses(tx *v1.Transaction) []string {
+	var addresses []string
+
+	switch t := tx.GetTransaction().GetTransaction().(type) {
+	case *v1.SignedTransaction_Plays:
+		for _, play := range t.Plays.GetPlays() {
+			addresses = append(addresses, play.GetUserId())
+		}
+	case *v1.SignedTransaction_ManageEntity:
+		addresses = append(addresses, t.ManageEntity.GetSigner())
+	case *v1.SignedTransaction_ValidatorRegistration:
+		addresses = append(addresses, t.ValidatorRegistration.GetCometAddress())
+	case *v1.SignedTransaction_ValidatorDeregistration:
+		addresses = append(addresses, t.ValidatorDeregistration.GetCometAddress())
+	case *v1.SignedTransaction_SlaRollup:
+		for _, report := range t.SlaRollup.GetReports() {
+			addresses = append(addresses, report.GetAddress())
+		}
+	case *v1.SignedTransaction_StorageProof:
+		addresses = append(addresses, t.StorageProof.GetAddress())
+	case *v1.SignedTransaction_VoteExtensions:
+		for _, ext := range t.VoteExtensions.GetExtensions() {
+			addresses = append(addresses, ext.GetValidatorAddress())
+		}
+	case *v1.SignedTransaction_Attestation:
+		if vr := t.Attestation.GetValidatorRegistration(); vr != nil {
+			addresses = append(addresses, vr.GetDelegateWallet(), vr.GetCometAddress())
+		}
+		if vd := t.Attestation.GetValidatorDeregistration(); vd != nil {
+			addresses = append(addresses, vd.GetCometAddress())
+		}
+		if unjail := t.Attestation.GetUnjailValidator(); unjail != nil {
+			addresses = append(addresses, unjail.GetCometAddress())
+		}
+	case *v1.SignedTransaction_Reward:
+		if create := t.Reward.GetCreate(); create != nil {
+			for _, auth := range create.GetClaimAuthorities() {
+				addresses = append(addresses, auth.GetAddress())
+			}
+		}
+		if del := t.Reward.GetDelete(); del != nil {
+			addresses = append(addresses, del.GetAddress())
+		}
+		if attest := t.Reward.GetAttest(); attest != nil {
+			addresses = append(addresses, attest.GetRewardAddress(), attest.GetEthRecipientAddress())
+		}
+	case *v1.SignedTransaction_FileUpload:
+		addresses = append(addresses, t.FileUpload.GetUploaderAddress(), t.FileUpload.GetValidatorAddress())
+	}
+
+	if txv2 := tx.GetTransactionv2(); txv2 != nil {
+		header := txv2.GetEnvelope().GetHeader()
+		addresses = append(addresses, header.GetFrom(), header.GetTo())
+		for _, receipt := range tx.GetTransactionReceipt().GetMessageReceipts() {
+			if ack := receipt.GetErnAck(); ack != nil {
+				addresses = append(addresses, ack.GetErnAddress())
+				addresses = append(addresses, ack.GetPartyAddresses()...)
+				addresses = append(addresses, ack.GetResourceAddresses()...)
+				addresses = append(addresses, ack.GetReleaseAddresses()...)
+				addresses = append(addresses, ack.GetDealAddresses()...)
+			}
+			if ack := receipt.GetMeadAck(); ack != nil {
+				addresses = append(addresses, ack.GetMeadAddress())
+			}
+			if ack := receipt.GetPieAck(); ack != nil {
+				addresses = append(addresses, ack.GetPieAddress())
+			}
+		}
+	}
+
+	return addresses
+}
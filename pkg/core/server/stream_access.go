@@ -0,0 +1,363 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	v1 "github.com/AudiusProject/audiusd/pkg/api/core/v1"
+	ddexv1beta1 "github.com/AudiusProject/audiusd/pkg/api/ddex/v1beta1"
+	storagev1 "github.com/AudiusProject/audiusd/pkg/api/storage/v1"
+	"github.com/AudiusProject/audiusd/pkg/common"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+type streamDenialReason = v1.GetStreamURLsResponse_StreamDenial_Reason
+
+const (
+	// DDEX allowed value for deals that apply in every territory
+	worldwideTerritoryCode = "Worldwide"
+	// DDEX use type requested by listeners that don't specify one
+	defaultStreamUseType = "OnDemandStream"
+)
+
+// streamUseTypes are the DDEX use types a deal for "Stream" also permits
+var streamUseTypes = map[string]bool{
+	"OnDemandStream":       true,
+	"NonInteractiveStream": true,
+}
+
+// streamAccess is what a listener is asking to do, deals are evaluated against it
+type streamAccess struct {
+	// ISO 3166-1 alpha-2 code of the requester, empty if unknown
+	countryCode     string
+	now             time.Time
+	useType         string
+	commercialModel string
+}
+
+// denialRank orders reasons by how far a deal got through evaluation, when every
+// deal denies access the furthest one explains the denial best
+func denialRank(reason streamDenialReason) int {
+	switch reason {
+	case v1.GetStreamURLsResponse_StreamDenial_REASON_TERRITORY, v1.GetStreamURLsResponse_StreamDenial_REASON_LOCATION_UNKNOWN:
+		return 1
+	case v1.GetStreamURLsResponse_StreamDenial_REASON_NOT_YET_VALID, v1.GetStreamURLsResponse_StreamDenial_REASON_EXPIRED:
+		return 2
+	case v1.GetStreamURLsResponse_StreamDenial_REASON_COMMERCIAL_MODEL:
+		return 3
+	case v1.GetStreamURLsResponse_StreamDenial_REASON_USE_TYPE:
+		return 4
+	default:
+		return 0
+	}
+}
+
+// evaluateDealTerms checks a deal's territory, validity period, commercial model and
+// use type in that order, returns REASON_UNSPECIFIED when the deal permits the access
+func evaluateDealTerms(terms *ddexv1beta1.Deal_ReleaseDeal_Deal_DealTerms, access streamAccess) streamDenialReason {
+	if terms == nil {
+		return v1.GetStreamURLsResponse_StreamDenial_REASON_NO_DEAL
+	}
+
+	if reason := evaluateTerritory(terms.GetTerritoryCode(), access.countryCode); reason != v1.GetStreamURLsResponse_StreamDenial_REASON_UNSPECIFIED {
+		return reason
+	}
+
+	if start := terms.GetValidityPeriod().GetStartDateTime(); start != nil && access.now.Before(start.AsTime()) {
+		return v1.GetStreamURLsResponse_StreamDenial_REASON_NOT_YET_VALID
+	}
+	if end := terms.GetValidityPeriod().GetEndDateTime(); end != nil && !access.now.Before(end.AsTime()) {
+		return v1.GetStreamURLsResponse_StreamDenial_REASON_EXPIRED
+	}
+
+	if terms.CommercialModelType != "" && access.commercialModel != "" && !strings.EqualFold(terms.CommercialModelType, access.commercialModel) {
+		return v1.GetStreamURLsResponse_StreamDenial_REASON_COMMERCIAL_MODEL
+	}
+
+	if !useTypePermits(terms.UseType, access.useType) {
+		return v1.GetStreamURLsResponse_StreamDenial_REASON_USE_TYPE
+	}
+
+	return v1.GetStreamURLsResponse_StreamDenial_REASON_UNSPECIFIED
+}
+
+func evaluateTerritory(territoryCodes []string, countryCode string) streamDenialReason {
+	// deals without territories aren't restricted
+	if len(territoryCodes) == 0 {
+		return v1.GetStreamURLsResponse_StreamDenial_REASON_UNSPECIFIED
+	}
+
+	for _, code := range territoryCodes {
+		if strings.EqualFold(code, worldwideTerritoryCode) {
+			return v1.GetStreamURLsResponse_StreamDenial_REASON_UNSPECIFIED
+		}
+	}
+
+	if countryCode == "" {
+		return v1.GetStreamURLsResponse_StreamDenial_REASON_LOCATION_UNKNOWN
+	}
+
+	for _, code := range territoryCodes {
+		if strings.EqualFold(code, countryCode) {
+			return v1.GetStreamURLsResponse_StreamDenial_REASON_UNSPECIFIED
+		}
+	}
+
+	return v1.GetStreamURLsResponse_StreamDenial_REASON_TERRITORY
+}
+
+func useTypePermits(dealUseType, requested string) bool {
+	if dealUseType == "" || strings.EqualFold(dealUseType, requested) {
+		return true
+	}
+	return strings.EqualFold(dealUseType, "Stream") && streamUseTypes[requested]
+}
+
+// evaluateReleaseDeals returns whether any deal bound to the release permits the access,
+// and the best explanation when none does
+func evaluateReleaseDeals(ern *ddexv1beta1.NewReleaseMessage, releaseRef string, access streamAccess) streamDenialReason {
+	best := v1.GetStreamURLsResponse_StreamDenial_REASON_NO_DEAL
+	for _, deal := range ern.GetDealList() {
+		rd := deal.GetReleaseDeal()
+		if rd == nil {
+			continue
+		}
+
+		bound := false
+		for _, ref := range rd.GetDealReleaseReference() {
+			if ref == releaseRef {
+				bound = true
+				break
+			}
+		}
+		if !bound {
+			continue
+		}
+
+		reason := evaluateDealTerms(rd.GetDeal().GetDealTerms(), access)
+		if reason == v1.GetStreamURLsResponse_StreamDenial_REASON_UNSPECIFIED {
+			return reason
+		}
+		if denialRank(reason) > denialRank(best) {
+			best = reason
+		}
+	}
+	return best
+}
+
+func releaseReference(release *ddexv1beta1.Release) string {
+	if mr := release.GetMainRelease(); mr != nil {
+		return mr.ReleaseReference
+	}
+	if tr := release.GetTrackRelease(); tr != nil {
+		return tr.ReleaseReference
+	}
+	return ""
+}
+
+// releaseResourceReferences lists the resources a release delivers
+func releaseResourceReferences(release *ddexv1beta1.Release) []string {
+	var refs []string
+	if mr := release.GetMainRelease(); mr != nil && mr.ResourceGroup != nil {
+		for _, rg := range mr.ResourceGroup.ResourceGroup {
+			for _, item := range rg.ResourceGroupContentItem {
+				refs = append(refs, item.ResourceGroupContentItemText)
+			}
+		}
+	}
+	if tr := release.GetTrackRelease(); tr != nil && tr.ReleaseResourceReference != "" {
+		refs = append(refs, tr.ReleaseResourceReference)
+	}
+	return refs
+}
+
+// listenerStreamableResources evaluates the deals of every release that delivers the entity,
+// returns the sound recording references the listener may stream, or why none are streamable
+func listenerStreamableResources(ern *ddexv1beta1.NewReleaseMessage, entityType, entityRef string, access streamAccess) (map[string]bool, streamDenialReason) {
+	permitted := map[string]bool{}
+	best := v1.GetStreamURLsResponse_StreamDenial_REASON_NO_DEAL
+
+	for _, release := range ern.GetReleaseList() {
+		ref := releaseReference(release)
+		resourceRefs := releaseResourceReferences(release)
+
+		switch entityType {
+		case "release":
+			if ref != entityRef {
+				continue
+			}
+		case "resource":
+			delivers := false
+			for _, resourceRef := range resourceRefs {
+				if resourceRef == entityRef {
+					delivers = true
+					break
+				}
+			}
+			if !delivers {
+				continue
+			}
+			resourceRefs = []string{entityRef}
+		}
+
+		reason := evaluateReleaseDeals(ern, ref, access)
+		if reason != v1.GetStreamURLsResponse_StreamDenial_REASON_UNSPECIFIED {
+			if denialRank(reason) > denialRank(best) {
+				best = reason
+			}
+			continue
+		}
+
+		for _, resourceRef := range resourceRefs {
+			permitted[resourceRef] = true
+		}
+	}
+
+	if len(permitted) == 0 {
+		return nil, best
+	}
+	return permitted, v1.GetStreamURLsResponse_StreamDenial_REASON_UNSPECIFIED
+}
+
+// requesterCountryCode resolves the caller's country through the storage service's geoip lookup
+func (c *CoreService) requesterCountryCode(ctx context.Context, peerAddr string) string {
+	if c.storageService == nil {
+		return ""
+	}
+
+	ip := common.GetClientIP(ctx)
+	if ip == "" {
+		host, _, err := net.SplitHostPort(peerAddr)
+		if err != nil {
+			return ""
+		}
+		ip = host
+	}
+
+	ipData, err := c.storageService.GetIPData(ctx, connect.NewRequest(&storagev1.GetIPDataRequest{Ip: ip}))
+	if err != nil {
+		c.core.logger.Debug("could not locate stream requester", zap.String("ip", ip), zap.Error(err))
+		return ""
+	}
+	return ipData.Msg.CountryCode
+}
+
+// getListenerStreamURLs issues stream urls for the requested addresses that a deal permits
+// the listener to stream, every other address gets a machine readable denial
+func (c *CoreService) getListenerStreamURLs(ctx context.Context, req *connect.Request[v1.GetStreamURLsRequest]) (*connect.Response[v1.GetStreamURLsResponse], error) {
+	access := streamAccess{
+		countryCode:     c.requesterCountryCode(ctx, req.Peer().Addr),
+		now:             time.Now(),
+		useType:         req.Msg.UseType,
+		commercialModel: req.Msg.CommercialModelType,
+	}
+	if access.useType == "" {
+		access.useType = defaultStreamUseType
+	}
+
+	res := &v1.GetStreamURLsResponse{
+		EntityStreamUrls: make(map[string]*v1.GetStreamURLsResponse_EntityStreamURLs),
+		Denials:          make(map[string]*v1.GetStreamURLsResponse_StreamDenial),
+	}
+
+	deny := func(address, ernAddress string, reason streamDenialReason, msg string) {
+		res.Denials[address] = &v1.GetStreamURLsResponse_StreamDenial{
+			Reason:     reason,
+			Message:    msg,
+			ErnAddress: ernAddress,
+		}
+	}
+
+	for _, address := range req.Msg.Addresses {
+		takedown, err := c.core.db.GetERNTakedownForAddress(ctx, address)
+		if err == nil {
+			deny(address, takedown.ErnAddress, v1.GetStreamURLsResponse_StreamDenial_REASON_TAKEN_DOWN,
+				fmt.Sprintf("taken down at block %d", takedown.BlockHeight))
+			continue
+		} else if !errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("failed to query ERN takedown: %w", err)
+		}
+
+		var (
+			ernAddress  string
+			entityType  string
+			entityIndex int
+			rawMessage  []byte
+		)
+
+		dbErn, err := c.core.db.GetERN(ctx, address)
+		if err == nil {
+			ernAddress = address
+			entityType = "ern"
+			rawMessage = dbErn.RawMessage
+		} else if errors.Is(err, pgx.ErrNoRows) {
+			result, err := c.core.db.GetERNContainingAddress(ctx, address)
+			if errors.Is(err, pgx.ErrNoRows) {
+				deny(address, "", v1.GetStreamURLsResponse_StreamDenial_REASON_NOT_FOUND, "address not found in any ERN")
+				continue
+			}
+			if err != nil {
+				return nil, fmt.Errorf("failed to query ERN containing address: %w", err)
+			}
+			ernAddress = result.ErnAddress
+			entityType = result.EntityType
+			entityIndex = ernEntityIndex(result.RawAcknowledgment, result.EntityType, address, int(result.EntityIndex))
+			rawMessage = result.RawMessage
+		} else {
+			return nil, fmt.Errorf("failed to get ERN: %w", err)
+		}
+
+		var ern ddexv1beta1.NewReleaseMessage
+		if err := proto.Unmarshal(rawMessage, &ern); err != nil {
+			c.core.logger.Error("failed to unmarshal ERN", zap.String("ern", ernAddress), zap.Error(err))
+			deny(address, ernAddress, v1.GetStreamURLsResponse_StreamDenial_REASON_INVALID_RELEASE, "ERN could not be decoded")
+			continue
+		}
+
+		// parties and deals aren't streamable in either mode
+		if entityType != "ern" && entityType != "release" && entityType != "resource" {
+			deny(address, ernAddress, v1.GetStreamURLsResponse_StreamDenial_REASON_NOT_STREAMABLE, entityType+" addresses can't be streamed")
+			continue
+		}
+		entityRef := c.getEntityReference(&ern, entityType, entityIndex)
+		if entityType != "ern" && entityRef == "" {
+			deny(address, ernAddress, v1.GetStreamURLsResponse_StreamDenial_REASON_INVALID_RELEASE, "ERN does not describe the "+entityType)
+			continue
+		}
+
+		permitted, reason := listenerStreamableResources(&ern, entityType, entityRef, access)
+		if permitted == nil {
+			deny(address, ernAddress, reason, "no deal permits "+access.useType)
+			continue
+		}
+
+		var urls []string
+		for _, resource := range ern.GetResourceList() {
+			sr := resource.GetSoundRecording()
+			if sr == nil || !permitted[sr.ResourceReference] {
+				continue
+			}
+			if uri := sr.GetSoundRecordingEdition().GetTechnicalDetails().GetDeliveryFile().GetFile().GetUri(); uri != "" {
+				urls = append(urls, c.generateStreamURLs(uri)...)
+			}
+		}
+
+		if len(urls) > 0 {
+			res.EntityStreamUrls[address] = &v1.GetStreamURLsResponse_EntityStreamURLs{
+				EntityType:      entityType,
+				EntityReference: entityRef,
+				Urls:            urls,
+				ErnAddress:      ernAddress,
+			}
+		}
+	}
+
+	return connect.NewResponse(res), nil
+}
@@ -0,0 +1,173 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	v1 "github.com/AudiusProject/audiusd/pkg/api/core/v1"
+	ddexv1beta1 "github.com/AudiusProject/audiusd/pkg/api/ddex/v1beta1"
+	"github.com/AudiusProject/audiusd/pkg/core/db"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func testReleaseDeal(releaseRef string, terms *ddexv1beta1.Deal_ReleaseDeal_Deal_DealTerms) *ddexv1beta1.Deal {
+	return &ddexv1beta1.Deal{
+		Deal: &ddexv1beta1.Deal_ReleaseDeal_{
+			ReleaseDeal: &ddexv1beta1.Deal_ReleaseDeal{
+				DealReleaseReference: []string{releaseRef},
+				Deal:                 &ddexv1beta1.Deal_ReleaseDeal_Deal{DealTerms: terms},
+			},
+		},
+	}
+}
+
+func TestEvaluateDealTerms(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	access := streamAccess{countryCode: "US", now: now, useType: "OnDemandStream", commercialModel: "SubscriptionModel"}
+
+	tests := []struct {
+		name   string
+		terms  *ddexv1beta1.Deal_ReleaseDeal_Deal_DealTerms
+		access streamAccess
+		want   streamDenialReason
+	}{
+		{
+			name:  "permitted",
+			terms: &ddexv1beta1.Deal_ReleaseDeal_Deal_DealTerms{TerritoryCode: []string{"US", "CA"}, CommercialModelType: "SubscriptionModel", UseType: "OnDemandStream"},
+			want:  v1.GetStreamURLsResponse_StreamDenial_REASON_UNSPECIFIED,
+		},
+		{
+			name:  "worldwide",
+			terms: &ddexv1beta1.Deal_ReleaseDeal_Deal_DealTerms{TerritoryCode: []string{"Worldwide"}, UseType: "Stream"},
+			want:  v1.GetStreamURLsResponse_StreamDenial_REASON_UNSPECIFIED,
+		},
+		{
+			name:  "outside territory",
+			terms: &ddexv1beta1.Deal_ReleaseDeal_Deal_DealTerms{TerritoryCode: []string{"GB"}},
+			want:  v1.GetStreamURLsResponse_StreamDenial_REASON_TERRITORY,
+		},
+		{
+			name:   "unknown location",
+			terms:  &ddexv1beta1.Deal_ReleaseDeal_Deal_DealTerms{TerritoryCode: []string{"GB"}},
+			access: streamAccess{now: now, useType: "OnDemandStream"},
+			want:   v1.GetStreamURLsResponse_StreamDenial_REASON_LOCATION_UNKNOWN,
+		},
+		{
+			name: "not yet valid",
+			terms: &ddexv1beta1.Deal_ReleaseDeal_Deal_DealTerms{ValidityPeriod: &ddexv1beta1.Deal_ReleaseDeal_Deal_DealTerms_ValidityPeriod{
+				StartDateTime: timestamppb.New(now.Add(time.Hour)),
+			}},
+			want: v1.GetStreamURLsResponse_StreamDenial_REASON_NOT_YET_VALID,
+		},
+		{
+			name: "expired",
+			terms: &ddexv1beta1.Deal_ReleaseDeal_Deal_DealTerms{ValidityPeriod: &ddexv1beta1.Deal_ReleaseDeal_Deal_DealTerms_ValidityPeriod{
+				StartDateTime: timestamppb.New(now.Add(-2 * time.Hour)),
+				EndDateTime:   timestamppb.New(now),
+			}},
+			want: v1.GetStreamURLsResponse_StreamDenial_REASON_EXPIRED,
+		},
+		{
+			name:  "commercial model",
+			terms: &ddexv1beta1.Deal_ReleaseDeal_Deal_DealTerms{CommercialModelType: "AdvertisementSupportedModel"},
+			want:  v1.GetStreamURLsResponse_StreamDenial_REASON_COMMERCIAL_MODEL,
+		},
+		{
+			name:  "use type",
+			terms: &ddexv1beta1.Deal_ReleaseDeal_Deal_DealTerms{UseType: "PermanentDownload"},
+			want:  v1.GetStreamURLsResponse_StreamDenial_REASON_USE_TYPE,
+		},
+		{
+			name: "no terms",
+			want: v1.GetStreamURLsResponse_StreamDenial_REASON_NO_DEAL,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := access
+			if tt.access.useType != "" {
+				a = tt.access
+			}
+			require.Equal(t, tt.want, evaluateDealTerms(tt.terms, a))
+		})
+	}
+}
+
+func TestListenerStreamableResources(t *testing.T) {
+	access := streamAccess{countryCode: "US", now: time.Now(), useType: "OnDemandStream"}
+
+	ern := &ddexv1beta1.NewReleaseMessage{
+		ReleaseList: []*ddexv1beta1.Release{
+			{Release: &ddexv1beta1.Release_TrackRelease_{TrackRelease: &ddexv1beta1.Release_TrackRelease{ReleaseReference: "R1", ReleaseResourceReference: "A1"}}},
+			{Release: &ddexv1beta1.Release_TrackRelease_{TrackRelease: &ddexv1beta1.Release_TrackRelease{ReleaseReference: "R2", ReleaseResourceReference: "A2"}}},
+			{Release: &ddexv1beta1.Release_TrackRelease_{TrackRelease: &ddexv1beta1.Release_TrackRelease{ReleaseReference: "R3", ReleaseResourceReference: "A3"}}},
+		},
+		DealList: []*ddexv1beta1.Deal{
+			testReleaseDeal("R1", &ddexv1beta1.Deal_ReleaseDeal_Deal_DealTerms{TerritoryCode: []string{"US"}}),
+			// the furthest failing deal explains the denial
+			testReleaseDeal("R2", &ddexv1beta1.Deal_ReleaseDeal_Deal_DealTerms{TerritoryCode: []string{"GB"}}),
+			testReleaseDeal("R2", &ddexv1beta1.Deal_ReleaseDeal_Deal_DealTerms{TerritoryCode: []string{"US"}, UseType: "PermanentDownload"}),
+		},
+	}
+
+	permitted, _ := listenerStreamableResources(ern, "ern", "", access)
+	require.Equal(t, map[string]bool{"A1": true}, permitted)
+
+	permitted, _ = listenerStreamableResources(ern, "resource", "A1", access)
+	require.Equal(t, map[string]bool{"A1": true}, permitted)
+
+	permitted, reason := listenerStreamableResources(ern, "release", "R2", access)
+	require.Nil(t, permitted)
+	require.Equal(t, v1.GetStreamURLsResponse_StreamDenial_REASON_USE_TYPE, reason)
+
+	permitted, reason = listenerStreamableResources(ern, "resource", "A3", access)
+	require.Nil(t, permitted)
+	require.Equal(t, v1.GetStreamURLsResponse_StreamDenial_REASON_NO_DEAL, reason)
+}
+
+func TestListenerStreamURLDenials(t *testing.T) {
+	ern, err := proto.Marshal(&ddexv1beta1.NewReleaseMessage{
+		PartyList: []*ddexv1beta1.Party{{PartyReference: "P1"}},
+	})
+	require.NoError(t, err)
+	ack, err := proto.Marshal(&ddexv1beta1.NewReleaseMessageAck{PartyAddresses: []string{"0xparty"}})
+	require.NoError(t, err)
+
+	fake := newFakeDB().on("GetERNContainingAddress", func(args ...any) ([][]any, error) {
+		switch args[0] {
+		case "0xundecodable":
+			return [][]any{{"0xern", "0xsender", "resource", int32(1), []byte{0xff}, ack}}, nil
+		case "0xparty":
+			return [][]any{{"0xern", "0xsender", "party", int32(1), ern, ack}}, nil
+		case "0xunlisted":
+			// the ERN's acknowledgment doesn't list the address
+			return [][]any{{"0xern", "0xsender", "resource", int32(1), ern, ack}}, nil
+		}
+		return nil, nil
+	})
+	c := &CoreService{core: &Server{db: db.New(fake), logger: zap.NewNop()}}
+
+	res, err := c.getListenerStreamURLs(context.Background(), connect.NewRequest(&v1.GetStreamURLsRequest{
+		Addresses: []string{"0xundecodable", "0xparty", "0xunlisted", "0xmissing"},
+	}))
+	require.NoError(t, err)
+	require.Empty(t, res.Msg.EntityStreamUrls)
+
+	reasons := map[string]streamDenialReason{}
+	for address, denial := range res.Msg.Denials {
+		reasons[address] = denial.Reason
+	}
+	require.Equal(t, map[string]streamDenialReason{
+		"0xundecodable": v1.GetStreamURLsResponse_StreamDenial_REASON_INVALID_RELEASE,
+		"0xparty":       v1.GetStreamURLsResponse_StreamDenial_REASON_NOT_STREAMABLE,
+		"0xunlisted":    v1.GetStreamURLsResponse_StreamDenial_REASON_INVALID_RELEASE,
+		"0xmissing":     v1.GetStreamURLsResponse_StreamDenial_REASON_NOT_FOUND,
+	}, reasons)
+	require.Equal(t, "0xern", res.Msg.Denials["0xundecodable"].ErnAddress)
+}
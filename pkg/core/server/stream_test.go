@@ -0,0 +1,57 @@
+package server
+
+import (
+	"testing"
+
+	v1 "github.com/AudiusProject/audiusd/pkg/api/core/v1"
+	"github.com/AudiusProject/audiusd/pkg/api/core/v1beta1"
+	ddexv1beta1 "github.com/AudiusProject/audiusd/pkg/api/ddex/v1beta1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamFilter(t *testing.T) {
+	play := &v1.Transaction{Hash: "play", Transaction: &v1.SignedTransaction{
+		Transaction: &v1.SignedTransaction_Plays{Plays: &v1.TrackPlays{Plays: []*v1.TrackPlay{{UserId: "user1"}}}},
+	}}
+	manageEntity := &v1.Transaction{Hash: "me", Transaction: &v1.SignedTransaction{
+		Transaction: &v1.SignedTransaction_ManageEntity{ManageEntity: &v1.ManageEntityLegacy{Signer: "0xAbC"}},
+	}}
+	ern := &v1.Transaction{
+		Hash: "ern",
+		Transactionv2: &v1beta1.Transaction{Envelope: &v1beta1.Envelope{
+			Header:   &v1beta1.EnvelopeHeader{From: "0xsender"},
+			Messages: []*v1beta1.Message{{Message: &v1beta1.Message_Ern{Ern: &ddexv1beta1.NewReleaseMessage{}}}},
+		}},
+		TransactionReceipt: &v1beta1.TransactionReceipt{MessageReceipts: []*v1beta1.MessageReceipt{{
+			Result: &v1beta1.MessageReceipt_ErnAck{ErnAck: &ddexv1beta1.NewReleaseMessageAck{ReleaseAddresses: []string{"0xrelease"}}},
+		}}},
+	}
+	txs := []*v1.Transaction{play, manageEntity, ern}
+
+	hashes := func(txs []*v1.Transaction) []string {
+		out := []string{}
+		for _, tx := range txs {
+			out = append(out, tx.Hash)
+		}
+		return out
+	}
+
+	tests := []struct {
+		name   string
+		filter *v1.StreamFilter
+		want   []string
+	}{
+		{name: "no filter", filter: nil, want: []string{"play", "me", "ern"}},
+		{name: "tx type", filter: &v1.StreamFilter{TxTypes: []string{"plays"}}, want: []string{"play"}},
+		{name: "v1beta1 message type", filter: &v1.StreamFilter{TxTypes: []string{"ern", "mead"}}, want: []string{"ern"}},
+		{name: "address case insensitive", filter: &v1.StreamFilter{Addresses: []string{"0xabc"}}, want: []string{"me"}},
+		{name: "created address", filter: &v1.StreamFilter{Addresses: []string{"0xRelease"}}, want: []string{"ern"}},
+		{name: "type and address", filter: &v1.StreamFilter{TxTypes: []string{"plays"}, Addresses: []string{"0xabc"}}, want: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, hashes(newStreamFilter(tt.filter).apply(txs)))
+		})
+	}
+}
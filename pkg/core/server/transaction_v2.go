@@ -4,30 +4,138 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
+	"connectrpc.com/connect"
 	"github.com/AudiusProject/audiusd/pkg/api/core/v1beta1"
 	ddexv1beta1 "github.com/AudiusProject/audiusd/pkg/api/ddex/v1beta1"
+	"github.com/AudiusProject/audiusd/pkg/common"
 	abcitypes "github.com/cometbft/cometbft/abci/types"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 )
 
+const v2TransactionCodespace = "v1beta1"
+
 var (
-	ErrV2TransactionExpired        = errors.New("transaction expired")
-	ErrV2TransactionInvalidChainID = errors.New("invalid chain id")
+	ErrV2TransactionExpired          = errors.New("transaction expired")
+	ErrV2TransactionInvalidChainID   = errors.New("invalid chain id")
+	ErrV2TransactionInvalidEnvelope  = errors.New("invalid envelope")
+	ErrV2TransactionInvalidSignature = errors.New("invalid envelope signature")
+	ErrV2TransactionUnauthorized     = errors.New("envelope signer is not the sender")
 )
 
-func (s *Server) validateV2Transaction(ctx context.Context, currentHeight int64, tx *v1beta1.Transaction) error {
+// checkV2Envelope ensures the parts of the envelope every later check reads are present
+func checkV2Envelope(tx *v1beta1.Transaction) error {
+	if tx.GetEnvelope() == nil {
+		return fmt.Errorf("%w: missing envelope", ErrV2TransactionInvalidEnvelope)
+	}
+	if tx.GetEnvelope().GetHeader() == nil {
+		return fmt.Errorf("%w: missing header", ErrV2TransactionInvalidEnvelope)
+	}
+	if tx.GetEnvelope().GetHeader().GetFrom() == "" {
+		return fmt.Errorf("%w: missing from", ErrV2TransactionInvalidEnvelope)
+	}
+	return nil
+}
+
+func (s *Server) envelopeSignaturesActive(height int64) bool {
+	activation := s.config.EnvelopeSignatureHeight
+	return activation > 0 && height >= activation
+}
+
+// v2TransactionResult is the exec result of a v1beta1 tx that failed to finalize. Blocks
+// before envelope signatures were enforced failed every such tx with code 2, replaying them
+// must produce the same results.
+func (s *Server) v2TransactionResult(err error, height int64) *abcitypes.ExecTxResult {
+	if !s.envelopeSignaturesActive(height) {
+		return &abcitypes.ExecTxResult{Code: 2, Log: err.Error()}
+	}
+	txErr := toV2TransactionError(err)
+	return &abcitypes.ExecTxResult{Code: uint32(txErr.Code), Log: txErr.Message, Codespace: v2TransactionCodespace}
+}
+
+// validateV2Envelope runs the checks that don't depend on app state, shared by
+// CheckTx, proposal validation, mempool admission and FinalizeBlock. height is
+// the block the tx would land in, the next block for txs not yet proposed.
+func (s *Server) validateV2Envelope(tx *v1beta1.Transaction, height int64) error {
+	if err := checkV2Envelope(tx); err != nil {
+		return err
+	}
+
 	header := tx.Envelope.Header
 	if header.ChainId != s.config.GenesisFile.ChainID {
-		return ErrV2TransactionInvalidChainID
+		return fmt.Errorf("%w: %s", ErrV2TransactionInvalidChainID, header.ChainId)
 	}
 
-	if header.Expiration < currentHeight {
+	if header.Expiration < height {
 		return ErrV2TransactionExpired
 	}
 
-	// TODO: check signature
+	if !s.envelopeSignaturesActive(height) {
+		return nil
+	}
+
+	signer, err := common.RecoverEnvelopeSigner(tx.Signature, tx.Envelope)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrV2TransactionInvalidSignature, err)
+	}
+
+	if !strings.EqualFold(signer, header.From) {
+		return fmt.Errorf("%w: signed by %s, from %s", ErrV2TransactionUnauthorized, signer, header.From)
+	}
+
+	return nil
+}
+
+// toV2TransactionError classifies a v1beta1 validation or finalization failure for receipts
+func toV2TransactionError(err error) *v1beta1.TransactionError {
+	code := v1beta1.TransactionError_ERROR_CODE_INTERNAL_ERROR
+	switch {
+	case errors.Is(err, ErrV2TransactionInvalidSignature):
+		code = v1beta1.TransactionError_ERROR_CODE_INVALID_SIGNATURE
+	case errors.Is(err, ErrV2TransactionUnauthorized), errors.Is(err, ErrERNNotOriginalSender), errors.Is(err, ErrERNUploadNotOwned), errors.Is(err, ErrEnrichmentNotAuthorized):
+		code = v1beta1.TransactionError_ERROR_CODE_UNAUTHORIZED
+	case errors.Is(err, ErrV2TransactionExpired):
+		code = v1beta1.TransactionError_ERROR_CODE_TIMEOUT
+	case errors.Is(err, ErrV2TransactionInvalidEnvelope), errors.Is(err, ErrV2TransactionInvalidChainID):
+		code = v1beta1.TransactionError_ERROR_CODE_INVALID_ENVELOPE
+	case errors.Is(err, ErrERNMessageValidation), errors.Is(err, ErrMEADMessageValidation), errors.Is(err, ErrPIEMessageValidation), errors.Is(err, ErrDelegationMessageValidation):
+		code = v1beta1.TransactionError_ERROR_CODE_INVALID_MESSAGE
+	}
+
+	return &v1beta1.TransactionError{
+		Code:    code,
+		Message: err.Error(),
+	}
+}
+
+// v2TransactionConnectError surfaces a rejected v1beta1 transaction to rpc
+// clients with its TransactionError attached as an error detail
+func v2TransactionConnectError(err error) *connect.Error {
+	txErr := toV2TransactionError(err)
+
+	code := connect.CodeInternal
+	switch txErr.Code {
+	case v1beta1.TransactionError_ERROR_CODE_INVALID_SIGNATURE, v1beta1.TransactionError_ERROR_CODE_UNAUTHORIZED:
+		code = connect.CodePermissionDenied
+	case v1beta1.TransactionError_ERROR_CODE_INVALID_ENVELOPE, v1beta1.TransactionError_ERROR_CODE_INVALID_MESSAGE:
+		code = connect.CodeInvalidArgument
+	case v1beta1.TransactionError_ERROR_CODE_TIMEOUT:
+		code = connect.CodeFailedPrecondition
+	}
+
+	connectErr := connect.NewError(code, err)
+	if detail, detailErr := connect.NewErrorDetail(txErr); detailErr == nil {
+		connectErr.AddDetail(detail)
+	}
+	return connectErr
+}
+
+func (s *Server) validateV2Transaction(ctx context.Context, currentHeight int64, tx *v1beta1.Transaction) error {
+	if err := s.validateV2Envelope(tx, currentHeight); err != nil {
+		return err
+	}
 
 	to := tx.Envelope.Header.To
 	from := tx.Envelope.Header.From
@@ -36,20 +144,45 @@ func (s *Server) validateV2Transaction(ctx context.Context, currentHeight int64,
 	eg := errgroup.Group{}
 	for _, msg := range tx.Envelope.Messages {
 		eg.Go(func() error {
+			var err error
 			switch msg.Message.(type) {
 			case *v1beta1.Message_Ern:
-				switch msg.GetErn().MessageHeader.MessageControlType {
-				case ddexv1beta1.MessageControlType_MESSAGE_CONTROL_TYPE_NEW_MESSAGE.Enum():
-					return s.validateERNNewMessage(ctx, msg.GetErn())
-				case ddexv1beta1.MessageControlType_MESSAGE_CONTROL_TYPE_UPDATED_MESSAGE.Enum():
-					return s.validateERNUpdateMessage(ctx, to, from, msg.GetErn())
-				case ddexv1beta1.MessageControlType_MESSAGE_CONTROL_TYPE_TAKEDOWN_MESSAGE.Enum():
-					return s.validateERNTakedownMessage(ctx, msg.GetErn())
+				switch msg.GetErn().GetMessageHeader().GetMessageControlType() {
+				case ddexv1beta1.MessageControlType_MESSAGE_CONTROL_TYPE_NEW_MESSAGE, ddexv1beta1.MessageControlType_MESSAGE_CONTROL_TYPE_TEST_MESSAGE:
+					err = s.validateERNNewMessage(ctx, s.db, from, msg.GetErn(), currentHeight)
+				case ddexv1beta1.MessageControlType_MESSAGE_CONTROL_TYPE_UPDATED_MESSAGE:
+					if s.ernVersioningActive(currentHeight) {
+						err = s.validateERNUpdateMessage(ctx, s.db, to, from, msg.GetErn(), currentHeight)
+					}
+				case ddexv1beta1.MessageControlType_MESSAGE_CONTROL_TYPE_TAKEDOWN_MESSAGE:
+					if s.ernTakedownsActive(currentHeight) {
+						err = s.validateERNTakedownMessage(ctx, s.db, to, from, msg.GetErn())
+					}
+				}
+				if err != nil {
+					return errors.Join(ErrERNMessageValidation, err)
 				}
 			case *v1beta1.Message_Mead:
-				return s.validateMEADNewMessage(ctx, msg.GetMead())
+				if s.enrichmentBindingActive(currentHeight) {
+					err = s.validateMEADNewMessage(ctx, s.db, from, msg.GetMead())
+				}
+				if err != nil {
+					return errors.Join(ErrMEADMessageValidation, err)
+				}
 			case *v1beta1.Message_Pie:
-				return s.validatePIENewMessage(ctx, msg.GetPie())
+				if s.enrichmentBindingActive(currentHeight) {
+					err = s.validatePIENewMessage(ctx, s.db, from, msg.GetPie())
+				}
+				if err != nil {
+					return errors.Join(ErrPIEMessageValidation, err)
+				}
+			case *v1beta1.Message_Delegation:
+				if s.enrichmentBindingActive(currentHeight) {
+					err = s.validateDelegationMessage(ctx, s.db, from, msg.GetDelegation())
+				}
+				if err != nil {
+					return errors.Join(ErrDelegationMessageValidation, err)
+				}
 			}
 			return nil
 		})
@@ -58,17 +191,10 @@ func (s *Server) validateV2Transaction(ctx context.Context, currentHeight int64,
 }
 
 func (s *Server) finalizeV2Transaction(ctx context.Context, req *abcitypes.FinalizeBlockRequest, tx *v1beta1.Transaction, txhash string) error {
-	header := tx.Envelope.Header
-	if header.ChainId != s.config.GenesisFile.ChainID {
-		return fmt.Errorf("invalid chain id: %s", header.ChainId)
-	}
-
-	if header.Expiration < req.Height {
-		return fmt.Errorf("transaction expired")
+	if err := s.validateV2Envelope(tx, req.Height); err != nil {
+		return err
 	}
 
-	// Use pre-calculated transaction hash for consistency
-
 	s.logger.Debug("finalizing v2 transaction", zap.String("tx", txhash), zap.Int("messages", len(tx.Envelope.Messages)))
 
 	for i, msg := range tx.Envelope.Messages {
@@ -89,6 +215,11 @@ func (s *Server) finalizeV2Transaction(ctx context.Context, req *abcitypes.Final
 			if err != nil {
 				return fmt.Errorf("failed to finalize PIE message: %w", err)
 			}
+		case *v1beta1.Message_Delegation:
+			err = s.finalizeDelegation(ctx, req, txhash, tx, int64(i))
+			if err != nil {
+				return fmt.Errorf("failed to finalize delegation message: %w", err)
+			}
 		}
 	}
 	return nil
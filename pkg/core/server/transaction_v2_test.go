@@ -0,0 +1,80 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/AudiusProject/audiusd/pkg/api/core/v1beta1"
+	"github.com/AudiusProject/audiusd/pkg/common"
+	"github.com/AudiusProject/audiusd/pkg/core/config"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestValidateV2EnvelopeActivation(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	s := &Server{config: &config.Config{
+		GenesisFile:             &types.GenesisDoc{ChainID: "audius-devnet"},
+		EnvelopeSignatureHeight: 10,
+	}}
+	envelope := &v1beta1.Envelope{Header: &v1beta1.EnvelopeHeader{
+		ChainId:    "audius-devnet",
+		From:       common.PrivKeyToAddress(key),
+		Expiration: 100,
+	}}
+	unsigned := &v1beta1.Transaction{Envelope: envelope}
+
+	// envelopes before the activation height are accepted unsigned as they always were
+	require.NoError(t, s.validateV2Envelope(unsigned, 9))
+	require.ErrorIs(t, s.validateV2Envelope(unsigned, 10), ErrV2TransactionInvalidSignature)
+
+	sig, err := common.SignEnvelope(key, v1beta1.Signature_SIGNATURE_TYPE_EIP712, envelope)
+	require.NoError(t, err)
+	require.NoError(t, s.validateV2Envelope(&v1beta1.Transaction{Envelope: envelope, Signature: sig}, 10))
+
+	// failed txs keep the legacy result code until then so replayed blocks hash the same
+	failed := errors.Join(ErrERNMessageValidation, errors.New("bad ern"))
+	require.Equal(t, uint32(2), s.v2TransactionResult(failed, 9).Code)
+	require.Equal(t, uint32(v1beta1.TransactionError_ERROR_CODE_INVALID_MESSAGE), s.v2TransactionResult(failed, 10).Code)
+}
+
+func TestCheckTxV2EnvelopeAtNextHeight(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	otherKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	s := &Server{
+		config: &config.Config{
+			GenesisFile:             &types.GenesisDoc{ChainID: "audius-devnet"},
+			EnvelopeSignatureHeight: 10,
+		},
+		cache: &Cache{},
+	}
+	envelope := &v1beta1.Envelope{Header: &v1beta1.EnvelopeHeader{
+		ChainId:    "audius-devnet",
+		From:       common.PrivKeyToAddress(key),
+		Expiration: 100,
+	}}
+	sig, err := common.SignEnvelope(otherKey, v1beta1.Signature_SIGNATURE_TYPE_EIP712, envelope)
+	require.NoError(t, err)
+	tx, err := proto.Marshal(&v1beta1.Transaction{Envelope: envelope, Signature: sig})
+	require.NoError(t, err)
+
+	s.cache.currentHeight.Store(8)
+	res, err := s.CheckTx(context.Background(), &abcitypes.CheckTxRequest{Tx: tx})
+	require.NoError(t, err)
+	require.Equal(t, abcitypes.CodeTypeOK, res.Code, res.Log)
+
+	// the tx can only land in block 10 once 9 is committed, where it must be signed by its sender
+	s.cache.currentHeight.Store(9)
+	res, err = s.CheckTx(context.Background(), &abcitypes.CheckTxRequest{Tx: tx})
+	require.NoError(t, err)
+	require.Equal(t, uint32(v1beta1.TransactionError_ERROR_CODE_UNAUTHORIZED), res.Code)
+}
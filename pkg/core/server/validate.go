@@ -0,0 +1,167 @@
+package server
+
+import (
+	"context"
+	"runtime"
+	"strings"
+	"sync"
+
+	v1 "github.com/AudiusProject/audiusd/pkg/api/core/v1"
+	"github.com/AudiusProject/audiusd/pkg/api/core/v1beta1"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+var (
+	// upper bound on the goroutines validating a single proposal
+	txValidationWorkers = runtime.NumCPU()
+
+	// SignedTransaction types whose validation can depend on another tx in the same block
+	conflictingTxTypes = map[protowire.Number]bool{}
+)
+
+func init() {
+	for _, name := range []protoreflect.Name{"attestation", "validator_registration", "validator_deregistration", "reward"} {
+		conflictingTxTypes[signedTransactionOneof.Fields().ByName(name).Number()] = true
+	}
+}
+
+// outcome of validating one block tx, see validateBlockTx
+type txValidation struct {
+	valid bool
+	err   error
+	// conflict key of the tx, see txConflictKey
+	key string
+}
+
+// validateTxsConcurrently runs validate over txs on a bounded worker pool and returns the
+// results in the same order as txs. Every tx is validated against the state before the
+// block, so txs sharing a conflict key can each pass even though only the first of them
+// would once the others are applied, see proposableTxs.
+func validateTxsConcurrently(ctx context.Context, txs [][]byte, validate func(ctx context.Context, tx []byte) (bool, error)) []txValidation {
+	results := make([]txValidation, len(txs))
+	// each worker owns a disjoint range of results
+	inChunks(len(txs), func(from, to int) {
+		for i := from; i < to; i++ {
+			valid, err := validate(ctx, txs[i])
+			results[i] = txValidation{valid: valid, err: err, key: txConflictKey(txs[i])}
+		}
+	})
+	return results
+}
+
+// proposableTxs picks the indexes of the valid txs a proposal can include. Only the first
+// valid tx per conflict key is taken, the rest stay in the mempool for a later block where
+// they're validated against the state the first one left behind.
+func proposableTxs(results []txValidation) (proposable []int, deferred []int) {
+	taken := map[string]bool{}
+	for i, result := range results {
+		if result.err != nil || !result.valid {
+			continue
+		}
+		if result.key != "" {
+			if taken[result.key] {
+				deferred = append(deferred, i)
+				continue
+			}
+			taken[result.key] = true
+		}
+		proposable = append(proposable, i)
+	}
+	return proposable, deferred
+}
+
+func (s *Server) conflictFreeProposalsActive(height int64) bool {
+	activation := s.config.ConflictFreeProposalHeight
+	return activation > 0 && height >= activation
+}
+
+// inChunks splits [0, n) into one contiguous range per worker and waits for all of them
+func inChunks(n int, fn func(from, to int)) {
+	workers := min(txValidationWorkers, n)
+	if workers <= 1 {
+		fn(0, n)
+		return
+	}
+
+	var wg sync.WaitGroup
+	size := (n + workers - 1) / workers
+	for from := 0; from < n; from += size {
+		wg.Add(1)
+		go func(from, to int) {
+			defer wg.Done()
+			fn(from, to)
+		}(from, min(from+size, n))
+	}
+	wg.Wait()
+}
+
+// txConflictKey names the state a tx's validation depends on that another tx in the
+// same block could also touch, txs without a key are independent of everything else
+func txConflictKey(tx []byte) string {
+	// most of a block is plays, skip decoding txs whose type can't conflict
+	if num, ok := signedTransactionType(tx); ok && !conflictingTxTypes[num] {
+		return ""
+	}
+
+	var signedTx v1.SignedTransaction
+	if err := proto.Unmarshal(tx, &signedTx); err == nil && signedTx.Transaction != nil {
+		switch t := signedTx.Transaction.(type) {
+		case *v1.SignedTransaction_Attestation:
+			if vr := t.Attestation.GetValidatorRegistration(); vr != nil {
+				return "validator:" + vr.GetCometAddress()
+			}
+			if vd := t.Attestation.GetValidatorDeregistration(); vd != nil {
+				return "validator:" + vd.GetCometAddress()
+			}
+			if unjail := t.Attestation.GetUnjailValidator(); unjail != nil {
+				return "validator:" + unjail.GetCometAddress()
+			}
+		case *v1.SignedTransaction_ValidatorRegistration:
+			return "validator:" + t.ValidatorRegistration.GetCometAddress()
+		case *v1.SignedTransaction_ValidatorDeregistration:
+			return "validator:" + t.ValidatorDeregistration.GetCometAddress()
+		case *v1.SignedTransaction_Reward:
+			if create := t.Reward.GetCreate(); create != nil {
+				return "reward:" + create.GetRewardId()
+			}
+			if del := t.Reward.GetDelete(); del != nil {
+				return "reward:" + strings.ToLower(del.GetAddress())
+			}
+			if attest := t.Reward.GetAttest(); attest != nil {
+				return "reward_claim:" + strings.ToLower(attest.GetRewardAddress()) + ":" + attest.GetRewardId() + ":" + attest.GetSpecifier()
+			}
+		}
+		return ""
+	}
+
+	var txv2 v1beta1.Transaction
+	if err := proto.Unmarshal(tx, &txv2); err == nil {
+		// updates and takedowns address the ERN they apply to
+		if to := txv2.GetEnvelope().GetHeader().GetTo(); to != "" {
+			return "ern:" + strings.ToLower(to)
+		}
+	}
+	return ""
+}
+
+// signedTransactionType finds the SignedTransaction oneof field set in tx without decoding it
+func signedTransactionType(tx []byte) (protowire.Number, bool) {
+	for len(tx) > 0 {
+		num, typ, n := protowire.ConsumeTag(tx)
+		if n < 0 {
+			return 0, false
+		}
+		tx = tx[n:]
+		if signedTransactionOneof.Fields().ByNumber(num) != nil {
+			return num, true
+		}
+		n = protowire.ConsumeFieldValue(num, typ, tx)
+		if n < 0 {
+			return 0, false
+		}
+		tx = tx[n:]
+	}
+	return 0, false
+}
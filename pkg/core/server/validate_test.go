@@ -0,0 +1,170 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	v1 "github.com/AudiusProject/audiusd/pkg/api/core/v1"
+	"github.com/AudiusProject/audiusd/pkg/api/core/v1beta1"
+	"github.com/AudiusProject/audiusd/pkg/common"
+	"github.com/AudiusProject/audiusd/pkg/core/config"
+	"github.com/cometbft/cometbft/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+func marshalTx(t testing.TB, msg proto.Message) []byte {
+	b, err := proto.Marshal(msg)
+	require.NoError(t, err)
+	return b
+}
+
+func playTx(t testing.TB, userID string) []byte {
+	return marshalTx(t, &v1.SignedTransaction{
+		Transaction: &v1.SignedTransaction_Plays{Plays: &v1.TrackPlays{Plays: []*v1.TrackPlay{{UserId: userID}}}},
+	})
+}
+
+func TestTxConflictKey(t *testing.T) {
+	txs := [][]byte{
+		playTx(t, "1"),
+		marshalTx(t, &v1.SignedTransaction{Transaction: &v1.SignedTransaction_Reward{Reward: &v1.RewardMessage{
+			Action: &v1.RewardMessage_Delete{Delete: &v1.DeleteReward{Address: "0xReward"}},
+		}}}),
+		marshalTx(t, &v1beta1.Transaction{Envelope: &v1beta1.Envelope{Header: &v1beta1.EnvelopeHeader{To: "0xErn"}}}),
+		marshalTx(t, &v1.SignedTransaction{Transaction: &v1.SignedTransaction_ValidatorDeregistration{
+			ValidatorDeregistration: &v1.ValidatorMisbehaviorDeregistration{CometAddress: "comet1"},
+		}}),
+		playTx(t, "2"),
+		marshalTx(t, &v1.SignedTransaction{Transaction: &v1.SignedTransaction_Reward{Reward: &v1.RewardMessage{
+			Action: &v1.RewardMessage_Delete{Delete: &v1.DeleteReward{Address: "0xreward"}},
+		}}}),
+		marshalTx(t, &v1beta1.Transaction{Envelope: &v1beta1.Envelope{Header: &v1beta1.EnvelopeHeader{To: "0xern"}}}),
+		marshalTx(t, &v1.SignedTransaction{Transaction: &v1.SignedTransaction_Attestation{Attestation: &v1.Attestation{
+			Body: &v1.Attestation_ValidatorDeregistration{ValidatorDeregistration: &v1.ValidatorDeregistration{CometAddress: "comet1"}},
+		}}}),
+	}
+
+	keys := make([]string, len(txs))
+	for i, tx := range txs {
+		keys[i] = txConflictKey(tx)
+	}
+	require.Equal(t, []string{"", "reward:0xreward", "ern:0xern", "validator:comet1", "", "reward:0xreward", "ern:0xern", "validator:comet1"}, keys)
+}
+
+func TestProposableTxs(t *testing.T) {
+	results := []txValidation{
+		{valid: true},
+		{valid: false, key: "reward:0xreward"},
+		{valid: true, key: "reward:0xreward"},
+		{valid: true},
+		{valid: true, key: "reward:0xreward"},
+		{err: fmt.Errorf("boom"), key: "ern:0xern"},
+		{valid: true, key: "ern:0xern"},
+		{valid: true, key: "ern:0xern"},
+	}
+
+	// the first valid tx per key is proposed, later ones wait for a block that sees its effects
+	proposable, deferred := proposableTxs(results)
+	require.Equal(t, []int{0, 2, 3, 6}, proposable)
+	require.Equal(t, []int{4, 7}, deferred)
+}
+
+func TestValidateTxsConcurrently(t *testing.T) {
+	txs := [][]byte{}
+	for i := range 100 {
+		txs = append(txs, playTx(t, fmt.Sprint(i)))
+	}
+
+	// every third tx is invalid, the last one errors
+	results := validateTxsConcurrently(context.Background(), txs, func(ctx context.Context, tx []byte) (bool, error) {
+		// runs on worker goroutines, failures are reported through the results
+		var signedTx v1.SignedTransaction
+		if err := proto.Unmarshal(tx, &signedTx); err != nil {
+			return false, err
+		}
+		userID := signedTx.GetPlays().GetPlays()[0].GetUserId()
+		if userID == "99" {
+			return false, fmt.Errorf("boom")
+		}
+		var i int
+		fmt.Sscan(userID, &i)
+		return i%3 != 0, nil
+	})
+
+	require.Len(t, results, len(txs))
+	for i, result := range results[:99] {
+		require.NoError(t, result.err)
+		require.Equal(t, i%3 != 0, result.valid, "tx %d", i)
+	}
+	require.Error(t, results[99].err)
+}
+
+func TestValidateBlockTxsConflictKeys(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	s := &Server{logger: zap.NewNop(), config: &config.Config{
+		GenesisFile:                &types.GenesisDoc{ChainID: "audius-devnet"},
+		ConflictFreeProposalHeight: 10,
+	}}
+	// signed so the envelope doesn't also decode as a v1 tx
+	ernTx := func(nonce string) []byte {
+		envelope := &v1beta1.Envelope{Header: &v1beta1.EnvelopeHeader{
+			ChainId:    "audius-devnet",
+			From:       common.PrivKeyToAddress(key),
+			To:         "0xern",
+			Nonce:      nonce,
+			Expiration: 100,
+		}}
+		sig, err := common.SignEnvelope(key, v1beta1.Signature_SIGNATURE_TYPE_EIP712, envelope)
+		require.NoError(t, err)
+		return marshalTx(t, &v1beta1.Transaction{Envelope: envelope, Signature: sig})
+	}
+	txs := [][]byte{ernTx("1"), playTx(t, "1"), ernTx("2")}
+
+	valid, err := s.validateBlockTxs(context.Background(), time.Now(), 9, nil, txs)
+	require.NoError(t, err)
+	require.True(t, valid)
+
+	// both updates passed against the state before the block, only the first may be proposed
+	valid, err = s.validateBlockTxs(context.Background(), time.Now(), 10, nil, txs)
+	require.NoError(t, err)
+	require.False(t, valid)
+
+	valid, err = s.validateBlockTxs(context.Background(), time.Now(), 10, nil, txs[:2])
+	require.NoError(t, err)
+	require.True(t, valid)
+}
+
+func BenchmarkValidateBlockTxs(b *testing.B) {
+	s := &Server{logger: zap.NewNop(), config: &config.Config{}}
+	txs := make([][]byte, 1000)
+	for i := range txs {
+		txs[i] = playTx(b, fmt.Sprint(i))
+	}
+	blockTime := time.Now()
+
+	b.Run("sequential", func(b *testing.B) {
+		for range b.N {
+			for _, tx := range txs {
+				if _, err := s.validateBlockTx(context.Background(), blockTime, 1, nil, tx); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		for range b.N {
+			valid, err := s.validateBlockTxs(context.Background(), blockTime, 1, nil, txs)
+			if err != nil || !valid {
+				b.Fatal(valid, err)
+			}
+		}
+	})
+}
@@ -0,0 +1,336 @@
+package server
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	v1 "github.com/AudiusProject/audiusd/pkg/api/core/v1"
+	"github.com/AudiusProject/audiusd/pkg/core/db"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	cometbfttypes "github.com/cometbft/cometbft/types"
+	gogotypes "github.com/cosmos/gogoproto/types"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// blocks after a challenge that its proofs are accepted from vote extensions, the
+	// plaintext proof is revealed after that until twice the deadline has passed
+	voteExtensionProofDeadline = 6
+	// how often, in blocks, validators report which peers they can reach
+	peerObservationInterval = 16
+
+	// bounds on what a single validator can put in its extension
+	maxVoteExtensionBytes  = 64 * 1024
+	maxVoteExtensionProofs = 16
+	maxPeerObservations    = 256
+)
+
+var ErrVoteExtensionsNotProposed = errors.New("vote extensions are only added to blocks by their proposer")
+
+// a proof this node produced for a challenge, waiting to be put in its vote extensions
+type pendingStorageProof struct {
+	proof     *v1.StorageProof
+	plaintext []byte
+}
+
+func (s *Server) voteExtensionsActive(height int64) bool {
+	activation := s.config.VoteExtensionsHeight
+	return activation > 0 && height >= activation
+}
+
+// voteExtensionsParamUpdate turns vote extensions on at the configured height, comet only
+// accepts the change from a block before the one it takes effect in
+func (s *Server) voteExtensionsParamUpdate(height int64) *cmtproto.ConsensusParams {
+	activation := s.config.VoteExtensionsHeight
+	if activation <= 1 || height != activation-1 {
+		return nil
+	}
+	return &cmtproto.ConsensusParams{
+		Feature: &cmtproto.FeatureParams{VoteExtensionsEnableHeight: &gogotypes.Int64Value{Value: activation}},
+	}
+}
+
+func (s *Server) queueStorageProof(height int64, cid string, proverAddresses []string, proof []byte) error {
+	proofSig, err := s.config.CometKey.Sign(proof)
+	if err != nil {
+		return fmt.Errorf("could not sign storage proof: %v", err)
+	}
+	s.pendingProofs.Set(height, &pendingStorageProof{
+		proof: &v1.StorageProof{
+			Height:          height,
+			Cid:             cid,
+			Address:         s.config.ProposerAddress,
+			ProofSignature:  proofSig,
+			ProverAddresses: proverAddresses,
+		},
+		plaintext: proof,
+	})
+	s.logger.Info("Queued storage proof for vote extension", zap.String("cid", cid), zap.Int64("height", height))
+	return nil
+}
+
+// buildVoteExtension collects what this node attaches to its precommit at height
+func (s *Server) buildVoteExtension(height int64) *v1.VoteExtension {
+	// extensions for height are aggregated into the block after it
+	blockHeight := height + 1
+	ext := &v1.VoteExtension{}
+
+	for challengeHeight, pending := range s.pendingProofs.ToMap() {
+		switch age := blockHeight - challengeHeight; {
+		case age <= voteExtensionProofDeadline:
+			ext.StorageProofs = append(ext.StorageProofs, pending.proof)
+		case age <= 2*voteExtensionProofDeadline:
+			ext.StorageProofVerifications = append(ext.StorageProofVerifications, &v1.StorageProofVerification{
+				Height: challengeHeight,
+				Proof:  pending.plaintext,
+			})
+		default:
+			s.pendingProofs.Delete(challengeHeight)
+		}
+	}
+
+	// oldest first so the cap drops the newest, which get another chance next height
+	sort.Slice(ext.StorageProofs, func(i, j int) bool { return ext.StorageProofs[i].Height < ext.StorageProofs[j].Height })
+	sort.Slice(ext.StorageProofVerifications, func(i, j int) bool {
+		return ext.StorageProofVerifications[i].Height < ext.StorageProofVerifications[j].Height
+	})
+	ext.StorageProofs = ext.StorageProofs[:min(len(ext.StorageProofs), maxVoteExtensionProofs)]
+	ext.StorageProofVerifications = ext.StorageProofVerifications[:min(len(ext.StorageProofVerifications), maxVoteExtensionProofs-len(ext.StorageProofs))]
+
+	if height%peerObservationInterval == 0 {
+		for _, peer := range s.peerStatus.Values() {
+			if peer.CometAddress == "" {
+				continue
+			}
+			if peer.ConnectrpcHealthy && peer.CometrpcHealthy {
+				ext.ReachablePeers = append(ext.ReachablePeers, peer.CometAddress)
+			} else {
+				ext.UnreachablePeers = append(ext.UnreachablePeers, peer.CometAddress)
+			}
+		}
+		slices.Sort(ext.ReachablePeers)
+		slices.Sort(ext.UnreachablePeers)
+		ext.ReachablePeers = ext.ReachablePeers[:min(len(ext.ReachablePeers), maxPeerObservations)]
+		ext.UnreachablePeers = ext.UnreachablePeers[:min(len(ext.UnreachablePeers), maxPeerObservations-len(ext.ReachablePeers))]
+	}
+
+	return ext
+}
+
+// validateVoteExtension checks what a validator extended its vote at height with. Only
+// committed blocks are read so every node comes to the same answer.
+func validateVoteExtension(ctx context.Context, q *db.Queries, validator string, ext *v1.VoteExtension, height int64) error {
+	blockHeight := height + 1
+
+	if len(ext.StorageProofs)+len(ext.StorageProofVerifications) > maxVoteExtensionProofs {
+		return fmt.Errorf("more than %d storage proofs", maxVoteExtensionProofs)
+	}
+	peers := len(ext.ReachablePeers) + len(ext.UnreachablePeers)
+	if peers > maxPeerObservations {
+		return fmt.Errorf("more than %d peer observations", maxPeerObservations)
+	}
+	if peers > 0 && height%peerObservationInterval != 0 {
+		return fmt.Errorf("peer observations at height %d, only every %d blocks", height, peerObservationInterval)
+	}
+
+	for _, sp := range ext.StorageProofs {
+		if !strings.EqualFold(sp.Address, validator) {
+			return fmt.Errorf("proof is for '%s' but was extended by '%s'", sp.Address, validator)
+		}
+		if len(sp.ProofSignature) == 0 {
+			return fmt.Errorf("proof for challenge at height %d is unsigned", sp.Height)
+		}
+		if blockHeight-sp.Height > voteExtensionProofDeadline {
+			return fmt.Errorf("proof for challenge at height '%d' extended at height '%d' which is past the deadline", sp.Height, height)
+		}
+		if err := isStorageProofChallenge(ctx, q, sp.Height); err != nil {
+			return err
+		}
+	}
+
+	for _, spv := range ext.StorageProofVerifications {
+		if blockHeight-spv.Height <= voteExtensionProofDeadline {
+			return fmt.Errorf("proof for challenge at height '%d' revealed at height '%d' which is before the deadline", spv.Height, height)
+		}
+		if err := isStorageProofChallenge(ctx, q, spv.Height); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateSignedVoteExtension checks an extension was signed by the registered validator it
+// claims to come from, as comet signed it, and returns it decoded
+func (s *Server) validateSignedVoteExtension(ctx context.Context, q *db.Queries, height int64, round int32, sve *v1.SignedVoteExtension) (*v1.VoteExtension, error) {
+	if len(sve.Extension) > maxVoteExtensionBytes {
+		return nil, fmt.Errorf("extension from %s is larger than %d bytes", sve.ValidatorAddress, maxVoteExtensionBytes)
+	}
+
+	node, err := q.GetRegisteredNodeByCometAddress(ctx, sve.ValidatorAddress)
+	if err != nil {
+		return nil, fmt.Errorf("could not get validator %s: %v", sve.ValidatorAddress, err)
+	}
+	pubKeyBytes, err := base64.StdEncoding.DecodeString(node.CometPubKey)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode public key for validator %s: %v", sve.ValidatorAddress, err)
+	}
+	signBytes := cometbfttypes.VoteExtensionSignBytes(s.config.GenesisFile.ChainID, &cmtproto.Vote{
+		Height:    height,
+		Round:     round,
+		Extension: sve.Extension,
+	})
+	if !ed25519.PubKey(pubKeyBytes).VerifySignature(signBytes, sve.Signature) {
+		return nil, fmt.Errorf("extension from %s has an invalid signature", sve.ValidatorAddress)
+	}
+
+	var ext v1.VoteExtension
+	if err := proto.Unmarshal(sve.Extension, &ext); err != nil {
+		return nil, fmt.Errorf("could not decode extension from %s: %v", sve.ValidatorAddress, err)
+	}
+	if err := validateVoteExtension(ctx, q, sve.ValidatorAddress, &ext, height); err != nil {
+		return nil, fmt.Errorf("invalid extension from %s: %v", sve.ValidatorAddress, err)
+	}
+
+	return &ext, nil
+}
+
+// createVoteExtensionsTx aggregates the extensions the last commit carried into one tx,
+// nil when there's nothing to aggregate
+func (s *Server) createVoteExtensionsTx(ctx context.Context, height int64, commit abcitypes.ExtendedCommitInfo) ([]byte, error) {
+	if !s.voteExtensionsActive(height - 1) {
+		return nil, nil
+	}
+
+	record := &v1.VoteExtensions{Height: height - 1, Round: commit.Round}
+	for _, vote := range commit.Votes {
+		if vote.BlockIdFlag != cmtproto.BlockIDFlagCommit || len(vote.VoteExtension) == 0 {
+			continue
+		}
+		sve := &v1.SignedVoteExtension{
+			ValidatorAddress: cometbfttypes.Address(vote.Validator.Address).String(),
+			Extension:        vote.VoteExtension,
+			Signature:        vote.ExtensionSignature,
+		}
+		// leave out what the rest of the network would reject rather than the whole block
+		if _, err := s.validateSignedVoteExtension(ctx, s.db, record.Height, record.Round, sve); err != nil {
+			s.logger.Error("Leaving out vote extension", zap.Int64("height", record.Height), zap.Error(err))
+			continue
+		}
+		record.Extensions = append(record.Extensions, sve)
+	}
+
+	if len(record.Extensions) == 0 {
+		return nil, nil
+	}
+
+	return proto.Marshal(&v1.SignedTransaction{
+		Transaction: &v1.SignedTransaction_VoteExtensions{VoteExtensions: record},
+	})
+}
+
+func (s *Server) isValidVoteExtensionsTx(ctx context.Context, q *db.Queries, stx *v1.SignedTransaction, blockHeight int64) ([]*v1.VoteExtension, error) {
+	record := stx.GetVoteExtensions()
+	if record == nil {
+		return nil, fmt.Errorf("unknown tx fell into isValidVoteExtensionsTx: %v", stx)
+	}
+	if !s.voteExtensionsActive(record.Height) {
+		return nil, fmt.Errorf("vote extensions are not enabled at height %d", record.Height)
+	}
+	if record.Height != blockHeight-1 {
+		return nil, fmt.Errorf("vote extensions for height %d in block %d", record.Height, blockHeight)
+	}
+	if len(record.Extensions) == 0 {
+		return nil, errors.New("no vote extensions")
+	}
+
+	exts := make([]*v1.VoteExtension, 0, len(record.Extensions))
+	seen := make(map[string]struct{}, len(record.Extensions))
+	for _, sve := range record.Extensions {
+		if _, ok := seen[sve.ValidatorAddress]; ok {
+			return nil, fmt.Errorf("more than one extension from %s", sve.ValidatorAddress)
+		}
+		seen[sve.ValidatorAddress] = struct{}{}
+
+		ext, err := s.validateSignedVoteExtension(ctx, q, record.Height, record.Round, sve)
+		if err != nil {
+			return nil, err
+		}
+		exts = append(exts, ext)
+	}
+
+	return exts, nil
+}
+
+func (s *Server) finalizeVoteExtensions(ctx context.Context, stx *v1.SignedTransaction, txhash string, blockHeight int64) (proto.Message, error) {
+	qtx := s.getDb()
+	exts, err := s.isValidVoteExtensionsTx(ctx, qtx, stx, blockHeight)
+	if err != nil {
+		return nil, fmt.Errorf("invalid vote extensions: %v", err)
+	}
+
+	record := stx.GetVoteExtensions()
+
+	// every proof is recorded before any challenge is resolved
+	for _, ext := range exts {
+		for _, sp := range ext.StorageProofs {
+			if err := s.recordStorageProof(ctx, qtx, sp); err != nil {
+				return nil, err
+			}
+		}
+	}
+	for _, ext := range exts {
+		for _, spv := range ext.StorageProofVerifications {
+			if err := s.resolveStorageProofChallenge(ctx, qtx, spv); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for i, ext := range exts {
+		if len(ext.ReachablePeers)+len(ext.UnreachablePeers) == 0 {
+			continue
+		}
+		params := db.InsertPeerObservationParams{
+			BlockHeight: record.Height,
+			Observer:    record.Extensions[i].ValidatorAddress,
+			Reachable:   nonNil(ext.ReachablePeers),
+			Unreachable: nonNil(ext.UnreachablePeers),
+			TxHash:      txhash,
+		}
+		if err := qtx.InsertPeerObservation(ctx, params); err != nil {
+			return nil, fmt.Errorf("could not record peer observation: %v", err)
+		}
+		s.stateInsert(peerObservationStateRow(params))
+	}
+
+	return record, nil
+}
+
+// countVoteExtensionsTxs counts the aggregated vote extension txs in a block, at most one is allowed
+func countVoteExtensionsTxs(txs [][]byte) int {
+	field := signedTransactionOneof.Fields().ByName("vote_extensions").Number()
+	n := 0
+	for _, tx := range txs {
+		if num, ok := signedTransactionType(tx); ok && num == field {
+			n++
+		}
+	}
+	return n
+}
+
+// not null columns need an empty array rather than a nil one
+func nonNil(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
@@ -0,0 +1,104 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v1 "github.com/AudiusProject/audiusd/pkg/api/core/v1"
+	"github.com/AudiusProject/audiusd/pkg/core/config"
+	"github.com/AudiusProject/audiusd/pkg/core/db"
+	"github.com/AudiusProject/audiusd/pkg/safemap"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestValidateVoteExtensionRejects(t *testing.T) {
+	ctx := context.Background()
+	const validator = "ABCD"
+
+	// every rejection here happens before the challenge is looked up
+	require.Error(t, validateVoteExtension(ctx, nil, validator, &v1.VoteExtension{
+		StorageProofs: make([]*v1.StorageProof, maxVoteExtensionProofs+1),
+	}, 10))
+	require.Error(t, validateVoteExtension(ctx, nil, validator, &v1.VoteExtension{
+		ReachablePeers: []string{"EF"},
+	}, peerObservationInterval+1))
+	require.Error(t, validateVoteExtension(ctx, nil, validator, &v1.VoteExtension{
+		StorageProofs: []*v1.StorageProof{{Height: 10, Address: "EF", ProofSignature: []byte{1}}},
+	}, 10))
+	require.Error(t, validateVoteExtension(ctx, nil, validator, &v1.VoteExtension{
+		StorageProofs: []*v1.StorageProof{{Height: 10, Address: "abcd"}},
+	}, 10))
+	require.Error(t, validateVoteExtension(ctx, nil, validator, &v1.VoteExtension{
+		StorageProofs: []*v1.StorageProof{{Height: 10, Address: "abcd", ProofSignature: []byte{1}}},
+	}, 10+voteExtensionProofDeadline))
+	require.Error(t, validateVoteExtension(ctx, nil, validator, &v1.VoteExtension{
+		StorageProofVerifications: []*v1.StorageProofVerification{{Height: 10, Proof: []byte{1}}},
+	}, 10+voteExtensionProofDeadline-1))
+
+	require.NoError(t, validateVoteExtension(ctx, nil, validator, &v1.VoteExtension{
+		ReachablePeers:   []string{"EF"},
+		UnreachablePeers: []string{"01"},
+	}, 2*peerObservationInterval))
+}
+
+func TestBuildVoteExtension(t *testing.T) {
+	s := &Server{
+		config:        &config.Config{ProposerAddress: "ABCD"},
+		pendingProofs: safemap.New[int64, *pendingStorageProof](),
+		peerStatus:    safemap.New[EthAddress, *v1.GetStatusResponse_PeerInfo_Peer](),
+	}
+	s.pendingProofs.Set(10, &pendingStorageProof{proof: &v1.StorageProof{Height: 10, Address: "ABCD"}, plaintext: []byte("proof")})
+	s.peerStatus.Set("0x1", &v1.GetStatusResponse_PeerInfo_Peer{CometAddress: "02", ConnectrpcHealthy: true, CometrpcHealthy: true})
+	s.peerStatus.Set("0x2", &v1.GetStatusResponse_PeerInfo_Peer{CometAddress: "01", ConnectrpcHealthy: true})
+	s.peerStatus.Set("0x3", &v1.GetStatusResponse_PeerInfo_Peer{})
+
+	// the proof goes out until the deadline
+	ext := s.buildVoteExtension(10 + voteExtensionProofDeadline - 1)
+	require.Len(t, ext.StorageProofs, 1)
+	require.Empty(t, ext.StorageProofVerifications)
+	require.Empty(t, ext.ReachablePeers)
+
+	// then the plaintext is revealed
+	ext = s.buildVoteExtension(10 + voteExtensionProofDeadline)
+	require.Empty(t, ext.StorageProofs)
+	require.Len(t, ext.StorageProofVerifications, 1)
+	require.Equal(t, []byte("proof"), ext.StorageProofVerifications[0].Proof)
+
+	// and dropped once the reveal window closes
+	ext = s.buildVoteExtension(10 + 2*voteExtensionProofDeadline)
+	require.Empty(t, ext.StorageProofVerifications)
+	require.False(t, s.pendingProofs.Has(10))
+
+	ext = s.buildVoteExtension(2 * peerObservationInterval)
+	require.Equal(t, []string{"02"}, ext.ReachablePeers)
+	require.Equal(t, []string{"01"}, ext.UnreachablePeers)
+}
+
+func TestCreateRollupPeerObservations(t *testing.T) {
+	var observedRange []any
+	fake := newFakeDB().
+		on("GetAllRegisteredNodesSorted", func(args ...any) ([][]any, error) {
+			validator := func(comet string) []any {
+				return []any{int32(1), "", "", "", comet, "", "validator", "", "", false, int64(0), int64(0)}
+			}
+			return [][]any{validator("AA"), validator("BB")}, nil
+		}).
+		on("GetPeerObservationRollups", func(args ...any) ([][]any, error) {
+			observedRange = args
+			return [][]any{{"AA", int64(3), int64(1)}}, nil
+		})
+	s := &Server{config: &config.Config{}, logger: zap.NewNop(), db: db.New(fake)}
+
+	rollup, err := s.createRollup(context.Background(), time.Unix(1_700_000_000, 0), 11)
+	require.NoError(t, err)
+
+	// observations of the rollup's blocks are recorded one block later
+	require.Equal(t, []any{int64(-1), int64(9)}, observedRange)
+	require.Len(t, rollup.Reports, 2)
+	require.Equal(t, int32(3), rollup.Reports[0].ReachableObservations)
+	require.Equal(t, int32(1), rollup.Reports[0].UnreachableObservations)
+	require.Zero(t, rollup.Reports[1].ReachableObservations)
+	require.Zero(t, rollup.Reports[1].UnreachableObservations)
+}
@@ -0,0 +1,40 @@
+package core
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AudiusProject/audiusd/pkg/core/config"
+	"github.com/AudiusProject/audiusd/pkg/core/server"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+// ExportSnapshot writes a snapshot of a stopped node to a directory or gocloud.dev/blob url
+func ExportSnapshot(ctx context.Context, logger *zap.Logger, dest string) error {
+	return withSnapshotArchive(ctx, logger, func(archive *server.SnapshotArchive) error {
+		return archive.Export(ctx, dest)
+	})
+}
+
+// ImportSnapshot seeds a node without any state from a snapshot written by ExportSnapshot
+func ImportSnapshot(ctx context.Context, logger *zap.Logger, src string) error {
+	return withSnapshotArchive(ctx, logger, func(archive *server.SnapshotArchive) error {
+		return archive.Import(ctx, src)
+	})
+}
+
+func withSnapshotArchive(ctx context.Context, logger *zap.Logger, fn func(*server.SnapshotArchive) error) error {
+	config, cometConfig, err := config.SetupNode(logger)
+	if err != nil {
+		return fmt.Errorf("setting up node: %v", err)
+	}
+
+	pool, err := pgxpool.New(ctx, config.PSQLConn)
+	if err != nil {
+		return fmt.Errorf("couldn't create pgx pool: %v", err)
+	}
+	defer pool.Close()
+
+	return fn(server.NewSnapshotArchive(config, cometConfig, logger, pool))
+}
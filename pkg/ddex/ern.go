@@ -0,0 +1,680 @@
+// Package ddex reads DDEX XML deliveries into the ddex protos the chain accepts.
+package ddex
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	ddexv1beta1 "github.com/AudiusProject/audiusd/pkg/api/ddex/v1beta1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ern 4.1 through 4.3 namespaces, e.g. http://ddex.net/xml/ern/43
+var ernNamespace = regexp.MustCompile(`^http://ddex\.net/xml/ern/4\d*$`)
+
+var isoDuration = regexp.MustCompile(`^P(\d+Y)?(\d+M)?(\d+D)?(T(\d+H)?(\d+M)?(\d+(\.\d+)?S)?)?$`)
+
+// LiveMessage is what ERN 4 calls a new release, the update and takedown
+// values are accepted so every control type the chain handles can be delivered
+var messageControlTypes = map[string]ddexv1beta1.MessageControlType{
+	"LiveMessage":     ddexv1beta1.MessageControlType_MESSAGE_CONTROL_TYPE_NEW_MESSAGE,
+	"NewMessage":      ddexv1beta1.MessageControlType_MESSAGE_CONTROL_TYPE_NEW_MESSAGE,
+	"UpdatedMessage":  ddexv1beta1.MessageControlType_MESSAGE_CONTROL_TYPE_UPDATED_MESSAGE,
+	"TakedownMessage": ddexv1beta1.MessageControlType_MESSAGE_CONTROL_TYPE_TAKEDOWN_MESSAGE,
+	"TestMessage":     ddexv1beta1.MessageControlType_MESSAGE_CONTROL_TYPE_TEST_MESSAGE,
+}
+
+// ParseERN reads an ERN 4.x NewReleaseMessage. Elements the protos don't model are
+// ignored, anything that is modeled has to be well formed and every party, resource
+// and release reference has to resolve. Errors are always ValidationErrors.
+func ParseERN(data []byte) (*ddexv1beta1.NewReleaseMessage, error) {
+	root, err := parseXML(data)
+	if err != nil {
+		return nil, ValidationErrors{err.(*ValidationError)}
+	}
+
+	p := &ernParser{
+		parties:   map[string]bool{},
+		resources: map[string]bool{},
+		releases:  map[string]bool{},
+	}
+	ern := p.newReleaseMessage(root)
+	p.checkReferences()
+	if len(p.errs) > 0 {
+		slices.SortStableFunc(p.errs, func(a, b *ValidationError) int { return a.Line - b.Line })
+		return nil, p.errs
+	}
+	return ern, nil
+}
+
+type reference struct {
+	el     *element
+	target string
+}
+
+type ernParser struct {
+	errs ValidationErrors
+
+	parties   map[string]bool
+	resources map[string]bool
+	releases  map[string]bool
+
+	// checked once every list is read since lists can reference each other in any order
+	partyRefs    []reference
+	resourceRefs []reference
+	releaseRefs  []reference
+}
+
+func (p *ernParser) errorf(el *element, format string, args ...any) {
+	p.errs = append(p.errs, &ValidationError{Path: el.path, Line: el.line, Message: fmt.Sprintf(format, args...)})
+}
+
+func (p *ernParser) required(parent *element, name string) *element {
+	c := parent.child(name)
+	if c == nil {
+		p.errorf(parent, "missing required element <%s>", name)
+	}
+	return c
+}
+
+func (p *ernParser) requiredText(parent *element, name string) string {
+	c := p.required(parent, name)
+	if c == nil {
+		return ""
+	}
+	if c.text == "" {
+		p.errorf(c, "must not be empty")
+	}
+	return c.text
+}
+
+func (p *ernParser) uintText(parent *element, name string) uint64 {
+	c := parent.child(name)
+	if c == nil {
+		return 0
+	}
+	v, err := strconv.ParseUint(c.text, 10, 64)
+	if err != nil {
+		p.errorf(c, "%q is not a non-negative integer", c.text)
+	}
+	return v
+}
+
+func (p *ernParser) floatText(parent *element, name string) float32 {
+	c := parent.child(name)
+	if c == nil {
+		return 0
+	}
+	v, err := strconv.ParseFloat(c.text, 32)
+	if err != nil {
+		p.errorf(c, "%q is not a number", c.text)
+	}
+	return float32(v)
+}
+
+func (p *ernParser) boolText(parent *element, name string) bool {
+	c := parent.child(name)
+	if c == nil {
+		return false
+	}
+	v, err := strconv.ParseBool(c.text)
+	if err != nil {
+		p.errorf(c, "%q is not a boolean", c.text)
+	}
+	return v
+}
+
+func (p *ernParser) durationText(parent *element, name string) string {
+	c := parent.child(name)
+	if c == nil {
+		return ""
+	}
+	if c.text == "P" || strings.HasSuffix(c.text, "T") || !isoDuration.MatchString(c.text) {
+		p.errorf(c, "%q is not an ISO 8601 duration", c.text)
+	}
+	return c.text
+}
+
+// dateText allows the partial dates DDEX does, e.g. 2023 or 2023-05
+func (p *ernParser) dateText(parent *element, name string) string {
+	c := parent.child(name)
+	if c == nil {
+		return ""
+	}
+	for _, layout := range []string{"2006-01-02", "2006-01", "2006"} {
+		if _, err := time.Parse(layout, c.text); err == nil {
+			return c.text
+		}
+	}
+	p.errorf(c, "%q is not an ISO 8601 date", c.text)
+	return c.text
+}
+
+func (p *ernParser) timestamp(el *element) *timestamppb.Timestamp {
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02T15:04:05.999999999", "2006-01-02"} {
+		if t, err := time.Parse(layout, el.text); err == nil {
+			return timestamppb.New(t)
+		}
+	}
+	p.errorf(el, "%q is not an ISO 8601 date or date time", el.text)
+	return nil
+}
+
+func (p *ernParser) define(defined map[string]bool, el *element, kind string) {
+	if el == nil || el.text == "" {
+		return
+	}
+	if defined[el.text] {
+		p.errorf(el, "duplicate %s reference %q", kind, el.text)
+	}
+	defined[el.text] = true
+}
+
+func (p *ernParser) refersTo(refs *[]reference, el *element) string {
+	if el == nil {
+		return ""
+	}
+	*refs = append(*refs, reference{el: el, target: el.text})
+	return el.text
+}
+
+func (p *ernParser) checkReferences() {
+	check := func(refs []reference, defined map[string]bool, kind string) {
+		for _, ref := range refs {
+			if !defined[ref.target] {
+				p.errorf(ref.el, "%s reference %q is not in the %sList", kind, ref.target, kind)
+			}
+		}
+	}
+	check(p.partyRefs, p.parties, "Party")
+	check(p.resourceRefs, p.resources, "Resource")
+	check(p.releaseRefs, p.releases, "Release")
+}
+
+func (p *ernParser) newReleaseMessage(root *element) *ddexv1beta1.NewReleaseMessage {
+	ern := &ddexv1beta1.NewReleaseMessage{}
+	if root.name != "NewReleaseMessage" {
+		p.errorf(root, "expected a NewReleaseMessage, got <%s>", root.name)
+		return ern
+	}
+	if !ernNamespace.MatchString(root.space) {
+		p.errorf(root, "namespace %q is not ERN 4.x", root.space)
+	}
+
+	if header := p.required(root, "MessageHeader"); header != nil {
+		ern.MessageHeader = p.messageHeader(header)
+	}
+	for _, el := range root.child("PartyList").all("Party") {
+		ern.PartyList = append(ern.PartyList, p.party(el))
+	}
+	if resourceList := root.child("ResourceList"); resourceList != nil {
+		for _, el := range resourceList.children {
+			if r := p.resource(el); r != nil {
+				ern.ResourceList = append(ern.ResourceList, r)
+			}
+		}
+	}
+	if releaseList := p.required(root, "ReleaseList"); releaseList != nil {
+		for _, el := range releaseList.children {
+			if r := p.release(el); r != nil {
+				ern.ReleaseList = append(ern.ReleaseList, r)
+			}
+		}
+		if len(ern.ReleaseList) == 0 {
+			p.errorf(releaseList, "must contain at least one release")
+		}
+	}
+	for _, el := range root.child("DealList").all("ReleaseDeal") {
+		ern.DealList = append(ern.DealList, p.releaseDeals(el)...)
+	}
+	return ern
+}
+
+func (p *ernParser) messageHeader(el *element) *ddexv1beta1.MessageHeader {
+	header := &ddexv1beta1.MessageHeader{
+		MessageId: p.requiredText(el, "MessageId"),
+	}
+	if threadID := el.child("MessageThreadId"); threadID != nil {
+		header.MessageThreadId = &threadID.text
+	}
+	if sender := p.required(el, "MessageSender"); sender != nil {
+		partyID := p.required(sender, "PartyId")
+		header.MessageSender = &ddexv1beta1.MessageSender{
+			PartyId:   p.partyID(partyID),
+			PartyName: p.partyName(sender.child("PartyName")),
+		}
+	}
+	if behalfOf := el.child("SentOnBehalfOf"); behalfOf != nil {
+		header.SentOnBehalfOf = &ddexv1beta1.MessageSender{
+			PartyId:   p.partyID(behalfOf.child("PartyId")),
+			PartyName: p.partyName(behalfOf.child("PartyName")),
+		}
+	}
+	for _, recipient := range el.all("MessageRecipient") {
+		header.MessageRecipient = append(header.MessageRecipient, &ddexv1beta1.MessageRecipient{
+			PartyId:   p.partyID(recipient.child("PartyId")),
+			PartyName: p.partyName(recipient.child("PartyName")),
+		})
+	}
+	if created := p.required(el, "MessageCreatedDateTime"); created != nil {
+		header.MessageCreatedDateTime = p.timestamp(created)
+	}
+
+	controlType := ddexv1beta1.MessageControlType_MESSAGE_CONTROL_TYPE_NEW_MESSAGE
+	if c := el.child("MessageControlType"); c != nil {
+		ct, ok := messageControlTypes[c.text]
+		if !ok {
+			p.errorf(c, "unsupported message control type %q", c.text)
+		}
+		controlType = ct
+	}
+	header.MessageControlType = &controlType
+	return header
+}
+
+// a message sender's PartyId is just a DPID, a party's can also hold proprietary ids
+func (p *ernParser) partyID(el *element) *ddexv1beta1.Party_PartyId {
+	if el == nil {
+		return nil
+	}
+	if len(el.children) == 0 {
+		return &ddexv1beta1.Party_PartyId{Dpid: el.text}
+	}
+	id := &ddexv1beta1.Party_PartyId{Dpid: el.childText("DPID")}
+	for _, pid := range el.all("ProprietaryId") {
+		id.ProprietaryIds = append(id.ProprietaryIds, &ddexv1beta1.Party_ProprietaryId{
+			Namespace: p.namespace(pid),
+			Id:        pid.text,
+		})
+	}
+	return id
+}
+
+func (p *ernParser) partyName(el *element) *ddexv1beta1.Party_PartyName {
+	if el == nil {
+		return nil
+	}
+	return &ddexv1beta1.Party_PartyName{
+		FullName:        p.requiredText(el, "FullName"),
+		FullNameIndexed: el.childText("FullNameIndexed"),
+	}
+}
+
+func (p *ernParser) namespace(el *element) string {
+	ns, ok := el.attrs["Namespace"]
+	if !ok || ns == "" {
+		p.errorf(el, "missing Namespace attribute")
+	}
+	return ns
+}
+
+func (p *ernParser) party(el *element) *ddexv1beta1.Party {
+	ref := el.child("PartyReference")
+	p.define(p.parties, ref, "Party")
+	party := &ddexv1beta1.Party{
+		PartyReference: p.requiredText(el, "PartyReference"),
+		PartyName:      p.partyName(el.child("PartyName")),
+		PartyId:        p.partyID(el.child("PartyId")),
+	}
+	if party.PartyName == nil && party.PartyId == nil {
+		p.errorf(el, "needs a <PartyName> or <PartyId>")
+	}
+	return party
+}
+
+func (p *ernParser) resourceID(el *element) *ddexv1beta1.Resource_ResourceId {
+	if el == nil {
+		return nil
+	}
+	id := &ddexv1beta1.Resource_ResourceId{Isrc: el.childText("ISRC")}
+	for _, pid := range el.all("ProprietaryId") {
+		id.ProprietaryId = append(id.ProprietaryId, &ddexv1beta1.Resource_ProprietaryId{
+			Namespace:     p.namespace(pid),
+			ProprietaryId: pid.text,
+		})
+	}
+	return id
+}
+
+func (p *ernParser) resource(el *element) *ddexv1beta1.Resource {
+	p.define(p.resources, el.child("ResourceReference"), "Resource")
+	switch el.name {
+	case "SoundRecording":
+		return &ddexv1beta1.Resource{Resource: &ddexv1beta1.Resource_SoundRecording_{SoundRecording: p.soundRecording(el)}}
+	case "Image":
+		return &ddexv1beta1.Resource{Resource: &ddexv1beta1.Resource_Image_{Image: p.image(el)}}
+	default:
+		p.errorf(el, "unsupported resource <%s>, only SoundRecording and Image resources are supported", el.name)
+		return nil
+	}
+}
+
+func (p *ernParser) soundRecording(el *element) *ddexv1beta1.Resource_SoundRecording {
+	sr := &ddexv1beta1.Resource_SoundRecording{
+		ResourceReference:     p.requiredText(el, "ResourceReference"),
+		Type:                  p.requiredText(el, "Type"),
+		DisplayTitleText:      el.childText("DisplayTitleText"),
+		VersionType:           el.childText("VersionType"),
+		DisplayArtistName:     el.childText("DisplayArtistName"),
+		Duration:              p.durationText(el, "Duration"),
+		FirstPublicationDate:  p.dateText(el, "FirstPublicationDate"),
+		ParentalWarningType:   el.childText("ParentalWarningType"),
+		LanguageOfPerformance: el.childText("LanguageOfPerformance"),
+	}
+	if title := el.child("DisplayTitle"); title != nil {
+		sr.DisplayTitle = &ddexv1beta1.Resource_DisplayTitle{TitleText: p.requiredText(title, "TitleText")}
+	}
+	for _, artist := range el.all("DisplayArtist") {
+		sr.DisplayArtist = append(sr.DisplayArtist, &ddexv1beta1.Resource_DisplayArtist{
+			ArtistPartyReference: p.refersTo(&p.partyRefs, p.required(artist, "ArtistPartyReference")),
+			DisplayArtistRole:    artist.childText("DisplayArtistRole"),
+			ArtisticRole:         artist.childText("ArtisticRole"),
+		})
+	}
+	// the Contributor proto has no fields yet, only its party reference is checked
+	for _, contributor := range el.all("Contributor") {
+		p.refersTo(&p.partyRefs, p.required(contributor, "ContributorPartyReference"))
+	}
+	if edition := el.child("SoundRecordingEdition"); edition != nil {
+		sr.SoundRecordingEdition = p.soundRecordingEdition(edition)
+	}
+	return sr
+}
+
+func (p *ernParser) soundRecordingEdition(el *element) *ddexv1beta1.Resource_SoundRecording_SoundRecordingEdition {
+	edition := &ddexv1beta1.Resource_SoundRecording_SoundRecordingEdition{
+		Type:       el.childText("Type"),
+		ResourceId: p.resourceID(el.child("ResourceId")),
+	}
+	if pline := el.child("PLine"); pline != nil {
+		edition.PLine = &ddexv1beta1.Resource_SoundRecording_SoundRecordingEdition_PLine{
+			Year:      p.dateText(pline, "Year"),
+			PLineText: p.requiredText(pline, "PLineText"),
+		}
+	}
+	td := el.child("TechnicalDetails")
+	if td == nil {
+		return edition
+	}
+	edition.TechnicalDetails = &ddexv1beta1.Resource_SoundRecording_SoundRecordingEdition_TechnicalDetails{
+		TechnicalResourceDetailsReference: p.requiredText(td, "TechnicalResourceDetailsReference"),
+		IsClip:                            p.boolText(td, "IsClip"),
+	}
+	if df := td.child("DeliveryFile"); df != nil {
+		deliveryFile := &ddexv1beta1.Resource_SoundRecording_SoundRecordingEdition_TechnicalDetails_DeliveryFile{
+			Type:                 df.childText("Type"),
+			AudioCodecType:       df.childText("AudioCodecType"),
+			NumberOfChannels:     uint32(p.uintText(df, "NumberOfChannels")),
+			SamplingRate:         p.floatText(df, "SamplingRate"),
+			BitsPerSample:        uint32(p.uintText(df, "BitsPerSample")),
+			IsProvidedInDelivery: p.boolText(df, "IsProvidedInDelivery"),
+		}
+		if f := p.required(df, "File"); f != nil {
+			uri, hashSum, fileSize := p.file(f)
+			deliveryFile.File = &ddexv1beta1.Resource_SoundRecording_SoundRecordingEdition_TechnicalDetails_DeliveryFile_File{
+				Uri:      uri,
+				FileSize: fileSize,
+			}
+			if hashSum != nil {
+				deliveryFile.File.HashSum = &ddexv1beta1.Resource_SoundRecording_SoundRecordingEdition_TechnicalDetails_DeliveryFile_File_HashSum{
+					Algorithm:    hashSum[0],
+					HashSumValue: hashSum[1],
+				}
+			}
+		}
+		edition.TechnicalDetails.DeliveryFile = deliveryFile
+	}
+	if cd := td.child("ClipDetails"); cd != nil {
+		clip := &ddexv1beta1.Resource_SoundRecording_SoundRecordingEdition_TechnicalDetails_ClipDetails{
+			TechnicalResourceDetailsReference: cd.childText("TechnicalResourceDetailsReference"),
+			ClipType:                          cd.childText("ClipType"),
+			ExpressionType:                    cd.childText("ExpressionType"),
+		}
+		if timing := cd.child("Timing"); timing != nil {
+			clip.Timing = &ddexv1beta1.Resource_SoundRecording_SoundRecordingEdition_TechnicalDetails_ClipDetails_Timing{
+				StartPoint:   p.uintText(timing, "StartPoint"),
+				EndPoint:     p.uintText(timing, "EndPoint"),
+				DurationUsed: p.durationText(timing, "DurationUsed"),
+			}
+		}
+		edition.TechnicalDetails.ClipDetails = clip
+	}
+	return edition
+}
+
+// file reads a delivered file, the hash sum is returned as algorithm and value
+// since sound recordings and images each have their own HashSum message
+func (p *ernParser) file(el *element) (string, []string, uint64) {
+	uri := p.requiredText(el, "URI")
+	var hashSum []string
+	if hs := el.child("HashSum"); hs != nil {
+		hashSum = []string{p.requiredText(hs, "Algorithm"), p.requiredText(hs, "HashSumValue")}
+	}
+	return uri, hashSum, p.uintText(el, "FileSize")
+}
+
+func (p *ernParser) image(el *element) *ddexv1beta1.Resource_Image {
+	img := &ddexv1beta1.Resource_Image{
+		ResourceReference: p.requiredText(el, "ResourceReference"),
+		Type:              p.requiredText(el, "Type"),
+		ResourceId:        p.resourceID(el.child("ResourceId")),
+	}
+	td := el.child("TechnicalDetails")
+	if td == nil {
+		return img
+	}
+	img.TechnicalDetails = &ddexv1beta1.Resource_Image_TechnicalDetails{
+		TechnicalResourceDetailsReference: p.requiredText(td, "TechnicalResourceDetailsReference"),
+		ImageCodecType:                    td.childText("ImageCodecType"),
+		ImageHeight:                       p.uintText(td, "ImageHeight"),
+		ImageWidth:                        p.uintText(td, "ImageWidth"),
+		ImageResolution:                   td.childText("ImageResolution"),
+		IsProvidedInDelivery:              p.boolText(td, "IsProvidedInDelivery"),
+	}
+	if f := td.child("File"); f != nil {
+		uri, hashSum, fileSize := p.file(f)
+		img.TechnicalDetails.File = &ddexv1beta1.Resource_Image_TechnicalDetails_File{
+			Uri:      uri,
+			FileSize: fileSize,
+		}
+		if hashSum != nil {
+			img.TechnicalDetails.File.HashSum = &ddexv1beta1.Resource_Image_TechnicalDetails_File_HashSum{
+				Algorithm:    hashSum[0],
+				HashSumValue: hashSum[1],
+			}
+		}
+	}
+	return img
+}
+
+func (p *ernParser) releaseID(el *element) *ddexv1beta1.Release_ReleaseId {
+	if el == nil {
+		return nil
+	}
+	id := &ddexv1beta1.Release_ReleaseId{
+		Grid:            el.childText("GRid"),
+		Icpn:            el.childText("ICPN"),
+		CatalogueNumber: el.childText("CatalogNumber"),
+	}
+	for _, pid := range el.all("ProprietaryId") {
+		id.ProprietaryId = append(id.ProprietaryId, &ddexv1beta1.Release_ProprietaryId{
+			Namespace: p.namespace(pid),
+			Id:        pid.text,
+		})
+	}
+	return id
+}
+
+func (p *ernParser) release(el *element) *ddexv1beta1.Release {
+	p.define(p.releases, el.child("ReleaseReference"), "Release")
+	switch el.name {
+	case "Release":
+		return &ddexv1beta1.Release{Release: &ddexv1beta1.Release_MainRelease{MainRelease: p.mainRelease(el)}}
+	case "TrackRelease":
+		return &ddexv1beta1.Release{Release: &ddexv1beta1.Release_TrackRelease_{TrackRelease: p.trackRelease(el)}}
+	default:
+		p.errorf(el, "unsupported release <%s>, only Release and TrackRelease are supported", el.name)
+		return nil
+	}
+}
+
+func (p *ernParser) mainRelease(el *element) *ddexv1beta1.Release_Release {
+	r := &ddexv1beta1.Release_Release{
+		ReleaseReference:      p.requiredText(el, "ReleaseReference"),
+		ReleaseType:           p.requiredText(el, "ReleaseType"),
+		ReleaseId:             p.releaseID(p.required(el, "ReleaseId")),
+		DisplayTitleText:      el.childText("DisplayTitleText"),
+		DisplayArtistName:     el.childText("DisplayArtistName"),
+		ReleaseLabelReference: p.refersTo(&p.partyRefs, el.child("ReleaseLabelReference")),
+		Duration:              p.durationText(el, "Duration"),
+		OriginalReleaseDate:   p.dateText(el, "OriginalReleaseDate"),
+		ParentalWarningType:   el.childText("ParentalWarningType"),
+	}
+	if title := el.child("DisplayTitle"); title != nil {
+		r.DisplayTitle = &ddexv1beta1.Release_DisplayTitle{TitleText: p.requiredText(title, "TitleText")}
+	}
+	for _, artist := range el.all("DisplayArtist") {
+		r.DisplayArtist = append(r.DisplayArtist, &ddexv1beta1.Release_DisplayArtist{
+			ArtistPartyReference: p.refersTo(&p.partyRefs, p.required(artist, "ArtistPartyReference")),
+			DisplayArtistRole:    artist.childText("DisplayArtistRole"),
+		})
+	}
+	if pline := el.child("PLine"); pline != nil {
+		r.PLine = &ddexv1beta1.Release_Release_PLine{
+			Year:      p.dateText(pline, "Year"),
+			PLineText: p.requiredText(pline, "PLineText"),
+		}
+	}
+	if genre := el.child("Genre"); genre != nil {
+		r.Genre = &ddexv1beta1.Release_Release_Genre{GenreText: p.requiredText(genre, "GenreText")}
+	}
+	if rg := el.child("ResourceGroup"); rg != nil {
+		r.ResourceGroup = &ddexv1beta1.Release_Release_ResourceGroup{}
+		for _, group := range rg.all("ResourceGroup") {
+			r.ResourceGroup.ResourceGroup = append(r.ResourceGroup.ResourceGroup, p.resourceGroup(group))
+		}
+	}
+	return r
+}
+
+func (p *ernParser) resourceGroup(el *element) *ddexv1beta1.Release_Release_ResourceGroup_ResourceGroup {
+	group := &ddexv1beta1.Release_Release_ResourceGroup_ResourceGroup{
+		ResourceGroupType: el.childText("ResourceGroupType"),
+		SequenceNumber:    el.childText("SequenceNumber"),
+	}
+	if group.SequenceNumber != "" {
+		p.uintText(el, "SequenceNumber")
+	}
+	if title := el.child("AdditionalTitle"); title != nil {
+		group.AdditionalTitle = &ddexv1beta1.Release_Release_ResourceGroup_ResourceGroup_AdditionalTitle{
+			TitleType: title.attrs["TitleType"],
+			TitleText: p.requiredText(title, "TitleText"),
+		}
+	}
+	for _, item := range el.all("ResourceGroupContentItem") {
+		group.ResourceGroupContentItem = append(group.ResourceGroupContentItem, &ddexv1beta1.Release_Release_ResourceGroup_ResourceGroup_ResourceGroupContentItem{
+			ResourceGroupContentItemText: p.refersTo(&p.resourceRefs, p.required(item, "ReleaseResourceReference")),
+		})
+	}
+	return group
+}
+
+func (p *ernParser) trackRelease(el *element) *ddexv1beta1.Release_TrackRelease {
+	r := &ddexv1beta1.Release_TrackRelease{
+		ReleaseReference:         p.requiredText(el, "ReleaseReference"),
+		ReleaseId:                p.releaseID(p.required(el, "ReleaseId")),
+		ReleaseResourceReference: p.refersTo(&p.resourceRefs, p.required(el, "ReleaseResourceReference")),
+		ReleaseLabelReference:    p.refersTo(&p.partyRefs, el.child("ReleaseLabelReference")),
+	}
+	if genre := el.child("Genre"); genre != nil {
+		r.Genre = &ddexv1beta1.Release_TrackRelease_Genre{GenreText: p.requiredText(genre, "GenreText")}
+	}
+	return r
+}
+
+// releaseDeals splits a ReleaseDeal into a Deal per deal, commercial model and use type
+// since the protos hold one of each, the split deals grant exactly what the original did
+func (p *ernParser) releaseDeals(el *element) []*ddexv1beta1.Deal {
+	refs := []string{}
+	for _, ref := range el.all("DealReleaseReference") {
+		refs = append(refs, p.refersTo(&p.releaseRefs, ref))
+	}
+	if len(refs) == 0 {
+		p.errorf(el, "missing required element <DealReleaseReference>")
+	}
+
+	deals := []*ddexv1beta1.Deal{}
+	dealEls := el.all("Deal")
+	if len(dealEls) == 0 {
+		p.errorf(el, "missing required element <Deal>")
+	}
+	for _, dealEl := range dealEls {
+		terms := p.required(dealEl, "DealTerms")
+		if terms == nil {
+			continue
+		}
+
+		base := &ddexv1beta1.Deal_ReleaseDeal_Deal_DealTerms{}
+		for _, tc := range terms.all("TerritoryCode") {
+			base.TerritoryCode = append(base.TerritoryCode, tc.text)
+		}
+		if len(base.TerritoryCode) == 0 {
+			p.errorf(terms, "missing required element <TerritoryCode>")
+		}
+		if vp := terms.child("ValidityPeriod"); vp != nil {
+			base.ValidityPeriod = &ddexv1beta1.Deal_ReleaseDeal_Deal_DealTerms_ValidityPeriod{}
+			if start := firstChild(vp, "StartDateTime", "StartDate"); start != nil {
+				base.ValidityPeriod.StartDateTime = p.timestamp(start)
+			}
+			if end := firstChild(vp, "EndDateTime", "EndDate"); end != nil {
+				base.ValidityPeriod.EndDateTime = p.timestamp(end)
+			}
+		}
+
+		models := textsOf(terms.all("CommercialModelType"))
+		if len(models) == 0 {
+			p.errorf(terms, "missing required element <CommercialModelType>")
+		}
+		useTypes := textsOf(terms.all("UseType"))
+		if len(useTypes) == 0 {
+			p.errorf(terms, "missing required element <UseType>")
+		}
+		for _, model := range models {
+			for _, useType := range useTypes {
+				dealTerms := &ddexv1beta1.Deal_ReleaseDeal_Deal_DealTerms{
+					TerritoryCode:       slices.Clone(base.TerritoryCode),
+					ValidityPeriod:      proto.CloneOf(base.ValidityPeriod),
+					CommercialModelType: model,
+					UseType:             useType,
+				}
+				deals = append(deals, &ddexv1beta1.Deal{Deal: &ddexv1beta1.Deal_ReleaseDeal_{ReleaseDeal: &ddexv1beta1.Deal_ReleaseDeal{
+					DealReleaseReference: slices.Clone(refs),
+					Deal:                 &ddexv1beta1.Deal_ReleaseDeal_Deal{DealTerms: dealTerms},
+				}}})
+			}
+		}
+	}
+	return deals
+}
+
+func firstChild(el *element, names ...string) *element {
+	for _, name := range names {
+		if c := el.child(name); c != nil {
+			return c
+		}
+	}
+	return nil
+}
+
+func textsOf(els []*element) []string {
+	texts := make([]string, len(els))
+	for i, el := range els {
+		texts[i] = el.text
+	}
+	return texts
+}
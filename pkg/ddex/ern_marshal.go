@@ -0,0 +1,354 @@
+package ddex
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	ddexv1beta1 "github.com/AudiusProject/audiusd/pkg/api/ddex/v1beta1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	ern43Namespace      = "http://ddex.net/xml/ern/43"
+	ern43SchemaLocation = ern43Namespace + " " + ern43Namespace + "/release-notification.xsd"
+)
+
+// MarshalERN writes a NewReleaseMessage as ERN 4.3 XML that ParseERN reads back to the
+// same message. Elements follow the schema's order, so sound recordings come before
+// images and the main release before track releases whatever order the protos are in.
+// ERN 4 only has live and test messages, updates and takedowns are sent as live messages.
+func MarshalERN(ern *ddexv1beta1.NewReleaseMessage) ([]byte, error) {
+	if ern.GetMessageHeader() == nil {
+		return nil, errors.New("ERN has no message header")
+	}
+
+	root := newElement("ern:NewReleaseMessage")
+	root.attrs["xmlns:ern"] = ern43Namespace
+	root.attrs["xmlns:xsi"] = "http://www.w3.org/2001/XMLSchema-instance"
+	root.attrs["xsi:schemaLocation"] = ern43SchemaLocation
+	root.attrs["AvsVersionId"] = "4"
+
+	writeMessageHeader(root.add("MessageHeader"), ern.GetMessageHeader())
+
+	if len(ern.GetPartyList()) > 0 {
+		partyList := root.add("PartyList")
+		for _, party := range ern.GetPartyList() {
+			writeParty(partyList.add("Party"), party)
+		}
+	}
+
+	if len(ern.GetResourceList()) > 0 {
+		resourceList := root.add("ResourceList")
+		for _, r := range ern.GetResourceList() {
+			if sr := r.GetSoundRecording(); sr != nil {
+				writeSoundRecording(resourceList.add("SoundRecording"), sr)
+			}
+		}
+		for _, r := range ern.GetResourceList() {
+			if img := r.GetImage(); img != nil {
+				writeImage(resourceList.add("Image"), img)
+			}
+		}
+	}
+
+	releaseList := root.add("ReleaseList")
+	for _, r := range ern.GetReleaseList() {
+		if mr := r.GetMainRelease(); mr != nil {
+			writeMainRelease(releaseList.add("Release"), mr)
+		}
+	}
+	for _, r := range ern.GetReleaseList() {
+		if tr := r.GetTrackRelease(); tr != nil {
+			writeTrackRelease(releaseList.add("TrackRelease"), tr)
+		}
+	}
+
+	if len(ern.GetDealList()) > 0 {
+		dealList := root.add("DealList")
+		for _, deal := range ern.GetDealList() {
+			if rd := deal.GetReleaseDeal(); rd != nil {
+				writeReleaseDeal(dealList.add("ReleaseDeal"), rd)
+			}
+		}
+	}
+
+	return root.marshal()
+}
+
+func writeMessageHeader(el *element, header *ddexv1beta1.MessageHeader) {
+	el.addText("MessageThreadId", header.GetMessageThreadId())
+	el.addText("MessageId", header.GetMessageId())
+	writeMessageParty(el.add("MessageSender"), header.GetMessageSender().GetPartyId(), header.GetMessageSender().GetPartyName())
+	if behalfOf := header.GetSentOnBehalfOf(); behalfOf != nil {
+		writeMessageParty(el.add("SentOnBehalfOf"), behalfOf.GetPartyId(), behalfOf.GetPartyName())
+	}
+	for _, recipient := range header.GetMessageRecipient() {
+		writeMessageParty(el.add("MessageRecipient"), recipient.GetPartyId(), recipient.GetPartyName())
+	}
+	el.addText("MessageCreatedDateTime", formatTimestamp(header.GetMessageCreatedDateTime()))
+
+	controlType := "LiveMessage"
+	if header.GetMessageControlType() == ddexv1beta1.MessageControlType_MESSAGE_CONTROL_TYPE_TEST_MESSAGE {
+		controlType = "TestMessage"
+	}
+	el.addText("MessageControlType", controlType)
+}
+
+// message header parties only carry a DPID
+func writeMessageParty(el *element, id *ddexv1beta1.Party_PartyId, name *ddexv1beta1.Party_PartyName) {
+	el.addText("PartyId", id.GetDpid())
+	writePartyName(el, name)
+}
+
+func writePartyName(parent *element, name *ddexv1beta1.Party_PartyName) {
+	if name == nil {
+		return
+	}
+	el := parent.add("PartyName")
+	el.addText("FullName", name.GetFullName())
+	el.addText("FullNameIndexed", name.GetFullNameIndexed())
+}
+
+func writeParty(el *element, party *ddexv1beta1.Party) {
+	el.addText("PartyReference", party.GetPartyReference())
+	if id := party.GetPartyId(); id != nil {
+		idEl := el.add("PartyId")
+		idEl.addText("DPID", id.GetDpid())
+		for _, pid := range id.GetProprietaryIds() {
+			writeProprietaryID(idEl, pid.GetNamespace(), pid.GetId())
+		}
+	}
+	writePartyName(el, party.GetPartyName())
+}
+
+func writeProprietaryID(parent *element, namespace, id string) {
+	if c := parent.addText("ProprietaryId", id); c != nil {
+		c.attrs["Namespace"] = namespace
+	}
+}
+
+func writeResourceID(parent *element, id *ddexv1beta1.Resource_ResourceId) {
+	if id == nil {
+		return
+	}
+	el := parent.add("ResourceId")
+	el.addText("ISRC", id.GetIsrc())
+	for _, pid := range id.GetProprietaryId() {
+		writeProprietaryID(el, pid.GetNamespace(), pid.GetProprietaryId())
+	}
+}
+
+func writePLine(parent *element, year, text string) {
+	el := parent.add("PLine")
+	el.addText("Year", year)
+	el.addText("PLineText", text)
+}
+
+func writeSoundRecording(el *element, sr *ddexv1beta1.Resource_SoundRecording) {
+	el.addText("ResourceReference", sr.GetResourceReference())
+	el.addText("Type", sr.GetType())
+
+	if edition := sr.GetSoundRecordingEdition(); edition != nil {
+		editionEl := el.add("SoundRecordingEdition")
+		editionEl.addText("Type", edition.GetType())
+		writeResourceID(editionEl, edition.GetResourceId())
+		if pline := edition.GetPLine(); pline != nil {
+			writePLine(editionEl, pline.GetYear(), pline.GetPLineText())
+		}
+		if td := edition.GetTechnicalDetails(); td != nil {
+			writeSoundRecordingTechnicalDetails(editionEl.add("TechnicalDetails"), td)
+		}
+	}
+
+	el.addText("DisplayTitleText", sr.GetDisplayTitleText())
+	if title := sr.GetDisplayTitle(); title != nil {
+		el.add("DisplayTitle").addText("TitleText", title.GetTitleText())
+	}
+	el.addText("VersionType", sr.GetVersionType())
+	el.addText("DisplayArtistName", sr.GetDisplayArtistName())
+	for i, artist := range sr.GetDisplayArtist() {
+		artistEl := el.add("DisplayArtist")
+		artistEl.attrs["SequenceNumber"] = strconv.Itoa(i + 1)
+		artistEl.addText("ArtistPartyReference", artist.GetArtistPartyReference())
+		artistEl.addText("DisplayArtistRole", artist.GetDisplayArtistRole())
+		artistEl.addText("ArtisticRole", artist.GetArtisticRole())
+	}
+	el.addText("Duration", sr.GetDuration())
+	el.addText("FirstPublicationDate", sr.GetFirstPublicationDate())
+	el.addText("ParentalWarningType", sr.GetParentalWarningType())
+	el.addText("LanguageOfPerformance", sr.GetLanguageOfPerformance())
+}
+
+func writeSoundRecordingTechnicalDetails(el *element, td *ddexv1beta1.Resource_SoundRecording_SoundRecordingEdition_TechnicalDetails) {
+	el.addText("TechnicalResourceDetailsReference", td.GetTechnicalResourceDetailsReference())
+
+	if df := td.GetDeliveryFile(); df != nil {
+		dfEl := el.add("DeliveryFile")
+		dfEl.addText("Type", df.GetType())
+		dfEl.addText("AudioCodecType", df.GetAudioCodecType())
+		dfEl.addText("NumberOfChannels", formatUint(uint64(df.GetNumberOfChannels())))
+		if df.GetSamplingRate() != 0 {
+			dfEl.addText("SamplingRate", strconv.FormatFloat(float64(df.GetSamplingRate()), 'f', -1, 32))
+		}
+		dfEl.addText("BitsPerSample", formatUint(uint64(df.GetBitsPerSample())))
+		if f := df.GetFile(); f != nil {
+			writeFile(dfEl, f.GetUri(), f.GetHashSum().GetAlgorithm(), f.GetHashSum().GetHashSumValue(), f.GetFileSize())
+		}
+		dfEl.addText("IsProvidedInDelivery", formatBool(df.GetIsProvidedInDelivery()))
+	}
+
+	el.addText("IsClip", formatBool(td.GetIsClip()))
+	if cd := td.GetClipDetails(); cd != nil {
+		cdEl := el.add("ClipDetails")
+		cdEl.addText("TechnicalResourceDetailsReference", cd.GetTechnicalResourceDetailsReference())
+		cdEl.addText("ClipType", cd.GetClipType())
+		if timing := cd.GetTiming(); timing != nil {
+			timingEl := cdEl.add("Timing")
+			timingEl.addText("StartPoint", formatUint(timing.GetStartPoint()))
+			timingEl.addText("EndPoint", formatUint(timing.GetEndPoint()))
+			timingEl.addText("DurationUsed", timing.GetDurationUsed())
+		}
+		cdEl.addText("ExpressionType", cd.GetExpressionType())
+	}
+}
+
+func writeFile(parent *element, uri, algorithm, hashSum string, fileSize uint64) {
+	el := parent.add("File")
+	el.addText("URI", uri)
+	if algorithm != "" || hashSum != "" {
+		hashEl := el.add("HashSum")
+		hashEl.addText("Algorithm", algorithm)
+		hashEl.addText("HashSumValue", hashSum)
+	}
+	el.addText("FileSize", formatUint(fileSize))
+}
+
+func writeImage(el *element, img *ddexv1beta1.Resource_Image) {
+	el.addText("ResourceReference", img.GetResourceReference())
+	el.addText("Type", img.GetType())
+	writeResourceID(el, img.GetResourceId())
+
+	td := img.GetTechnicalDetails()
+	if td == nil {
+		return
+	}
+	tdEl := el.add("TechnicalDetails")
+	tdEl.addText("TechnicalResourceDetailsReference", td.GetTechnicalResourceDetailsReference())
+	tdEl.addText("ImageCodecType", td.GetImageCodecType())
+	tdEl.addText("ImageHeight", formatUint(td.GetImageHeight()))
+	tdEl.addText("ImageWidth", formatUint(td.GetImageWidth()))
+	tdEl.addText("ImageResolution", td.GetImageResolution())
+	if f := td.GetFile(); f != nil {
+		writeFile(tdEl, f.GetUri(), f.GetHashSum().GetAlgorithm(), f.GetHashSum().GetHashSumValue(), f.GetFileSize())
+	}
+	tdEl.addText("IsProvidedInDelivery", formatBool(td.GetIsProvidedInDelivery()))
+}
+
+func writeReleaseID(parent *element, id *ddexv1beta1.Release_ReleaseId) {
+	el := parent.add("ReleaseId")
+	el.addText("GRid", id.GetGrid())
+	el.addText("ICPN", id.GetIcpn())
+	el.addText("CatalogNumber", id.GetCatalogueNumber())
+	for _, pid := range id.GetProprietaryId() {
+		writeProprietaryID(el, pid.GetNamespace(), pid.GetId())
+	}
+}
+
+func writeMainRelease(el *element, r *ddexv1beta1.Release_Release) {
+	el.addText("ReleaseReference", r.GetReleaseReference())
+	el.addText("ReleaseType", r.GetReleaseType())
+	writeReleaseID(el, r.GetReleaseId())
+	el.addText("DisplayTitleText", r.GetDisplayTitleText())
+	if title := r.GetDisplayTitle(); title != nil {
+		el.add("DisplayTitle").addText("TitleText", title.GetTitleText())
+	}
+	el.addText("DisplayArtistName", r.GetDisplayArtistName())
+	for i, artist := range r.GetDisplayArtist() {
+		artistEl := el.add("DisplayArtist")
+		artistEl.attrs["SequenceNumber"] = strconv.Itoa(i + 1)
+		artistEl.addText("ArtistPartyReference", artist.GetArtistPartyReference())
+		artistEl.addText("DisplayArtistRole", artist.GetDisplayArtistRole())
+	}
+	el.addText("ReleaseLabelReference", r.GetReleaseLabelReference())
+	if pline := r.GetPLine(); pline != nil {
+		writePLine(el, pline.GetYear(), pline.GetPLineText())
+	}
+	el.addText("Duration", r.GetDuration())
+	if genre := r.GetGenre(); genre != nil {
+		el.add("Genre").addText("GenreText", genre.GetGenreText())
+	}
+	el.addText("OriginalReleaseDate", r.GetOriginalReleaseDate())
+	el.addText("ParentalWarningType", r.GetParentalWarningType())
+
+	if rg := r.GetResourceGroup(); rg != nil {
+		rgEl := el.add("ResourceGroup")
+		for _, group := range rg.GetResourceGroup() {
+			groupEl := rgEl.add("ResourceGroup")
+			groupEl.addText("ResourceGroupType", group.GetResourceGroupType())
+			if title := group.GetAdditionalTitle(); title != nil {
+				titleEl := groupEl.add("AdditionalTitle")
+				if title.GetTitleType() != "" {
+					titleEl.attrs["TitleType"] = title.GetTitleType()
+				}
+				titleEl.addText("TitleText", title.GetTitleText())
+			}
+			groupEl.addText("SequenceNumber", group.GetSequenceNumber())
+			for i, item := range group.GetResourceGroupContentItem() {
+				itemEl := groupEl.add("ResourceGroupContentItem")
+				itemEl.addText("SequenceNumber", strconv.Itoa(i+1))
+				itemEl.addText("ReleaseResourceReference", item.GetResourceGroupContentItemText())
+			}
+		}
+	}
+}
+
+func writeTrackRelease(el *element, r *ddexv1beta1.Release_TrackRelease) {
+	el.addText("ReleaseReference", r.GetReleaseReference())
+	writeReleaseID(el, r.GetReleaseId())
+	el.addText("ReleaseResourceReference", r.GetReleaseResourceReference())
+	el.addText("ReleaseLabelReference", r.GetReleaseLabelReference())
+	if genre := r.GetGenre(); genre != nil {
+		el.add("Genre").addText("GenreText", genre.GetGenreText())
+	}
+}
+
+func writeReleaseDeal(el *element, rd *ddexv1beta1.Deal_ReleaseDeal) {
+	for _, ref := range rd.GetDealReleaseReference() {
+		el.addText("DealReleaseReference", ref)
+	}
+	terms := rd.GetDeal().GetDealTerms()
+	termsEl := el.add("Deal").add("DealTerms")
+	for _, tc := range terms.GetTerritoryCode() {
+		termsEl.addText("TerritoryCode", tc)
+	}
+	if vp := terms.GetValidityPeriod(); vp != nil {
+		vpEl := termsEl.add("ValidityPeriod")
+		vpEl.addText("StartDateTime", formatTimestamp(vp.GetStartDateTime()))
+		vpEl.addText("EndDateTime", formatTimestamp(vp.GetEndDateTime()))
+	}
+	termsEl.addText("CommercialModelType", terms.GetCommercialModelType())
+	termsEl.addText("UseType", terms.GetUseType())
+}
+
+func formatTimestamp(ts *timestamppb.Timestamp) string {
+	if ts == nil {
+		return ""
+	}
+	return ts.AsTime().UTC().Format(time.RFC3339Nano)
+}
+
+// zero values are left out like any other unset field
+func formatUint(v uint64) string {
+	if v == 0 {
+		return ""
+	}
+	return strconv.FormatUint(v, 10)
+}
+
+func formatBool(v bool) string {
+	if !v {
+		return ""
+	}
+	return "true"
+}
@@ -0,0 +1,54 @@
+package ddex
+
+import (
+	"testing"
+
+	ddexv1beta1 "github.com/AudiusProject/audiusd/pkg/api/ddex/v1beta1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarshalERNRoundTrip(t *testing.T) {
+	for _, sample := range []string{"ern43_audio_single.xml", "ern42_audio_album.xml"} {
+		t.Run(sample, func(t *testing.T) {
+			ern, err := ParseERN(readSample(t, sample))
+			require.NoError(t, err)
+
+			data, err := MarshalERN(ern)
+			require.NoError(t, err)
+			require.Contains(t, string(data), `xmlns:ern="http://ddex.net/xml/ern/43"`)
+
+			roundTripped, err := ParseERN(data)
+			require.NoError(t, err, string(data))
+			requireProtoEqual(t, ern, roundTripped)
+
+			// and it's stable from there
+			again, err := MarshalERN(roundTripped)
+			require.NoError(t, err)
+			require.Equal(t, string(data), string(again))
+		})
+	}
+}
+
+func TestMarshalERNSchemaOrder(t *testing.T) {
+	ern, err := ParseERN(readSample(t, "ern43_audio_single.xml"))
+	require.NoError(t, err)
+
+	// images and track releases first in the protos still come after in the xml
+	ern.ResourceList[0], ern.ResourceList[1] = ern.ResourceList[1], ern.ResourceList[0]
+	ern.ReleaseList[0], ern.ReleaseList[1] = ern.ReleaseList[1], ern.ReleaseList[0]
+	ern.MessageHeader.MessageControlType = ddexv1beta1.MessageControlType_MESSAGE_CONTROL_TYPE_UPDATED_MESSAGE.Enum()
+
+	data, err := MarshalERN(ern)
+	require.NoError(t, err)
+
+	root, err := parseXML(data)
+	require.NoError(t, err)
+	require.Equal(t, "SoundRecording", root.child("ResourceList").children[0].name)
+	require.Equal(t, "Image", root.child("ResourceList").children[1].name)
+	require.Equal(t, "Release", root.child("ReleaseList").children[0].name)
+	require.Equal(t, "TrackRelease", root.child("ReleaseList").children[1].name)
+	require.Equal(t, "LiveMessage", root.child("MessageHeader").childText("MessageControlType"))
+
+	_, err = MarshalERN(&ddexv1beta1.NewReleaseMessage{})
+	require.Error(t, err)
+}
@@ -0,0 +1,353 @@
+package ddex
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	ddexv1beta1 "github.com/AudiusProject/audiusd/pkg/api/ddex/v1beta1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func readSample(t *testing.T, name string) []byte {
+	data, err := os.ReadFile("testdata/" + name)
+	require.NoError(t, err)
+	return data
+}
+
+func requireProtoEqual(t *testing.T, expected, actual proto.Message) {
+	if !proto.Equal(expected, actual) {
+		require.Equal(t, protojson.Format(expected), protojson.Format(actual))
+	}
+}
+
+func TestParseERNSingle(t *testing.T) {
+	ern, err := ParseERN(readSample(t, "ern43_audio_single.xml"))
+	require.NoError(t, err)
+
+	deal := func(model string) *ddexv1beta1.Deal {
+		return &ddexv1beta1.Deal{Deal: &ddexv1beta1.Deal_ReleaseDeal_{ReleaseDeal: &ddexv1beta1.Deal_ReleaseDeal{
+			DealReleaseReference: []string{"R0", "R1"},
+			Deal: &ddexv1beta1.Deal_ReleaseDeal_Deal{DealTerms: &ddexv1beta1.Deal_ReleaseDeal_Deal_DealTerms{
+				TerritoryCode: []string{"Worldwide"},
+				ValidityPeriod: &ddexv1beta1.Deal_ReleaseDeal_Deal_DealTerms_ValidityPeriod{
+					StartDateTime: timestamppb.New(time.Date(2024, 9, 13, 0, 0, 0, 0, time.UTC)),
+				},
+				CommercialModelType: model,
+				UseType:             "OnDemandStream",
+			}},
+		}}}
+	}
+
+	threadID := "SINGLE_0001"
+	expected := &ddexv1beta1.NewReleaseMessage{
+		MessageHeader: &ddexv1beta1.MessageHeader{
+			MessageThreadId: &threadID,
+			MessageId:       "SINGLE_0001_MSG",
+			MessageSender: &ddexv1beta1.MessageSender{
+				PartyId:   &ddexv1beta1.Party_PartyId{Dpid: "PADPIDA2024010501X"},
+				PartyName: &ddexv1beta1.Party_PartyName{FullName: "Melodic Records Entertainment"},
+			},
+			MessageRecipient: []*ddexv1beta1.MessageRecipient{{
+				PartyId:   &ddexv1beta1.Party_PartyId{Dpid: "PADPIDA202401120D9"},
+				PartyName: &ddexv1beta1.Party_PartyName{FullName: "Audius"},
+			}},
+			MessageCreatedDateTime: timestamppb.New(time.Date(2025, 6, 4, 17, 9, 19, 141000000, time.UTC)),
+			MessageControlType:     ddexv1beta1.MessageControlType_MESSAGE_CONTROL_TYPE_NEW_MESSAGE.Enum(),
+		},
+		PartyList: []*ddexv1beta1.Party{
+			{
+				PartyReference: "P_ARTIST_1",
+				PartyName:      &ddexv1beta1.Party_PartyName{FullName: "Marcus Stone", FullNameIndexed: "Stone, Marcus"},
+				PartyId: &ddexv1beta1.Party_PartyId{ProprietaryIds: []*ddexv1beta1.Party_ProprietaryId{
+					{Namespace: "PADPIDA2024010501X", Id: "ART-1"},
+				}},
+			},
+			{
+				PartyReference: "P_LABEL",
+				PartyName:      &ddexv1beta1.Party_PartyName{FullName: "Harmony Records Legacy"},
+				PartyId:        &ddexv1beta1.Party_PartyId{Dpid: "PADPIDA2024010501X"},
+			},
+		},
+		ResourceList: []*ddexv1beta1.Resource{
+			{Resource: &ddexv1beta1.Resource_SoundRecording_{SoundRecording: &ddexv1beta1.Resource_SoundRecording{
+				ResourceReference: "A1",
+				Type:              "MusicalWorkSoundRecording",
+				SoundRecordingEdition: &ddexv1beta1.Resource_SoundRecording_SoundRecordingEdition{
+					Type:       "NonImmersiveEdition",
+					ResourceId: &ddexv1beta1.Resource_ResourceId{Isrc: "USRC17607839"},
+					PLine: &ddexv1beta1.Resource_SoundRecording_SoundRecordingEdition_PLine{
+						Year:      "2024",
+						PLineText: "(P) 2024 Melodic Records Entertainment",
+					},
+					TechnicalDetails: &ddexv1beta1.Resource_SoundRecording_SoundRecordingEdition_TechnicalDetails{
+						TechnicalResourceDetailsReference: "T1",
+						DeliveryFile: &ddexv1beta1.Resource_SoundRecording_SoundRecordingEdition_TechnicalDetails_DeliveryFile{
+							Type:             "AudioFile",
+							AudioCodecType:   "FLAC",
+							NumberOfChannels: 2,
+							SamplingRate:     44.1,
+							BitsPerSample:    16,
+							File: &ddexv1beta1.Resource_SoundRecording_SoundRecordingEdition_TechnicalDetails_DeliveryFile_File{
+								Uri: "baeaaaiqsea7fdpebodzygr3nbz5jlejqc3bvxm4gm4gegndwh4qbcpqtpasvu",
+								HashSum: &ddexv1beta1.Resource_SoundRecording_SoundRecordingEdition_TechnicalDetails_DeliveryFile_File_HashSum{
+									Algorithm:    "MD5",
+									HashSumValue: "8b1a9953c4611296a827abf8c47804d7",
+								},
+								FileSize: 31457280,
+							},
+						},
+					},
+				},
+				DisplayTitleText:  "Night Drive",
+				DisplayTitle:      &ddexv1beta1.Resource_DisplayTitle{TitleText: "Night Drive"},
+				DisplayArtistName: "Marcus Stone",
+				DisplayArtist: []*ddexv1beta1.Resource_DisplayArtist{
+					{ArtistPartyReference: "P_ARTIST_1", DisplayArtistRole: "MainArtist"},
+				},
+				Duration:              "PT0H3M21S",
+				ParentalWarningType:   "NotExplicit",
+				LanguageOfPerformance: "en",
+			}}},
+			{Resource: &ddexv1beta1.Resource_Image_{Image: &ddexv1beta1.Resource_Image{
+				ResourceReference: "A2",
+				Type:              "FrontCoverImage",
+				ResourceId: &ddexv1beta1.Resource_ResourceId{ProprietaryId: []*ddexv1beta1.Resource_ProprietaryId{
+					{Namespace: "PADPIDA2024010501X", ProprietaryId: "IMG-1"},
+				}},
+				TechnicalDetails: &ddexv1beta1.Resource_Image_TechnicalDetails{
+					TechnicalResourceDetailsReference: "T2",
+					ImageCodecType:                    "JPEG",
+					ImageHeight:                       1400,
+					ImageWidth:                        1400,
+					File: &ddexv1beta1.Resource_Image_TechnicalDetails_File{
+						Uri: "resources/cover.jpg",
+						HashSum: &ddexv1beta1.Resource_Image_TechnicalDetails_File_HashSum{
+							Algorithm:    "MD5",
+							HashSumValue: "5eb63bbbe01eeed093cb22bb8f5acdc3",
+						},
+					},
+				},
+			}}},
+		},
+		ReleaseList: []*ddexv1beta1.Release{
+			{Release: &ddexv1beta1.Release_MainRelease{MainRelease: &ddexv1beta1.Release_Release{
+				ReleaseReference:  "R0",
+				ReleaseType:       "Single",
+				ReleaseId:         &ddexv1beta1.Release_ReleaseId{Icpn: "0123456789012", CatalogueNumber: "MRE-0001"},
+				DisplayTitleText:  "Night Drive",
+				DisplayTitle:      &ddexv1beta1.Release_DisplayTitle{TitleText: "Night Drive"},
+				DisplayArtistName: "Marcus Stone",
+				DisplayArtist: []*ddexv1beta1.Release_DisplayArtist{
+					{ArtistPartyReference: "P_ARTIST_1", DisplayArtistRole: "MainArtist"},
+				},
+				ReleaseLabelReference: "P_LABEL",
+				PLine: &ddexv1beta1.Release_Release_PLine{
+					Year:      "2024",
+					PLineText: "(P) 2024 Melodic Records Entertainment",
+				},
+				Duration:            "PT0H3M21S",
+				Genre:               &ddexv1beta1.Release_Release_Genre{GenreText: "Electronic"},
+				OriginalReleaseDate: "2024-09-13",
+				ParentalWarningType: "NotExplicit",
+				ResourceGroup: &ddexv1beta1.Release_Release_ResourceGroup{
+					ResourceGroup: []*ddexv1beta1.Release_Release_ResourceGroup_ResourceGroup{{
+						SequenceNumber: "1",
+						ResourceGroupContentItem: []*ddexv1beta1.Release_Release_ResourceGroup_ResourceGroup_ResourceGroupContentItem{
+							{ResourceGroupContentItemText: "A1"},
+						},
+					}},
+				},
+			}}},
+			{Release: &ddexv1beta1.Release_TrackRelease_{TrackRelease: &ddexv1beta1.Release_TrackRelease{
+				ReleaseReference:         "R1",
+				ReleaseId:                &ddexv1beta1.Release_ReleaseId{},
+				ReleaseResourceReference: "A1",
+				ReleaseLabelReference:    "P_LABEL",
+				Genre:                    &ddexv1beta1.Release_TrackRelease_Genre{GenreText: "Electronic"},
+			}}},
+		},
+		DealList: []*ddexv1beta1.Deal{
+			deal("AdvertisementSupportedModel"),
+			deal("SubscriptionModel"),
+		},
+	}
+	requireProtoEqual(t, expected, ern)
+
+	// what gets put on chain reads back the same
+	raw, err := proto.Marshal(ern)
+	require.NoError(t, err)
+	var decoded ddexv1beta1.NewReleaseMessage
+	require.NoError(t, proto.Unmarshal(raw, &decoded))
+	requireProtoEqual(t, ern, &decoded)
+}
+
+func TestParseERNAlbum(t *testing.T) {
+	ern, err := ParseERN(readSample(t, "ern42_audio_album.xml"))
+	require.NoError(t, err)
+
+	header := ern.GetMessageHeader()
+	require.Equal(t, ddexv1beta1.MessageControlType_MESSAGE_CONTROL_TYPE_TEST_MESSAGE, header.GetMessageControlType())
+	require.True(t, header.GetMessageCreatedDateTime().AsTime().Equal(time.Date(2025, 6, 4, 17, 9, 19, 141000000, time.UTC)))
+
+	require.Len(t, ern.GetPartyList(), 4)
+	require.Len(t, ern.GetResourceList(), 3)
+
+	midnight := ern.GetResourceList()[0].GetSoundRecording()
+	require.Equal(t, "Midnight Express", midnight.GetDisplayTitle().GetTitleText())
+	require.Equal(t, "Vocals", midnight.GetDisplayArtist()[1].GetArtisticRole())
+	require.Equal(t, float32(48), midnight.GetSoundRecordingEdition().GetTechnicalDetails().GetDeliveryFile().GetSamplingRate())
+
+	preview := ern.GetResourceList()[1].GetSoundRecording().GetSoundRecordingEdition().GetTechnicalDetails()
+	require.True(t, preview.GetIsClip())
+	require.True(t, preview.GetDeliveryFile().GetIsProvidedInDelivery())
+	require.Nil(t, preview.GetDeliveryFile().GetFile().GetHashSum())
+	require.Equal(t, uint64(30), preview.GetClipDetails().GetTiming().GetStartPoint())
+	require.Equal(t, "PT30S", preview.GetClipDetails().GetTiming().GetDurationUsed())
+	require.Equal(t, "2023-05", ern.GetResourceList()[1].GetSoundRecording().GetFirstPublicationDate())
+
+	cover := ern.GetResourceList()[2].GetImage()
+	require.Equal(t, "72dpi", cover.GetTechnicalDetails().GetImageResolution())
+	require.Equal(t, uint64(1000), cover.GetTechnicalDetails().GetFile().GetFileSize())
+
+	album := ern.GetReleaseList()[0].GetMainRelease()
+	require.Equal(t, &ddexv1beta1.Release_ReleaseId{
+		Grid:            "A10301A0004509182C",
+		Icpn:            "123456789012",
+		CatalogueNumber: "F0100045091829",
+		ProprietaryId:   []*ddexv1beta1.Release_ProprietaryId{{Namespace: "PADPIDA2024010501X", Id: "REL-0001"}},
+	}, album.GetReleaseId())
+	group := album.GetResourceGroup().GetResourceGroup()[0]
+	require.Equal(t, "GroupingTitle", group.GetAdditionalTitle().GetTitleType())
+	require.Len(t, group.GetResourceGroupContentItem(), 2)
+
+	// two use types in the first deal and one in the second
+	deals := ern.GetDealList()
+	require.Len(t, deals, 3)
+	require.Equal(t, "OnDemandStream", deals[0].GetReleaseDeal().GetDeal().GetDealTerms().GetUseType())
+	require.Equal(t, "NonInteractiveStream", deals[1].GetReleaseDeal().GetDeal().GetDealTerms().GetUseType())
+	require.Equal(t, []string{"US", "CA"}, deals[1].GetReleaseDeal().GetDeal().GetDealTerms().GetTerritoryCode())
+	require.Equal(t, "PermanentDownload", deals[2].GetReleaseDeal().GetDeal().GetDealTerms().GetUseType())
+	require.Nil(t, deals[2].GetReleaseDeal().GetDeal().GetDealTerms().GetValidityPeriod())
+}
+
+func TestParseERNValidationErrors(t *testing.T) {
+	single := string(readSample(t, "ern43_audio_single.xml"))
+
+	tests := []struct {
+		name    string
+		old     string
+		new     string
+		path    string
+		line    int
+		message string
+	}{
+		{
+			name:    "not ern 4",
+			old:     `xmlns:ern="http://ddex.net/xml/ern/43"`,
+			new:     `xmlns:ern="http://ddex.net/xml/ern/382"`,
+			path:    "/NewReleaseMessage",
+			line:    2,
+			message: "is not ERN 4.x",
+		},
+		{
+			name:    "missing message id",
+			old:     "<MessageId>SINGLE_0001_MSG</MessageId>",
+			path:    "/NewReleaseMessage/MessageHeader",
+			line:    3,
+			message: "missing required element <MessageId>",
+		},
+		{
+			name:    "bad duration",
+			old:     "<Duration>PT0H3M21S</Duration>\n      <ParentalWarningType>NotExplicit</ParentalWarningType>\n      <LanguageOfPerformance>",
+			new:     "<Duration>3:21</Duration>\n      <ParentalWarningType>NotExplicit</ParentalWarningType>\n      <LanguageOfPerformance>",
+			path:    "/NewReleaseMessage/ResourceList/SoundRecording/Duration",
+			line:    88,
+			message: `"3:21" is not an ISO 8601 duration`,
+		},
+		{
+			name:    "unknown artist",
+			old:     "<ArtistPartyReference>P_ARTIST_1</ArtistPartyReference>\n        <DisplayArtistRole>MainArtist</DisplayArtistRole>\n      </DisplayArtist>\n      <ReleaseLabelReference>",
+			new:     "<ArtistPartyReference>P_ARTIST_2</ArtistPartyReference>\n        <DisplayArtistRole>MainArtist</DisplayArtistRole>\n      </DisplayArtist>\n      <ReleaseLabelReference>",
+			path:    "/NewReleaseMessage/ReleaseList/Release/DisplayArtist/ArtistPartyReference",
+			line:    128,
+			message: `Party reference "P_ARTIST_2" is not in the PartyList`,
+		},
+		{
+			name:    "unknown track resource",
+			old:     "<ReleaseResourceReference>A1</ReleaseResourceReference>\n      <ReleaseLabelReference>",
+			new:     "<ReleaseResourceReference>A9</ReleaseResourceReference>\n      <ReleaseLabelReference>",
+			path:    "/NewReleaseMessage/ReleaseList/TrackRelease/ReleaseResourceReference",
+			line:    158,
+			message: `Resource reference "A9" is not in the ResourceList`,
+		},
+		{
+			name:    "unknown deal release",
+			old:     "<DealReleaseReference>R1</DealReleaseReference>",
+			new:     "<DealReleaseReference>R2</DealReleaseReference>",
+			path:    "/NewReleaseMessage/DealList/ReleaseDeal/DealReleaseReference[2]",
+			line:    168,
+			message: `Release reference "R2" is not in the ReleaseList`,
+		},
+		{
+			name:    "duplicate resource",
+			old:     "<ResourceReference>A2</ResourceReference>",
+			new:     "<ResourceReference>A1</ResourceReference>",
+			path:    "/NewReleaseMessage/ResourceList/Image/ResourceReference",
+			line:    93,
+			message: `duplicate Resource reference "A1"`,
+		},
+		{
+			name:    "bad file size",
+			old:     "<FileSize>31457280</FileSize>",
+			new:     "<FileSize>30MB</FileSize>",
+			path:    "/NewReleaseMessage/ResourceList/SoundRecording/SoundRecordingEdition/TechnicalDetails/DeliveryFile/File/FileSize",
+			line:    70,
+			message: `"30MB" is not a non-negative integer`,
+		},
+		{
+			name:    "unsupported control type",
+			old:     "<MessageControlType>LiveMessage</MessageControlType>",
+			new:     "<MessageControlType>CorrectionMessage</MessageControlType>",
+			path:    "/NewReleaseMessage/MessageHeader/MessageControlType",
+			line:    19,
+			message: `unsupported message control type "CorrectionMessage"`,
+		},
+		{
+			name:    "malformed",
+			old:     "</PartyList>",
+			new:     "</PartyLst>",
+			path:    "/NewReleaseMessage/PartyList",
+			line:    42,
+			message: "malformed xml",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := strings.Replace(single, tt.old, tt.new, 1)
+			require.NotEqual(t, single, data)
+
+			_, err := ParseERN([]byte(data))
+			var errs ValidationErrors
+			require.ErrorAs(t, err, &errs)
+			require.Len(t, errs, 1, err.Error())
+			require.Equal(t, tt.path, errs[0].Path)
+			require.Equal(t, tt.line, errs[0].Line)
+			require.Contains(t, errs[0].Message, tt.message)
+		})
+	}
+
+	// every problem is reported, in document order
+	data := strings.Replace(single, "<MessageId>SINGLE_0001_MSG</MessageId>", "", 1)
+	data = strings.Replace(data, "<FileSize>31457280</FileSize>", "<FileSize>-1</FileSize>", 1)
+	_, err := ParseERN([]byte(data))
+	var errs ValidationErrors
+	require.ErrorAs(t, err, &errs)
+	require.Len(t, errs, 2)
+	require.Less(t, errs[0].Line, errs[1].Line)
+}
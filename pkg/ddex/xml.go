@@ -0,0 +1,221 @@
+package ddex
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+)
+
+// ValidationError points at the element of a DDEX XML message that couldn't be read
+type ValidationError struct {
+	// element path from the root, repeated siblings are indexed from 1
+	// e.g. /NewReleaseMessage/ResourceList/SoundRecording[2]/Duration
+	Path    string
+	Line    int
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("%s (line %d): %s", e.Path, e.Line, e.Message)
+}
+
+// ValidationErrors is every problem found in a message, in document order
+type ValidationErrors []*ValidationError
+
+func (e ValidationErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// element is a parsed xml element, namespaces are dropped from child
+// element and attribute names since DDEX only qualifies the root
+type element struct {
+	name     string
+	space    string
+	attrs    map[string]string
+	text     string
+	children []*element
+	line     int
+	path     string
+}
+
+func parseXML(data []byte) (*element, error) {
+	dec := xml.NewDecoder(bytes.NewReader(data))
+	var root *element
+	stack := []*element{}
+	for {
+		line, _ := dec.InputPos()
+		tok, err := dec.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			var syntaxErr *xml.SyntaxError
+			if errors.As(err, &syntaxErr) {
+				line = syntaxErr.Line
+			}
+			path := ""
+			for _, el := range stack {
+				path += "/" + el.name
+			}
+			if path == "" {
+				path = "/"
+			}
+			return nil, &ValidationError{Path: path, Line: line, Message: fmt.Sprintf("malformed xml: %v", err)}
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			el := &element{name: t.Name.Local, space: t.Name.Space, attrs: map[string]string{}, line: line}
+			for _, attr := range t.Attr {
+				if attr.Name.Space == "xmlns" || attr.Name.Local == "xmlns" {
+					continue
+				}
+				el.attrs[attr.Name.Local] = attr.Value
+			}
+			if len(stack) == 0 {
+				if root != nil {
+					return nil, &ValidationError{Path: "/" + el.name, Line: line, Message: "more than one root element"}
+				}
+				root = el
+			} else {
+				parent := stack[len(stack)-1]
+				parent.children = append(parent.children, el)
+			}
+			stack = append(stack, el)
+		case xml.EndElement:
+			el := stack[len(stack)-1]
+			el.text = strings.TrimSpace(el.text)
+			stack = stack[:len(stack)-1]
+		case xml.CharData:
+			if len(stack) > 0 {
+				stack[len(stack)-1].text += string(t)
+			}
+		}
+	}
+	if root == nil {
+		return nil, &ValidationError{Path: "/", Line: 1, Message: "empty document"}
+	}
+	root.setPaths("/" + root.name)
+	return root, nil
+}
+
+func (e *element) setPaths(path string) {
+	e.path = path
+	counts := map[string]int{}
+	for _, c := range e.children {
+		counts[c.name]++
+	}
+	seen := map[string]int{}
+	for _, c := range e.children {
+		seen[c.name]++
+		if counts[c.name] > 1 {
+			c.setPaths(fmt.Sprintf("%s/%s[%d]", path, c.name, seen[c.name]))
+		} else {
+			c.setPaths(path + "/" + c.name)
+		}
+	}
+}
+
+// child is the first child named name, nil if there isn't one
+func (e *element) child(name string) *element {
+	if e == nil {
+		return nil
+	}
+	for _, c := range e.children {
+		if c.name == name {
+			return c
+		}
+	}
+	return nil
+}
+
+func (e *element) all(name string) []*element {
+	if e == nil {
+		return nil
+	}
+	els := []*element{}
+	for _, c := range e.children {
+		if c.name == name {
+			els = append(els, c)
+		}
+	}
+	return els
+}
+
+// childText is the text of the first child named name, empty if there isn't one
+func (e *element) childText(name string) string {
+	if c := e.child(name); c != nil {
+		return c.text
+	}
+	return ""
+}
+
+func newElement(name string) *element {
+	return &element{name: name, attrs: map[string]string{}}
+}
+
+func (e *element) add(name string) *element {
+	c := newElement(name)
+	e.children = append(e.children, c)
+	return c
+}
+
+// addText adds a child holding text, empty text is left out and returns nil
+func (e *element) addText(name, text string) *element {
+	if text == "" {
+		return nil
+	}
+	c := e.add(name)
+	c.text = text
+	return c
+}
+
+func (e *element) marshal() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	enc := xml.NewEncoder(&buf)
+	enc.Indent("", "  ")
+	if err := e.encode(enc); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	buf.WriteByte('\n')
+	return buf.Bytes(), nil
+}
+
+func (e *element) encode(enc *xml.Encoder) error {
+	start := xml.StartElement{Name: xml.Name{Local: e.name}}
+	names := make([]string, 0, len(e.attrs))
+	for name := range e.attrs {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: name}, Value: e.attrs[name]})
+	}
+
+	if err := enc.EncodeToken(start); err != nil {
+		return err
+	}
+	if e.text != "" {
+		if err := enc.EncodeToken(xml.CharData(e.text)); err != nil {
+			return err
+		}
+	}
+	for _, c := range e.children {
+		if err := c.encode(enc); err != nil {
+			return err
+		}
+	}
+	return enc.EncodeToken(start.End())
+}
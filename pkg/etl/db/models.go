@@ -69,6 +69,37 @@ type EtlBlock struct {
 	BlockTime       pgtype.Timestamp `json:"block_time"`
 }
 
+type EtlDeal struct {
+	ID                   int32            `json:"id"`
+	Address              string           `json:"address"`
+	ErnAddress           string           `json:"ern_address"`
+	ReleaseReferences    []string         `json:"release_references"`
+	CommercialModelType  string           `json:"commercial_model_type"`
+	UseType              string           `json:"use_type"`
+	TerritoryCodes       []string         `json:"territory_codes"`
+	ValidityStart        pgtype.Timestamp `json:"validity_start"`
+	ValidityEnd          pgtype.Timestamp `json:"validity_end"`
+	Sender               string           `json:"sender"`
+	BlockHeight          int64            `json:"block_height"`
+	TxHash               string           `json:"tx_hash"`
+	CreatedAt            pgtype.Timestamp `json:"created_at"`
+	TakenDownBlockHeight pgtype.Int8      `json:"taken_down_block_height"`
+}
+
+type EtlFileUpload struct {
+	ID                 int32            `json:"id"`
+	UploaderAddress    string           `json:"uploader_address"`
+	Cid                string           `json:"cid"`
+	TranscodedCid      string           `json:"transcoded_cid"`
+	UploadID           string           `json:"upload_id"`
+	UploadSignature    string           `json:"upload_signature"`
+	ValidatorAddress   string           `json:"validator_address"`
+	ValidatorSignature string           `json:"validator_signature"`
+	BlockHeight        int64            `json:"block_height"`
+	TxHash             string           `json:"tx_hash"`
+	CreatedAt          pgtype.Timestamp `json:"created_at"`
+}
+
 type EtlManageEntity struct {
 	ID          int32            `json:"id"`
 	Address     string           `json:"address"`
@@ -84,6 +115,19 @@ type EtlManageEntity struct {
 	CreatedAt   pgtype.Timestamp `json:"created_at"`
 }
 
+type EtlParty struct {
+	ID             int32            `json:"id"`
+	Address        string           `json:"address"`
+	ErnAddress     string           `json:"ern_address"`
+	PartyReference string           `json:"party_reference"`
+	PartyName      string           `json:"party_name"`
+	Dpid           string           `json:"dpid"`
+	Sender         string           `json:"sender"`
+	BlockHeight    int64            `json:"block_height"`
+	TxHash         string           `json:"tx_hash"`
+	CreatedAt      pgtype.Timestamp `json:"created_at"`
+}
+
 type EtlPlay struct {
 	ID          int32            `json:"id"`
 	UserID      string           `json:"user_id"`
@@ -98,6 +142,51 @@ type EtlPlay struct {
 	RecordedAt  pgtype.Timestamp `json:"recorded_at"`
 }
 
+type EtlRelease struct {
+	ID                   int32            `json:"id"`
+	Address              string           `json:"address"`
+	ErnAddress           string           `json:"ern_address"`
+	ReleaseReference     string           `json:"release_reference"`
+	ReleaseType          string           `json:"release_type"`
+	Title                string           `json:"title"`
+	DisplayArtist        string           `json:"display_artist"`
+	Sender               string           `json:"sender"`
+	BlockHeight          int64            `json:"block_height"`
+	TxHash               string           `json:"tx_hash"`
+	CreatedAt            pgtype.Timestamp `json:"created_at"`
+	TakenDownBlockHeight pgtype.Int8      `json:"taken_down_block_height"`
+}
+
+type EtlResource struct {
+	ID                   int32            `json:"id"`
+	Address              string           `json:"address"`
+	ErnAddress           string           `json:"ern_address"`
+	ResourceReference    string           `json:"resource_reference"`
+	ResourceType         string           `json:"resource_type"`
+	Title                string           `json:"title"`
+	Isrc                 string           `json:"isrc"`
+	FileUri              string           `json:"file_uri"`
+	Sender               string           `json:"sender"`
+	BlockHeight          int64            `json:"block_height"`
+	TxHash               string           `json:"tx_hash"`
+	CreatedAt            pgtype.Timestamp `json:"created_at"`
+	TakenDownBlockHeight pgtype.Int8      `json:"taken_down_block_height"`
+}
+
+type EtlReward struct {
+	ID                 int32            `json:"id"`
+	Address            string           `json:"address"`
+	RewardID           string           `json:"reward_id"`
+	Name               string           `json:"name"`
+	Amount             int64            `json:"amount"`
+	ClaimAuthorities   []string         `json:"claim_authorities"`
+	Sender             string           `json:"sender"`
+	BlockHeight        int64            `json:"block_height"`
+	TxHash             string           `json:"tx_hash"`
+	CreatedAt          pgtype.Timestamp `json:"created_at"`
+	DeletedBlockHeight pgtype.Int8      `json:"deleted_block_height"`
+}
+
 type EtlSlaNodeReport struct {
 	ID                 int32            `json:"id"`
 	SlaRollupID        int32            `json:"sla_rollup_id"`
@@ -136,6 +136,121 @@ func (q *Queries) GetAllActiveValidatorsWithRecentRollups(ctx context.Context) (
 	return items, nil
 }
 
+const getAvailableCities = `-- name: GetAvailableCities :many
+select city, region, country, count(*) as play_count
+from etl_plays
+where city != ''
+  and ($1::text = '' or region = $1::text)
+  and ($2::text = '' or country = $2::text)
+group by city, region, country
+order by play_count desc, country, region, city
+`
+
+type GetAvailableCitiesParams struct {
+	Region  string `json:"region"`
+	Country string `json:"country"`
+}
+
+type GetAvailableCitiesRow struct {
+	City      string `json:"city"`
+	Region    string `json:"region"`
+	Country   string `json:"country"`
+	PlayCount int64  `json:"play_count"`
+}
+
+func (q *Queries) GetAvailableCities(ctx context.Context, arg GetAvailableCitiesParams) ([]GetAvailableCitiesRow, error) {
+	rows, err := q.db.Query(ctx, getAvailableCities, arg.Region, arg.Country)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetAvailableCitiesRow
+	for rows.Next() {
+		var i GetAvailableCitiesRow
+		if err := rows.Scan(
+			&i.City,
+			&i.Region,
+			&i.Country,
+			&i.PlayCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAvailableCountries = `-- name: GetAvailableCountries :many
+select country, count(*) as play_count
+from etl_plays
+where country != ''
+group by country
+order by play_count desc, country
+`
+
+type GetAvailableCountriesRow struct {
+	Country   string `json:"country"`
+	PlayCount int64  `json:"play_count"`
+}
+
+func (q *Queries) GetAvailableCountries(ctx context.Context) ([]GetAvailableCountriesRow, error) {
+	rows, err := q.db.Query(ctx, getAvailableCountries)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetAvailableCountriesRow
+	for rows.Next() {
+		var i GetAvailableCountriesRow
+		if err := rows.Scan(&i.Country, &i.PlayCount); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAvailableRegions = `-- name: GetAvailableRegions :many
+select region, country, count(*) as play_count
+from etl_plays
+where region != ''
+  and ($1::text = '' or country = $1::text)
+group by region, country
+order by play_count desc, country, region
+`
+
+type GetAvailableRegionsRow struct {
+	Region    string `json:"region"`
+	Country   string `json:"country"`
+	PlayCount int64  `json:"play_count"`
+}
+
+func (q *Queries) GetAvailableRegions(ctx context.Context, country string) ([]GetAvailableRegionsRow, error) {
+	rows, err := q.db.Query(ctx, getAvailableRegions, country)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetAvailableRegionsRow
+	for rows.Next() {
+		var i GetAvailableRegionsRow
+		if err := rows.Scan(&i.Region, &i.Country, &i.PlayCount); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getBlockByHeight = `-- name: GetBlockByHeight :one
 select id, proposer_address, block_height, block_time from etl_blocks
 where block_height = $1
@@ -215,6 +330,44 @@ func (q *Queries) GetBlockTransactionCount(ctx context.Context, blockHeight int6
 	return count, err
 }
 
+const getBlocksByBlockHeightCursor = `-- name: GetBlocksByBlockHeightCursor :many
+select id, proposer_address, block_height, block_time from etl_blocks
+where block_height > $1 or (block_height = $1 and id > $2)
+order by block_height, id
+limit $3
+`
+
+type GetBlocksByBlockHeightCursorParams struct {
+	BlockHeight int64 `json:"block_height"`
+	ID          int32 `json:"id"`
+	Limit       int32 `json:"limit"`
+}
+
+func (q *Queries) GetBlocksByBlockHeightCursor(ctx context.Context, arg GetBlocksByBlockHeightCursorParams) ([]EtlBlock, error) {
+	rows, err := q.db.Query(ctx, getBlocksByBlockHeightCursor, arg.BlockHeight, arg.ID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []EtlBlock
+	for rows.Next() {
+		var i EtlBlock
+		if err := rows.Scan(
+			&i.ID,
+			&i.ProposerAddress,
+			&i.BlockHeight,
+			&i.BlockTime,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getBlocksByPage = `-- name: GetBlocksByPage :many
 select id, proposer_address, block_height, block_time from etl_blocks
 order by block_height desc
@@ -455,6 +608,66 @@ func (q *Queries) GetManageEntitiesByBlockHeightCursor(ctx context.Context, arg
 	return items, nil
 }
 
+const getManageEntitiesFilteredCursor = `-- name: GetManageEntitiesFilteredCursor :many
+select id, address, entity_type, entity_id, action, metadata, signature, signer, nonce, block_height, tx_hash, created_at from etl_manage_entities
+where (block_height > $1::bigint or (block_height = $1::bigint and id > $2::int))
+  and ($3::text = '' or lower(address) = lower($3::text))
+  and ($4::text = '' or entity_type = $4::text)
+  and ($5::text = '' or action = $5::text)
+order by block_height, id
+limit $6
+`
+
+type GetManageEntitiesFilteredCursorParams struct {
+	BlockHeight int64  `json:"block_height"`
+	ID          int32  `json:"id"`
+	Address     string `json:"address"`
+	EntityType  string `json:"entity_type"`
+	Action      string `json:"action"`
+	PageLimit   int32  `json:"page_limit"`
+}
+
+// empty filters match any manage entity
+func (q *Queries) GetManageEntitiesFilteredCursor(ctx context.Context, arg GetManageEntitiesFilteredCursorParams) ([]EtlManageEntity, error) {
+	rows, err := q.db.Query(ctx, getManageEntitiesFilteredCursor,
+		arg.BlockHeight,
+		arg.ID,
+		arg.Address,
+		arg.EntityType,
+		arg.Action,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []EtlManageEntity
+	for rows.Next() {
+		var i EtlManageEntity
+		if err := rows.Scan(
+			&i.ID,
+			&i.Address,
+			&i.EntityType,
+			&i.EntityID,
+			&i.Action,
+			&i.Metadata,
+			&i.Signature,
+			&i.Signer,
+			&i.Nonce,
+			&i.BlockHeight,
+			&i.TxHash,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getManageEntityByTxHash = `-- name: GetManageEntityByTxHash :one
 select id, address, entity_type, entity_id, action, metadata, signature, signer, nonce, block_height, tx_hash, created_at from etl_manage_entities
 where tx_hash = $1
@@ -563,6 +776,129 @@ func (q *Queries) GetPlaysByTxHash(ctx context.Context, txHash string) ([]EtlPla
 	return items, nil
 }
 
+const getPlaysFilteredCursor = `-- name: GetPlaysFilteredCursor :many
+select id, user_id, track_id, city, region, country, played_at, block_height, tx_hash, listened_at, recorded_at from etl_plays
+where (block_height > $1::bigint or (block_height = $1::bigint and id > $2::int))
+  and ($3::text = '' or tx_hash in (
+    select tx_hash from etl_transactions where lower(address) = lower($3::text)
+  ))
+  and ($4::text = '' or user_id = $4::text)
+  and ($5::text = '' or track_id = $5::text)
+  and ($6::timestamp is null or played_at >= $6::timestamp)
+  and ($7::timestamp is null or played_at < $7::timestamp)
+  and ($8::text = '' or city = $8::text)
+  and ($9::text = '' or region = $9::text)
+  and ($10::text = '' or country = $10::text)
+order by block_height, id
+limit $11
+`
+
+type GetPlaysFilteredCursorParams struct {
+	BlockHeight  int64            `json:"block_height"`
+	ID           int32            `json:"id"`
+	Address      string           `json:"address"`
+	UserID       string           `json:"user_id"`
+	TrackID      string           `json:"track_id"`
+	PlayedAfter  pgtype.Timestamp `json:"played_after"`
+	PlayedBefore pgtype.Timestamp `json:"played_before"`
+	City         string           `json:"city"`
+	Region       string           `json:"region"`
+	Country      string           `json:"country"`
+	PageLimit    int32            `json:"page_limit"`
+}
+
+// empty filters match any play
+func (q *Queries) GetPlaysFilteredCursor(ctx context.Context, arg GetPlaysFilteredCursorParams) ([]EtlPlay, error) {
+	rows, err := q.db.Query(ctx, getPlaysFilteredCursor,
+		arg.BlockHeight,
+		arg.ID,
+		arg.Address,
+		arg.UserID,
+		arg.TrackID,
+		arg.PlayedAfter,
+		arg.PlayedBefore,
+		arg.City,
+		arg.Region,
+		arg.Country,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []EtlPlay
+	for rows.Next() {
+		var i EtlPlay
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.TrackID,
+			&i.City,
+			&i.Region,
+			&i.Country,
+			&i.PlayedAt,
+			&i.BlockHeight,
+			&i.TxHash,
+			&i.ListenedAt,
+			&i.RecordedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRegisteredValidatorsCursor = `-- name: GetRegisteredValidatorsCursor :many
+select id, address, endpoint, comet_address, node_type, spid, voting_power, status, registered_at, deregistered_at, created_at, updated_at from etl_validators
+where status = 'active'
+  and (registered_at > $1 or (registered_at = $1 and id > $2))
+order by registered_at, id
+limit $3
+`
+
+type GetRegisteredValidatorsCursorParams struct {
+	RegisteredAt int64 `json:"registered_at"`
+	ID           int32 `json:"id"`
+	Limit        int32 `json:"limit"`
+}
+
+func (q *Queries) GetRegisteredValidatorsCursor(ctx context.Context, arg GetRegisteredValidatorsCursorParams) ([]EtlValidator, error) {
+	rows, err := q.db.Query(ctx, getRegisteredValidatorsCursor, arg.RegisteredAt, arg.ID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []EtlValidator
+	for rows.Next() {
+		var i EtlValidator
+		if err := rows.Scan(
+			&i.ID,
+			&i.Address,
+			&i.Endpoint,
+			&i.CometAddress,
+			&i.NodeType,
+			&i.Spid,
+			&i.VotingPower,
+			&i.Status,
+			&i.RegisteredAt,
+			&i.DeregisteredAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getRelationTypesByAddress = `-- name: GetRelationTypesByAddress :many
 select distinct 
        case 
@@ -1199,6 +1535,58 @@ func (q *Queries) GetTransactionsByPage(ctx context.Context, arg GetTransactions
 	return items, nil
 }
 
+const getTransactionsFilteredCursor = `-- name: GetTransactionsFilteredCursor :many
+select id, tx_hash, block_height, tx_index, tx_type, address, created_at from etl_transactions
+where (block_height > $1::bigint or (block_height = $1::bigint and id > $2::int))
+  and ($3::text = '' or lower(address) = lower($3::text))
+  and ($4::text = '' or tx_type = $4::text)
+order by block_height, id
+limit $5
+`
+
+type GetTransactionsFilteredCursorParams struct {
+	BlockHeight int64  `json:"block_height"`
+	ID          int32  `json:"id"`
+	Address     string `json:"address"`
+	TxType      string `json:"tx_type"`
+	PageLimit   int32  `json:"page_limit"`
+}
+
+// empty filters match any transaction
+func (q *Queries) GetTransactionsFilteredCursor(ctx context.Context, arg GetTransactionsFilteredCursorParams) ([]EtlTransaction, error) {
+	rows, err := q.db.Query(ctx, getTransactionsFilteredCursor,
+		arg.BlockHeight,
+		arg.ID,
+		arg.Address,
+		arg.TxType,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []EtlTransaction
+	for rows.Next() {
+		var i EtlTransaction
+		if err := rows.Scan(
+			&i.ID,
+			&i.TxHash,
+			&i.BlockHeight,
+			&i.TxIndex,
+			&i.TxType,
+			&i.Address,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getValidatorByAddress = `-- name: GetValidatorByAddress :one
 select id, address, endpoint, comet_address, node_type, spid, voting_power, status, registered_at, deregistered_at, created_at, updated_at from etl_validators
 where lower(address) = lower($1) or lower(comet_address) = lower($1)
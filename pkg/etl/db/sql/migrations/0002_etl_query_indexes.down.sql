@@ -0,0 +1,11 @@
+drop index if exists etl_plays_available_cities_idx;
+drop index if exists etl_plays_available_regions_idx;
+drop index if exists etl_plays_available_countries_idx;
+drop index if exists etl_validators_registered_cursor_idx;
+drop index if exists etl_manage_entities_address_lower_idx;
+drop index if exists etl_plays_location_idx;
+drop index if exists etl_plays_played_at_idx;
+drop index if exists etl_plays_track_id_idx;
+drop index if exists etl_plays_user_id_idx;
+drop index if exists etl_transactions_address_lower_cursor_idx;
+drop index if exists etl_transactions_tx_type_cursor_idx;
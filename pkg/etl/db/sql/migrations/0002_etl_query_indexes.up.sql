@@ -0,0 +1,16 @@
+-- Indexes for the filtered ETL rpc queries
+
+create index if not exists etl_transactions_tx_type_cursor_idx on etl_transactions(tx_type, block_height, id);
+create index if not exists etl_transactions_address_lower_cursor_idx on etl_transactions(lower(address), block_height, id);
+create index if not exists etl_plays_user_id_idx on etl_plays(user_id, block_height, id);
+create index if not exists etl_plays_track_id_idx on etl_plays(track_id, block_height, id);
+create index if not exists etl_plays_played_at_idx on etl_plays(played_at);
+create index if not exists etl_plays_location_idx on etl_plays(country, region, city);
+create index if not exists etl_manage_entities_address_lower_idx on etl_manage_entities(lower(address), block_height, id);
+create index if not exists etl_validators_registered_cursor_idx on etl_validators(registered_at, id) where status = 'active';
+
+-- GetAvailableCountries, GetAvailableRegions and GetAvailableCities group every located play,
+-- these let them count from an index instead of the table
+create index if not exists etl_plays_available_countries_idx on etl_plays(country) where country != '';
+create index if not exists etl_plays_available_regions_idx on etl_plays(country, region) where region != '';
+create index if not exists etl_plays_available_cities_idx on etl_plays(country, region, city) where city != '';
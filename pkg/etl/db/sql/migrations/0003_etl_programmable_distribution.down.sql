@@ -0,0 +1,7 @@
+-- Drop programmable distribution tables, indexes go with them
+drop table if exists etl_file_uploads;
+drop table if exists etl_rewards;
+drop table if exists etl_deals;
+drop table if exists etl_parties;
+drop table if exists etl_resources;
+drop table if exists etl_releases;
@@ -0,0 +1,119 @@
+-- Tables for programmable distribution transactions
+
+create table if not exists etl_releases(
+  id serial primary key,
+  address text not null,
+  ern_address text not null,
+  release_reference text not null,
+  release_type text not null,
+  title text not null,
+  display_artist text not null,
+  sender text not null,
+  block_height bigint not null,
+  tx_hash text not null,
+  created_at timestamp not null,
+  taken_down_block_height bigint
+);
+
+create table if not exists etl_resources(
+  id serial primary key,
+  address text not null,
+  ern_address text not null,
+  resource_reference text not null,
+  resource_type text not null,
+  title text not null,
+  isrc text not null,
+  file_uri text not null,
+  sender text not null,
+  block_height bigint not null,
+  tx_hash text not null,
+  created_at timestamp not null,
+  taken_down_block_height bigint
+);
+
+create table if not exists etl_parties(
+  id serial primary key,
+  address text not null,
+  ern_address text not null,
+  party_reference text not null,
+  party_name text not null,
+  dpid text not null,
+  sender text not null,
+  block_height bigint not null,
+  tx_hash text not null,
+  created_at timestamp not null
+);
+
+create table if not exists etl_deals(
+  id serial primary key,
+  address text not null,
+  ern_address text not null,
+  release_references text[] not null,
+  commercial_model_type text not null,
+  use_type text not null,
+  territory_codes text[] not null,
+  validity_start timestamp,
+  validity_end timestamp,
+  sender text not null,
+  block_height bigint not null,
+  tx_hash text not null,
+  created_at timestamp not null,
+  taken_down_block_height bigint
+);
+
+create table if not exists etl_rewards(
+  id serial primary key,
+  address text not null,
+  reward_id text not null,
+  name text not null,
+  amount bigint not null,
+  claim_authorities text[] not null,
+  sender text not null,
+  block_height bigint not null,
+  tx_hash text not null,
+  created_at timestamp not null,
+  deleted_block_height bigint
+);
+
+create table if not exists etl_file_uploads(
+  id serial primary key,
+  uploader_address text not null,
+  cid text not null,
+  transcoded_cid text not null,
+  upload_id text not null,
+  upload_signature text not null,
+  validator_address text not null,
+  validator_signature text not null,
+  block_height bigint not null,
+  tx_hash text not null,
+  created_at timestamp not null
+);
+
+-- Cursor pagination composite indexes (block_height, id) for efficient pagination
+create index if not exists etl_releases_cursor_idx on etl_releases(block_height, id);
+create index if not exists etl_resources_cursor_idx on etl_resources(block_height, id);
+create index if not exists etl_parties_cursor_idx on etl_parties(block_height, id);
+create index if not exists etl_deals_cursor_idx on etl_deals(block_height, id);
+create index if not exists etl_rewards_cursor_idx on etl_rewards(block_height, id);
+create index if not exists etl_file_uploads_cursor_idx on etl_file_uploads(block_height, id);
+
+-- Address lookups, takedowns and deletes update rows by address
+create index if not exists etl_releases_address_idx on etl_releases(address);
+create index if not exists etl_releases_ern_address_idx on etl_releases(ern_address);
+create index if not exists etl_resources_address_idx on etl_resources(address);
+create index if not exists etl_resources_ern_address_idx on etl_resources(ern_address);
+create index if not exists etl_parties_address_idx on etl_parties(address);
+create index if not exists etl_parties_ern_address_idx on etl_parties(ern_address);
+create index if not exists etl_deals_address_idx on etl_deals(address);
+create index if not exists etl_deals_ern_address_idx on etl_deals(ern_address);
+create index if not exists etl_rewards_address_idx on etl_rewards(address);
+create index if not exists etl_file_uploads_cid_idx on etl_file_uploads(cid);
+create index if not exists etl_file_uploads_uploader_address_lower_idx on etl_file_uploads(lower(uploader_address));
+
+-- Hash lookup indexes
+create index if not exists etl_releases_tx_hash_idx on etl_releases(tx_hash);
+create index if not exists etl_resources_tx_hash_idx on etl_resources(tx_hash);
+create index if not exists etl_parties_tx_hash_idx on etl_parties(tx_hash);
+create index if not exists etl_deals_tx_hash_idx on etl_deals(tx_hash);
+create index if not exists etl_rewards_tx_hash_idx on etl_rewards(tx_hash);
+create index if not exists etl_file_uploads_tx_hash_idx on etl_file_uploads(tx_hash);
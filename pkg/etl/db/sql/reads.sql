@@ -68,6 +68,79 @@ where block_height > $1 or (block_height = $1 and id > $2)
 order by block_height, id
 limit $3;
 
+-- name: GetBlocksByBlockHeightCursor :many
+select * from etl_blocks
+where block_height > $1 or (block_height = $1 and id > $2)
+order by block_height, id
+limit $3;
+
+-- name: GetTransactionsFilteredCursor :many
+-- empty filters match any transaction
+select * from etl_transactions
+where (block_height > @block_height::bigint or (block_height = @block_height::bigint and id > @id::int))
+  and (@address::text = '' or lower(address) = lower(@address::text))
+  and (@tx_type::text = '' or tx_type = @tx_type::text)
+order by block_height, id
+limit @page_limit;
+
+-- name: GetPlaysFilteredCursor :many
+-- empty filters match any play
+select * from etl_plays
+where (block_height > @block_height::bigint or (block_height = @block_height::bigint and id > @id::int))
+  and (@address::text = '' or tx_hash in (
+    select tx_hash from etl_transactions where lower(address) = lower(@address::text)
+  ))
+  and (@user_id::text = '' or user_id = @user_id::text)
+  and (@track_id::text = '' or track_id = @track_id::text)
+  and (sqlc.narg(played_after)::timestamp is null or played_at >= sqlc.narg(played_after)::timestamp)
+  and (sqlc.narg(played_before)::timestamp is null or played_at < sqlc.narg(played_before)::timestamp)
+  and (@city::text = '' or city = @city::text)
+  and (@region::text = '' or region = @region::text)
+  and (@country::text = '' or country = @country::text)
+order by block_height, id
+limit @page_limit;
+
+-- name: GetManageEntitiesFilteredCursor :many
+-- empty filters match any manage entity
+select * from etl_manage_entities
+where (block_height > @block_height::bigint or (block_height = @block_height::bigint and id > @id::int))
+  and (@address::text = '' or lower(address) = lower(@address::text))
+  and (@entity_type::text = '' or entity_type = @entity_type::text)
+  and (@action::text = '' or action = @action::text)
+order by block_height, id
+limit @page_limit;
+
+-- name: GetRegisteredValidatorsCursor :many
+select * from etl_validators
+where status = 'active'
+  and (registered_at > $1 or (registered_at = $1 and id > $2))
+order by registered_at, id
+limit $3;
+
+-- name: GetAvailableCountries :many
+select country, count(*) as play_count
+from etl_plays
+where country != ''
+group by country
+order by play_count desc, country;
+
+-- name: GetAvailableRegions :many
+select region, country, count(*) as play_count
+from etl_plays
+where region != ''
+  and (@country::text = '' or country = @country::text)
+group by region, country
+order by play_count desc, country, region;
+
+-- name: GetAvailableCities :many
+select city, region, country, count(*) as play_count
+from etl_plays
+where city != ''
+  and (@region::text = '' or region = @region::text)
+  and (@country::text = '' or country = @country::text)
+group by city, region, country
+order by play_count desc, country, region, city;
+
 -- Transaction content queries by hash
 -- name: GetPlaysByTxHash :many
 select * from etl_plays
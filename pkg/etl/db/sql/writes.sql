@@ -63,3 +63,56 @@ on conflict (endpoint) do nothing;
 
 -- name: DeregisterValidator :exec
 update etl_validators set deregistered_at = $1, updated_at = $2, status = $3 where comet_address = $4;
+
+-- name: InsertRelease :exec
+insert into etl_releases (address, ern_address, release_reference, release_type, title, display_artist, sender, block_height, tx_hash, created_at)
+values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10);
+
+-- name: InsertResource :exec
+insert into etl_resources (address, ern_address, resource_reference, resource_type, title, isrc, file_uri, sender, block_height, tx_hash, created_at)
+values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11);
+
+-- name: InsertParty :exec
+insert into etl_parties (address, ern_address, party_reference, party_name, dpid, sender, block_height, tx_hash, created_at)
+values ($1, $2, $3, $4, $5, $6, $7, $8, $9);
+
+-- name: InsertDeal :exec
+insert into etl_deals (address, ern_address, release_references, commercial_model_type, use_type, territory_codes, validity_start, validity_end, sender, block_height, tx_hash, created_at)
+values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12);
+
+-- ERN updates rewrite the entities they keep, rows carry the version that last wrote them
+-- name: UpdateRelease :execrows
+update etl_releases set release_reference = $2, release_type = $3, title = $4, display_artist = $5, block_height = $6, tx_hash = $7
+where address = $1;
+
+-- name: UpdateResource :execrows
+update etl_resources set resource_reference = $2, resource_type = $3, title = $4, isrc = $5, file_uri = $6, block_height = $7, tx_hash = $8
+where address = $1;
+
+-- name: UpdateParty :execrows
+update etl_parties set party_reference = $2, party_name = $3, dpid = $4, block_height = $5, tx_hash = $6
+where address = $1;
+
+-- name: UpdateDeal :execrows
+update etl_deals set release_references = $2, commercial_model_type = $3, use_type = $4, territory_codes = $5, validity_start = $6, validity_end = $7, block_height = $8, tx_hash = $9
+where address = $1;
+
+-- name: TakedownReleases :exec
+update etl_releases set taken_down_block_height = $1 where address = any($2::text[]) and taken_down_block_height is null;
+
+-- name: TakedownResources :exec
+update etl_resources set taken_down_block_height = $1 where address = any($2::text[]) and taken_down_block_height is null;
+
+-- name: TakedownDeals :exec
+update etl_deals set taken_down_block_height = $1 where address = any($2::text[]) and taken_down_block_height is null;
+
+-- name: InsertReward :exec
+insert into etl_rewards (address, reward_id, name, amount, claim_authorities, sender, block_height, tx_hash, created_at)
+values ($1, $2, $3, $4, $5, $6, $7, $8, $9);
+
+-- name: DeleteReward :exec
+update etl_rewards set deleted_block_height = $1 where address = $2 and deleted_block_height is null;
+
+-- name: InsertFileUpload :exec
+insert into etl_file_uploads (uploader_address, cid, transcoded_cid, upload_id, upload_signature, validator_address, validator_signature, block_height, tx_hash, created_at)
+values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10);
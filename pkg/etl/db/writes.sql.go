@@ -11,6 +11,20 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const deleteReward = `-- name: DeleteReward :exec
+update etl_rewards set deleted_block_height = $1 where address = $2 and deleted_block_height is null
+`
+
+type DeleteRewardParams struct {
+	DeletedBlockHeight pgtype.Int8 `json:"deleted_block_height"`
+	Address            string      `json:"address"`
+}
+
+func (q *Queries) DeleteReward(ctx context.Context, arg DeleteRewardParams) error {
+	_, err := q.db.Exec(ctx, deleteReward, arg.DeletedBlockHeight, arg.Address)
+	return err
+}
+
 const deregisterValidator = `-- name: DeregisterValidator :exec
 update etl_validators set deregistered_at = $1, updated_at = $2, status = $3 where comet_address = $4
 `
@@ -71,6 +85,78 @@ func (q *Queries) InsertBlock(ctx context.Context, arg InsertBlockParams) error
 	return err
 }
 
+const insertDeal = `-- name: InsertDeal :exec
+insert into etl_deals (address, ern_address, release_references, commercial_model_type, use_type, territory_codes, validity_start, validity_end, sender, block_height, tx_hash, created_at)
+values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+`
+
+type InsertDealParams struct {
+	Address             string           `json:"address"`
+	ErnAddress          string           `json:"ern_address"`
+	ReleaseReferences   []string         `json:"release_references"`
+	CommercialModelType string           `json:"commercial_model_type"`
+	UseType             string           `json:"use_type"`
+	TerritoryCodes      []string         `json:"territory_codes"`
+	ValidityStart       pgtype.Timestamp `json:"validity_start"`
+	ValidityEnd         pgtype.Timestamp `json:"validity_end"`
+	Sender              string           `json:"sender"`
+	BlockHeight         int64            `json:"block_height"`
+	TxHash              string           `json:"tx_hash"`
+	CreatedAt           pgtype.Timestamp `json:"created_at"`
+}
+
+func (q *Queries) InsertDeal(ctx context.Context, arg InsertDealParams) error {
+	_, err := q.db.Exec(ctx, insertDeal,
+		arg.Address,
+		arg.ErnAddress,
+		arg.ReleaseReferences,
+		arg.CommercialModelType,
+		arg.UseType,
+		arg.TerritoryCodes,
+		arg.ValidityStart,
+		arg.ValidityEnd,
+		arg.Sender,
+		arg.BlockHeight,
+		arg.TxHash,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const insertFileUpload = `-- name: InsertFileUpload :exec
+insert into etl_file_uploads (uploader_address, cid, transcoded_cid, upload_id, upload_signature, validator_address, validator_signature, block_height, tx_hash, created_at)
+values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+`
+
+type InsertFileUploadParams struct {
+	UploaderAddress    string           `json:"uploader_address"`
+	Cid                string           `json:"cid"`
+	TranscodedCid      string           `json:"transcoded_cid"`
+	UploadID           string           `json:"upload_id"`
+	UploadSignature    string           `json:"upload_signature"`
+	ValidatorAddress   string           `json:"validator_address"`
+	ValidatorSignature string           `json:"validator_signature"`
+	BlockHeight        int64            `json:"block_height"`
+	TxHash             string           `json:"tx_hash"`
+	CreatedAt          pgtype.Timestamp `json:"created_at"`
+}
+
+func (q *Queries) InsertFileUpload(ctx context.Context, arg InsertFileUploadParams) error {
+	_, err := q.db.Exec(ctx, insertFileUpload,
+		arg.UploaderAddress,
+		arg.Cid,
+		arg.TranscodedCid,
+		arg.UploadID,
+		arg.UploadSignature,
+		arg.ValidatorAddress,
+		arg.ValidatorSignature,
+		arg.BlockHeight,
+		arg.TxHash,
+		arg.CreatedAt,
+	)
+	return err
+}
+
 const insertManageEntity = `-- name: InsertManageEntity :exec
 insert into etl_manage_entities (address, entity_type, entity_id, action, metadata, signature, signer, nonce, block_height, tx_hash, created_at)
 values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
@@ -107,6 +193,38 @@ func (q *Queries) InsertManageEntity(ctx context.Context, arg InsertManageEntity
 	return err
 }
 
+const insertParty = `-- name: InsertParty :exec
+insert into etl_parties (address, ern_address, party_reference, party_name, dpid, sender, block_height, tx_hash, created_at)
+values ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+`
+
+type InsertPartyParams struct {
+	Address        string           `json:"address"`
+	ErnAddress     string           `json:"ern_address"`
+	PartyReference string           `json:"party_reference"`
+	PartyName      string           `json:"party_name"`
+	Dpid           string           `json:"dpid"`
+	Sender         string           `json:"sender"`
+	BlockHeight    int64            `json:"block_height"`
+	TxHash         string           `json:"tx_hash"`
+	CreatedAt      pgtype.Timestamp `json:"created_at"`
+}
+
+func (q *Queries) InsertParty(ctx context.Context, arg InsertPartyParams) error {
+	_, err := q.db.Exec(ctx, insertParty,
+		arg.Address,
+		arg.ErnAddress,
+		arg.PartyReference,
+		arg.PartyName,
+		arg.Dpid,
+		arg.Sender,
+		arg.BlockHeight,
+		arg.TxHash,
+		arg.CreatedAt,
+	)
+	return err
+}
+
 const insertPlay = `-- name: InsertPlay :exec
 insert into etl_plays (user_id, track_id, city, region, country, played_at, block_height, tx_hash, listened_at, recorded_at)
 values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
@@ -175,6 +293,108 @@ func (q *Queries) InsertPlays(ctx context.Context, arg InsertPlaysParams) error
 	return err
 }
 
+const insertRelease = `-- name: InsertRelease :exec
+insert into etl_releases (address, ern_address, release_reference, release_type, title, display_artist, sender, block_height, tx_hash, created_at)
+values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+`
+
+type InsertReleaseParams struct {
+	Address          string           `json:"address"`
+	ErnAddress       string           `json:"ern_address"`
+	ReleaseReference string           `json:"release_reference"`
+	ReleaseType      string           `json:"release_type"`
+	Title            string           `json:"title"`
+	DisplayArtist    string           `json:"display_artist"`
+	Sender           string           `json:"sender"`
+	BlockHeight      int64            `json:"block_height"`
+	TxHash           string           `json:"tx_hash"`
+	CreatedAt        pgtype.Timestamp `json:"created_at"`
+}
+
+func (q *Queries) InsertRelease(ctx context.Context, arg InsertReleaseParams) error {
+	_, err := q.db.Exec(ctx, insertRelease,
+		arg.Address,
+		arg.ErnAddress,
+		arg.ReleaseReference,
+		arg.ReleaseType,
+		arg.Title,
+		arg.DisplayArtist,
+		arg.Sender,
+		arg.BlockHeight,
+		arg.TxHash,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const insertResource = `-- name: InsertResource :exec
+insert into etl_resources (address, ern_address, resource_reference, resource_type, title, isrc, file_uri, sender, block_height, tx_hash, created_at)
+values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+`
+
+type InsertResourceParams struct {
+	Address           string           `json:"address"`
+	ErnAddress        string           `json:"ern_address"`
+	ResourceReference string           `json:"resource_reference"`
+	ResourceType      string           `json:"resource_type"`
+	Title             string           `json:"title"`
+	Isrc              string           `json:"isrc"`
+	FileUri           string           `json:"file_uri"`
+	Sender            string           `json:"sender"`
+	BlockHeight       int64            `json:"block_height"`
+	TxHash            string           `json:"tx_hash"`
+	CreatedAt         pgtype.Timestamp `json:"created_at"`
+}
+
+func (q *Queries) InsertResource(ctx context.Context, arg InsertResourceParams) error {
+	_, err := q.db.Exec(ctx, insertResource,
+		arg.Address,
+		arg.ErnAddress,
+		arg.ResourceReference,
+		arg.ResourceType,
+		arg.Title,
+		arg.Isrc,
+		arg.FileUri,
+		arg.Sender,
+		arg.BlockHeight,
+		arg.TxHash,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const insertReward = `-- name: InsertReward :exec
+insert into etl_rewards (address, reward_id, name, amount, claim_authorities, sender, block_height, tx_hash, created_at)
+values ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+`
+
+type InsertRewardParams struct {
+	Address          string           `json:"address"`
+	RewardID         string           `json:"reward_id"`
+	Name             string           `json:"name"`
+	Amount           int64            `json:"amount"`
+	ClaimAuthorities []string         `json:"claim_authorities"`
+	Sender           string           `json:"sender"`
+	BlockHeight      int64            `json:"block_height"`
+	TxHash           string           `json:"tx_hash"`
+	CreatedAt        pgtype.Timestamp `json:"created_at"`
+}
+
+func (q *Queries) InsertReward(ctx context.Context, arg InsertRewardParams) error {
+	_, err := q.db.Exec(ctx, insertReward,
+		arg.Address,
+		arg.RewardID,
+		arg.Name,
+		arg.Amount,
+		arg.ClaimAuthorities,
+		arg.Sender,
+		arg.BlockHeight,
+		arg.TxHash,
+		arg.CreatedAt,
+	)
+	return err
+}
+
 const insertSlaNodeReport = `-- name: InsertSlaNodeReport :exec
 insert into etl_sla_node_reports (sla_rollup_id, address, num_blocks_proposed, challenges_received, challenges_failed, block_height, tx_hash, created_at)
 values ($1, $2, $3, $4, $5, $6, $7, $8)
@@ -472,3 +692,174 @@ func (q *Queries) RegisterValidator(ctx context.Context, arg RegisterValidatorPa
 	)
 	return err
 }
+
+const takedownDeals = `-- name: TakedownDeals :exec
+update etl_deals set taken_down_block_height = $1 where address = any($2::text[]) and taken_down_block_height is null
+`
+
+type TakedownDealsParams struct {
+	TakenDownBlockHeight pgtype.Int8 `json:"taken_down_block_height"`
+	Column2              []string    `json:"column_2"`
+}
+
+func (q *Queries) TakedownDeals(ctx context.Context, arg TakedownDealsParams) error {
+	_, err := q.db.Exec(ctx, takedownDeals, arg.TakenDownBlockHeight, arg.Column2)
+	return err
+}
+
+const takedownReleases = `-- name: TakedownReleases :exec
+update etl_releases set taken_down_block_height = $1 where address = any($2::text[]) and taken_down_block_height is null
+`
+
+type TakedownReleasesParams struct {
+	TakenDownBlockHeight pgtype.Int8 `json:"taken_down_block_height"`
+	Column2              []string    `json:"column_2"`
+}
+
+func (q *Queries) TakedownReleases(ctx context.Context, arg TakedownReleasesParams) error {
+	_, err := q.db.Exec(ctx, takedownReleases, arg.TakenDownBlockHeight, arg.Column2)
+	return err
+}
+
+const takedownResources = `-- name: TakedownResources :exec
+update etl_resources set taken_down_block_height = $1 where address = any($2::text[]) and taken_down_block_height is null
+`
+
+type TakedownResourcesParams struct {
+	TakenDownBlockHeight pgtype.Int8 `json:"taken_down_block_height"`
+	Column2              []string    `json:"column_2"`
+}
+
+func (q *Queries) TakedownResources(ctx context.Context, arg TakedownResourcesParams) error {
+	_, err := q.db.Exec(ctx, takedownResources, arg.TakenDownBlockHeight, arg.Column2)
+	return err
+}
+
+const updateDeal = `-- name: UpdateDeal :execrows
+update etl_deals set release_references = $2, commercial_model_type = $3, use_type = $4, territory_codes = $5, validity_start = $6, validity_end = $7, block_height = $8, tx_hash = $9
+where address = $1
+`
+
+type UpdateDealParams struct {
+	Address             string           `json:"address"`
+	ReleaseReferences   []string         `json:"release_references"`
+	CommercialModelType string           `json:"commercial_model_type"`
+	UseType             string           `json:"use_type"`
+	TerritoryCodes      []string         `json:"territory_codes"`
+	ValidityStart       pgtype.Timestamp `json:"validity_start"`
+	ValidityEnd         pgtype.Timestamp `json:"validity_end"`
+	BlockHeight         int64            `json:"block_height"`
+	TxHash              string           `json:"tx_hash"`
+}
+
+func (q *Queries) UpdateDeal(ctx context.Context, arg UpdateDealParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateDeal,
+		arg.Address,
+		arg.ReleaseReferences,
+		arg.CommercialModelType,
+		arg.UseType,
+		arg.TerritoryCodes,
+		arg.ValidityStart,
+		arg.ValidityEnd,
+		arg.BlockHeight,
+		arg.TxHash,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateParty = `-- name: UpdateParty :execrows
+update etl_parties set party_reference = $2, party_name = $3, dpid = $4, block_height = $5, tx_hash = $6
+where address = $1
+`
+
+type UpdatePartyParams struct {
+	Address        string `json:"address"`
+	PartyReference string `json:"party_reference"`
+	PartyName      string `json:"party_name"`
+	Dpid           string `json:"dpid"`
+	BlockHeight    int64  `json:"block_height"`
+	TxHash         string `json:"tx_hash"`
+}
+
+func (q *Queries) UpdateParty(ctx context.Context, arg UpdatePartyParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateParty,
+		arg.Address,
+		arg.PartyReference,
+		arg.PartyName,
+		arg.Dpid,
+		arg.BlockHeight,
+		arg.TxHash,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateRelease = `-- name: UpdateRelease :execrows
+update etl_releases set release_reference = $2, release_type = $3, title = $4, display_artist = $5, block_height = $6, tx_hash = $7
+where address = $1
+`
+
+type UpdateReleaseParams struct {
+	Address          string `json:"address"`
+	ReleaseReference string `json:"release_reference"`
+	ReleaseType      string `json:"release_type"`
+	Title            string `json:"title"`
+	DisplayArtist    string `json:"display_artist"`
+	BlockHeight      int64  `json:"block_height"`
+	TxHash           string `json:"tx_hash"`
+}
+
+// ERN updates rewrite the entities they keep, rows carry the version that last wrote them
+func (q *Queries) UpdateRelease(ctx context.Context, arg UpdateReleaseParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateRelease,
+		arg.Address,
+		arg.ReleaseReference,
+		arg.ReleaseType,
+		arg.Title,
+		arg.DisplayArtist,
+		arg.BlockHeight,
+		arg.TxHash,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateResource = `-- name: UpdateResource :execrows
+update etl_resources set resource_reference = $2, resource_type = $3, title = $4, isrc = $5, file_uri = $6, block_height = $7, tx_hash = $8
+where address = $1
+`
+
+type UpdateResourceParams struct {
+	Address           string `json:"address"`
+	ResourceReference string `json:"resource_reference"`
+	ResourceType      string `json:"resource_type"`
+	Title             string `json:"title"`
+	Isrc              string `json:"isrc"`
+	FileUri           string `json:"file_uri"`
+	BlockHeight       int64  `json:"block_height"`
+	TxHash            string `json:"tx_hash"`
+}
+
+func (q *Queries) UpdateResource(ctx context.Context, arg UpdateResourceParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateResource,
+		arg.Address,
+		arg.ResourceReference,
+		arg.ResourceType,
+		arg.Title,
+		arg.Isrc,
+		arg.FileUri,
+		arg.BlockHeight,
+		arg.TxHash,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
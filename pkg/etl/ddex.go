@@ -0,0 +1,316 @@
+package etl
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	corev1 "github.com/AudiusProject/audiusd/pkg/api/core/v1"
+	corev1beta1 "github.com/AudiusProject/audiusd/pkg/api/core/v1beta1"
+	ddexv1beta1 "github.com/AudiusProject/audiusd/pkg/api/ddex/v1beta1"
+	"github.com/AudiusProject/audiusd/pkg/common"
+	"github.com/AudiusProject/audiusd/pkg/etl/db"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// getTxTypeV2 names a v1beta1 transaction after the kind of messages in its envelope,
+// envelopes that mix message kinds are indexed as ddex
+func getTxTypeV2(txv2 *corev1beta1.Transaction) string {
+	txType := ""
+	for _, msg := range txv2.GetEnvelope().GetMessages() {
+		msgType := ""
+		switch msg.GetMessage().(type) {
+		case *corev1beta1.Message_Ern:
+			msgType = TxTypeERN
+		case *corev1beta1.Message_Mead:
+			msgType = TxTypeMEAD
+		case *corev1beta1.Message_Pie:
+			msgType = TxTypePIE
+		case *corev1beta1.Message_Delegation:
+			msgType = TxTypeDelegation
+		}
+		if txType != "" && txType != msgType {
+			return TxTypeDDEX
+		}
+		txType = msgType
+	}
+	if txType == "" {
+		return TxTypeDDEX
+	}
+	return txType
+}
+
+// indexTransactionV2 writes the entities created by a v1beta1 transaction, the addresses
+// come from the receipt core attaches so they match what was finalized on chain
+func (etl *ETLService) indexTransactionV2(ctx context.Context, block *corev1.Block, tx *corev1.Transaction) {
+	txv2 := tx.GetTransactionv2()
+	sender := txv2.GetEnvelope().GetHeader().GetFrom()
+	receipts := tx.GetTransactionReceipt().GetMessageReceipts()
+
+	for i, msg := range txv2.GetEnvelope().GetMessages() {
+		ern := msg.GetErn()
+		if ern == nil {
+			// MEAD, PIE and delegation messages are only recorded as transactions
+			continue
+		}
+
+		var ack *ddexv1beta1.NewReleaseMessageAck
+		if i < len(receipts) {
+			ack = receipts[i].GetErnAck()
+		}
+		if ack == nil {
+			etl.logger.Error("missing ERN receipt", zap.String("tx", tx.Hash), zap.Int("index", i))
+			continue
+		}
+
+		switch ern.GetMessageHeader().GetMessageControlType() {
+		case ddexv1beta1.MessageControlType_MESSAGE_CONTROL_TYPE_NEW_MESSAGE, ddexv1beta1.MessageControlType_MESSAGE_CONTROL_TYPE_TEST_MESSAGE:
+			etl.indexERNMessage(ctx, block, tx, sender, ern, ack, false)
+		case ddexv1beta1.MessageControlType_MESSAGE_CONTROL_TYPE_UPDATED_MESSAGE:
+			etl.indexERNMessage(ctx, block, tx, sender, ern, ack, true)
+		case ddexv1beta1.MessageControlType_MESSAGE_CONTROL_TYPE_TAKEDOWN_MESSAGE:
+			etl.indexERNTakedownMessage(ctx, block, tx, ack)
+		}
+	}
+}
+
+// indexERNMessage writes the entities of a new or updated ERN. An update's acknowledgment
+// keeps the address of every entity carried over from the previous version, those rows are
+// rewritten and only entities new to the update are inserted.
+func (etl *ETLService) indexERNMessage(ctx context.Context, block *corev1.Block, tx *corev1.Transaction, sender string, ern *ddexv1beta1.NewReleaseMessage, ack *ddexv1beta1.NewReleaseMessageAck, update bool) {
+	createdAt := pgtype.Timestamp{Time: block.Timestamp.AsTime(), Valid: true}
+
+	for i, party := range ern.GetPartyList() {
+		if i >= len(ack.PartyAddresses) {
+			break
+		}
+		params := db.InsertPartyParams{
+			Address:        ack.PartyAddresses[i],
+			ErnAddress:     ack.ErnAddress,
+			PartyReference: party.GetPartyReference(),
+			PartyName:      party.GetPartyName().GetFullName(),
+			Dpid:           party.GetPartyId().GetDpid(),
+			Sender:         sender,
+			BlockHeight:    block.Height,
+			TxHash:         tx.Hash,
+			CreatedAt:      createdAt,
+		}
+		if update {
+			updated, err := etl.db.UpdateParty(ctx, db.UpdatePartyParams{
+				Address:        params.Address,
+				PartyReference: params.PartyReference,
+				PartyName:      params.PartyName,
+				Dpid:           params.Dpid,
+				BlockHeight:    params.BlockHeight,
+				TxHash:         params.TxHash,
+			})
+			if err != nil {
+				etl.logger.Error("error updating party", zap.String("address", params.Address), zap.Error(err))
+				continue
+			}
+			if updated > 0 {
+				continue
+			}
+		}
+		if err := etl.db.InsertParty(ctx, params); err != nil {
+			etl.logger.Error("error inserting party", zap.String("address", params.Address), zap.Error(err))
+		}
+	}
+
+	for i, resource := range ern.GetResourceList() {
+		if i >= len(ack.ResourceAddresses) {
+			break
+		}
+		params := db.InsertResourceParams{
+			Address:     ack.ResourceAddresses[i],
+			ErnAddress:  ack.ErnAddress,
+			Sender:      sender,
+			BlockHeight: block.Height,
+			TxHash:      tx.Hash,
+			CreatedAt:   createdAt,
+		}
+		if sr := resource.GetSoundRecording(); sr != nil {
+			sre := sr.GetSoundRecordingEdition()
+			params.ResourceReference = sr.GetResourceReference()
+			params.ResourceType = sr.GetType()
+			params.Title = sr.GetDisplayTitleText()
+			params.Isrc = sre.GetResourceId().GetIsrc()
+			params.FileUri = sre.GetTechnicalDetails().GetDeliveryFile().GetFile().GetUri()
+		} else if img := resource.GetImage(); img != nil {
+			params.ResourceReference = img.GetResourceReference()
+			params.ResourceType = img.GetType()
+			params.Isrc = img.GetResourceId().GetIsrc()
+			params.FileUri = img.GetTechnicalDetails().GetFile().GetUri()
+		}
+		if update {
+			updated, err := etl.db.UpdateResource(ctx, db.UpdateResourceParams{
+				Address:           params.Address,
+				ResourceReference: params.ResourceReference,
+				ResourceType:      params.ResourceType,
+				Title:             params.Title,
+				Isrc:              params.Isrc,
+				FileUri:           params.FileUri,
+				BlockHeight:       params.BlockHeight,
+				TxHash:            params.TxHash,
+			})
+			if err != nil {
+				etl.logger.Error("error updating resource", zap.String("address", params.Address), zap.Error(err))
+				continue
+			}
+			if updated > 0 {
+				continue
+			}
+		}
+		if err := etl.db.InsertResource(ctx, params); err != nil {
+			etl.logger.Error("error inserting resource", zap.String("address", params.Address), zap.Error(err))
+		}
+	}
+
+	for i, release := range ern.GetReleaseList() {
+		if i >= len(ack.ReleaseAddresses) {
+			break
+		}
+		params := db.InsertReleaseParams{
+			Address:     ack.ReleaseAddresses[i],
+			ErnAddress:  ack.ErnAddress,
+			Sender:      sender,
+			BlockHeight: block.Height,
+			TxHash:      tx.Hash,
+			CreatedAt:   createdAt,
+		}
+		if mr := release.GetMainRelease(); mr != nil {
+			params.ReleaseReference = mr.GetReleaseReference()
+			params.ReleaseType = mr.GetReleaseType()
+			params.Title = mr.GetDisplayTitleText()
+			params.DisplayArtist = mr.GetDisplayArtistName()
+		} else if tr := release.GetTrackRelease(); tr != nil {
+			params.ReleaseReference = tr.GetReleaseReference()
+			params.ReleaseType = "TrackRelease"
+		}
+		if update {
+			updated, err := etl.db.UpdateRelease(ctx, db.UpdateReleaseParams{
+				Address:          params.Address,
+				ReleaseReference: params.ReleaseReference,
+				ReleaseType:      params.ReleaseType,
+				Title:            params.Title,
+				DisplayArtist:    params.DisplayArtist,
+				BlockHeight:      params.BlockHeight,
+				TxHash:           params.TxHash,
+			})
+			if err != nil {
+				etl.logger.Error("error updating release", zap.String("address", params.Address), zap.Error(err))
+				continue
+			}
+			if updated > 0 {
+				continue
+			}
+		}
+		if err := etl.db.InsertRelease(ctx, params); err != nil {
+			etl.logger.Error("error inserting release", zap.String("address", params.Address), zap.Error(err))
+		}
+	}
+
+	for i, deal := range ern.GetDealList() {
+		if i >= len(ack.DealAddresses) {
+			break
+		}
+		rd := deal.GetReleaseDeal()
+		terms := rd.GetDeal().GetDealTerms()
+		params := db.InsertDealParams{
+			Address:             ack.DealAddresses[i],
+			ErnAddress:          ack.ErnAddress,
+			ReleaseReferences:   nonNilStrings(rd.GetDealReleaseReference()),
+			CommercialModelType: terms.GetCommercialModelType(),
+			UseType:             terms.GetUseType(),
+			TerritoryCodes:      nonNilStrings(terms.GetTerritoryCode()),
+			ValidityStart:       toPgTimestamp(terms.GetValidityPeriod().GetStartDateTime()),
+			ValidityEnd:         toPgTimestamp(terms.GetValidityPeriod().GetEndDateTime()),
+			Sender:              sender,
+			BlockHeight:         block.Height,
+			TxHash:              tx.Hash,
+			CreatedAt:           createdAt,
+		}
+		if update {
+			updated, err := etl.db.UpdateDeal(ctx, db.UpdateDealParams{
+				Address:             params.Address,
+				ReleaseReferences:   params.ReleaseReferences,
+				CommercialModelType: params.CommercialModelType,
+				UseType:             params.UseType,
+				TerritoryCodes:      params.TerritoryCodes,
+				ValidityStart:       params.ValidityStart,
+				ValidityEnd:         params.ValidityEnd,
+				BlockHeight:         params.BlockHeight,
+				TxHash:              params.TxHash,
+			})
+			if err != nil {
+				etl.logger.Error("error updating deal", zap.String("address", params.Address), zap.Error(err))
+				continue
+			}
+			if updated > 0 {
+				continue
+			}
+		}
+		if err := etl.db.InsertDeal(ctx, params); err != nil {
+			etl.logger.Error("error inserting deal", zap.String("address", params.Address), zap.Error(err))
+		}
+	}
+}
+
+// indexERNTakedownMessage marks everything listed in the takedown acknowledgment as taken down
+func (etl *ETLService) indexERNTakedownMessage(ctx context.Context, block *corev1.Block, tx *corev1.Transaction, ack *ddexv1beta1.NewReleaseMessageAck) {
+	height := pgtype.Int8{Int64: block.Height, Valid: true}
+
+	if err := etl.db.TakedownReleases(ctx, db.TakedownReleasesParams{TakenDownBlockHeight: height, Column2: ack.ReleaseAddresses}); err != nil {
+		etl.logger.Error("error taking down releases", zap.String("tx", tx.Hash), zap.Error(err))
+	}
+	if err := etl.db.TakedownResources(ctx, db.TakedownResourcesParams{TakenDownBlockHeight: height, Column2: ack.ResourceAddresses}); err != nil {
+		etl.logger.Error("error taking down resources", zap.String("tx", tx.Hash), zap.Error(err))
+	}
+	if err := etl.db.TakedownDeals(ctx, db.TakedownDealsParams{TakenDownBlockHeight: height, Column2: ack.DealAddresses}); err != nil {
+		etl.logger.Error("error taking down deals", zap.String("tx", tx.Hash), zap.Error(err))
+	}
+}
+
+// rewardAddress derives the address of a reward created by a legacy reward transaction the
+// same way core does on finalize, from the chain id the block was served with
+func rewardAddress(block *corev1.Block, txHash string) (string, error) {
+	if block.GetChainId() == "" {
+		return "", errors.New("block has no chain id")
+	}
+	txhashBytes, err := common.HexToBytes(txHash)
+	if err != nil {
+		return "", fmt.Errorf("could not decode tx hash: %w", err)
+	}
+	return common.CreateAddress(txhashBytes, block.GetChainId(), block.GetHeight(), 0, ""), nil
+}
+
+// recoverRewardSigner recovers the signer of a reward message the same way core does on finalize
+func recoverRewardSigner(signature string, signatureData string) (string, error) {
+	dataBytes, err := hex.DecodeString(signatureData)
+	if err != nil {
+		return "", err
+	}
+	_, signer, err := common.EthRecover(signature, dataBytes)
+	if err != nil {
+		return "", err
+	}
+	return signer, nil
+}
+
+func toPgTimestamp(ts *timestamppb.Timestamp) pgtype.Timestamp {
+	if ts == nil {
+		return pgtype.Timestamp{Valid: false}
+	}
+	return pgtype.Timestamp{Time: ts.AsTime(), Valid: true}
+}
+
+// nonNilStrings keeps not null text[] columns from receiving null
+func nonNilStrings(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
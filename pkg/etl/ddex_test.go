@@ -0,0 +1,82 @@
+package etl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "github.com/AudiusProject/audiusd/pkg/api/core/v1"
+	ddexv1beta1 "github.com/AudiusProject/audiusd/pkg/api/ddex/v1beta1"
+	"github.com/AudiusProject/audiusd/pkg/common"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestRewardAddress(t *testing.T) {
+	txHash := "A1B2C3D4"
+	txhashBytes, err := common.HexToBytes(txHash)
+	require.NoError(t, err)
+
+	address, err := rewardAddress(&corev1.Block{ChainId: "audius-devnet", Height: 7}, txHash)
+	require.NoError(t, err)
+	require.Equal(t, common.CreateAddress(txhashBytes, "audius-devnet", 7, 0, ""), address)
+
+	// a guessed chain id would index the reward under an address core never created
+	_, err = rewardAddress(&corev1.Block{Height: 7}, txHash)
+	require.Error(t, err)
+}
+
+func testMainRelease(ref, title string) *ddexv1beta1.Release {
+	return &ddexv1beta1.Release{Release: &ddexv1beta1.Release_MainRelease{MainRelease: &ddexv1beta1.Release_Release{
+		ReleaseReference: ref,
+		ReleaseType:      "Album",
+		DisplayTitleText: title,
+	}}}
+}
+
+func TestIndexERNUpdate(t *testing.T) {
+	etl := testETL(t)
+	ctx := context.Background()
+
+	_, err := etl.pool.Exec(ctx, "truncate etl_releases restart identity")
+	require.NoError(t, err)
+
+	block := func(height int64) *corev1.Block {
+		return &corev1.Block{Height: height, ChainId: "audius-devnet", Timestamp: timestamppb.New(time.Now())}
+	}
+
+	etl.indexERNMessage(ctx, block(1), &corev1.Transaction{Hash: "tx1"}, "0xsender",
+		&ddexv1beta1.NewReleaseMessage{ReleaseList: []*ddexv1beta1.Release{testMainRelease("R1", "Demo")}},
+		&ddexv1beta1.NewReleaseMessageAck{ErnAddress: "0xern", ReleaseAddresses: []string{"0xr1"}},
+		false,
+	)
+
+	// the update keeps R1's address and adds R2
+	etl.indexERNMessage(ctx, block(2), &corev1.Transaction{Hash: "tx2"}, "0xsender",
+		&ddexv1beta1.NewReleaseMessage{ReleaseList: []*ddexv1beta1.Release{testMainRelease("R1", "Master"), testMainRelease("R2", "Bonus")}},
+		&ddexv1beta1.NewReleaseMessageAck{ErnAddress: "0xern", ReleaseAddresses: []string{"0xr1", "0xr2"}},
+		true,
+	)
+
+	rows, err := etl.pool.Query(ctx, "select address, title, block_height, tx_hash from etl_releases order by address")
+	require.NoError(t, err)
+	defer rows.Close()
+
+	type release struct {
+		address, title string
+		height         int64
+		txHash         string
+	}
+	var releases []release
+	for rows.Next() {
+		var r release
+		require.NoError(t, rows.Scan(&r.address, &r.title, &r.height, &r.txHash))
+		releases = append(releases, r)
+	}
+	require.NoError(t, rows.Err())
+
+	require.Equal(t, []release{
+		{address: "0xr1", title: "Master", height: 2, txHash: "tx2"},
+		{address: "0xr2", title: "Bonus", height: 2, txHash: "tx2"},
+	}, releases)
+}
@@ -2,20 +2,31 @@ package etl
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"connectrpc.com/connect"
 	corev1 "github.com/AudiusProject/audiusd/pkg/api/core/v1"
-	corev1connect "github.com/AudiusProject/audiusd/pkg/api/core/v1/v1connect"
 	v1 "github.com/AudiusProject/audiusd/pkg/api/etl/v1"
 	"github.com/AudiusProject/audiusd/pkg/api/etl/v1/v1connect"
 	"github.com/AudiusProject/audiusd/pkg/etl/db"
 	"github.com/AudiusProject/audiusd/pkg/etl/location"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var _ v1connect.ETLServiceHandler = (*ETLService)(nil)
 
+// CoreClient is the part of the core service the etl reads from, satisfied by
+// both a remote core client and the in process core service
+type CoreClient interface {
+	GetStatus(context.Context, *connect.Request[corev1.GetStatusRequest]) (*connect.Response[corev1.GetStatusResponse], error)
+	GetNodeInfo(context.Context, *connect.Request[corev1.GetNodeInfoRequest]) (*connect.Response[corev1.GetNodeInfoResponse], error)
+	GetBlock(context.Context, *connect.Request[corev1.GetBlockRequest]) (*connect.Response[corev1.GetBlockResponse], error)
+}
+
 type ETLService struct {
 	dbURL               string
 	runDownMigrations   bool
@@ -24,7 +35,7 @@ type ETLService struct {
 	checkReadiness      bool
 	ChainID             string
 
-	core   corev1connect.CoreServiceClient
+	core   CoreClient
 	pool   *pgxpool.Pool
 	db     *db.Queries
 	logger *zap.Logger
@@ -37,7 +48,7 @@ type ETLService struct {
 	mvRefresher *MaterializedViewRefresher
 }
 
-func NewETLService(core corev1connect.CoreServiceClient, logger *zap.Logger) *ETLService {
+func NewETLService(core CoreClient, logger *zap.Logger) *ETLService {
 	etl := &ETLService{
 		logger: logger.With(zap.String("service", "etl")),
 		core:   core,
@@ -106,36 +117,409 @@ func (e *ETLService) GetHealth(context.Context, *connect.Request[v1.GetHealthReq
 }
 
 // GetBlocks implements v1connect.ETLServiceHandler.
-func (e *ETLService) GetBlocks(context.Context, *connect.Request[v1.GetBlocksRequest]) (*connect.Response[v1.GetBlocksResponse], error) {
-	return connect.NewResponse(&v1.GetBlocksResponse{}), nil
+func (e *ETLService) GetBlocks(ctx context.Context, req *connect.Request[v1.GetBlocksRequest]) (*connect.Response[v1.GetBlocksResponse], error) {
+	if err := e.checkDB(); err != nil {
+		return nil, err
+	}
+
+	blockHeight, id := cursorPosition(req.Msg.Cursor)
+	blocks, err := e.db.GetBlocksByBlockHeightCursor(ctx, db.GetBlocksByBlockHeightCursorParams{
+		BlockHeight: blockHeight,
+		ID:          id,
+		Limit:       pageLimit(req.Msg.Limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not get blocks: %w", err)
+	}
+
+	res := &v1.GetBlocksResponse{
+		Blocks:     make([]*v1.GetBlockResponse, 0, len(blocks)),
+		NextCursor: nextCursor(req.Msg.Cursor),
+	}
+	for _, b := range blocks {
+		res.Blocks = append(res.Blocks, &v1.GetBlockResponse{
+			BlockHeight:     b.BlockHeight,
+			ProposerAddress: b.ProposerAddress,
+			Timestamp:       timestamppb.New(b.BlockTime.Time),
+		})
+		res.NextCursor = &v1.Cursor{BlockHeight: b.BlockHeight, Id: int64(b.ID)}
+	}
+
+	return connect.NewResponse(res), nil
 }
 
 // GetLocation implements v1connect.ETLServiceHandler.
-func (e *ETLService) GetLocation(context.Context, *connect.Request[v1.GetLocationRequest]) (*connect.Response[v1.GetLocationResponse], error) {
-	return connect.NewResponse(&v1.GetLocationResponse{}), nil
+func (e *ETLService) GetLocation(ctx context.Context, req *connect.Request[v1.GetLocationRequest]) (*connect.Response[v1.GetLocationResponse], error) {
+	if err := e.checkDB(); err != nil {
+		return nil, err
+	}
+
+	res := &v1.GetLocationResponse{}
+
+	switch q := req.Msg.Query.(type) {
+	case *v1.GetLocationRequest_GetAvailableCities:
+		cities, err := e.db.GetAvailableCities(ctx, db.GetAvailableCitiesParams{
+			Region:  q.GetAvailableCities.Region,
+			Country: q.GetAvailableCities.Country,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("could not get cities: %w", err)
+		}
+		for _, c := range cities {
+			res.Locations = append(res.Locations, &v1.GetLocationResponse_Location{
+				City:      c.City,
+				Region:    c.Region,
+				Country:   c.Country,
+				PlayCount: c.PlayCount,
+			})
+		}
+	case *v1.GetLocationRequest_GetAvailableRegions:
+		regions, err := e.db.GetAvailableRegions(ctx, q.GetAvailableRegions.Country)
+		if err != nil {
+			return nil, fmt.Errorf("could not get regions: %w", err)
+		}
+		for _, r := range regions {
+			res.Locations = append(res.Locations, &v1.GetLocationResponse_Location{
+				Region:    r.Region,
+				Country:   r.Country,
+				PlayCount: r.PlayCount,
+			})
+		}
+	case *v1.GetLocationRequest_GetAvailableCountries:
+		countries, err := e.db.GetAvailableCountries(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not get countries: %w", err)
+		}
+		for _, c := range countries {
+			res.Locations = append(res.Locations, &v1.GetLocationResponse_Location{
+				Country:   c.Country,
+				PlayCount: c.PlayCount,
+			})
+		}
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("query is required"))
+	}
+
+	return connect.NewResponse(res), nil
 }
 
 // GetManageEntities implements v1connect.ETLServiceHandler.
-func (e *ETLService) GetManageEntities(context.Context, *connect.Request[v1.GetManageEntitiesRequest]) (*connect.Response[v1.GetManageEntitiesResponse], error) {
-	return connect.NewResponse(&v1.GetManageEntitiesResponse{}), nil
+func (e *ETLService) GetManageEntities(ctx context.Context, req *connect.Request[v1.GetManageEntitiesRequest]) (*connect.Response[v1.GetManageEntitiesResponse], error) {
+	if err := e.checkDB(); err != nil {
+		return nil, err
+	}
+
+	blockHeight, id := cursorPosition(req.Msg.Cursor)
+	limit := pageLimit(req.Msg.Limit)
+
+	var entities []db.EtlManageEntity
+	var err error
+	if req.Msg.Address == "" && req.Msg.EntityType == "" && req.Msg.Action == "" {
+		entities, err = e.db.GetManageEntitiesByBlockHeightCursor(ctx, db.GetManageEntitiesByBlockHeightCursorParams{
+			BlockHeight: blockHeight,
+			ID:          id,
+			Limit:       limit,
+		})
+	} else {
+		entities, err = e.db.GetManageEntitiesFilteredCursor(ctx, db.GetManageEntitiesFilteredCursorParams{
+			BlockHeight: blockHeight,
+			ID:          id,
+			Address:     req.Msg.Address,
+			EntityType:  req.Msg.EntityType,
+			Action:      req.Msg.Action,
+			PageLimit:   limit,
+		})
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not get manage entities: %w", err)
+	}
+
+	res := &v1.GetManageEntitiesResponse{
+		ManageEntities: make([]*v1.GetManageEntityResponse, 0, len(entities)),
+		NextCursor:     nextCursor(req.Msg.Cursor),
+	}
+	for _, me := range entities {
+		res.ManageEntities = append(res.ManageEntities, &v1.GetManageEntityResponse{
+			Address:    me.Address,
+			EntityType: me.EntityType,
+			EntityId:   me.EntityID,
+			Action:     me.Action,
+			Metadata:   me.Metadata.String,
+			Signature:  me.Signature,
+			Signer:     me.Signer,
+			Nonce:      me.Nonce,
+			Block:      me.BlockHeight,
+			TxHash:     me.TxHash,
+		})
+		res.NextCursor = &v1.Cursor{BlockHeight: me.BlockHeight, Id: int64(me.ID)}
+	}
+
+	return connect.NewResponse(res), nil
 }
 
 // GetPlays implements v1connect.ETLServiceHandler.
-func (e *ETLService) GetPlays(context.Context, *connect.Request[v1.GetPlaysRequest]) (*connect.Response[v1.GetPlaysResponse], error) {
-	return connect.NewResponse(&v1.GetPlaysResponse{}), nil
+func (e *ETLService) GetPlays(ctx context.Context, req *connect.Request[v1.GetPlaysRequest]) (*connect.Response[v1.GetPlaysResponse], error) {
+	if err := e.checkDB(); err != nil {
+		return nil, err
+	}
+
+	blockHeight, id := cursorPosition(req.Msg.Cursor)
+	limit := pageLimit(req.Msg.Limit)
+
+	filter := db.GetPlaysFilteredCursorParams{
+		BlockHeight: blockHeight,
+		ID:          id,
+		PageLimit:   limit,
+	}
+
+	filtered := true
+	switch q := req.Msg.Query.(type) {
+	case *v1.GetPlaysRequest_GetPlaysByAddress:
+		if q.GetPlaysByAddress.Address == "" {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("address is required"))
+		}
+		filter.Address = q.GetPlaysByAddress.Address
+	case *v1.GetPlaysRequest_GetPlaysByUser:
+		if q.GetPlaysByUser.UserId == "" {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("user id is required"))
+		}
+		filter.UserID = q.GetPlaysByUser.UserId
+	case *v1.GetPlaysRequest_GetPlaysByTrack:
+		if q.GetPlaysByTrack.TrackId == "" {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("track id is required"))
+		}
+		filter.TrackID = q.GetPlaysByTrack.TrackId
+	case *v1.GetPlaysRequest_GetPlaysByTimeRange:
+		if start := q.GetPlaysByTimeRange.Start; start != nil {
+			filter.PlayedAfter = pgtype.Timestamp{Time: start.AsTime(), Valid: true}
+		}
+		if end := q.GetPlaysByTimeRange.End; end != nil {
+			filter.PlayedBefore = pgtype.Timestamp{Time: end.AsTime(), Valid: true}
+		}
+	case *v1.GetPlaysRequest_GetPlaysByLocation:
+		filter.City = q.GetPlaysByLocation.City
+		filter.Region = q.GetPlaysByLocation.Region
+		filter.Country = q.GetPlaysByLocation.Country
+	default:
+		filtered = false
+	}
+
+	var plays []db.EtlPlay
+	var err error
+	if !filtered {
+		plays, err = e.db.GetPlaysByBlockHeightCursor(ctx, db.GetPlaysByBlockHeightCursorParams{
+			BlockHeight: blockHeight,
+			ID:          id,
+			Limit:       limit,
+		})
+	} else {
+		plays, err = e.db.GetPlaysFilteredCursor(ctx, filter)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not get plays: %w", err)
+	}
+
+	res := &v1.GetPlaysResponse{
+		Plays:      make([]*v1.GetPlayResponse, 0, len(plays)),
+		NextCursor: nextCursor(req.Msg.Cursor),
+	}
+	for _, p := range plays {
+		res.Plays = append(res.Plays, &v1.GetPlayResponse{
+			Address:     p.UserID,
+			TrackId:     p.TrackID,
+			Timestamp:   p.PlayedAt.Time.Unix(),
+			City:        p.City,
+			Country:     p.Country,
+			Region:      p.Region,
+			BlockHeight: p.BlockHeight,
+			TxHash:      p.TxHash,
+		})
+		res.NextCursor = &v1.Cursor{BlockHeight: p.BlockHeight, Id: int64(p.ID)}
+	}
+
+	return connect.NewResponse(res), nil
 }
 
 // GetTransactions implements v1connect.ETLServiceHandler.
-func (e *ETLService) GetTransactions(context.Context, *connect.Request[v1.GetTransactionsRequest]) (*connect.Response[v1.GetTransactionsResponse], error) {
-	return connect.NewResponse(&v1.GetTransactionsResponse{}), nil
+func (e *ETLService) GetTransactions(ctx context.Context, req *connect.Request[v1.GetTransactionsRequest]) (*connect.Response[v1.GetTransactionsResponse], error) {
+	if err := e.checkDB(); err != nil {
+		return nil, err
+	}
+
+	blockHeight, id := cursorPosition(req.Msg.Cursor)
+	limit := pageLimit(req.Msg.Limit)
+
+	filter := db.GetTransactionsFilteredCursorParams{
+		BlockHeight: blockHeight,
+		ID:          id,
+		PageLimit:   limit,
+	}
+
+	filtered := true
+	switch q := req.Msg.Query.(type) {
+	case *v1.GetTransactionsRequest_GetTransactionsByAddress:
+		if q.GetTransactionsByAddress.Address == "" {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("address is required"))
+		}
+		filter.Address = q.GetTransactionsByAddress.Address
+	case *v1.GetTransactionsRequest_GetTransactionsByType:
+		if q.GetTransactionsByType.TxType == "" {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("tx type is required"))
+		}
+		filter.TxType = q.GetTransactionsByType.TxType
+	default:
+		filtered = false
+	}
+
+	var txs []db.EtlTransaction
+	var err error
+	if !filtered {
+		txs, err = e.db.GetTransactionsByBlockHeightCursor(ctx, db.GetTransactionsByBlockHeightCursorParams{
+			BlockHeight: blockHeight,
+			ID:          id,
+			Limit:       limit,
+		})
+	} else {
+		txs, err = e.db.GetTransactionsFilteredCursor(ctx, filter)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not get transactions: %w", err)
+	}
+
+	res := &v1.GetTransactionsResponse{
+		Transactions: make([]*v1.GetTransactionResponse, 0, len(txs)),
+		NextCursor:   nextCursor(req.Msg.Cursor),
+	}
+	for _, tx := range txs {
+		res.Transactions = append(res.Transactions, &v1.GetTransactionResponse{
+			TxHash:      tx.TxHash,
+			BlockHeight: tx.BlockHeight,
+			TxIndex:     tx.TxIndex,
+			TxType:      tx.TxType,
+			Address:     tx.Address.String,
+			Timestamp:   timestamppb.New(tx.CreatedAt.Time),
+		})
+		res.NextCursor = &v1.Cursor{BlockHeight: tx.BlockHeight, Id: int64(tx.ID)}
+	}
+
+	return connect.NewResponse(res), nil
 }
 
 // GetValidators implements v1connect.ETLServiceHandler.
-func (e *ETLService) GetValidators(context.Context, *connect.Request[v1.GetValidatorsRequest]) (*connect.Response[v1.GetValidatorsResponse], error) {
-	return connect.NewResponse(&v1.GetValidatorsResponse{}), nil
+func (e *ETLService) GetValidators(ctx context.Context, req *connect.Request[v1.GetValidatorsRequest]) (*connect.Response[v1.GetValidatorsResponse], error) {
+	if err := e.checkDB(); err != nil {
+		return nil, err
+	}
+
+	blockHeight, id := cursorPosition(req.Msg.Cursor)
+	limit := pageLimit(req.Msg.Limit)
+
+	res := &v1.GetValidatorsResponse{
+		NextCursor: nextCursor(req.Msg.Cursor),
+	}
+
+	switch req.Msg.Query.(type) {
+	case *v1.GetValidatorsRequest_GetValidatorRegistrations:
+		registrations, err := e.db.GetValidatorRegistrationsByBlockHeightCursor(ctx, db.GetValidatorRegistrationsByBlockHeightCursorParams{
+			BlockHeight: blockHeight,
+			ID:          id,
+			Limit:       limit,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("could not get validator registrations: %w", err)
+		}
+		for _, r := range registrations {
+			res.Validators = append(res.Validators, &v1.GetValidatorResponse{
+				Address:          r.Address,
+				ValidatorAddress: r.CometAddress,
+				BlockHeight:      r.BlockHeight,
+				TxHash:           r.TxHash,
+				Endpoint:         r.Endpoint,
+				NodeType:         r.NodeType,
+				Spid:             r.Spid,
+				VotingPower:      r.VotingPower,
+			})
+			res.NextCursor = &v1.Cursor{BlockHeight: r.BlockHeight, Id: int64(r.ID)}
+		}
+	case *v1.GetValidatorsRequest_GetValidatorDeregistrations:
+		deregistrations, err := e.db.GetValidatorDeregistrationsByBlockHeightCursor(ctx, db.GetValidatorDeregistrationsByBlockHeightCursorParams{
+			BlockHeight: blockHeight,
+			ID:          id,
+			Limit:       limit,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("could not get validator deregistrations: %w", err)
+		}
+		for _, d := range deregistrations {
+			res.Validators = append(res.Validators, &v1.GetValidatorResponse{
+				ValidatorAddress: d.CometAddress,
+				BlockHeight:      d.BlockHeight,
+				TxHash:           d.TxHash,
+			})
+			res.NextCursor = &v1.Cursor{BlockHeight: d.BlockHeight, Id: int64(d.ID)}
+		}
+	default:
+		validators, err := e.db.GetRegisteredValidatorsCursor(ctx, db.GetRegisteredValidatorsCursorParams{
+			RegisteredAt: blockHeight,
+			ID:           id,
+			Limit:        limit,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("could not get registered validators: %w", err)
+		}
+		for _, v := range validators {
+			res.Validators = append(res.Validators, &v1.GetValidatorResponse{
+				Address:          v.Address,
+				ValidatorAddress: v.CometAddress,
+				BlockHeight:      v.RegisteredAt,
+				Timestamp:        timestamppb.New(v.CreatedAt.Time),
+				Endpoint:         v.Endpoint,
+				NodeType:         v.NodeType,
+				Spid:             v.Spid,
+				VotingPower:      v.VotingPower,
+				Status:           v.Status,
+			})
+			res.NextCursor = &v1.Cursor{BlockHeight: v.RegisteredAt, Id: int64(v.ID)}
+		}
+	}
+
+	return connect.NewResponse(res), nil
 }
 
 // Ping implements v1connect.ETLServiceHandler.
 func (e *ETLService) Ping(context.Context, *connect.Request[v1.PingRequest]) (*connect.Response[v1.PingResponse], error) {
 	return connect.NewResponse(&v1.PingResponse{}), nil
 }
+
+const (
+	defaultPageLimit = 100
+	maxPageLimit     = 1000
+)
+
+// checkDB guards the rpcs against being served before the indexer has connected
+func (e *ETLService) checkDB() error {
+	if e.db == nil {
+		return connect.NewError(connect.CodeUnavailable, errors.New("etl database not initialized"))
+	}
+	return nil
+}
+
+func pageLimit(limit int32) int32 {
+	if limit <= 0 {
+		return defaultPageLimit
+	}
+	if limit > maxPageLimit {
+		return maxPageLimit
+	}
+	return limit
+}
+
+// cursorPosition returns the (block_height, id) a page starts after, the zero cursor starts at the beginning
+func cursorPosition(cursor *v1.Cursor) (int64, int32) {
+	return cursor.GetBlockHeight(), int32(cursor.GetId())
+}
+
+// nextCursor is the cursor returned when a page is empty, so clients can keep polling from where they are
+func nextCursor(cursor *v1.Cursor) *v1.Cursor {
+	return &v1.Cursor{BlockHeight: cursor.GetBlockHeight(), Id: cursor.GetId()}
+}
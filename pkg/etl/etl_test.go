@@ -0,0 +1,243 @@
+package etl
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	v1 "github.com/AudiusProject/audiusd/pkg/api/etl/v1"
+	"github.com/AudiusProject/audiusd/pkg/etl/db"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// testETL serves the rpcs from an empty etl database at dbUrl, tests are skipped without one
+func testETL(t *testing.T) *ETLService {
+	dbUrl := os.Getenv("dbUrl")
+	if dbUrl == "" {
+		t.Skip("dbUrl not set")
+	}
+
+	ctx := context.Background()
+	require.NoError(t, db.RunMigrations(zap.NewNop(), dbUrl, false))
+
+	pool, err := pgxpool.New(ctx, dbUrl)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+
+	_, err = pool.Exec(ctx, "truncate etl_blocks, etl_transactions, etl_plays, etl_manage_entities restart identity")
+	require.NoError(t, err)
+
+	return &ETLService{
+		pool:   pool,
+		db:     db.New(pool),
+		logger: zap.NewNop(),
+	}
+}
+
+type testPlay struct {
+	userID, trackID, city, region, country string
+	playedAt                               time.Time
+}
+
+// insertPlays indexes the plays as one play transaction from address at height
+func insertPlays(t *testing.T, etl *ETLService, height int64, txHash, address string, plays ...testPlay) {
+	ctx := context.Background()
+	require.NoError(t, etl.db.InsertTransaction(ctx, db.InsertTransactionParams{
+		TxHash:      txHash,
+		BlockHeight: height,
+		TxType:      TxTypePlay,
+		Address:     pgtype.Text{String: address, Valid: true},
+		CreatedAt:   pgtype.Timestamp{Time: time.Now(), Valid: true},
+	}))
+	if len(plays) == 0 {
+		return
+	}
+
+	params := db.InsertPlaysParams{}
+	for _, p := range plays {
+		params.Column1 = append(params.Column1, p.userID)
+		params.Column2 = append(params.Column2, p.trackID)
+		params.Column3 = append(params.Column3, p.city)
+		params.Column4 = append(params.Column4, p.region)
+		params.Column5 = append(params.Column5, p.country)
+		params.Column6 = append(params.Column6, pgtype.Timestamp{Time: p.playedAt, Valid: true})
+		params.Column7 = append(params.Column7, height)
+		params.Column8 = append(params.Column8, txHash)
+		params.Column9 = append(params.Column9, pgtype.Timestamp{Time: p.playedAt, Valid: true})
+		params.Column10 = append(params.Column10, pgtype.Timestamp{Time: time.Now(), Valid: true})
+	}
+	require.NoError(t, etl.db.InsertPlays(ctx, params))
+}
+
+func playTxHashes(res *connect.Response[v1.GetPlaysResponse]) []string {
+	hashes := []string{}
+	for _, p := range res.Msg.Plays {
+		hashes = append(hashes, p.TxHash+"/"+p.TrackId)
+	}
+	return hashes
+}
+
+func TestGetPlays(t *testing.T) {
+	etl := testETL(t)
+	ctx := context.Background()
+
+	noon := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	insertPlays(t, etl, 1, "tx1", "0xAbC",
+		testPlay{userID: "1", trackID: "t1", city: "Austin", region: "TX", country: "US", playedAt: noon},
+		testPlay{userID: "2", trackID: "t2", city: "Toronto", region: "ON", country: "CA", playedAt: noon.Add(time.Hour)},
+	)
+	insertPlays(t, etl, 2, "tx2", "0xdef",
+		testPlay{userID: "1", trackID: "t3", city: "Dallas", region: "TX", country: "US", playedAt: noon.Add(2 * time.Hour)},
+	)
+
+	tests := []struct {
+		name string
+		req  *v1.GetPlaysRequest
+		want []string
+	}{
+		{
+			name: "all",
+			req:  &v1.GetPlaysRequest{},
+			want: []string{"tx1/t1", "tx1/t2", "tx2/t3"},
+		},
+		{
+			// the address is the transaction's, not the listener's user id
+			name: "by address",
+			req:  &v1.GetPlaysRequest{Query: &v1.GetPlaysRequest_GetPlaysByAddress{GetPlaysByAddress: &v1.GetPlaysByAddress{Address: "0xabc"}}},
+			want: []string{"tx1/t1", "tx1/t2"},
+		},
+		{
+			name: "by address matching a user id",
+			req:  &v1.GetPlaysRequest{Query: &v1.GetPlaysRequest_GetPlaysByAddress{GetPlaysByAddress: &v1.GetPlaysByAddress{Address: "1"}}},
+			want: []string{},
+		},
+		{
+			name: "by user",
+			req:  &v1.GetPlaysRequest{Query: &v1.GetPlaysRequest_GetPlaysByUser{GetPlaysByUser: &v1.GetPlaysByUser{UserId: "1"}}},
+			want: []string{"tx1/t1", "tx2/t3"},
+		},
+		{
+			name: "by track",
+			req:  &v1.GetPlaysRequest{Query: &v1.GetPlaysRequest_GetPlaysByTrack{GetPlaysByTrack: &v1.GetPlaysByTrack{TrackId: "t2"}}},
+			want: []string{"tx1/t2"},
+		},
+		{
+			name: "by time range",
+			req: &v1.GetPlaysRequest{Query: &v1.GetPlaysRequest_GetPlaysByTimeRange{GetPlaysByTimeRange: &v1.GetPlaysByTimeRange{
+				Start: timestamppb.New(noon.Add(time.Hour)),
+				End:   timestamppb.New(noon.Add(2 * time.Hour)),
+			}}},
+			want: []string{"tx1/t2"},
+		},
+		{
+			name: "by location",
+			req:  &v1.GetPlaysRequest{Query: &v1.GetPlaysRequest_GetPlaysByLocation{GetPlaysByLocation: &v1.GetPlaysByLocation{Region: "TX", Country: "US"}}},
+			want: []string{"tx1/t1", "tx2/t3"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := etl.GetPlays(ctx, connect.NewRequest(tt.req))
+			require.NoError(t, err)
+			require.Equal(t, tt.want, playTxHashes(res))
+		})
+	}
+
+	t.Run("pages", func(t *testing.T) {
+		res, err := etl.GetPlays(ctx, connect.NewRequest(&v1.GetPlaysRequest{Limit: 2}))
+		require.NoError(t, err)
+		require.Equal(t, []string{"tx1/t1", "tx1/t2"}, playTxHashes(res))
+
+		res, err = etl.GetPlays(ctx, connect.NewRequest(&v1.GetPlaysRequest{Limit: 2, Cursor: res.Msg.NextCursor}))
+		require.NoError(t, err)
+		require.Equal(t, []string{"tx2/t3"}, playTxHashes(res))
+
+		// an exhausted page keeps the cursor so clients can poll from it
+		cursor := res.Msg.NextCursor
+		res, err = etl.GetPlays(ctx, connect.NewRequest(&v1.GetPlaysRequest{Limit: 2, Cursor: cursor}))
+		require.NoError(t, err)
+		require.Empty(t, res.Msg.Plays)
+		require.Equal(t, cursor.BlockHeight, res.Msg.NextCursor.BlockHeight)
+		require.Equal(t, cursor.Id, res.Msg.NextCursor.Id)
+	})
+}
+
+func TestGetTransactions(t *testing.T) {
+	etl := testETL(t)
+	ctx := context.Background()
+
+	insertPlays(t, etl, 1, "tx1", "0xAbC")
+	require.NoError(t, etl.db.InsertTransaction(ctx, db.InsertTransactionParams{
+		TxHash:      "tx2",
+		BlockHeight: 2,
+		TxType:      TxTypeManageEntity,
+		Address:     pgtype.Text{String: "0xabc", Valid: true},
+		CreatedAt:   pgtype.Timestamp{Time: time.Now(), Valid: true},
+	}))
+	insertPlays(t, etl, 3, "tx3", "0xdef")
+
+	hashes := func(req *v1.GetTransactionsRequest) []string {
+		res, err := etl.GetTransactions(ctx, connect.NewRequest(req))
+		require.NoError(t, err)
+		hashes := []string{}
+		for _, tx := range res.Msg.Transactions {
+			hashes = append(hashes, tx.TxHash)
+		}
+		return hashes
+	}
+
+	require.Equal(t, []string{"tx1", "tx2", "tx3"}, hashes(&v1.GetTransactionsRequest{}))
+	require.Equal(t, []string{"tx1", "tx2"}, hashes(&v1.GetTransactionsRequest{Query: &v1.GetTransactionsRequest_GetTransactionsByAddress{
+		GetTransactionsByAddress: &v1.GetTransactionsByAddress{Address: "0xABC"},
+	}}))
+	require.Equal(t, []string{"tx1", "tx3"}, hashes(&v1.GetTransactionsRequest{Query: &v1.GetTransactionsRequest_GetTransactionsByType{
+		GetTransactionsByType: &v1.GetTransactionsByType{TxType: TxTypePlay},
+	}}))
+
+	_, err := etl.GetTransactions(ctx, connect.NewRequest(&v1.GetTransactionsRequest{Query: &v1.GetTransactionsRequest_GetTransactionsByAddress{
+		GetTransactionsByAddress: &v1.GetTransactionsByAddress{},
+	}}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+func TestGetLocation(t *testing.T) {
+	etl := testETL(t)
+	ctx := context.Background()
+
+	now := time.Now()
+	insertPlays(t, etl, 1, "tx1", "0xabc",
+		testPlay{userID: "1", trackID: "t1", city: "Austin", region: "TX", country: "US", playedAt: now},
+		testPlay{userID: "1", trackID: "t1", city: "Austin", region: "TX", country: "US", playedAt: now},
+		testPlay{userID: "1", trackID: "t1", city: "Dallas", region: "TX", country: "US", playedAt: now},
+		testPlay{userID: "1", trackID: "t1", city: "Toronto", region: "ON", country: "CA", playedAt: now},
+		// unlocated plays aren't counted
+		testPlay{userID: "1", trackID: "t1", playedAt: now},
+	)
+
+	locations := func(req *v1.GetLocationRequest) []*v1.GetLocationResponse_Location {
+		res, err := etl.GetLocation(ctx, connect.NewRequest(req))
+		require.NoError(t, err)
+		return res.Msg.Locations
+	}
+
+	countries := locations(&v1.GetLocationRequest{Query: &v1.GetLocationRequest_GetAvailableCountries{GetAvailableCountries: &v1.GetAvailableCountries{}}})
+	require.Len(t, countries, 2)
+	require.Equal(t, "US", countries[0].Country)
+	require.Equal(t, int64(3), countries[0].PlayCount)
+
+	regions := locations(&v1.GetLocationRequest{Query: &v1.GetLocationRequest_GetAvailableRegions{GetAvailableRegions: &v1.GetAvailableRegions{Country: "US"}}})
+	require.Len(t, regions, 1)
+	require.Equal(t, "TX", regions[0].Region)
+
+	cities := locations(&v1.GetLocationRequest{Query: &v1.GetLocationRequest_GetAvailableCities{GetAvailableCities: &v1.GetAvailableCities{Region: "TX"}}})
+	require.Len(t, cities, 2)
+	require.Equal(t, "Austin", cities[0].City)
+	require.Equal(t, int64(2), cities[0].PlayCount)
+}
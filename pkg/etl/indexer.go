@@ -10,6 +10,7 @@ import (
 
 	"connectrpc.com/connect"
 	corev1 "github.com/AudiusProject/audiusd/pkg/api/core/v1"
+	"github.com/AudiusProject/audiusd/pkg/common"
 	"github.com/AudiusProject/audiusd/pkg/etl/db"
 	"github.com/AudiusProject/audiusd/pkg/etl/location"
 	"github.com/jackc/pgx/v5"
@@ -17,6 +18,7 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
 )
 
 const (
@@ -30,6 +32,14 @@ const (
 	TxTypeStorageProof                       = "storage_proof"
 	TxTypeStorageProofVerification           = "storage_proof_verification"
 	TxTypeRelease                            = "release"
+	TxTypeReward                             = "reward"
+	TxTypeFileUpload                         = "file_upload"
+	TxTypeVoteExtensions                     = "vote_extensions"
+	TxTypeERN                                = "ern"
+	TxTypeMEAD                               = "mead"
+	TxTypePIE                                = "pie"
+	TxTypeDDEX                               = "ddex"
+	TxTypeDelegation                         = "delegation"
 )
 
 // ChallengeStats represents storage proof challenge statistics for a validator
@@ -220,7 +230,18 @@ func (etl *ETLService) indexBlocks() error {
 					CreatedAt:   pgtype.Timestamp{Time: block.Timestamp.AsTime(), Valid: true},
 				}
 
-				switch signedTx := tx.Transaction.Transaction.(type) {
+				// failed transactions are recorded but nothing they would have created is indexed
+				applied := tx.ResultCode == 0
+
+				if txv2 := tx.GetTransactionv2(); txv2 != nil {
+					insertTxParams.TxType = getTxTypeV2(txv2)
+					insertTxParams.Address = pgtype.Text{String: txv2.GetEnvelope().GetHeader().GetFrom(), Valid: true}
+					if applied {
+						etl.indexTransactionV2(context.Background(), block, tx)
+					}
+				}
+
+				switch signedTx := tx.GetTransaction().GetTransaction().(type) {
 				case *corev1.SignedTransaction_Plays:
 					insertTxParams.TxType = TxTypePlay
 					// Use the first play's user_id as the transaction address
@@ -403,8 +424,16 @@ func (etl *ETLService) indexBlocks() error {
 						}
 
 						// Insert SLA node reports with the actual rollup ID and challenge data
+						onChainChallenges := reportsChallenges(sr)
 						for _, report := range sr.Reports {
 							stats := challengeStats[report.Address] // Get challenge stats for this validator
+							if onChainChallenges {
+								// newer rollups carry the outcomes validators agreed on
+								stats = ChallengeStats{
+									ChallengesReceived: report.ChallengesReceived,
+									ChallengesFailed:   report.ChallengesFailed,
+								}
+							}
 
 							err = etl.db.InsertSlaNodeReport(context.Background(), db.InsertSlaNodeReportParams{
 								SlaRollupID:        rollupId, // Use the actual rollup ID
@@ -425,40 +454,113 @@ func (etl *ETLService) indexBlocks() error {
 					insertTxParams.TxType = TxTypeStorageProof
 					sp := signedTx.StorageProof
 					insertTxParams.Address = pgtype.Text{String: sp.Address, Valid: true}
-					err = etl.db.InsertStorageProof(context.Background(), db.InsertStorageProofParams{
-						Height:          sp.Height,
-						Address:         sp.Address,
-						ProverAddresses: sp.ProverAddresses,
-						Cid:             sp.Cid,
-						ProofSignature:  sp.ProofSignature,
-						Proof:           nil, // Will be set during verification
-						Status:          "unresolved",
-						BlockHeight:     block.Height,
-						TxHash:          tx.Hash,
-						CreatedAt:       pgtype.Timestamp{Time: block.Timestamp.AsTime(), Valid: true},
-					})
-					if err != nil {
-						etl.logger.Error("error inserting storage proof", zap.Error(err))
-					}
+					etl.indexStorageProof(block, tx, sp)
 				case *corev1.SignedTransaction_StorageProofVerification:
 					insertTxParams.TxType = TxTypeStorageProofVerification
-					spv := signedTx.StorageProofVerification
 					// Storage proof verification doesn't have a specific address, leave as null
-					err = etl.db.InsertStorageProofVerification(context.Background(), db.InsertStorageProofVerificationParams{
-						Height:      spv.Height,
-						Proof:       spv.Proof,
-						BlockHeight: block.Height,
-						TxHash:      tx.Hash,
-						CreatedAt:   pgtype.Timestamp{Time: block.Timestamp.AsTime(), Valid: true},
-					})
-					if err != nil {
-						etl.logger.Error("error inserting storage proof verification", zap.Error(err))
-					} else {
-						// Process consensus for this storage proof challenge
-						err = etl.processStorageProofConsensus(spv.Height, spv.Proof, block.Height, tx.Hash, block.Timestamp.AsTime())
+					etl.indexStorageProofVerification(block, tx, signedTx.StorageProofVerification)
+				case *corev1.SignedTransaction_VoteExtensions:
+					insertTxParams.TxType = TxTypeVoteExtensions
+					if !applied {
+						break
+					}
+					// proofs and reveals carried in vote extensions are indexed as if they were their own txs
+					for _, sve := range signedTx.VoteExtensions.GetExtensions() {
+						var ext corev1.VoteExtension
+						if err := proto.Unmarshal(sve.Extension, &ext); err != nil {
+							etl.logger.Error("error decoding vote extension", zap.String("tx", tx.Hash), zap.String("validator", sve.ValidatorAddress), zap.Error(err))
+							continue
+						}
+						for _, sp := range ext.StorageProofs {
+							etl.indexStorageProof(block, tx, sp)
+						}
+						for _, spv := range ext.StorageProofVerifications {
+							etl.indexStorageProofVerification(block, tx, spv)
+						}
+					}
+				case *corev1.SignedTransaction_Release:
+					insertTxParams.TxType = TxTypeRelease
+					// Legacy release - no specific table insert needed
+				case *corev1.SignedTransaction_Reward:
+					insertTxParams.TxType = TxTypeReward
+					if !applied {
+						break
+					}
+					switch action := signedTx.Reward.GetAction().(type) {
+					case *corev1.RewardMessage_Create:
+						cr := action.Create
+						signer, err := recoverRewardSigner(cr.Signature, common.CreateDeterministicCreateRewardData(cr))
+						if err != nil {
+							etl.logger.Error("error recovering reward signer", zap.String("tx", tx.Hash), zap.Error(err))
+						}
+						insertTxParams.Address = pgtype.Text{String: signer, Valid: signer != ""}
+
+						address, err := rewardAddress(block, tx.Hash)
 						if err != nil {
-							etl.logger.Error("error processing storage proof consensus", zap.Error(err))
+							etl.logger.Error("error deriving reward address", zap.String("tx", tx.Hash), zap.Error(err))
+							break
 						}
+						claimAuthorities := make([]string, len(cr.ClaimAuthorities))
+						for i, auth := range cr.ClaimAuthorities {
+							claimAuthorities[i] = auth.Address
+						}
+						err = etl.db.InsertReward(context.Background(), db.InsertRewardParams{
+							Address:          address,
+							RewardID:         cr.RewardId,
+							Name:             cr.Name,
+							Amount:           int64(cr.Amount),
+							ClaimAuthorities: claimAuthorities,
+							Sender:           signer,
+							BlockHeight:      block.Height,
+							TxHash:           tx.Hash,
+							CreatedAt:        pgtype.Timestamp{Time: block.Timestamp.AsTime(), Valid: true},
+						})
+						if err != nil {
+							etl.logger.Error("error inserting reward", zap.Error(err))
+						}
+					case *corev1.RewardMessage_Delete:
+						dr := action.Delete
+						signer, err := recoverRewardSigner(dr.Signature, common.CreateDeterministicDeleteRewardData(dr))
+						if err != nil {
+							etl.logger.Error("error recovering reward signer", zap.String("tx", tx.Hash), zap.Error(err))
+						}
+						insertTxParams.Address = pgtype.Text{String: signer, Valid: signer != ""}
+						err = etl.db.DeleteReward(context.Background(), db.DeleteRewardParams{
+							DeletedBlockHeight: pgtype.Int8{Int64: block.Height, Valid: true},
+							Address:            dr.Address,
+						})
+						if err != nil {
+							etl.logger.Error("error deleting reward", zap.Error(err))
+						}
+					case *corev1.RewardMessage_Attest:
+						// attested claims are listed by core, only the attester is indexed
+						signer, err := recoverRewardSigner(action.Attest.Signature, common.CreateDeterministicAttestRewardClaimData(action.Attest))
+						if err != nil {
+							etl.logger.Error("error recovering reward signer", zap.String("tx", tx.Hash), zap.Error(err))
+						}
+						insertTxParams.Address = pgtype.Text{String: signer, Valid: signer != ""}
+					}
+				case *corev1.SignedTransaction_FileUpload:
+					insertTxParams.TxType = TxTypeFileUpload
+					fu := signedTx.FileUpload
+					insertTxParams.Address = pgtype.Text{String: fu.UploaderAddress, Valid: true}
+					if !applied {
+						break
+					}
+					err := etl.db.InsertFileUpload(context.Background(), db.InsertFileUploadParams{
+						UploaderAddress:    fu.UploaderAddress,
+						Cid:                fu.Cid,
+						TranscodedCid:      fu.TranscodedCid,
+						UploadID:           fu.UploadId,
+						UploadSignature:    fu.UploadSignature,
+						ValidatorAddress:   fu.ValidatorAddress,
+						ValidatorSignature: fu.ValidatorSignature,
+						BlockHeight:        block.Height,
+						TxHash:             tx.Hash,
+						CreatedAt:          pgtype.Timestamp{Time: block.Timestamp.AsTime(), Valid: true},
+					})
+					if err != nil {
+						etl.logger.Error("error inserting file upload", zap.Error(err))
 					}
 				case *corev1.SignedTransaction_Attestation:
 					at := signedTx.Attestation
@@ -594,6 +696,17 @@ func (etl *ETLService) startPgNotifyListener(ctx context.Context) error {
 	}
 }
 
+// reportsChallenges is whether a rollup carries storage proof outcomes, older rollups
+// leave them out and have them calculated from indexed storage proofs instead
+func reportsChallenges(sr *corev1.SlaRollup) bool {
+	for _, report := range sr.Reports {
+		if report.ChallengesReceived > 0 {
+			return true
+		}
+	}
+	return false
+}
+
 // calculateChallengeStatistics aggregates storage proof challenge data for validators within a block range
 // NOTE: This function may be called before all storage proof data for the block range is available,
 // leading to potentially inaccurate pre-calculated statistics. Consider calculating these dynamically
@@ -622,6 +735,44 @@ func (etl *ETLService) calculateChallengeStatistics(blockStart, blockEnd int64)
 	return stats, nil
 }
 
+func (etl *ETLService) indexStorageProof(block *corev1.Block, tx *corev1.Transaction, sp *corev1.StorageProof) {
+	err := etl.db.InsertStorageProof(context.Background(), db.InsertStorageProofParams{
+		Height:          sp.Height,
+		Address:         sp.Address,
+		ProverAddresses: sp.ProverAddresses,
+		Cid:             sp.Cid,
+		ProofSignature:  sp.ProofSignature,
+		Proof:           nil, // Will be set during verification
+		Status:          "unresolved",
+		BlockHeight:     block.Height,
+		TxHash:          tx.Hash,
+		CreatedAt:       pgtype.Timestamp{Time: block.Timestamp.AsTime(), Valid: true},
+	})
+	if err != nil {
+		etl.logger.Error("error inserting storage proof", zap.Error(err))
+	}
+}
+
+func (etl *ETLService) indexStorageProofVerification(block *corev1.Block, tx *corev1.Transaction, spv *corev1.StorageProofVerification) {
+	err := etl.db.InsertStorageProofVerification(context.Background(), db.InsertStorageProofVerificationParams{
+		Height:      spv.Height,
+		Proof:       spv.Proof,
+		BlockHeight: block.Height,
+		TxHash:      tx.Hash,
+		CreatedAt:   pgtype.Timestamp{Time: block.Timestamp.AsTime(), Valid: true},
+	})
+	if err != nil {
+		etl.logger.Error("error inserting storage proof verification", zap.Error(err))
+		return
+	}
+
+	// Process consensus for this storage proof challenge
+	err = etl.processStorageProofConsensus(spv.Height, spv.Proof, block.Height, tx.Hash, block.Timestamp.AsTime())
+	if err != nil {
+		etl.logger.Error("error processing storage proof consensus", zap.Error(err))
+	}
+}
+
 func (etl *ETLService) processStorageProofConsensus(height int64, proof []byte, blockHeight int64, txHash string, blockTime time.Time) error {
 	ctx := context.Background()
 
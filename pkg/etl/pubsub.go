@@ -71,25 +71,19 @@ func (ps *Pubsub[Message]) HasSubscribers(topic string) bool {
 	return exists && len(subs) > 0
 }
 
-// Publish sends a message to all subscribers of the specified topic
+// Publish sends a message to all subscribers of the specified topic. Sends happen under
+// the read lock and never block, so Unsubscribe can't close a channel mid send and a
+// subscriber that isn't ready misses the message.
 func (ps *Pubsub[Message]) Publish(ctx context.Context, topic string, msg Message) {
 	ps.mu.RLock()
 	defer ps.mu.RUnlock()
 
-	// Helper function to send messages to a topic
-	publishToTopic := func(topic string) {
-		if subs, exists := ps.subscribers[topic]; exists {
-			for ch := range subs {
-				go func(ch chan Message) {
-					select {
-					case ch <- msg:
-						// Message sent successfully
-					default:
-						// Subscriber is not ready, drop the message
-					}
-				}(ch)
-			}
+	for ch := range ps.subscribers[topic] {
+		select {
+		case ch <- msg:
+			// Message sent successfully
+		default:
+			// Subscriber is not ready, drop the message
 		}
 	}
-	publishToTopic(topic)
 }
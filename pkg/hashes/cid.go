@@ -1,6 +1,7 @@
 package hashes
 
 import (
+	"fmt"
 	"io"
 
 	"github.com/ipfs/go-cid"
@@ -34,3 +35,32 @@ func ComputeFileCID(f io.ReadSeeker) (string, error) {
 	}
 	return cid.String(), nil
 }
+
+// FileCIDDigest returns the sha256 of the file a CID was computed from. It
+// understands the identity wrapped cids ComputeFileCID produces as well as
+// plain sha256 raw cids, dag cids don't hash the file itself so they're rejected.
+func FileCIDDigest(c string) ([]byte, error) {
+	parsed, err := cid.Decode(c)
+	if err != nil {
+		return nil, err
+	}
+
+	decoded, err := multihash.Decode(parsed.Hash())
+	if err != nil {
+		return nil, err
+	}
+
+	if decoded.Code == multihash.IDENTITY {
+		decoded, err = multihash.Decode(decoded.Digest)
+		if err != nil {
+			return nil, err
+		}
+	} else if parsed.Type() != cid.Raw {
+		return nil, fmt.Errorf("cid %s does not hash a file", c)
+	}
+
+	if decoded.Code != multihash.SHA2_256 {
+		return nil, fmt.Errorf("cid %s is not a sha256 hash", c)
+	}
+	return decoded.Digest, nil
+}
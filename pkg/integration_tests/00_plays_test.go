@@ -7,23 +7,33 @@ import (
 
 	"connectrpc.com/connect"
 	corev1 "github.com/AudiusProject/audiusd/pkg/api/core/v1"
+	storagev1 "github.com/AudiusProject/audiusd/pkg/api/storage/v1"
 	"github.com/AudiusProject/audiusd/pkg/common"
 	"github.com/AudiusProject/audiusd/pkg/integration_tests/utils"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/google/uuid"
 	protob "google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// delegatePrivateKey of audiusd-2, see dev/env/audiusd-2.env
+const contentOneDelegateKey = "21118f9a6de181061a2abd549511105adb4877cf9026f271092e6813b7cf58ab"
+
 func TestSubmitAndReadPlayThroughGRPC(t *testing.T) {
 	ctx := context.Background()
 	sdk := utils.DiscoveryOne
 
+	// plays have to be witnessed by a registered storage validator, sign as the first devnet content node
+	contentKey, err := crypto.HexToECDSA(contentOneDelegateKey)
+	if err != nil {
+		t.Fatalf("Failed to load content node key: %v", err)
+	}
+
 	listens := []*corev1.TrackPlay{
 		{
 			UserId:    uuid.NewString(),
 			TrackId:   uuid.NewString(),
 			Timestamp: timestamppb.New(time.Now()),
-			Signature: "todo: impl",
 			City:      uuid.NewString(),
 			Region:    uuid.NewString(),
 			Country:   uuid.NewString(),
@@ -32,7 +42,6 @@ func TestSubmitAndReadPlayThroughGRPC(t *testing.T) {
 			UserId:    uuid.NewString(),
 			TrackId:   uuid.NewString(),
 			Timestamp: timestamppb.New(time.Now()),
-			Signature: "todo: impl",
 			City:      uuid.NewString(),
 			Region:    uuid.NewString(),
 			Country:   uuid.NewString(),
@@ -41,18 +50,32 @@ func TestSubmitAndReadPlayThroughGRPC(t *testing.T) {
 			UserId:    uuid.NewString(),
 			TrackId:   uuid.NewString(),
 			Timestamp: timestamppb.New(time.Now()),
-			Signature: "todo: impl",
 			City:      uuid.NewString(),
 			Region:    uuid.NewString(),
 			Country:   uuid.NewString(),
 		},
 	}
 
+	for _, listen := range listens {
+		listen.Signature, _, err = common.GeneratePlaySignature(contentKey, &storagev1.StreamTrackSignatureData{
+			TrackId:   listen.TrackId,
+			Timestamp: listen.Timestamp.AsTime().Unix(),
+		})
+		if err != nil {
+			t.Fatalf("Failed to sign play: %v", err)
+		}
+	}
+
+	plays := &corev1.TrackPlays{Plays: listens}
+	playsSig, err := common.ProtoSign(contentKey, plays)
+	if err != nil {
+		t.Fatalf("Failed to sign plays: %v", err)
+	}
+
 	playEvent := &corev1.SignedTransaction{
+		Signature: playsSig,
 		Transaction: &corev1.SignedTransaction_Plays{
-			Plays: &corev1.TrackPlays{
-				Plays: listens,
-			},
+			Plays: plays,
 		},
 	}
 
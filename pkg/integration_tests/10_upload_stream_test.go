@@ -239,7 +239,8 @@ func TestUploadStream(t *testing.T) {
 		},
 	}
 
-	transaction := &corev1beta1.Transaction{Envelope: envelope}
+	transaction, err := sdk.SignEnvelope(envelope)
+	require.NoError(t, err, "failed to sign ERN envelope")
 
 	submitRes, err := sdk.Core.SendTransaction(ctx, connect.NewRequest(&corev1.SendTransactionRequest{
 		Transactionv2: transaction,
@@ -378,4 +379,43 @@ func TestUploadStream(t *testing.T) {
 	require.Error(t, err, "expired signature should be rejected")
 	require.Nil(t, expiredRes, "should not return stream URLs for expired signature")
 	t.Log("✓ Expired signature rejected")
+
+	// Test that only the original sender can take down the release
+	t.Log("\n=== Testing takedown ===")
+	require.Nil(t, sdk2.Init(ctx), "failed to initialize second SDK")
+	_, err = sdk2.TakedownRelease(ctx, ernReceipt.ErnAddress)
+	require.Error(t, err, "non-owner should not be able to take down the release")
+	t.Log("✓ Takedown by non-owner rejected")
+
+	takedown, err := sdk.TakedownRelease(ctx, ernReceipt.ErnAddress)
+	require.Nil(t, err, "failed to take down release")
+	require.Equal(t, ernReceipt.ErnAddress, takedown.ERNAddress)
+	require.ElementsMatch(t, ernReceipt.ResourceAddresses, takedown.ResourceAddresses)
+	require.ElementsMatch(t, ernReceipt.ReleaseAddresses, takedown.ReleaseAddresses)
+	t.Logf("✓ Release taken down in tx %s", takedown.TxHash)
+
+	// Taken down addresses are no longer streamable
+	for _, address := range addressesToStream {
+		takedownSigData := &corev1.GetStreamURLsSignature{
+			Addresses: []string{address},
+			ExpiresAt: timestamppb.New(streamExpiry),
+		}
+		takedownSigBytes, err := proto.Marshal(takedownSigData)
+		require.Nil(t, err, "failed to marshal stream signature data")
+
+		takedownSig, err := common.EthSign(sdk.PrivKey(), takedownSigBytes)
+		require.Nil(t, err, "failed to generate stream signature")
+
+		_, err = sdk.Core.GetStreamURLs(ctx, connect.NewRequest(&corev1.GetStreamURLsRequest{
+			Signature: takedownSig,
+			Addresses: []string{address},
+			ExpiresAt: timestamppb.New(streamExpiry),
+		}))
+		require.Error(t, err, "taken down address %s should not be streamable", address)
+		require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+	}
+	t.Log("✓ Taken down addresses rejected by GetStreamURLs")
+
+	_, err = sdk.TakedownRelease(ctx, ernReceipt.ErnAddress)
+	require.Error(t, err, "a release can only be taken down once")
 }
@@ -1,7 +1,12 @@
 package integration_tests
 
 import (
+	"bytes"
 	"context"
+	"crypto/ecdsa"
+	"encoding/binary"
+	"math/rand/v2"
+	"strings"
 	"testing"
 	"time"
 
@@ -10,7 +15,11 @@ import (
 	corev1beta1 "github.com/AudiusProject/audiusd/pkg/api/core/v1beta1"
 	ddexv1beta1 "github.com/AudiusProject/audiusd/pkg/api/ddex/v1beta1"
 	"github.com/AudiusProject/audiusd/pkg/common"
+	"github.com/AudiusProject/audiusd/pkg/hashes"
 	"github.com/AudiusProject/audiusd/pkg/integration_tests/utils"
+	auds "github.com/AudiusProject/audiusd/pkg/sdk"
+	"github.com/AudiusProject/audiusd/pkg/sdk/mediorum"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/proto"
@@ -21,6 +30,15 @@ func TestDDEX(t *testing.T) {
 	ctx := context.Background()
 	sdk := utils.DiscoveryOne
 
+	// envelopes must be signed by their sender
+	senderKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	senderAddress := common.PrivKeyToAddress(senderKey)
+
+	// MEAD and PIE messages enrich the entities of the ERN released first
+	var releasedERN *ddexv1beta1.NewReleaseMessage
+	var releasedAck *ddexv1beta1.NewReleaseMessageAck
+
 	// Wait for the node to be ready once for all subtests
 	t.Run("NodeReady", func(t *testing.T) {
 		timeout := time.After(30 * time.Second)
@@ -40,16 +58,23 @@ func TestDDEX(t *testing.T) {
 		}
 	})
 
+	// every sound recording the sender releases must be delivered as one of its uploads
+	var deliveryCID string
+	t.Run("UploadDeliveryFile", func(t *testing.T) {
+		deliveryCID = uploadDeliveryFile(t, ctx, senderKey)
+		require.NotEmpty(t, deliveryCID)
+	})
+
 	// Test individual message submissions
 	t.Run("ERNNewMessage", func(t *testing.T) {
 		// Create ERN NewReleaseMessage based on fake band data
-		ernMessage := createFakeBandERNMessage()
+		ernMessage := createFakeBandERNMessage(deliveryCID)
 
 		// Create transaction envelope
 		envelope := &corev1beta1.Envelope{
 			Header: &corev1beta1.EnvelopeHeader{
 				ChainId:    "audius-devnet",
-				From:       "PADPIDA2024010501X",
+				From:       senderAddress,
 				To:         "PADPIDA202401120D9",
 				Nonce:      "1",
 				Expiration: time.Now().Add(time.Hour).Unix(),
@@ -63,8 +88,12 @@ func TestDDEX(t *testing.T) {
 			},
 		}
 
+		signature, err := common.SignEnvelope(senderKey, corev1beta1.Signature_SIGNATURE_TYPE_PERSONAL, envelope)
+		require.NoError(t, err)
+
 		transaction := &corev1beta1.Transaction{
-			Envelope: envelope,
+			Signature: signature,
+			Envelope:  envelope,
 		}
 
 		// Calculate expected transaction hash
@@ -97,6 +126,10 @@ func TestDDEX(t *testing.T) {
 				t.Logf("ERN transaction %s successfully submitted and retrieved", txhash)
 			}
 
+			releasedERN = ernMessage
+			releasedAck = submitRes.Msg.TransactionReceipt.MessageReceipts[0].GetErnAck()
+			require.NotNil(t, releasedAck)
+
 			t.Logf("✓ ERN transaction %s successfully processed with %d parties, %d resources, %d releases, %d deals - representing 'Live - Electric Nights' album (2h43m of live music)",
 				txhash, len(ernMessage.PartyList), len(ernMessage.ResourceList), len(ernMessage.ReleaseList), len(ernMessage.DealList))
 		} else if submitRes.Msg.Transaction != nil {
@@ -111,13 +144,13 @@ func TestDDEX(t *testing.T) {
 
 	t.Run("MEADNewMessage", func(t *testing.T) {
 		// Create MEAD message based on fake band data
-		meadMessage := createFakeBandMEADMessage()
+		meadMessage := createFakeBandMEADMessage(releasedERN, releasedAck)
 
 		// Create transaction envelope
 		envelope := &corev1beta1.Envelope{
 			Header: &corev1beta1.EnvelopeHeader{
 				ChainId:    "audius-devnet",
-				From:       "PADPIDA2024010501X",
+				From:       senderAddress,
 				To:         "PADPIDA202401120D9",
 				Nonce:      "2",
 				Expiration: time.Now().Add(time.Hour).Unix(),
@@ -131,8 +164,12 @@ func TestDDEX(t *testing.T) {
 			},
 		}
 
+		signature, err := common.SignEnvelope(senderKey, corev1beta1.Signature_SIGNATURE_TYPE_PERSONAL, envelope)
+		require.NoError(t, err)
+
 		transaction := &corev1beta1.Transaction{
-			Envelope: envelope,
+			Signature: signature,
+			Envelope:  envelope,
 		}
 
 		// Calculate expected transaction hash
@@ -179,13 +216,13 @@ func TestDDEX(t *testing.T) {
 
 	t.Run("PIENewMessage", func(t *testing.T) {
 		// Create PIE message based on fake band data
-		pieMessage := createFakeBandPIEMessage()
+		pieMessage := createFakeBandPIEMessage(releasedERN, releasedAck)
 
 		// Create transaction envelope
 		envelope := &corev1beta1.Envelope{
 			Header: &corev1beta1.EnvelopeHeader{
 				ChainId:    "audius-devnet",
-				From:       "PADPIDA2024010501X",
+				From:       senderAddress,
 				To:         "PADPIDA202401120D9",
 				Nonce:      "3",
 				Expiration: time.Now().Add(time.Hour).Unix(),
@@ -199,8 +236,12 @@ func TestDDEX(t *testing.T) {
 			},
 		}
 
+		signature, err := common.SignEnvelope(senderKey, corev1beta1.Signature_SIGNATURE_TYPE_PERSONAL, envelope)
+		require.NoError(t, err)
+
 		transaction := &corev1beta1.Transaction{
-			Envelope: envelope,
+			Signature: signature,
+			Envelope:  envelope,
 		}
 
 		// Calculate expected transaction hash
@@ -245,17 +286,69 @@ func TestDDEX(t *testing.T) {
 		}
 	})
 
+	t.Run("DelegatedEnrichment", func(t *testing.T) {
+		delegateKey, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		delegateAddress := common.PrivKeyToAddress(delegateKey)
+
+		send := func(key *ecdsa.PrivateKey, from, nonce string, msg *corev1beta1.Message) (*corev1.SendTransactionResponse, error) {
+			envelope := &corev1beta1.Envelope{
+				Header: &corev1beta1.EnvelopeHeader{
+					ChainId:    "audius-devnet",
+					From:       from,
+					Nonce:      nonce,
+					Expiration: time.Now().Add(time.Hour).Unix(),
+				},
+				Messages: []*corev1beta1.Message{msg},
+			}
+			signature, err := common.SignEnvelope(key, corev1beta1.Signature_SIGNATURE_TYPE_PERSONAL, envelope)
+			require.NoError(t, err)
+			res, err := sdk.Core.SendTransaction(ctx, connect.NewRequest(&corev1.SendTransactionRequest{
+				Transactionv2: &corev1beta1.Transaction{Signature: signature, Envelope: envelope},
+			}))
+			if err != nil {
+				return nil, err
+			}
+			return res.Msg, nil
+		}
+
+		mead := &corev1beta1.Message{Message: &corev1beta1.Message_Mead{Mead: createFakeBandMEADMessage(releasedERN, releasedAck)}}
+
+		// only the ERN sender may enrich its entities until it names a delegate
+		_, err = send(delegateKey, delegateAddress, "delegated-mead-1", mead)
+		require.Error(t, err)
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+		delegation, err := send(senderKey, senderAddress, "delegate", &corev1beta1.Message{
+			Message: &corev1beta1.Message_Delegation{Delegation: &corev1beta1.DelegationMessage{Delegate: delegateAddress}},
+		})
+		require.NoError(t, err)
+		require.NotNil(t, delegation.TransactionReceipt.MessageReceipts[0].GetDelegationAck())
+
+		_, err = send(delegateKey, delegateAddress, "delegated-mead-2", mead)
+		require.NoError(t, err)
+
+		enrichment, err := sdk.Core.GetEnrichment(ctx, connect.NewRequest(&corev1.GetEnrichmentRequest{
+			Address: ernEntityAddress(releasedERN, releasedAck, "A1"),
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, "resource", enrichment.Msg.EntityType)
+		assert.Equal(t, "Energetic", enrichment.Msg.GetResource().GetResourceSummary().GetMood().GetType())
+		require.NotEmpty(t, enrichment.Msg.Sources)
+		assert.True(t, strings.EqualFold(delegateAddress, enrichment.Msg.Sources[len(enrichment.Msg.Sources)-1].Sender))
+	})
+
 	t.Run("MultiMessageTransaction", func(t *testing.T) {
 		// Create all three message types
-		ernMessage := createFakeBandERNMessage()
-		meadMessage := createFakeBandMEADMessage()
-		pieMessage := createFakeBandPIEMessage()
+		ernMessage := createFakeBandERNMessage(deliveryCID)
+		meadMessage := createFakeBandMEADMessage(releasedERN, releasedAck)
+		pieMessage := createFakeBandPIEMessage(releasedERN, releasedAck)
 
 		// Create transaction envelope with multiple messages
 		envelope := &corev1beta1.Envelope{
 			Header: &corev1beta1.EnvelopeHeader{
 				ChainId:    "audius-devnet",
-				From:       "PADPIDA2024010501X",
+				From:       senderAddress,
 				To:         "PADPIDA202401120D9",
 				Nonce:      "4",
 				Expiration: time.Now().Add(time.Hour).Unix(),
@@ -279,8 +372,12 @@ func TestDDEX(t *testing.T) {
 			},
 		}
 
+		signature, err := common.SignEnvelope(senderKey, corev1beta1.Signature_SIGNATURE_TYPE_PERSONAL, envelope)
+		require.NoError(t, err)
+
 		transaction := &corev1beta1.Transaction{
-			Envelope: envelope,
+			Signature: signature,
+			Envelope:  envelope,
 		}
 
 		// Calculate expected transaction hash
@@ -331,15 +428,15 @@ func TestDDEX(t *testing.T) {
 	// Test getter methods
 	t.Run("GetterMethods", func(t *testing.T) {
 		// Create all three message types for submission
-		ernMessage := createFakeBandERNMessage()
-		meadMessage := createFakeBandMEADMessage()
-		pieMessage := createFakeBandPIEMessage()
+		ernMessage := createFakeBandERNMessage(deliveryCID)
+		meadMessage := createFakeBandMEADMessage(releasedERN, releasedAck)
+		pieMessage := createFakeBandPIEMessage(releasedERN, releasedAck)
 
 		// Submit all messages in a single transaction for getter testing
 		envelope := &corev1beta1.Envelope{
 			Header: &corev1beta1.EnvelopeHeader{
 				ChainId:    "audius-devnet",
-				From:       "PADPIDA2024010501X",
+				From:       senderAddress,
 				To:         "PADPIDA202401120D9",
 				Nonce:      "5",
 				Expiration: time.Now().Add(time.Hour).Unix(),
@@ -363,8 +460,12 @@ func TestDDEX(t *testing.T) {
 			},
 		}
 
+		signature, err := common.SignEnvelope(senderKey, corev1beta1.Signature_SIGNATURE_TYPE_PERSONAL, envelope)
+		require.NoError(t, err)
+
 		transaction := &corev1beta1.Transaction{
-			Envelope: envelope,
+			Signature: signature,
+			Envelope:  envelope,
 		}
 
 		// Submit the transaction
@@ -434,8 +535,61 @@ func TestDDEX(t *testing.T) {
 	})
 }
 
-// createFakeBandERNMessage creates an ERN message based on fake band data
-func createFakeBandERNMessage() *ddexv1beta1.NewReleaseMessage {
+// noiseWAV is a second of mono 16 bit noise, different every call so its upload is never
+// one another test already made
+func noiseWAV() []byte {
+	const sampleRate = 44100
+	samples := make([]int16, sampleRate)
+	for i := range samples {
+		samples[i] = int16(rand.IntN(1<<16) - 1<<15)
+	}
+
+	buf := &bytes.Buffer{}
+	dataSize := uint32(len(samples) * 2)
+	buf.WriteString("RIFF")
+	binary.Write(buf, binary.LittleEndian, 36+dataSize)
+	buf.WriteString("WAVEfmt ")
+	// pcm, one channel, 16 bits per sample
+	for _, field := range []any{uint32(16), uint16(1), uint16(1), uint32(sampleRate), uint32(sampleRate * 2), uint16(2), uint16(16)} {
+		binary.Write(buf, binary.LittleEndian, field)
+	}
+	buf.WriteString("data")
+	binary.Write(buf, binary.LittleEndian, dataSize)
+	binary.Write(buf, binary.LittleEndian, samples)
+	return buf.Bytes()
+}
+
+// uploadDeliveryFile uploads audio as key so releases sent by key can deliver it, returning
+// the transcoded CID
+func uploadDeliveryFile(t *testing.T, ctx context.Context, key *ecdsa.PrivateKey) string {
+	storage := auds.NewAudiusdSDK("node3.audiusd.devnet")
+	storage.SetPrivKey(key)
+
+	audio := bytes.NewReader(noiseWAV())
+	fileCID, err := hashes.ComputeFileCID(audio)
+	require.NoError(t, err)
+
+	sigBytes, err := proto.Marshal(&corev1.UploadSignature{Cid: fileCID})
+	require.NoError(t, err)
+	signature, err := common.EthSign(key, sigBytes)
+	require.NoError(t, err)
+
+	uploads, err := storage.Mediorum.UploadFile(ctx, audio, "noise.wav", &mediorum.UploadOptions{
+		Template:          "audio",
+		Signature:         signature,
+		WaitForTranscode:  true,
+		WaitForFileUpload: true,
+		OriginalCID:       fileCID,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, uploads)
+	require.Equal(t, "done", uploads[0].Status, "upload failed: %s", uploads[0].Error)
+	return uploads[0].GetTranscodedCID()
+}
+
+// createFakeBandERNMessage creates an ERN message based on fake band data, every sound
+// recording is delivered as deliveryCID
+func createFakeBandERNMessage(deliveryCID string) *ddexv1beta1.NewReleaseMessage {
 	// Create message header based on fake XML MessageHeader
 	messageHeader := &ddexv1beta1.MessageHeader{
 		MessageThreadId: stringPtr("F0100045091829_ADS"),
@@ -818,6 +972,27 @@ func createFakeBandERNMessage() *ddexv1beta1.NewReleaseMessage {
 		},
 	}
 
+	for _, resource := range resources {
+		if sr := resource.GetSoundRecording(); sr != nil {
+			sr.SoundRecordingEdition = &ddexv1beta1.Resource_SoundRecording_SoundRecordingEdition{
+				TechnicalDetails: &ddexv1beta1.Resource_SoundRecording_SoundRecordingEdition_TechnicalDetails{
+					TechnicalResourceDetailsReference: "T" + sr.ResourceReference,
+					DeliveryFile: &ddexv1beta1.Resource_SoundRecording_SoundRecordingEdition_TechnicalDetails_DeliveryFile{
+						Type:                 "AudioFile",
+						IsProvidedInDelivery: true,
+						File: &ddexv1beta1.Resource_SoundRecording_SoundRecordingEdition_TechnicalDetails_DeliveryFile_File{
+							Uri: deliveryCID,
+							HashSum: &ddexv1beta1.Resource_SoundRecording_SoundRecordingEdition_TechnicalDetails_DeliveryFile_File_HashSum{
+								Algorithm:    "IPFS",
+								HashSumValue: deliveryCID,
+							},
+						},
+					},
+				},
+			}
+		}
+	}
+
 	// Release information - fake album data
 	// Main Album: "Live - Electric Nights" (R0) - 2h43m total, Rock genre
 	// Individual track releases: R1-R44+ (each track as separate release)
@@ -879,8 +1054,28 @@ func stringPtr(s string) *string {
 	return &s
 }
 
+// ernEntityAddress finds the chain address an ERN receipt gave the entity with ref
+func ernEntityAddress(ern *ddexv1beta1.NewReleaseMessage, ack *ddexv1beta1.NewReleaseMessageAck, ref string) string {
+	for i, party := range ern.PartyList {
+		if party.PartyReference == ref {
+			return ack.PartyAddresses[i]
+		}
+	}
+	for i, resource := range ern.ResourceList {
+		if resource.GetSoundRecording().GetResourceReference() == ref || resource.GetImage().GetResourceReference() == ref {
+			return ack.ResourceAddresses[i]
+		}
+	}
+	for i, release := range ern.ReleaseList {
+		if release.GetMainRelease().GetReleaseReference() == ref || release.GetTrackRelease().GetReleaseReference() == ref {
+			return ack.ReleaseAddresses[i]
+		}
+	}
+	return ""
+}
+
 // createFakeBandMEADMessage creates a MEAD message with enrichment data for fake band resources and releases
-func createFakeBandMEADMessage() *ddexv1beta1.MeadMessage {
+func createFakeBandMEADMessage(ern *ddexv1beta1.NewReleaseMessage, ack *ddexv1beta1.NewReleaseMessageAck) *ddexv1beta1.MeadMessage {
 	// Create message header
 	messageHeader := &ddexv1beta1.MessageHeader{
 		MessageThreadId: stringPtr("F0100045091829_MEAD"),
@@ -919,6 +1114,10 @@ func createFakeBandMEADMessage() *ddexv1beta1.MeadMessage {
 								Namespace:     "AUDIUS",
 								ProprietaryId: "res_midnight_express",
 							},
+							{
+								Namespace:     common.OAPNamespace,
+								ProprietaryId: ernEntityAddress(ern, ack, "A1"),
+							},
 						},
 					},
 					DisplayTitle: &ddexv1beta1.Resource_DisplayTitle{
@@ -960,6 +1159,10 @@ func createFakeBandMEADMessage() *ddexv1beta1.MeadMessage {
 								Namespace:     "AUDIUS",
 								ProprietaryId: "res_electric_storm",
 							},
+							{
+								Namespace:     common.OAPNamespace,
+								ProprietaryId: ernEntityAddress(ern, ack, "A2"),
+							},
 						},
 					},
 					DisplayTitle: &ddexv1beta1.Resource_DisplayTitle{
@@ -994,6 +1197,12 @@ func createFakeBandMEADMessage() *ddexv1beta1.MeadMessage {
 						Grid:            "F10301F00045091829",
 						Icpn:            "123456789012",
 						CatalogueNumber: "F0100045091829",
+						ProprietaryId: []*ddexv1beta1.Release_ProprietaryId{
+							{
+								Namespace: common.OAPNamespace,
+								Id:        ernEntityAddress(ern, ack, "R0"),
+							},
+						},
 					},
 					DisplayTitle: &ddexv1beta1.Release_DisplayTitle{
 						TitleText: "Live - Electric Nights",
@@ -1026,7 +1235,7 @@ func createFakeBandMEADMessage() *ddexv1beta1.MeadMessage {
 }
 
 // createFakeBandPIEMessage creates a PIE message with party enrichment data for fake band members
-func createFakeBandPIEMessage() *ddexv1beta1.PieMessage {
+func createFakeBandPIEMessage(ern *ddexv1beta1.NewReleaseMessage, ack *ddexv1beta1.NewReleaseMessageAck) *ddexv1beta1.PieMessage {
 	// Create message header
 	messageHeader := &ddexv1beta1.MessageHeader{
 		MessageThreadId: stringPtr("F0100045091829_PIE"),
@@ -1060,6 +1269,9 @@ func createFakeBandPIEMessage() *ddexv1beta1.PieMessage {
 				PartyReference: "P_ARTIST_1199281",
 				PartyId: &ddexv1beta1.Party_PartyId{
 					Dpid: "BAND_ELECTRIC_RIDERS_001",
+					ProprietaryIds: []*ddexv1beta1.Party_ProprietaryId{
+						{Namespace: common.OAPNamespace, Id: ernEntityAddress(ern, ack, "P_ARTIST_1199281")},
+					},
 				},
 				PartyName: []*ddexv1beta1.Party_PartyName{{
 					FullName: "The Electric Riders",
@@ -1091,6 +1303,9 @@ func createFakeBandPIEMessage() *ddexv1beta1.PieMessage {
 				PartyReference: "P_ARTIST_4729799",
 				PartyId: &ddexv1beta1.Party_PartyId{
 					Dpid: "ARTIST_MARCUS_STONE_001",
+					ProprietaryIds: []*ddexv1beta1.Party_ProprietaryId{
+						{Namespace: common.OAPNamespace, Id: ernEntityAddress(ern, ack, "P_ARTIST_4729799")},
+					},
 				},
 				PartyName: []*ddexv1beta1.Party_PartyName{{
 					FullName: "Marcus Stone",
@@ -1103,6 +1318,9 @@ func createFakeBandPIEMessage() *ddexv1beta1.PieMessage {
 				PartyReference: "P_LABEL_HARMONY_RECORDS",
 				PartyId: &ddexv1beta1.Party_PartyId{
 					Dpid: "LABEL_HARMONY_001",
+					ProprietaryIds: []*ddexv1beta1.Party_ProprietaryId{
+						{Namespace: common.OAPNamespace, Id: ernEntityAddress(ern, ack, "P_LABEL_HARMONY_RECORDS")},
+					},
 				},
 				PartyName: []*ddexv1beta1.Party_PartyName{{
 					FullName: "Harmony Records Legacy",
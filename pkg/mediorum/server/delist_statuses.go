@@ -300,3 +300,15 @@ func (ss *MediorumServer) isCidBlacklisted(ctx context.Context, cid string) bool
 	}
 	return blacklisted
 }
+
+// isCidTakenDown checks core for an on-chain ERN takedown covering the cid
+func (ss *MediorumServer) isCidTakenDown(ctx context.Context, cid string) bool {
+	if ss.core == nil {
+		return false
+	}
+	takenDown, err := ss.core.IsCIDTakenDown(ctx, cid)
+	if err != nil {
+		ss.logger.Error("isCidTakenDown error", zap.Error(err), zap.String("cid", cid))
+	}
+	return takenDown
+}
@@ -8,7 +8,8 @@ import (
 
 	"connectrpc.com/connect"
 	v1 "github.com/AudiusProject/audiusd/pkg/api/core/v1"
-	"github.com/AudiusProject/audiusd/pkg/mediorum/server/signature"
+	storagev1 "github.com/AudiusProject/audiusd/pkg/api/storage/v1"
+	"github.com/AudiusProject/audiusd/pkg/common"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -130,8 +131,8 @@ func (ss *MediorumServer) processPlayRecordBatch(ctx context.Context) error {
 		Plays: corePlays,
 	}
 
-	// sign plays event payload with mediorum priv key
-	signedPlaysEvent, err := signature.SignCoreBytes(playsTx, ss.Config.privateKey)
+	// sign plays event payload with mediorum priv key, core checks the signer is a registered validator
+	signedPlaysEvent, err := common.ProtoSign(ss.Config.privateKey, playsTx)
 	if err != nil {
 		ss.logger.Error("core error signing plays proto event", zap.Error(err))
 		return err
@@ -171,3 +172,12 @@ func (ss *MediorumServer) processPlayRecordBatch(ctx context.Context) error {
 	ss.logger.Info("core plays recorded", zap.Int("plays", len(corePlays)), zap.String("tx", res.Msg.Transaction.Hash))
 	return nil
 }
+
+// signPlay signs a play the way core verifies it, see common.RecoverPlaySignature
+func (ss *MediorumServer) signPlay(trackID string, playTime time.Time) (string, error) {
+	sig, _, err := common.GeneratePlaySignature(ss.Config.privateKey, &storagev1.StreamTrackSignatureData{
+		TrackId:   trackID,
+		Timestamp: playTime.Unix(),
+	})
+	return sig, err
+}
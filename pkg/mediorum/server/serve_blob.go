@@ -77,6 +77,11 @@ func (ss *MediorumServer) ensureNotDelisted(next echo.HandlerFunc) echo.HandlerF
 			return c.String(403, "cid is blacklisted by this node")
 		}
 
+		if ss.isCidTakenDown(ctx, key) {
+			ss.logger.Debug("cid is taken down", zap.String("cid", key))
+			return c.String(403, "cid has been taken down")
+		}
+
 		c.Set("checkedDelistStatus", true)
 		return next(c)
 	}
@@ -311,32 +316,26 @@ func (ss *MediorumServer) logTrackListen(c echo.Context) {
 		userId = strconv.Itoa(sig.Data.UserID)
 	}
 
-	signatureData, err := signature.GenerateListenTimestampAndSignature(ss.Config.privateKey)
+	geoData, err := ss.getGeoFromIP(c.RealIP())
 	if err != nil {
-		ss.logger.Error("unable to build request", zap.Error(err))
+		ss.logger.Error("core plays bad ip", zap.Error(err))
 		return
 	}
 
-	// parse out time as proto object from legacy listen sig
-	parsedTime, err := time.Parse(time.RFC3339, signatureData.Timestamp)
-	if err != nil {
-		ss.logger.Error("core error parsing time:", zap.Error(err))
-		return
-	}
+	trackID := fmt.Sprint(sig.Data.TrackId)
+	playTime := time.Now().UTC().Truncate(time.Second)
 
-	geoData, err := ss.getGeoFromIP(c.RealIP())
+	playSignature, err := ss.signPlay(trackID, playTime)
 	if err != nil {
-		ss.logger.Error("core plays bad ip", zap.Error(err))
+		ss.logger.Error("unable to sign play", zap.Error(err))
 		return
 	}
 
-	trackID := fmt.Sprint(sig.Data.TrackId)
-
 	ss.playEventQueue.pushPlayEvent(&PlayEvent{
 		UserID:           userId,
 		TrackID:          trackID,
-		PlayTime:         parsedTime,
-		Signature:        signatureData.Signature,
+		PlayTime:         playTime,
+		Signature:        playSignature,
 		City:             geoData.City,
 		Country:          geoData.Country,
 		Region:           geoData.Region,
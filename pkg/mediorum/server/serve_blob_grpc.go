@@ -10,7 +10,6 @@ import (
 	v1storage "github.com/AudiusProject/audiusd/pkg/api/storage/v1"
 	"github.com/AudiusProject/audiusd/pkg/common"
 	"github.com/AudiusProject/audiusd/pkg/mediorum/cidutil"
-	"github.com/AudiusProject/audiusd/pkg/mediorum/server/signature"
 	"go.uber.org/zap"
 	"gocloud.dev/gcerrors"
 )
@@ -73,12 +72,6 @@ func (s *MediorumServer) streamTrackGRPC(ctx context.Context, req *v1storage.Str
 
 	go func() {
 		// record play event to chain
-		signatureData, err := signature.GenerateListenTimestampAndSignature(s.Config.privateKey)
-		if err != nil {
-			s.logger.Error("unable to build request", zap.Error(err))
-			return
-		}
-
 		ip := common.GetClientIP(ctx)
 		geoData, err := s.getGeoFromIP(ip)
 		if err != nil {
@@ -86,17 +79,18 @@ func (s *MediorumServer) streamTrackGRPC(ctx context.Context, req *v1storage.Str
 			return
 		}
 
-		parsedTime, err := time.Parse(time.RFC3339, signatureData.Timestamp)
+		playTime := time.Now().UTC().Truncate(time.Second)
+		playSignature, err := s.signPlay(reqSig.Data.TrackId, playTime)
 		if err != nil {
-			s.logger.Error("core error parsing time", zap.Error(err))
+			s.logger.Error("unable to sign play", zap.Error(err))
 			return
 		}
 
 		s.playEventQueue.pushPlayEvent(&PlayEvent{
 			UserID:           ethAddress,
 			TrackID:          reqSig.Data.TrackId,
-			PlayTime:         parsedTime,
-			Signature:        signatureData.Signature,
+			PlayTime:         playTime,
+			Signature:        playSignature,
 			City:             geoData.City,
 			Country:          geoData.Country,
 			Region:           geoData.Region,
@@ -0,0 +1,44 @@
+package pubsub
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublishWhileUnsubscribing(t *testing.T) {
+	ps := NewPubsub[int]()
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(2)
+		ch := ps.Subscribe("topic")
+		go func() {
+			defer wg.Done()
+			ps.Publish(ctx, "topic", i)
+		}()
+		go func() {
+			defer wg.Done()
+			ps.Unsubscribe("topic", ch)
+		}()
+	}
+	wg.Wait()
+
+	require.NotContains(t, ps.subscribers, "topic")
+}
+
+func TestPublishDropsForSlowSubscribers(t *testing.T) {
+	ps := NewPubsub[int]()
+	ch := ps.Subscribe("topic")
+	defer ps.Unsubscribe("topic", ch)
+
+	ps.Publish(context.Background(), "topic", 1)
+	ps.Publish(context.Background(), "topic", 2)
+
+	require.Equal(t, 1, <-ch)
+	// the second message found the buffer full
+	require.Empty(t, ch)
+}
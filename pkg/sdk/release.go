@@ -3,6 +3,7 @@ package sdk
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 	corev1beta1 "github.com/AudiusProject/audiusd/pkg/api/core/v1beta1"
 	ddexv1beta1 "github.com/AudiusProject/audiusd/pkg/api/ddex/v1beta1"
 	"github.com/AudiusProject/audiusd/pkg/common"
+	"github.com/AudiusProject/audiusd/pkg/ddex"
 	"github.com/AudiusProject/audiusd/pkg/hashes"
 	"github.com/AudiusProject/audiusd/pkg/sdk/mediorum"
 	"google.golang.org/protobuf/proto"
@@ -104,7 +106,10 @@ func (s *AudiusdSDK) UploadAndRelease(
 		},
 	}
 
-	transaction := &corev1beta1.Transaction{Envelope: envelope}
+	transaction, err := s.SignEnvelope(envelope)
+	if err != nil {
+		return nil, err
+	}
 
 	submitRes, err := s.Core.SendTransaction(ctx, connect.NewRequest(&corev1.SendTransactionRequest{
 		Transactionv2: transaction,
@@ -130,3 +135,166 @@ func (s *AudiusdSDK) UploadAndRelease(
 
 	return result, nil
 }
+
+// ParseERNXML reads an ERN 4.x XML delivery into the NewReleaseMessage UploadAndRelease
+// takes, errors are ddex.ValidationErrors that point at the offending elements
+func (s *AudiusdSDK) ParseERNXML(data []byte) (*ddexv1beta1.NewReleaseMessage, error) {
+	return ddex.ParseERN(data)
+}
+
+// GetERNXML renders the latest version of an ERN as ERN 4.3 XML addressed to
+// the recipient's DPID, entity chain addresses are included as OAP proprietary ids
+func (s *AudiusdSDK) GetERNXML(ctx context.Context, ernAddress, recipientPartyID, recipientName string) ([]byte, error) {
+	res, err := s.Core.GetERNXML(ctx, connect.NewRequest(&corev1.GetERNXMLRequest{
+		Address:          ernAddress,
+		RecipientPartyId: recipientPartyID,
+		RecipientName:    recipientName,
+	}))
+	if err != nil {
+		return nil, err
+	}
+	return res.Msg.Xml, nil
+}
+
+// GetERNHistory lists every message finalized for an ERN, pass two version numbers to
+// also get what changed between them or zero for both to skip the diff
+func (s *AudiusdSDK) GetERNHistory(ctx context.Context, ernAddress string, diffFrom, diffTo uint32) (*corev1.GetERNHistoryResponse, error) {
+	res, err := s.Core.GetERNHistory(ctx, connect.NewRequest(&corev1.GetERNHistoryRequest{
+		Address:         ernAddress,
+		DiffFromVersion: diffFrom,
+		DiffToVersion:   diffTo,
+	}))
+	if err != nil {
+		return nil, err
+	}
+	return res.Msg, nil
+}
+
+type TakedownReleaseResult struct {
+	ERNAddress        string
+	ResourceAddresses []string
+	ReleaseAddresses  []string
+	DealAddresses     []string
+
+	// Transaction hash
+	TxHash string
+}
+
+// TakedownRelease sends a DDEX takedown for an ERN this signer originally released,
+// the ERN and all of its releases, resources and deals stop streaming once it's finalized
+func (s *AudiusdSDK) TakedownRelease(ctx context.Context, ernAddress string) (*TakedownReleaseResult, error) {
+	if s.privKey == nil {
+		return nil, errors.New("private key not set")
+	}
+
+	envelope := &corev1beta1.Envelope{
+		Header: &corev1beta1.EnvelopeHeader{
+			ChainId:    s.ChainID(),
+			From:       s.Address(),
+			To:         ernAddress,
+			Nonce:      "takedown:" + ernAddress, // an ERN can only be taken down once
+			Expiration: time.Now().Add(time.Hour).Unix(),
+		},
+		Messages: []*corev1beta1.Message{
+			{
+				Message: &corev1beta1.Message_Ern{
+					Ern: &ddexv1beta1.NewReleaseMessage{
+						MessageHeader: &ddexv1beta1.MessageHeader{
+							MessageControlType: ddexv1beta1.MessageControlType_MESSAGE_CONTROL_TYPE_TAKEDOWN_MESSAGE.Enum(),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	transaction, err := s.SignEnvelope(envelope)
+	if err != nil {
+		return nil, err
+	}
+
+	submitRes, err := s.Core.SendTransaction(ctx, connect.NewRequest(&corev1.SendTransactionRequest{
+		Transactionv2: transaction,
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	if txErr := submitRes.Msg.TransactionReceipt.GetError(); txErr != nil {
+		return nil, fmt.Errorf("takedown failed: %s", txErr.GetMessage())
+	}
+
+	receipts := submitRes.Msg.TransactionReceipt.GetMessageReceipts()
+	if len(receipts) == 0 || receipts[0].GetErnAck() == nil {
+		return nil, errors.New("failed to get ERN takedown receipt")
+	}
+	ernReceipt := receipts[0].GetErnAck()
+
+	return &TakedownReleaseResult{
+		ERNAddress:        ernReceipt.ErnAddress,
+		ResourceAddresses: ernReceipt.ResourceAddresses,
+		ReleaseAddresses:  ernReceipt.ReleaseAddresses,
+		DealAddresses:     ernReceipt.DealAddresses,
+		TxHash:            submitRes.Msg.TransactionReceipt.TxHash,
+	}, nil
+}
+
+// AuthorizeDelegate lets delegate act for this signer on its releases, enriching them with
+// MEAD and PIE messages and uploading the files they stream. Returns the transaction hash.
+func (s *AudiusdSDK) AuthorizeDelegate(ctx context.Context, delegate string) (string, error) {
+	return s.sendDelegation(ctx, &corev1beta1.DelegationMessage{Delegate: delegate})
+}
+
+// RevokeDelegate withdraws an earlier AuthorizeDelegate. Returns the transaction hash.
+func (s *AudiusdSDK) RevokeDelegate(ctx context.Context, delegate string) (string, error) {
+	return s.sendDelegation(ctx, &corev1beta1.DelegationMessage{Delegate: delegate, Revoke: true})
+}
+
+func (s *AudiusdSDK) sendDelegation(ctx context.Context, delegation *corev1beta1.DelegationMessage) (string, error) {
+	if s.privKey == nil {
+		return "", errors.New("private key not set")
+	}
+
+	envelope := &corev1beta1.Envelope{
+		Header: &corev1beta1.EnvelopeHeader{
+			ChainId:    s.ChainID(),
+			From:       s.Address(),
+			To:         delegation.Delegate,
+			Nonce:      fmt.Sprintf("delegation:%d", time.Now().UnixNano()),
+			Expiration: time.Now().Add(time.Hour).Unix(),
+		},
+		Messages: []*corev1beta1.Message{
+			{
+				Message: &corev1beta1.Message_Delegation{
+					Delegation: delegation,
+				},
+			},
+		},
+	}
+
+	transaction, err := s.SignEnvelope(envelope)
+	if err != nil {
+		return "", err
+	}
+
+	submitRes, err := s.Core.SendTransaction(ctx, connect.NewRequest(&corev1.SendTransactionRequest{
+		Transactionv2: transaction,
+	}))
+	if err != nil {
+		return "", err
+	}
+
+	if txErr := submitRes.Msg.TransactionReceipt.GetError(); txErr != nil {
+		return "", fmt.Errorf("delegation failed: %s", txErr.GetMessage())
+	}
+	return submitRes.Msg.TransactionReceipt.GetTxHash(), nil
+}
+
+// GetEnrichment merges every MEAD or PIE enrichment of a resource, release or party address
+func (s *AudiusdSDK) GetEnrichment(ctx context.Context, address string) (*corev1.GetEnrichmentResponse, error) {
+	res, err := s.Core.GetEnrichment(ctx, connect.NewRequest(&corev1.GetEnrichmentRequest{Address: address}))
+	if err != nil {
+		return nil, err
+	}
+	return res.Msg, nil
+}
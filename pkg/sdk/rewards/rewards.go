@@ -136,3 +136,18 @@ func (r *Rewards) GetRewardAttestation(ctx context.Context, req *v1.GetRewardAtt
 	}
 	return resp.Msg, nil
 }
+
+// GetRewardClaims lists the reward claims validators attested to for a recipient
+func (r *Rewards) GetRewardClaims(ctx context.Context, ethRecipientAddress string) (*v1.GetRewardClaimsResponse, error) {
+	if ethRecipientAddress == "" {
+		return nil, errors.New("eth_recipient_address required")
+	}
+	req := connect.NewRequest(&v1.GetRewardClaimsRequest{
+		EthRecipientAddress: ethRecipientAddress,
+	})
+	resp, err := r.core.GetRewardClaims(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
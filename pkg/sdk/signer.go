@@ -4,6 +4,7 @@ import (
 	"crypto/ecdsa"
 	"errors"
 
+	corev1beta1 "github.com/AudiusProject/audiusd/pkg/api/core/v1beta1"
 	"github.com/AudiusProject/audiusd/pkg/common"
 	"github.com/ethereum/go-ethereum/crypto"
 )
@@ -36,6 +37,24 @@ func (s *AudiusdSDK) Sign(msg []byte) (string, error) {
 	return signature, nil
 }
 
+// SignEnvelope wraps a v1beta1 envelope in a transaction signed by the sdk's key,
+// the envelope header's from must be this sdk's address
+func (s *AudiusdSDK) SignEnvelope(envelope *corev1beta1.Envelope) (*corev1beta1.Transaction, error) {
+	if s.privKey == nil {
+		return nil, errors.New("private key not set")
+	}
+
+	signature, err := common.SignEnvelope(s.privKey, corev1beta1.Signature_SIGNATURE_TYPE_PERSONAL, envelope)
+	if err != nil {
+		return nil, err
+	}
+
+	return &corev1beta1.Transaction{
+		Signature: signature,
+		Envelope:  envelope,
+	}, nil
+}
+
 func (s *AudiusdSDK) RecoverSigner(msg []byte, signature string) (string, error) {
 	_, address, err := common.EthRecover(signature, msg)
 	if err != nil {